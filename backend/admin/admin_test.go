@@ -2,23 +2,34 @@ package admin
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/alecthomas/assert/v2"
 	"github.com/alecthomas/types/optional"
 
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/configuration"
 	"github.com/block/ftl/internal/configuration/manager"
 	"github.com/block/ftl/internal/configuration/providers"
 	"github.com/block/ftl/internal/configuration/routers"
 	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/rpc"
 )
 
 func TestAdminService(t *testing.T) {
@@ -222,6 +233,34 @@ func TestAdminValidation(t *testing.T) {
 
 	testSetConfig(t, ctx, admin, "", "city", "Gotham", "")
 	testSetSecret(t, ctx, admin, "", "universe", "DC", "")
+
+	testSetConfig(t, ctx, admin, "", "ftlCaptureSensitivePayloads", "yes", "JSON validation failed: ftlCaptureSensitivePayloads must be a boolean")
+	testSetConfig(t, ctx, admin, "", "ftlCaptureSensitivePayloads", true, "")
+}
+
+func TestSensitivePayloadCapture(t *testing.T) {
+	config := tempConfigPath(t, "testdata/ftl-project.toml", "admin")
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	cm, err := manager.New(ctx, routers.ProjectConfig[configuration.Configuration]{Config: config}, providers.Inline[configuration.Configuration]{})
+	assert.NoError(t, err)
+	sm, err := manager.New(ctx, routers.ProjectConfig[configuration.Secrets]{Config: config}, providers.Inline[configuration.Secrets]{})
+	assert.NoError(t, err)
+	admin := NewAdminService(cm, sm, &mockSchemaRetriever{})
+
+	capture, err := sensitivePayloadCapture(ctx, admin)
+	assert.Error(t, err)
+	assert.False(t, capture, "capture should be off until it is configured")
+
+	testSetConfig(t, ctx, admin, "", "ftlCaptureSensitivePayloads", true, "")
+	capture, err = sensitivePayloadCapture(ctx, admin)
+	assert.NoError(t, err)
+	assert.True(t, capture)
+
+	testSetConfig(t, ctx, admin, "", "ftlCaptureSensitivePayloads", false, "")
+	capture, err = sensitivePayloadCapture(ctx, admin)
+	assert.NoError(t, err)
+	assert.False(t, capture)
 }
 
 // nolint
@@ -261,3 +300,110 @@ func testSetSecret(t testing.TB, ctx context.Context, admin *AdminService, modul
 	}))
 	assert.EqualError(t, err, expectedError)
 }
+
+// fakeTimeline records published config changes.
+type fakeTimeline struct {
+	timelinepbconnect.TimelineServiceClient
+	changes []timeline.ConfigChange
+}
+
+func (f *fakeTimeline) Publish(ctx context.Context, event timeline.Event) {
+	change, ok := event.(timeline.ConfigChange)
+	if !ok {
+		panic(fmt.Sprintf("unexpected event %T", event))
+	}
+	f.changes = append(f.changes, change)
+}
+
+func TestConfigHistory(t *testing.T) {
+	config := tempConfigPath(t, "testdata/ftl-project.toml", "admin")
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	cm, err := manager.New(ctx, routers.ProjectConfig[configuration.Configuration]{Config: config}, providers.Inline[configuration.Configuration]{})
+	assert.NoError(t, err)
+	sm, err := manager.New(ctx, routers.ProjectConfig[configuration.Secrets]{Config: config}, providers.Inline[configuration.Secrets]{})
+	assert.NoError(t, err)
+	admin := NewAdminService(cm, sm, &mockSchemaRetriever{})
+
+	ref := &ftlv1.ConfigRef{Module: optional.Some("batmobile").Ptr(), Name: "color"}
+	_, err = admin.ConfigHistory(ctx, connect.NewRequest(&ftlv1.ConfigHistoryRequest{Ref: ref}))
+	assert.EqualError(t, err, "unimplemented: history is only recorded by an FTL cluster")
+
+	dir := t.TempDir()
+	path, keyPath := filepath.Join(dir, "history.db"), filepath.Join(dir, "history.key")
+	changeHistory, err := openHistory(ctx, path, keyPath)
+	assert.NoError(t, err)
+	timelineClient := &fakeTimeline{}
+	admin.timelineClient = optional.Some[timelineService](timelineClient)
+	admin.changes = optional.Some(changeHistory)
+	handlerPath, handler := ftlv1connect.NewAdminServiceHandler(admin, connect.WithInterceptors(rpc.AuthenticationInterceptor(rpc.AuthenticateHeader("X-Authenticated-User"))))
+	mux := http.NewServeMux()
+	mux.Handle(handlerPath, handler)
+	server := httptest.NewServer(rpc.ContextValuesMiddleware(ctx, mux))
+	t.Cleanup(server.Close)
+	client := ftlv1connect.NewAdminServiceClient(server.Client(), server.URL)
+	for _, value := range []string{`"Black"`, `"Blue"`} {
+		req := connect.NewRequest(&ftlv1.ConfigSetRequest{Ref: ref, Value: []byte(value)})
+		// The actor is only taken from the authenticated subject of the request.
+		req.Header().Set("X-Authenticated-User", "alfred")
+		req.Header().Set("Ftl-Actor", "joker")
+		_, err = client.ConfigSet(ctx, req)
+		assert.NoError(t, err)
+	}
+	_, err = client.ConfigUnset(ctx, connect.NewRequest(&ftlv1.ConfigUnsetRequest{Ref: ref}))
+	assert.NoError(t, err)
+
+	history, err := admin.ConfigHistory(ctx, connect.NewRequest(&ftlv1.ConfigHistoryRequest{Ref: ref}))
+	assert.NoError(t, err)
+	changes := history.Msg.Changes
+	assert.Equal(t, 3, len(changes))
+	assert.Equal(t, ftlv1.ConfigChangeOperation_CONFIG_CHANGE_OPERATION_UNSET, changes[0].Operation)
+	assert.Equal(t, `"Black"`, string(changes[2].Value))
+	assert.Equal(t, "alfred", changes[2].Actor)
+	assert.Equal(t, "", changes[0].Actor, "unauthenticated changes have no actor")
+	assert.Equal(t, "inline", changes[2].Provider)
+	assert.Equal(t, []int64{changes[2].Id, changes[1].Id, changes[0].Id}, slices.Map(timelineClient.changes, func(c timeline.ConfigChange) int64 { return c.ID }))
+
+	_, err = admin.ConfigRestore(ctx, connect.NewRequest(&ftlv1.ConfigRestoreRequest{Ref: ref, ChangeId: changes[0].Id}))
+	assert.EqualError(t, err, "failed_precondition: change 3 did not set a value for batmobile.color")
+	_, err = admin.ConfigRestore(ctx, connect.NewRequest(&ftlv1.ConfigRestoreRequest{Ref: ref, ChangeId: 42}))
+	assert.EqualError(t, err, "not_found: no change 42 to batmobile.color")
+	_, err = admin.ConfigRestore(ctx, connect.NewRequest(&ftlv1.ConfigRestoreRequest{Ref: ref, ChangeId: changes[2].Id}))
+	assert.NoError(t, err)
+	var color string
+	assert.NoError(t, cm.Get(ctx, refFromConfigRef(ref), &color))
+	assert.Equal(t, "Black", color)
+
+	// Secret values are never recorded, only their fingerprints.
+	secretRef := &ftlv1.ConfigRef{Module: optional.Some("batmobile").Ptr(), Name: "owner"}
+	for range 2 {
+		_, err = admin.SecretSet(ctx, connect.NewRequest(&ftlv1.SecretSetRequest{Ref: secretRef, Value: []byte(`"Bruce Wayne"`)}))
+		assert.NoError(t, err)
+	}
+	secretHistory, err := admin.SecretHistory(ctx, connect.NewRequest(&ftlv1.SecretHistoryRequest{Ref: secretRef}))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(secretHistory.Msg.Changes))
+	assert.Zero(t, secretHistory.Msg.Changes[0].Value)
+	assert.Equal(t, secretHistory.Msg.Changes[0].GetFingerprint(), secretHistory.Msg.Changes[1].GetFingerprint())
+	hash := sha256.Sum256([]byte(`"Bruce Wayne"`))
+	assert.NotEqual(t, hex.EncodeToString(hash[:]), secretHistory.Msg.Changes[0].GetFingerprint(), "fingerprints must be keyed")
+	assert.Zero(t, timelineClient.changes[len(timelineClient.changes)-1].Value)
+
+	// Changes that fail to apply are not recorded.
+	_, err = changeHistory.Record(ctx, timeline.ConfigChange{Ref: refFromConfigRef(ref), Operation: timeline.ConfigChangeOperationUnset, Time: time.Now()}, func() error {
+		return errors.New("provider failed")
+	})
+	assert.EqualError(t, err, "provider failed")
+
+	// History survives a restart, and is fingerprinted with the same key.
+	assert.NoError(t, changeHistory.Close())
+	changeHistory, err = openHistory(ctx, path, keyPath)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = changeHistory.Close() })
+	admin.changes = optional.Some(changeHistory)
+	history, err = admin.ConfigHistory(ctx, connect.NewRequest(&ftlv1.ConfigHistoryRequest{Ref: ref}))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(history.Msg.Changes))
+	assert.Equal(t, changes[2].Id, history.Msg.Changes[0].GetRestoredFrom())
+	assert.Equal(t, changes[2].GetFingerprint(), history.Msg.Changes[0].GetFingerprint())
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/atomic"
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/log"
+)
+
+// CaptureSensitivePayloadsRef is the global configuration value that switches on the capture of
+// sensitive fields in timeline payloads.
+//
+// It is only honoured by development clusters, and is set with `ftl config set --json ftlCaptureSensitivePayloads true`.
+var CaptureSensitivePayloadsRef = configuration.NewRef("", "ftlCaptureSensitivePayloads")
+
+const capturePollInterval = 5 * time.Second
+
+// WatchSensitivePayloadCapture polls the admin service for CaptureSensitivePayloadsRef.
+//
+// The returned function reports whether sensitive payloads are currently captured. It is false
+// until the value has been read, and whenever it can't be read.
+func WatchSensitivePayloadCapture(ctx context.Context, client Client) func() bool {
+	capture := atomic.New(false)
+	go func() {
+		logger := log.FromContext(ctx)
+		for {
+			enabled, err := sensitivePayloadCapture(ctx, client)
+			if err != nil {
+				logger.Tracef("Not capturing sensitive payloads: %v", err)
+			}
+			if previous := capture.Swap(enabled); previous != enabled && enabled {
+				logger.Warnf("Capturing sensitive fields in timeline payloads")
+			} else if previous != enabled {
+				logger.Infof("Redacting sensitive fields in timeline payloads")
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(capturePollInterval):
+			}
+		}
+	}()
+	return capture.Load
+}
+
+func sensitivePayloadCapture(ctx context.Context, client Client) (bool, error) {
+	resp, err := client.ConfigGet(ctx, connect.NewRequest(&ftlv1.ConfigGetRequest{
+		Ref: &ftlv1.ConfigRef{Name: CaptureSensitivePayloadsRef.Name},
+	}))
+	if err != nil {
+		return false, fmt.Errorf("failed to get %s: %w", CaptureSensitivePayloadsRef, err)
+	}
+	var enabled bool
+	if err := json.Unmarshal(resp.Msg.Value, &enabled); err != nil {
+		return false, fmt.Errorf("%s must be a boolean: %w", CaptureSensitivePayloadsRef, err)
+	}
+	return enabled, nil
+}
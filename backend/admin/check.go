@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/internal/configuration"
+)
+
+// ConfigCheck checks that every config value and secret used by active modules is set and valid.
+func (s *AdminService) ConfigCheck(ctx context.Context, req *connect.Request[ftlv1.ConfigCheckRequest]) (*connect.Response[ftlv1.ConfigCheckResponse], error) {
+	sch, err := s.schr.GetActiveSchema(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("could not get the active schema: %w", err))
+	}
+	problems := []*ftlv1.ConfigCheckResponse_Problem{}
+	for _, module := range sch.Modules {
+		if module.Builtin || (req.Msg.Module != nil && module.Name != *req.Msg.Module) {
+			continue
+		}
+		configs, err := s.cm.MapForModule(ctx, module.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map configs for module: %w", err)
+		}
+		secrets, err := s.sm.MapForModule(ctx, module.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map secrets for module: %w", err)
+		}
+		for _, problem := range configuration.CheckModuleValues(sch, module, configs, secrets) {
+			problems = append(problems, &ftlv1.ConfigCheckResponse_Problem{
+				Ref:    &ftlv1.ConfigRef{Module: &problem.Module, Name: problem.Name},
+				Secret: problem.Secret,
+				Error:  problem.Err.Error(),
+			})
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Ref.GetModule() < problems[j].Ref.GetModule()
+	})
+	return connect.NewResponse(&ftlv1.ConfigCheckResponse{Problems: problems}), nil
+}
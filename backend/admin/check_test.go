@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/configuration/manager"
+	"github.com/block/ftl/internal/configuration/providers"
+	"github.com/block/ftl/internal/configuration/routers"
+	"github.com/block/ftl/internal/log"
+)
+
+func TestConfigCheck(t *testing.T) {
+	config := tempConfigPath(t, "", "check")
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+
+	cm, err := manager.New(ctx, routers.ProjectConfig[configuration.Configuration]{Config: config}, providers.Inline[configuration.Configuration]{})
+	assert.NoError(t, err)
+	sm, err := manager.New(ctx, routers.ProjectConfig[configuration.Secrets]{Config: config}, providers.Inline[configuration.Secrets]{})
+	assert.NoError(t, err)
+	admin := NewAdminService(cm, sm, &mockSchemaRetriever{})
+
+	testSetConfig(t, ctx, admin, "batmobile", "color", "Black", "")
+	testSetSecret(t, ctx, admin, "batmobile", "owner", "Bruce Wayne", "")
+	// Global values are visible to every module.
+	err = cm.Set(ctx, configuration.NewRef("", "capacity"), 3)
+	assert.NoError(t, err)
+
+	resp, err := admin.ConfigCheck(ctx, connect.NewRequest(&ftlv1.ConfigCheckRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"batmobile.capacity: %!s(float64=3) is not a valid variant of enum batmobile.Capacity",
+		"batmobile.horsepower (secret): not set",
+	}, slices.Map(resp.Msg.Problems, func(p *ftlv1.ConfigCheckResponse_Problem) string {
+		ref := p.Ref.GetModule() + "." + p.Ref.Name
+		if p.Secret {
+			ref += " (secret)"
+		}
+		return ref + ": " + p.Error
+	}))
+}
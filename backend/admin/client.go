@@ -31,6 +31,15 @@ type Client interface {
 	// Unset a config value.
 	ConfigUnset(ctx context.Context, req *connect.Request[ftlv1.ConfigUnsetRequest]) (*connect.Response[ftlv1.ConfigUnsetResponse], error)
 
+	// Get the history of changes to a config value.
+	ConfigHistory(ctx context.Context, req *connect.Request[ftlv1.ConfigHistoryRequest]) (*connect.Response[ftlv1.ConfigHistoryResponse], error)
+
+	// Restore a config value to the value set by a previous change.
+	ConfigRestore(ctx context.Context, req *connect.Request[ftlv1.ConfigRestoreRequest]) (*connect.Response[ftlv1.ConfigRestoreResponse], error)
+
+	// Check that every config value and secret used by modules is set and valid.
+	ConfigCheck(ctx context.Context, req *connect.Request[ftlv1.ConfigCheckRequest]) (*connect.Response[ftlv1.ConfigCheckResponse], error)
+
 	// List secrets.
 	SecretsList(ctx context.Context, req *connect.Request[ftlv1.SecretsListRequest]) (*connect.Response[ftlv1.SecretsListResponse], error)
 
@@ -43,6 +52,9 @@ type Client interface {
 	// Unset a secret.
 	SecretUnset(ctx context.Context, req *connect.Request[ftlv1.SecretUnsetRequest]) (*connect.Response[ftlv1.SecretUnsetResponse], error)
 
+	// Get the history of changes to a secret.
+	SecretHistory(ctx context.Context, req *connect.Request[ftlv1.SecretHistoryRequest]) (*connect.Response[ftlv1.SecretHistoryResponse], error)
+
 	// MapConfigsForModule combines all configuration values visible to the module.
 	// Local values take precedence.
 	MapConfigsForModule(ctx context.Context, req *connect.Request[ftlv1.MapConfigsForModuleRequest]) (*connect.Response[ftlv1.MapConfigsForModuleResponse], error)
@@ -50,6 +62,9 @@ type Client interface {
 	// MapSecretsForModule combines all secrets visible to the module.
 	// Local values take precedence.
 	MapSecretsForModule(ctx context.Context, req *connect.Request[ftlv1.MapSecretsForModuleRequest]) (*connect.Response[ftlv1.MapSecretsForModuleResponse], error)
+
+	// Get the state of the circuit breakers for verbs in active deployments.
+	GetCircuitBreakers(ctx context.Context, req *connect.Request[ftlv1.GetCircuitBreakersRequest]) (*connect.Response[ftlv1.GetCircuitBreakersResponse], error)
 }
 
 // ShouldUseLocalClient returns whether a local admin client should be used based on the admin service client and the endpoint.
@@ -0,0 +1,228 @@
+package admin
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	_ "modernc.org/sqlite" // SQL driver
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/timeline"
+	"github.com/block/ftl/internal/configuration"
+)
+
+// historyKeySize is the size in bytes of the key that values are fingerprinted with.
+const historyKeySize = 32
+
+var errChangeNotFound = errors.New("change not found")
+
+// history is a durable audit log of changes to configuration and secrets.
+//
+// Values are fingerprinted with an HMAC keyed by a key that is only held by the admin service, so that
+// the fingerprints of low-entropy secrets can't be reversed by guessing values.
+type history struct {
+	db  *sql.DB
+	key []byte
+}
+
+// openHistory opens the history stored at path, with the fingerprint key at keyPath.
+//
+// The key is created if it does not exist.
+func openHistory(ctx context.Context, path, keyPath string) (*history, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create directory for history %q: %w", path, err)
+	}
+	key, err := loadOrCreateHistoryKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history %q: %w", path, err)
+	}
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		`CREATE TABLE IF NOT EXISTS changes (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			secret INTEGER NOT NULL,
+			module TEXT NOT NULL,
+			name TEXT NOT NULL,
+			created_at INTEGER NOT NULL,
+			actor TEXT NOT NULL,
+			provider TEXT NOT NULL,
+			operation TEXT NOT NULL,
+			value BLOB,
+			fingerprint TEXT,
+			restored_from INTEGER
+		)`,
+		`CREATE INDEX IF NOT EXISTS changes_ref ON changes (secret, module, name, id)`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("failed to initialise history %q: %w", path, err)
+		}
+	}
+	return &history{db: db, key: key}, nil
+}
+
+func loadOrCreateHistoryKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return nil, fmt.Errorf("failed to create directory for history key %q: %w", path, err)
+		}
+		key = make([]byte, historyKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate history key: %w", err)
+		}
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if errors.Is(err, os.ErrExist) {
+			// Created concurrently, so use that one.
+			return loadOrCreateHistoryKey(path)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to create history key %q: %w", path, err)
+		}
+		defer f.Close()
+		if _, err := f.Write(key); err != nil {
+			return nil, fmt.Errorf("failed to write history key %q: %w", path, err)
+		}
+		return key, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read history key %q: %w", path, err)
+	}
+	if len(key) < historyKeySize {
+		return nil, fmt.Errorf("history key %q must be at least %d bytes", path, historyKeySize)
+	}
+	return key, nil
+}
+
+func (h *history) Close() error {
+	if err := h.db.Close(); err != nil {
+		return fmt.Errorf("failed to close history: %w", err)
+	}
+	return nil
+}
+
+// fingerprint returns the hex encoded HMAC-SHA256 of a value.
+func (h *history) fingerprint(value []byte) string {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write(value)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Record a change that is made by apply, returning it with its ID and the fingerprint of its value.
+//
+// The change is recorded in a transaction that is only committed if apply succeeds, so that the history
+// never misses a change or records one that failed. The values of secrets are never stored, and are
+// removed from the returned change.
+func (h *history) Record(ctx context.Context, change timeline.ConfigChange, apply func() error) (timeline.ConfigChange, error) {
+	var value []byte
+	var fingerprint sql.NullString
+	if change.Operation == timeline.ConfigChangeOperationSet {
+		change.Fingerprint = h.fingerprint(change.Value)
+		fingerprint = sql.NullString{String: change.Fingerprint, Valid: true}
+		if change.Secret {
+			change.Value = nil
+		} else {
+			value = change.Value
+		}
+	}
+	tx, err := h.db.BeginTx(ctx, nil)
+	if err != nil {
+		return change, fmt.Errorf("failed to record change to %s: %w", change.Ref, err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+	result, err := tx.ExecContext(ctx, `INSERT INTO changes
+		(secret, module, name, created_at, actor, provider, operation, value, fingerprint, restored_from)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		change.Secret, change.Ref.Module.Default(""), change.Ref.Name, change.Time.UnixNano(), change.Actor,
+		string(change.Provider), string(change.Operation), value, fingerprint, change.RestoredFrom.Ptr())
+	if err != nil {
+		return change, fmt.Errorf("failed to record change to %s: %w", change.Ref, err)
+	}
+	change.ID, err = result.LastInsertId()
+	if err != nil {
+		return change, fmt.Errorf("failed to record change to %s: %w", change.Ref, err)
+	}
+	if err := apply(); err != nil {
+		return change, err
+	}
+	if err := tx.Commit(); err != nil {
+		return change, fmt.Errorf("failed to record change to %s: %w", change.Ref, err)
+	}
+	return change, nil
+}
+
+const changeColumns = `id, created_at, actor, provider, operation, value, fingerprint, restored_from`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanChange(row scanner) (*ftlv1.ConfigChange, error) {
+	var (
+		change       ftlv1.ConfigChange
+		createdAt    int64
+		operation    string
+		fingerprint  sql.NullString
+		restoredFrom sql.NullInt64
+	)
+	err := row.Scan(&change.Id, &createdAt, &change.Actor, &change.Provider, &operation, &change.Value, &fingerprint, &restoredFrom)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	change.Timestamp = timestamppb.New(time.Unix(0, createdAt))
+	change.Operation = timeline.ConfigChangeOperation(operation).ToProto()
+	if fingerprint.Valid {
+		change.Fingerprint = &fingerprint.String
+	}
+	if restoredFrom.Valid {
+		change.RestoredFrom = &restoredFrom.Int64
+	}
+	return &change, nil
+}
+
+// List the changes to a value, most recent first.
+func (h *history) List(ctx context.Context, ref configuration.Ref, secret bool) ([]*ftlv1.ConfigChange, error) {
+	rows, err := h.db.QueryContext(ctx, "SELECT "+changeColumns+" FROM changes WHERE secret = ? AND module = ? AND name = ? ORDER BY id DESC",
+		secret, ref.Module.Default(""), ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes to %s: %w", ref, err)
+	}
+	defer rows.Close()
+	out := []*ftlv1.ConfigChange{}
+	for rows.Next() {
+		change, err := scanChange(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list changes to %s: %w", ref, err)
+		}
+		out = append(out, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list changes to %s: %w", ref, err)
+	}
+	return out, nil
+}
+
+// Get a change to a value.
+func (h *history) Get(ctx context.Context, ref configuration.Ref, secret bool, id int64) (*ftlv1.ConfigChange, error) {
+	change, err := scanChange(h.db.QueryRowContext(ctx, "SELECT "+changeColumns+" FROM changes WHERE secret = ? AND module = ? AND name = ? AND id = ?",
+		secret, ref.Module.Default(""), ref.Name, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errChangeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get change %d to %s: %w", id, ref, err)
+	}
+	return change, nil
+}
@@ -3,32 +3,54 @@ package admin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 	_ "github.com/jackc/pgx/v5/stdlib"
 
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/common/encoding"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/internal/configuration"
 	"github.com/block/ftl/internal/configuration/manager"
 	"github.com/block/ftl/internal/configuration/providers"
 	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/rbac"
 	"github.com/block/ftl/internal/rpc"
 	"github.com/block/ftl/internal/schema/schemaeventsource"
 )
 
 type Config struct {
-	Bind *url.URL `help:"Socket to bind to." default:"http://127.0.0.1:8896" env:"FTL_BIND"`
+	Bind             *url.URL `help:"Socket to bind to." default:"http://127.0.0.1:8896" env:"FTL_BIND"`
+	TimelineEndpoint *url.URL `help:"Timeline endpoint." env:"FTL_TIMELINE_ENDPOINT" default:"http://127.0.0.1:8894"`
+	RBACPolicy       string   `help:"Path to a role-based access control policy. All requests are allowed if omitted." env:"FTL_RBAC_POLICY" placeholder:"FILE"`
+	IdentityHeader   string   `help:"Header that an authenticating proxy sets to the subject of each request, for access control. The proxy must remove it from the requests it receives." env:"FTL_IDENTITY_HEADER" placeholder:"HEADER"`
+	History          string   `help:"Path to the SQLite database changes to configuration and secrets are recorded in." type:"path" default:"~/.ftl/admin-history.db" env:"FTL_ADMIN_HISTORY"`
+	HistoryKey       string   `help:"Path to the key values are fingerprinted with in the history. Created if it does not exist. Defaults to the history path with a .key suffix." type:"path" env:"FTL_ADMIN_HISTORY_KEY" placeholder:"FILE"`
 }
 
 type AdminService struct {
 	schr SchemaRetriever
 	cm   *manager.Manager[configuration.Configuration]
 	sm   *manager.Manager[configuration.Secrets]
+	// timelineClient is used to determine circuit breaker states and to publish
+	// configuration changes, and is not set for local clients.
+	timelineClient optional.Option[timelineService]
+	// changes records changes to configuration and secrets, and is not set for local clients.
+	changes optional.Option[*history]
+}
+
+// timelineService is the subset of [timeline.Client] used by the admin service.
+type timelineService interface {
+	timelinepbconnect.TimelineServiceClient
+	Publish(ctx context.Context, event timeline.Event)
 }
 
 var _ ftlv1connect.AdminServiceHandler = (*AdminService)(nil)
@@ -69,14 +91,41 @@ func Start(
 	cm *manager.Manager[configuration.Configuration],
 	sm *manager.Manager[configuration.Secrets],
 	schr SchemaRetriever,
+	timelineClient *timeline.Client,
 ) error {
 
 	logger := log.FromContext(ctx).Scope("admin")
 	svc := NewAdminService(cm, sm, schr)
+	svc.timelineClient = optional.Some[timelineService](timelineClient)
+
+	historyKey := config.HistoryKey
+	if historyKey == "" {
+		historyKey = config.History + ".key"
+	}
+	changes, err := openHistory(ctx, config.History, historyKey)
+	if err != nil {
+		return err
+	}
+	defer changes.Close()
+	svc.changes = optional.Some(changes)
+
+	authenticators := []rpc.Authenticator{}
+	if config.IdentityHeader != "" {
+		authenticators = append(authenticators, rpc.AuthenticateHeader(config.IdentityHeader))
+	}
+	// Changes are attributed to the authenticated subject of each request in the history.
+	options := []connect.HandlerOption{connect.WithInterceptors(rpc.AuthenticationInterceptor(authenticators...))}
+	if config.RBACPolicy != "" {
+		policy, err := rbac.Load(config.RBACPolicy)
+		if err != nil {
+			return fmt.Errorf("failed to load access control policy: %w", err)
+		}
+		options = append(options, connect.WithInterceptors(rpc.AuthorizationInterceptor(policy, timeline.PublishAccessDenied(timelineClient), authenticators...)))
+	}
 
 	logger.Debugf("Admin service listening on: %s", config.Bind)
-	err := rpc.Serve(ctx, config.Bind,
-		rpc.GRPC(ftlv1connect.NewAdminServiceHandler, svc),
+	err = rpc.Serve(ctx, config.Bind,
+		rpc.GRPC(ftlv1connect.NewAdminServiceHandler, svc, options...),
 	)
 	if err != nil {
 		return fmt.Errorf("admin service stopped serving: %w", err)
@@ -84,6 +133,29 @@ func Start(
 	return nil
 }
 
+// GetCircuitBreakers returns the state of the circuit breakers for verbs in active deployments.
+func (s *AdminService) GetCircuitBreakers(ctx context.Context, req *connect.Request[ftlv1.GetCircuitBreakersRequest]) (*connect.Response[ftlv1.GetCircuitBreakersResponse], error) {
+	timelineClient, ok := s.timelineClient.Get()
+	if !ok {
+		return connect.NewResponse(&ftlv1.GetCircuitBreakersResponse{}), nil
+	}
+	sch, err := s.schr.GetActiveSchema(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the active schema: %w", err)
+	}
+	deployments := []string{}
+	for _, module := range sch.Modules {
+		if module.Runtime != nil && module.Runtime.Deployment != nil && module.Runtime.Deployment.DeploymentKey != "" {
+			deployments = append(deployments, module.Runtime.Deployment.DeploymentKey)
+		}
+	}
+	breakers, err := timeline.CircuitBreakers(ctx, timelineClient, deployments)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return connect.NewResponse(&ftlv1.GetCircuitBreakersResponse{CircuitBreakers: breakers}), nil
+}
+
 func (s *AdminService) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
 	return connect.NewResponse(&ftlv1.PingResponse{}), nil
 }
@@ -162,22 +234,88 @@ func (s *AdminService) ConfigSet(ctx context.Context, req *connect.Request[ftlv1
 		return nil, err
 	}
 
-	err = s.cm.SetJSON(ctx, refFromConfigRef(req.Msg.GetRef()), req.Msg.Value)
+	err = s.applyChange(ctx, timeline.ConfigChange{
+		Ref:       refFromConfigRef(req.Msg.GetRef()),
+		Provider:  s.cm.ProviderKey(),
+		Operation: timeline.ConfigChangeOperationSet,
+		Value:     req.Msg.Value,
+	}, func() error {
+		if err := s.cm.SetJSON(ctx, refFromConfigRef(req.Msg.GetRef()), req.Msg.Value); err != nil {
+			return fmt.Errorf("failed to set config: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to set config: %w", err)
+		return nil, err
 	}
 	return connect.NewResponse(&ftlv1.ConfigSetResponse{}), nil
 }
 
 // ConfigUnset unsets the config value at the given ref.
 func (s *AdminService) ConfigUnset(ctx context.Context, req *connect.Request[ftlv1.ConfigUnsetRequest]) (*connect.Response[ftlv1.ConfigUnsetResponse], error) {
-	err := s.cm.Unset(ctx, refFromConfigRef(req.Msg.GetRef()))
+	err := s.applyChange(ctx, timeline.ConfigChange{
+		Ref:       refFromConfigRef(req.Msg.GetRef()),
+		Provider:  s.cm.ProviderKey(),
+		Operation: timeline.ConfigChangeOperationUnset,
+	}, func() error {
+		if err := s.cm.Unset(ctx, refFromConfigRef(req.Msg.GetRef())); err != nil {
+			return fmt.Errorf("failed to unset config: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to unset config: %w", err)
+		return nil, err
 	}
 	return connect.NewResponse(&ftlv1.ConfigUnsetResponse{}), nil
 }
 
+// ConfigHistory returns the recorded changes to a config value, most recent first.
+func (s *AdminService) ConfigHistory(ctx context.Context, req *connect.Request[ftlv1.ConfigHistoryRequest]) (*connect.Response[ftlv1.ConfigHistoryResponse], error) {
+	changes, err := s.changeHistory(ctx, refFromConfigRef(req.Msg.GetRef()), false)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.ConfigHistoryResponse{Changes: changes}), nil
+}
+
+// ConfigRestore sets a config value to the value recorded by a previous change.
+func (s *AdminService) ConfigRestore(ctx context.Context, req *connect.Request[ftlv1.ConfigRestoreRequest]) (*connect.Response[ftlv1.ConfigRestoreResponse], error) {
+	ref := refFromConfigRef(req.Msg.GetRef())
+	changes, ok := s.changes.Get()
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnimplemented, errNoHistory)
+	}
+	change, err := changes.Get(ctx, ref, false, req.Msg.ChangeId)
+	if errors.Is(err, errChangeNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no change %d to %s", req.Msg.ChangeId, ref))
+	} else if err != nil {
+		return nil, err
+	}
+	if change.Value == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("change %d did not set a value for %s", req.Msg.ChangeId, ref))
+	}
+	err = s.validateAgainstSchema(ctx, false, ref, change.Value)
+	if err != nil {
+		return nil, err
+	}
+	err = s.applyChange(ctx, timeline.ConfigChange{
+		Ref:          ref,
+		Provider:     s.cm.ProviderKey(),
+		Operation:    timeline.ConfigChangeOperationSet,
+		Value:        change.Value,
+		RestoredFrom: optional.Some(change.Id),
+	}, func() error {
+		if err := s.cm.SetJSON(ctx, ref, change.Value); err != nil {
+			return fmt.Errorf("failed to set config: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.ConfigRestoreResponse{}), nil
+}
+
 // SecretsList returns the list of secrets, optionally filtered by module.
 func (s *AdminService) SecretsList(ctx context.Context, req *connect.Request[ftlv1.SecretsListRequest]) (*connect.Response[ftlv1.SecretsListResponse], error) {
 	listing, err := s.sm.List(ctx)
@@ -235,22 +373,90 @@ func (s *AdminService) SecretSet(ctx context.Context, req *connect.Request[ftlv1
 		return nil, err
 	}
 
-	err = s.sm.SetJSON(ctx, refFromConfigRef(req.Msg.GetRef()), req.Msg.Value)
+	err = s.applyChange(ctx, timeline.ConfigChange{
+		Ref:       refFromConfigRef(req.Msg.GetRef()),
+		Secret:    true,
+		Provider:  s.sm.ProviderKey(),
+		Operation: timeline.ConfigChangeOperationSet,
+		Value:     req.Msg.Value,
+	}, func() error {
+		if err := s.sm.SetJSON(ctx, refFromConfigRef(req.Msg.GetRef()), req.Msg.Value); err != nil {
+			return fmt.Errorf("failed to set secret: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to set secret: %w", err)
+		return nil, err
 	}
 	return connect.NewResponse(&ftlv1.SecretSetResponse{}), nil
 }
 
 // SecretUnset unsets the secret value at the given ref.
 func (s *AdminService) SecretUnset(ctx context.Context, req *connect.Request[ftlv1.SecretUnsetRequest]) (*connect.Response[ftlv1.SecretUnsetResponse], error) {
-	err := s.sm.Unset(ctx, refFromConfigRef(req.Msg.GetRef()))
+	err := s.applyChange(ctx, timeline.ConfigChange{
+		Ref:       refFromConfigRef(req.Msg.GetRef()),
+		Secret:    true,
+		Provider:  s.sm.ProviderKey(),
+		Operation: timeline.ConfigChangeOperationUnset,
+	}, func() error {
+		if err := s.sm.Unset(ctx, refFromConfigRef(req.Msg.GetRef())); err != nil {
+			return fmt.Errorf("failed to unset secret: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to unset secret: %w", err)
+		return nil, err
 	}
 	return connect.NewResponse(&ftlv1.SecretUnsetResponse{}), nil
 }
 
+// SecretHistory returns the recorded changes to a secret, most recent first.
+//
+// Secret values are not recorded, only their fingerprints.
+func (s *AdminService) SecretHistory(ctx context.Context, req *connect.Request[ftlv1.SecretHistoryRequest]) (*connect.Response[ftlv1.SecretHistoryResponse], error) {
+	changes, err := s.changeHistory(ctx, refFromConfigRef(req.Msg.GetRef()), true)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.SecretHistoryResponse{Changes: changes}), nil
+}
+
+var errNoHistory = errors.New("history is only recorded by an FTL cluster")
+
+// applyChange makes a change with apply, records it in the history and publishes it to the timeline,
+// attributing it to the authenticated subject of the request.
+//
+// The change is only recorded if it is applied, and is only applied if it can be recorded. Changes by
+// clients that are not authenticated are recorded without an actor, and logged.
+func (s *AdminService) applyChange(ctx context.Context, change timeline.ConfigChange, apply func() error) error {
+	changes, ok := s.changes.Get()
+	if !ok {
+		return apply()
+	}
+	actor, ok := rpc.Subject(ctx)
+	if !ok {
+		log.FromContext(ctx).Warnf("Recording a change to %s by an unauthenticated client", change.Ref)
+	}
+	change.Actor = actor
+	change.Time = time.Now()
+	change, err := changes.Record(ctx, change, apply)
+	if err != nil {
+		return err
+	}
+	if timelineClient, ok := s.timelineClient.Get(); ok {
+		timelineClient.Publish(ctx, change)
+	}
+	return nil
+}
+
+func (s *AdminService) changeHistory(ctx context.Context, ref configuration.Ref, secret bool) ([]*ftlv1.ConfigChange, error) {
+	changes, ok := s.changes.Get()
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnimplemented, errNoHistory)
+	}
+	return changes.List(ctx, ref, secret)
+}
+
 // MapConfigsForModule combines all configuration values visible to the module.
 func (s *AdminService) MapConfigsForModule(ctx context.Context, req *connect.Request[ftlv1.MapConfigsForModuleRequest]) (*connect.Response[ftlv1.MapConfigsForModuleResponse], error) {
 	values, err := s.cm.MapForModule(ctx, req.Msg.Module)
@@ -276,6 +482,14 @@ func refFromConfigRef(cr *ftlv1.ConfigRef) configuration.Ref {
 func (s *AdminService) validateAgainstSchema(ctx context.Context, isSecret bool, ref configuration.Ref, value json.RawMessage) error {
 	logger := log.FromContext(ctx)
 
+	if !isSecret && ref == CaptureSensitivePayloadsRef {
+		var capture bool
+		if err := json.Unmarshal(value, &capture); err != nil {
+			return fmt.Errorf("JSON validation failed: %s must be a boolean", ref)
+		}
+		return nil
+	}
+
 	// Globals aren't in the module schemas, so we have nothing to validate against.
 	if !ref.Module.Ok() {
 		return nil
@@ -0,0 +1,482 @@
+// Package async durably queues and executes asynchronous verb calls.
+package async
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/kong"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	asyncpb "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1"
+	asyncconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/timeline"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/identity"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/routing"
+	"github.com/block/ftl/internal/rpc"
+	"github.com/block/ftl/internal/rpc/headers"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
+)
+
+type Config struct {
+	Bind          *url.URL      `help:"Socket to bind to." default:"http://127.0.0.1:8897" env:"FTL_BIND"`
+	DB            string        `help:"Path to the SQLite database async calls are persisted in." type:"path" default:"~/.ftl/async.db" env:"FTL_ASYNC_DB"`
+	Concurrency   int           `help:"Maximum number of async calls to execute concurrently." default:"16" env:"FTL_ASYNC_CONCURRENCY"`
+	PollInterval  time.Duration `help:"Maximum time to wait between checks for due async calls." default:"5s" env:"FTL_ASYNC_POLL_INTERVAL"`
+	CatchAttempts int           `help:"Maximum number of attempts of a catch verb before an async call fails." default:"10" env:"FTL_ASYNC_CATCH_ATTEMPTS"`
+	// Unlike the controller, which publishes its public keys to runners, the async service has no way to receive
+	// them, so it is configured with the same keys.
+	CallerSigningKeys []string `help:"Base64 encoded Ed25519 seeds the controller signs caller tokens with, used to identify the module enqueuing each call. Without them only exported verbs can be called asynchronously." env:"FTL_CALLER_SIGNING_KEYS" placeholder:"KEY"`
+}
+
+func (c *Config) SetDefaults() {
+	if err := kong.ApplyDefaults(c); err != nil {
+		panic(err)
+	}
+}
+
+const defaultListLimit = 100
+
+type service struct {
+	config         Config
+	store          *store
+	view           schemaeventsource.View
+	client         routing.CallClient
+	timelineClient *timeline.Client
+	// callerKeys verify the caller tokens of enqueued calls, and are nil if no keys are configured.
+	callerKeys identity.Verifier
+	// wake is signalled when a call may have become due, or an execution slot has been freed.
+	wake chan struct{}
+}
+
+var _ asyncconnect.AsyncServiceHandler = (*service)(nil)
+
+// Start the async service. Blocks until the context is cancelled.
+func Start(ctx context.Context, config Config, eventSource schemaeventsource.EventSource, client routing.CallClient, timelineClient *timeline.Client) error {
+	config.SetDefaults()
+
+	logger := log.FromContext(ctx).Scope("async")
+	ctx = log.ContextWithLogger(ctx, logger)
+
+	st, err := openStore(ctx, config.DB)
+	if err != nil {
+		return err
+	}
+	defer st.Close()
+
+	var callerKeys identity.Verifier
+	if len(config.CallerSigningKeys) > 0 {
+		keys, err := identity.NewKeySetFromSigningKeys(config.CallerSigningKeys...)
+		if err != nil {
+			return fmt.Errorf("invalid caller signing keys: %w", err)
+		}
+		callerKeys = keys
+	} else {
+		logger.Warnf("No caller signing keys configured, so only exported verbs can be called asynchronously")
+	}
+
+	svc := newService(config, st, eventSource, client, timelineClient, callerKeys)
+	reset, err := st.ResetRunning(ctx)
+	if err != nil {
+		return err
+	}
+	if reset > 0 {
+		logger.Infof("Rescheduling %d async calls interrupted by a previous shutdown", reset)
+	}
+	go func() {
+		// Calls can't be routed or matched to their retry policy until the schema is known.
+		if eventSource.WaitForInitialSync(ctx) {
+			svc.run(ctx)
+		}
+	}()
+
+	logger.Debugf("Async service listening on: %s", config.Bind)
+	err = rpc.Serve(ctx, config.Bind,
+		rpc.GRPC(asyncconnect.NewAsyncServiceHandler, svc),
+		rpc.HTTP("/", http.NotFoundHandler()),
+		rpc.PProf(),
+	)
+	if err != nil {
+		return fmt.Errorf("async service stopped serving: %w", err)
+	}
+	return nil
+}
+
+func newService(config Config, st *store, eventSource schemaeventsource.EventSource, client routing.CallClient, timelineClient *timeline.Client, callerKeys identity.Verifier) *service {
+	return &service{
+		config:         config,
+		store:          st,
+		view:           eventSource.ViewOnly(),
+		client:         client,
+		timelineClient: timelineClient,
+		callerKeys:     callerKeys,
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+func (s *service) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func (s *service) EnqueueCall(ctx context.Context, req *connect.Request[asyncpb.EnqueueCallRequest]) (*connect.Response[asyncpb.EnqueueCallResponse], error) {
+	if req.Msg.Verb == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("verb is required"))
+	}
+	verb := schema.RefFromProto(req.Msg.Verb).ToRefKey()
+	// Calls are only persisted to verbs that exist, rather than failing every attempt once they are due.
+	decl, ok := resolveVerb(s.view.Get(), verb)
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("verb %s not found", verb))
+	}
+	callers := []*schema.Ref{}
+	if req.Msg.Caller != nil {
+		callers = append(callers, schema.RefFromProto(req.Msg.Caller))
+	}
+	caller, verified := identity.ResolveCaller(ctx, s.callerKeys, req.Header(), callers).Get()
+	verified = verified && caller.Verified
+	// Async calls are only made by modules, so unlike synchronous calls, a call without a verified caller is
+	// treated as one from another module rather than as an external call.
+	if !decl.IsExported() && (!verified || caller.Module != verb.Module) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verb))
+	}
+	if !json.Valid(req.Msg.Body) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("request body for %s is not valid JSON", verb))
+	}
+	now := time.Now()
+	c := &call{
+		ID:            model.NewAsyncCallKey(verb.Module, verb.Name).String(),
+		Verb:          verb,
+		Request:       req.Msg.Body,
+		RequestKey:    model.NewRequestKey(model.OriginAsync, verb.String()).String(),
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	if verified {
+		c.CallerModule = optional.Some(caller.Module)
+	}
+	if err := s.store.Enqueue(ctx, c); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	log.FromContext(ctx).Debugf("Enqueued async call %s to %s", c.ID, verb)
+	s.notify()
+	return connect.NewResponse(&asyncpb.EnqueueCallResponse{Id: c.ID}), nil
+}
+
+func (s *service) GetCall(ctx context.Context, req *connect.Request[asyncpb.GetCallRequest]) (*connect.Response[asyncpb.GetCallResponse], error) {
+	c, err := s.store.Get(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(&asyncpb.GetCallResponse{Call: c.ToProto()}), nil
+}
+
+func (s *service) ListCalls(ctx context.Context, req *connect.Request[asyncpb.ListCallsRequest]) (*connect.Response[asyncpb.ListCallsResponse], error) {
+	state := optional.None[callState]()
+	if req.Msg.State != nil {
+		st, err := callStateFromProto(req.Msg.GetState())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		state = optional.Some(st)
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	calls, err := s.store.List(ctx, optional.Ptr(req.Msg.Module), state, limit)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&asyncpb.ListCallsResponse{Calls: slices.Map(calls, (*call).ToProto)}), nil
+}
+
+func (s *service) RetryCall(ctx context.Context, req *connect.Request[asyncpb.RetryCallRequest]) (*connect.Response[asyncpb.RetryCallResponse], error) {
+	if err := s.store.Retry(ctx, req.Msg.Id, time.Now()); err != nil {
+		return nil, toConnectError(err)
+	}
+	log.FromContext(ctx).Infof("Retrying async call %s", req.Msg.Id)
+	s.notify()
+	return connect.NewResponse(&asyncpb.RetryCallResponse{}), nil
+}
+
+func (s *service) CancelCall(ctx context.Context, req *connect.Request[asyncpb.CancelCallRequest]) (*connect.Response[asyncpb.CancelCallResponse], error) {
+	if err := s.store.Cancel(ctx, req.Msg.Id, time.Now()); err != nil {
+		return nil, toConnectError(err)
+	}
+	log.FromContext(ctx).Infof("Cancelled async call %s", req.Msg.Id)
+	return connect.NewResponse(&asyncpb.CancelCallResponse{}), nil
+}
+
+func toConnectError(err error) error {
+	var stateErr *invalidStateError
+	switch {
+	case errors.Is(err, errNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.As(err, &stateErr):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// run executes calls as they become due until the context is cancelled.
+func (s *service) run(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	slots := make(chan struct{}, max(s.config.Concurrency, 1))
+	for {
+		if free := cap(slots) - len(slots); free > 0 {
+			due, err := s.store.ClaimDue(ctx, time.Now(), free)
+			if err != nil {
+				logger.Errorf(err, "Failed to claim due async calls")
+			}
+			for _, c := range due {
+				slots <- struct{}{}
+				go func() {
+					defer func() {
+						<-slots
+						s.notify()
+					}()
+					s.execute(ctx, c)
+				}()
+			}
+		}
+
+		wait := s.config.PollInterval
+		if next, err := s.store.NextDue(ctx); err != nil {
+			logger.Errorf(err, "Failed to determine when the next async call is due")
+		} else if next, ok := next.Get(); ok {
+			wait = min(wait, max(time.Until(next), 0))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-time.After(wait):
+		}
+	}
+}
+
+// execute makes a single attempt of a call and records the outcome.
+func (s *service) execute(ctx context.Context, c *call) {
+	logger := log.FromContext(ctx)
+	start := time.Now()
+	target := c.target()
+	sch := s.view.Get()
+
+	event := timeline.AsyncExecute{
+		RequestKey: optional.Some(c.RequestKey),
+		EventType:  timeline.AsyncExecuteEventTypeCall,
+		Verb:       *target.ToRef(),
+		Time:       start,
+	}
+	if module, ok := sch.Module(target.Module).Get(); ok && module.Runtime != nil && module.Runtime.Deployment != nil {
+		if key, err := model.ParseDeploymentKey(module.Runtime.Deployment.DeploymentKey); err == nil {
+			event.DeploymentKey = key
+		}
+	}
+
+	verb, ok := resolveVerb(sch, c.Verb)
+	var callErr error
+	var response []byte
+	if !ok {
+		callErr = fmt.Errorf("verb %s not found", c.Verb)
+	} else {
+		response, callErr = s.call(ctx, c, verb)
+	}
+
+	now := time.Now()
+	c.UpdatedAt = now
+	if callErr == nil {
+		logger.Debugf("Async call %s to %s succeeded", c.ID, target)
+		c.State = callStateSucceeded
+		c.Response = optional.Some(response)
+		c.Error = optional.None[string]()
+	} else {
+		event.Error = optional.Some(callErr.Error())
+		c.Error = optional.Some(callErr.Error())
+		s.scheduleRetry(ctx, c, verb, now)
+	}
+	s.timelineClient.Publish(ctx, event)
+	if err := s.store.Update(ctx, c); err != nil {
+		logger.Errorf(err, "Failed to record outcome of async call %s", c.ID)
+	}
+}
+
+// scheduleRetry updates a failed call according to the callee's retry policy.
+//
+// Once retries are exhausted the call switches to the catch verb if one is
+// declared, which is then retried with the callee's backoff up to
+// [Config.CatchAttempts] times.
+func (s *service) scheduleRetry(ctx context.Context, c *call, verb *schema.Verb, now time.Time) {
+	logger := log.FromContext(ctx)
+	params := schema.RetryParams{}
+	if verb != nil {
+		if retry, ok := slices.FindVariant[*schema.MetadataRetry](verb.Metadata); ok {
+			var err error
+			if params, err = retry.RetryParams(); err != nil {
+				logger.Errorf(err, "Invalid retry policy for %s", c.Verb)
+			}
+		}
+	}
+	catch, catching := c.CatchVerb.Get()
+	switch {
+	case catching && c.Attempts >= s.config.CatchAttempts:
+		c.State = callStateFailed
+		logger.Errorf(errors.New(c.Error.Default("")), "Async call %s to %s failed, catch verb %s failed after %d attempts", c.ID, c.Verb, catch, c.Attempts)
+
+	case catching || c.Attempts <= params.Count:
+		c.State = callStatePending
+		c.NextAttemptAt = now.Add(backoff(params, c.Attempts))
+		logger.Warnf("Async call %s to %s failed, retrying at %s: %s", c.ID, c.target(), c.NextAttemptAt.Format(time.RFC3339), c.Error.Default(""))
+
+	case params.Catch.Ok():
+		catch, _ := params.Catch.Get()
+		c.State = callStatePending
+		c.CatchVerb = optional.Some(catch)
+		c.Attempts = 0
+		c.NextAttemptAt = now
+		logger.Warnf("Async call %s to %s failed after %d retries, calling catch verb %s: %s", c.ID, c.Verb, params.Count, catch, c.Error.Default(""))
+
+	default:
+		c.State = callStateFailed
+		logger.Errorf(errors.New(c.Error.Default("")), "Async call %s to %s failed", c.ID, c.Verb)
+	}
+}
+
+// backoff returns the delay before the next attempt, doubling from the
+// minimum backoff until the maximum is reached.
+func backoff(params schema.RetryParams, attempts int) time.Duration {
+	delay := max(params.MinBackoff, schema.MinBackoffLimit)
+	maxDelay := max(params.MaxBackoff, delay)
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+func (s *service) call(ctx context.Context, c *call, verb *schema.Verb) ([]byte, error) {
+	body := c.Request
+	if _, ok := c.CatchVerb.Get(); ok {
+		var err error
+		body, err = json.Marshal(map[string]any{
+			"verb":        map[string]string{"module": c.Verb.Module, "name": c.Verb.Name},
+			"request":     json.RawMessage(c.Request),
+			"requestType": verb.Request.String(),
+			"error":       c.Error.Default(""),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode catch request: %w", err)
+		}
+	}
+	req := connect.NewRequest(&ftlv1.CallRequest{
+		Verb:     c.target().ToProto(),
+		Body:     body,
+		Metadata: &ftlv1.Metadata{},
+	})
+	// Every attempt of a call shares an idempotency key.
+	req.Msg.Metadata.Set(headers.IdempotencyKeyHeader, c.ID)
+	if key, err := model.ParseRequestKey(c.RequestKey); err == nil {
+		headers.SetRequestKey(req.Header(), key)
+	}
+	// The call was authorised when it was enqueued, so it is made without a caller chain, as with cron jobs. The
+	// chain alone can't be verified by the callee, so it would limit the call to exported verbs.
+	resp, err := s.client.Call(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("call to %s failed: %w", c.target(), err)
+	}
+	switch resp := resp.Msg.Response.(type) {
+	case *ftlv1.CallResponse_Error_:
+		return nil, fmt.Errorf("%s: %s", c.target(), resp.Error.Message)
+	case *ftlv1.CallResponse_Body:
+		return resp.Body, nil
+	default:
+		return nil, nil
+	}
+}
+
+func resolveVerb(sch *schema.Schema, ref schema.RefKey) (*schema.Verb, bool) {
+	decl, ok := sch.Resolve(ref.ToRef()).Get()
+	if !ok {
+		return nil, false
+	}
+	verb, ok := decl.(*schema.Verb)
+	return verb, ok
+}
+
+func (c *call) ToProto() *asyncpb.AsyncCall {
+	out := &asyncpb.AsyncCall{
+		Id:         c.ID,
+		Verb:       c.Verb.ToProto(),
+		Request:    c.Request,
+		State:      c.State.ToProto(),
+		Attempts:   int64(c.Attempts),
+		Error:      c.Error.Ptr(),
+		RequestKey: c.RequestKey,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+		UpdatedAt:  timestamppb.New(c.UpdatedAt),
+	}
+	out.CallerModule = c.CallerModule.Ptr()
+	if response, ok := c.Response.Get(); ok {
+		out.Response = response
+	}
+	if catch, ok := c.CatchVerb.Get(); ok {
+		out.CatchVerb = catch.ToProto()
+	}
+	if c.State == callStatePending {
+		out.NextAttemptAt = timestamppb.New(c.NextAttemptAt)
+	}
+	return out
+}
+
+func (s callState) ToProto() asyncpb.AsyncCallState {
+	switch s {
+	case callStatePending:
+		return asyncpb.AsyncCallState_ASYNC_CALL_STATE_PENDING
+	case callStateRunning:
+		return asyncpb.AsyncCallState_ASYNC_CALL_STATE_RUNNING
+	case callStateSucceeded:
+		return asyncpb.AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED
+	case callStateFailed:
+		return asyncpb.AsyncCallState_ASYNC_CALL_STATE_FAILED
+	case callStateCancelled:
+		return asyncpb.AsyncCallState_ASYNC_CALL_STATE_CANCELLED
+	default:
+		return asyncpb.AsyncCallState_ASYNC_CALL_STATE_UNSPECIFIED
+	}
+}
+
+func callStateFromProto(s asyncpb.AsyncCallState) (callState, error) {
+	switch s {
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_PENDING:
+		return callStatePending, nil
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_RUNNING:
+		return callStateRunning, nil
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED:
+		return callStateSucceeded, nil
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_FAILED:
+		return callStateFailed, nil
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_CANCELLED:
+		return callStateCancelled, nil
+	default:
+		return "", fmt.Errorf("unsupported async call state %s", s)
+	}
+}
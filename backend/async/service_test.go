@@ -0,0 +1,351 @@
+package async
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	asyncpb "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/timeline"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/identity"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/routing"
+	"github.com/block/ftl/internal/rpc/headers"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
+)
+
+// verbClient fails calls to any verb in failing, and records every request.
+type verbClient struct {
+	lock     sync.Mutex
+	failing  map[string]bool
+	requests []*ftlv1.CallRequest
+}
+
+var _ routing.CallClient = (*verbClient)(nil)
+
+func (v *verbClient) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.requests = append(v.requests, req.Msg)
+	if v.failing[req.Msg.Verb.Name] {
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: "boom"}}}), nil
+	}
+	return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte(`"ok"`)}}), nil
+}
+
+func (v *verbClient) setFailing(verb string, failing bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.failing[verb] = failing
+}
+
+func (v *verbClient) requestsTo(verb string) []*ftlv1.CallRequest {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	out := []*ftlv1.CallRequest{}
+	for _, req := range v.requests {
+		if req.Verb.Name == verb {
+			out = append(out, req)
+		}
+	}
+	return out
+}
+
+func newTestService(t *testing.T, client routing.CallClient) (context.Context, *service) {
+	t.Helper()
+	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, log.Config{Level: log.Debug}))
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	t.Cleanup(cancel)
+
+	zero := 0
+	eventSource := schemaeventsource.NewUnattached()
+	eventSource.Publish(schemaeventsource.EventUpsert{
+		Deployment: optional.Some(model.NewDeploymentKey("test")),
+		Module: &schema.Module{
+			Name: "test",
+			Runtime: &schema.ModuleRuntime{
+				Deployment: &schema.ModuleRuntimeDeployment{DeploymentKey: model.NewDeploymentKey("test").String()},
+			},
+			Decls: []schema.Decl{
+				&schema.Verb{Name: "echo", Request: &schema.String{}, Response: &schema.String{}},
+				&schema.Verb{Name: "greet", Export: true, Request: &schema.String{}, Response: &schema.String{}},
+				&schema.Verb{
+					Name: "charge", Request: &schema.String{}, Response: &schema.Unit{},
+					Metadata: []schema.Metadata{&schema.MetadataRetry{Count: &zero, Catch: &schema.Ref{Module: "test", Name: "recover"}}},
+				},
+				&schema.Verb{Name: "recover", Request: &schema.Ref{Module: "builtin", Name: "CatchRequest", TypeParameters: []schema.Type{&schema.String{}}}, Response: &schema.Unit{}},
+			},
+		},
+	})
+
+	st, err := openStore(ctx, filepath.Join(t.TempDir(), "async.db"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = st.Close() })
+
+	timelineEndpoint, err := url.Parse("http://localhost:8080")
+	assert.NoError(t, err)
+	signer, err := identity.NewSigner(time.Minute)
+	assert.NoError(t, err)
+	config := Config{}
+	config.SetDefaults()
+	return ctx, newService(config, st, eventSource, client, timeline.NewClient(ctx, timelineEndpoint), signer)
+}
+
+// callerToken mints a token identifying a deployment of module, as the controller would.
+func callerToken(t *testing.T, svc *service, module string) string {
+	t.Helper()
+	signer, ok := svc.callerKeys.(*identity.Signer)
+	assert.True(t, ok)
+	token, _, err := signer.Mint(model.NewDeploymentKey(module))
+	assert.NoError(t, err)
+	return token
+}
+
+// enqueueAs enqueues a call from the caller verb, identified by token if it is not empty.
+func enqueueAs(ctx context.Context, svc *service, caller *schema.Ref, token string, verb string, body string) (*connect.Response[asyncpb.EnqueueCallResponse], error) {
+	req := connect.NewRequest(&asyncpb.EnqueueCallRequest{
+		Verb:   (&schema.Ref{Module: "test", Name: verb}).ToProto(),
+		Body:   []byte(body),
+		Caller: caller.ToProto(),
+	})
+	if token != "" {
+		headers.SetCallerToken(req.Header(), token)
+	}
+	return svc.EnqueueCall(ctx, req)
+}
+
+func enqueue(ctx context.Context, t *testing.T, svc *service, verb string, body string) string {
+	t.Helper()
+	resp, err := enqueueAs(ctx, svc, &schema.Ref{Module: "test", Name: "caller"}, callerToken(t, svc, "test"), verb, body)
+	assert.NoError(t, err)
+	return resp.Msg.Id
+}
+
+func waitForState(ctx context.Context, t *testing.T, svc *service, id string, state asyncpb.AsyncCallState) *asyncpb.AsyncCall {
+	t.Helper()
+	for {
+		resp, err := svc.GetCall(ctx, connect.NewRequest(&asyncpb.GetCallRequest{Id: id}))
+		assert.NoError(t, err)
+		if resp.Msg.Call.State == state {
+			return resp.Msg.Call
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for %s to be %s, last state %s", id, state, resp.Msg.Call.State)
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+}
+
+func TestAsyncCall(t *testing.T) {
+	client := &verbClient{failing: map[string]bool{}}
+	ctx, svc := newTestService(t, client)
+	go svc.run(ctx)
+
+	id := enqueue(ctx, t, svc, "echo", `"hello"`)
+	call := waitForState(ctx, t, svc, id, asyncpb.AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED)
+	assert.Equal(t, `"ok"`, string(call.Response))
+	assert.Equal(t, 1, call.Attempts)
+	assert.Equal(t, "test", call.GetCallerModule())
+
+	requests := client.requestsTo("echo")
+	assert.Equal(t, 1, len(requests))
+	assert.Equal(t, `"hello"`, string(requests[0].Body))
+}
+
+func TestEnqueueCallToMissingVerb(t *testing.T) {
+	ctx, svc := newTestService(t, &verbClient{failing: map[string]bool{}})
+
+	_, err := svc.EnqueueCall(ctx, connect.NewRequest(&asyncpb.EnqueueCallRequest{
+		Verb: (&schema.Ref{Module: "test", Name: "missing"}).ToProto(),
+		Body: []byte(`"hello"`),
+	}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	calls, err := svc.ListCalls(ctx, connect.NewRequest(&asyncpb.ListCallsRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(calls.Msg.Calls))
+}
+
+func TestEnqueueCallChecksCaller(t *testing.T) {
+	ctx, svc := newTestService(t, &verbClient{failing: map[string]bool{}})
+	self := &schema.Ref{Module: "test", Name: "caller"}
+	other := &schema.Ref{Module: "other", Name: "caller"}
+
+	t.Run("SelfCall", func(t *testing.T) {
+		resp, err := enqueueAs(ctx, svc, self, callerToken(t, svc, "test"), "echo", `"hello"`)
+		assert.NoError(t, err)
+		call, err := svc.GetCall(ctx, connect.NewRequest(&asyncpb.GetCallRequest{Id: resp.Msg.Id}))
+		assert.NoError(t, err)
+		assert.Equal(t, "test", call.Msg.Call.GetCallerModule())
+	})
+
+	t.Run("CrossModuleCall", func(t *testing.T) {
+		_, err := enqueueAs(ctx, svc, other, callerToken(t, svc, "other"), "echo", `"hello"`)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		resp, err := enqueueAs(ctx, svc, other, callerToken(t, svc, "other"), "greet", `"hello"`)
+		assert.NoError(t, err)
+		call, err := svc.GetCall(ctx, connect.NewRequest(&asyncpb.GetCallRequest{Id: resp.Msg.Id}))
+		assert.NoError(t, err)
+		assert.Equal(t, "other", call.Msg.Call.GetCallerModule())
+	})
+
+	t.Run("ForgedCaller", func(t *testing.T) {
+		// The caller claims to be in the module, but carries a token for another module.
+		_, err := enqueueAs(ctx, svc, self, callerToken(t, svc, "other"), "echo", `"hello"`)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	})
+
+	t.Run("MissingToken", func(t *testing.T) {
+		_, err := enqueueAs(ctx, svc, self, "", "echo", `"hello"`)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		_, err = enqueueAs(ctx, svc, nil, "", "echo", `"hello"`)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		resp, err := enqueueAs(ctx, svc, self, "", "greet", `"hello"`)
+		assert.NoError(t, err)
+		call, err := svc.GetCall(ctx, connect.NewRequest(&asyncpb.GetCallRequest{Id: resp.Msg.Id}))
+		assert.NoError(t, err)
+		assert.Zero(t, call.Msg.Call.CallerModule)
+	})
+}
+
+func TestAsyncCallCatch(t *testing.T) {
+	client := &verbClient{failing: map[string]bool{"charge": true}}
+	ctx, svc := newTestService(t, client)
+	go svc.run(ctx)
+
+	id := enqueue(ctx, t, svc, "charge", `"payment"`)
+	call := waitForState(ctx, t, svc, id, asyncpb.AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED)
+	assert.Equal(t, "recover", call.CatchVerb.Name)
+
+	requests := client.requestsTo("recover")
+	assert.Equal(t, 1, len(requests))
+	var catchRequest map[string]any
+	assert.NoError(t, json.Unmarshal(requests[0].Body, &catchRequest))
+	assert.Equal(t, map[string]any{
+		"verb":        map[string]any{"module": "test", "name": "charge"},
+		"request":     "payment",
+		"requestType": "String",
+		"error":       "test.charge: boom",
+	}, catchRequest)
+}
+
+func TestAsyncCallFailAndRetry(t *testing.T) {
+	client := &verbClient{failing: map[string]bool{"echo": true}}
+	ctx, svc := newTestService(t, client)
+	go svc.run(ctx)
+
+	id := enqueue(ctx, t, svc, "echo", `"hello"`)
+	call := waitForState(ctx, t, svc, id, asyncpb.AsyncCallState_ASYNC_CALL_STATE_FAILED)
+	assert.Equal(t, "test.echo: boom", call.GetError())
+
+	client.setFailing("echo", false)
+	_, err := svc.RetryCall(ctx, connect.NewRequest(&asyncpb.RetryCallRequest{Id: id}))
+	assert.NoError(t, err)
+	call = waitForState(ctx, t, svc, id, asyncpb.AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED)
+	assert.Equal(t, 1, call.Attempts)
+	assert.Equal(t, 2, len(client.requestsTo("echo")))
+
+	_, err = svc.RetryCall(ctx, connect.NewRequest(&asyncpb.RetryCallRequest{Id: id}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestAsyncCallCancel(t *testing.T) {
+	client := &verbClient{failing: map[string]bool{}}
+	// The executor is not started, so calls remain pending.
+	ctx, svc := newTestService(t, client)
+
+	id := enqueue(ctx, t, svc, "echo", `"hello"`)
+	_, err := svc.CancelCall(ctx, connect.NewRequest(&asyncpb.CancelCallRequest{Id: id}))
+	assert.NoError(t, err)
+	waitForState(ctx, t, svc, id, asyncpb.AsyncCallState_ASYNC_CALL_STATE_CANCELLED)
+
+	_, err = svc.CancelCall(ctx, connect.NewRequest(&asyncpb.CancelCallRequest{Id: id}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	_, err = svc.CancelCall(ctx, connect.NewRequest(&asyncpb.CancelCallRequest{Id: "acl-test-missing-0000"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	cancelled := asyncpb.AsyncCallState_ASYNC_CALL_STATE_CANCELLED
+	list, err := svc.ListCalls(ctx, connect.NewRequest(&asyncpb.ListCallsRequest{State: &cancelled}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list.Msg.Calls))
+	assert.Equal(t, id, list.Msg.Calls[0].Id)
+}
+
+func TestStoreResetRunning(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "async.db")
+	st, err := openStore(ctx, path)
+	assert.NoError(t, err)
+	now := time.Now()
+	err = st.Enqueue(ctx, &call{
+		ID:            "acl-test-echo-0001",
+		Verb:          schema.RefKey{Module: "test", Name: "echo"},
+		Request:       []byte(`{}`),
+		RequestKey:    "req-async-test-0001",
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	})
+	assert.NoError(t, err)
+	claimed, err := st.ClaimDue(ctx, now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(claimed))
+	assert.NoError(t, st.Close())
+
+	// Simulate a restart while the call was running.
+	st, err = openStore(ctx, path)
+	assert.NoError(t, err)
+	defer st.Close()
+	reset, err := st.ResetRunning(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), reset)
+	c, err := st.Get(ctx, "acl-test-echo-0001")
+	assert.NoError(t, err)
+	assert.Equal(t, callStatePending, c.State)
+	assert.Equal(t, 1, c.Attempts)
+}
+
+func TestBackoff(t *testing.T) {
+	params := schema.RetryParams{Count: 10, MinBackoff: 5 * time.Second, MaxBackoff: time.Minute}
+	delays := []time.Duration{}
+	for attempt := 1; attempt <= 6; attempt++ {
+		delays = append(delays, backoff(params, attempt))
+	}
+	assert.Equal(t, []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}, delays)
+}
+
+func TestCatchAttemptsAreCapped(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	config := Config{}
+	config.SetDefaults()
+	svc := &service{config: config}
+	now := time.Now()
+	c := &call{
+		ID:        "test",
+		Verb:      schema.RefKey{Module: "test", Name: "charge"},
+		CatchVerb: optional.Some(schema.RefKey{Module: "test", Name: "recover"}),
+		Error:     optional.Some("boom"),
+	}
+
+	c.Attempts = config.CatchAttempts - 1
+	svc.scheduleRetry(ctx, c, nil, now)
+	assert.Equal(t, callStatePending, c.State)
+
+	c.Attempts = config.CatchAttempts
+	svc.scheduleRetry(ctx, c, nil, now)
+	assert.Equal(t, callStateFailed, c.State)
+}
@@ -0,0 +1,370 @@
+package async
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	_ "modernc.org/sqlite" // SQL driver
+
+	"github.com/block/ftl/common/schema"
+)
+
+type callState string
+
+const (
+	callStatePending   callState = "pending"
+	callStateRunning   callState = "running"
+	callStateSucceeded callState = "succeeded"
+	callStateFailed    callState = "failed"
+	callStateCancelled callState = "cancelled"
+)
+
+var errNotFound = errors.New("async call not found")
+
+// call is a durable record of an asynchronous verb call.
+type call struct {
+	ID   string
+	Verb schema.RefKey
+	// CallerModule is the module that enqueued the call, if it was identified by its caller token.
+	CallerModule optional.Option[string]
+	Request      []byte
+	State        callState
+	// Attempts is the number of attempts made so far, reset when the call
+	// switches to its catch verb or is manually retried.
+	Attempts int
+	Response optional.Option[[]byte]
+	Error    optional.Option[string]
+	// CatchVerb is set once the callee's retries are exhausted and the catch
+	// verb is being called instead.
+	CatchVerb     optional.Option[schema.RefKey]
+	RequestKey    string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	NextAttemptAt time.Time
+}
+
+// target returns the verb the next attempt should call.
+func (c *call) target() schema.RefKey {
+	if catch, ok := c.CatchVerb.Get(); ok {
+		return catch
+	}
+	return c.Verb
+}
+
+// store is a file-backed queue of asynchronous calls.
+type store struct {
+	db *sql.DB
+}
+
+func openStore(ctx context.Context, path string) (*store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create directory for async store %q: %w", path, err)
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open async store %q: %w", path, err)
+	}
+	// Serialise all access through a single connection so state transitions are atomic.
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		`CREATE TABLE IF NOT EXISTS calls (
+			id TEXT PRIMARY KEY,
+			module TEXT NOT NULL,
+			verb TEXT NOT NULL,
+			caller TEXT,
+			request BLOB NOT NULL,
+			state TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			response BLOB,
+			error TEXT,
+			catch_verb TEXT,
+			request_key TEXT NOT NULL,
+			created_at INTEGER NOT NULL,
+			updated_at INTEGER NOT NULL,
+			next_attempt_at INTEGER NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS calls_due ON calls (state, next_attempt_at)`,
+		`CREATE INDEX IF NOT EXISTS calls_created ON calls (created_at)`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("failed to initialise async store %q: %w", path, err)
+		}
+	}
+	return &store{db: db}, nil
+}
+
+func (s *store) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("failed to close async store: %w", err)
+	}
+	return nil
+}
+
+func (s *store) Enqueue(ctx context.Context, c *call) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO calls
+		(id, module, verb, caller, request, state, attempts, request_key, created_at, updated_at, next_attempt_at)
+		VALUES (?, ?, ?, ?, ?, ?, 0, ?, ?, ?, ?)`,
+		c.ID, c.Verb.Module, c.Verb, nullString(c.CallerModule), c.Request, callStatePending, c.RequestKey,
+		c.CreatedAt.UnixNano(), c.CreatedAt.UnixNano(), c.NextAttemptAt.UnixNano())
+	if err != nil {
+		return fmt.Errorf("failed to enqueue async call: %w", err)
+	}
+	return nil
+}
+
+const callColumns = `id, verb, caller, request, state, attempts, response, error, catch_verb, request_key, created_at, updated_at, next_attempt_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCall(row scanner) (*call, error) {
+	var (
+		c                               call
+		caller, errMsg, catchVerb       sql.NullString
+		response                        []byte
+		createdAt, updatedAt, nextAttAt int64
+	)
+	err := row.Scan(&c.ID, &c.Verb, &caller, &c.Request, &c.State, &c.Attempts, &response, &errMsg, &catchVerb,
+		&c.RequestKey, &createdAt, &updatedAt, &nextAttAt)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	if caller.Valid {
+		c.CallerModule = optional.Some(caller.String)
+	}
+	if c.CatchVerb, err = parseNullRef(catchVerb); err != nil {
+		return nil, err
+	}
+	if response != nil {
+		c.Response = optional.Some(response)
+	}
+	if errMsg.Valid {
+		c.Error = optional.Some(errMsg.String)
+	}
+	c.CreatedAt = time.Unix(0, createdAt)
+	c.UpdatedAt = time.Unix(0, updatedAt)
+	c.NextAttemptAt = time.Unix(0, nextAttAt)
+	return &c, nil
+}
+
+func (s *store) Get(ctx context.Context, id string) (*call, error) {
+	c, err := scanCall(s.db.QueryRowContext(ctx, "SELECT "+callColumns+" FROM calls WHERE id = ?", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get async call %s: %w", id, err)
+	}
+	return c, nil
+}
+
+// List calls, most recent first.
+func (s *store) List(ctx context.Context, module optional.Option[string], state optional.Option[callState], limit int) ([]*call, error) {
+	where := []string{"1 = 1"}
+	args := []any{}
+	if module, ok := module.Get(); ok {
+		where = append(where, "module = ?")
+		args = append(args, module)
+	}
+	if state, ok := state.Get(); ok {
+		where = append(where, "state = ?")
+		args = append(args, state)
+	}
+	args = append(args, limit)
+	rows, err := s.db.QueryContext(ctx, "SELECT "+callColumns+" FROM calls WHERE "+strings.Join(where, " AND ")+
+		" ORDER BY created_at DESC LIMIT ?", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list async calls: %w", err)
+	}
+	defer rows.Close()
+	out := []*call{}
+	for rows.Next() {
+		c, err := scanCall(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list async calls: %w", err)
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list async calls: %w", err)
+	}
+	return out, nil
+}
+
+// ClaimDue marks up to limit pending calls that are due as running and returns them.
+func (s *store) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*call, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	rows, err := tx.QueryContext(ctx, "SELECT "+callColumns+" FROM calls WHERE state = ? AND next_attempt_at <= ? ORDER BY next_attempt_at LIMIT ?",
+		callStatePending, now.UnixNano(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due async calls: %w", err)
+	}
+	out := []*call{}
+	for rows.Next() {
+		c, err := scanCall(rows)
+		if err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to query due async calls: %w", err)
+		}
+		out = append(out, c)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("failed to query due async calls: %w", err)
+	}
+	for _, c := range out {
+		c.State = callStateRunning
+		c.Attempts++
+		c.UpdatedAt = now
+		_, err := tx.ExecContext(ctx, "UPDATE calls SET state = ?, attempts = ?, updated_at = ? WHERE id = ?",
+			c.State, c.Attempts, now.UnixNano(), c.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim async call %s: %w", c.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit claimed async calls: %w", err)
+	}
+	return out, nil
+}
+
+// NextDue returns when the earliest pending call is due, if any.
+func (s *store) NextDue(ctx context.Context) (optional.Option[time.Time], error) {
+	var next sql.NullInt64
+	err := s.db.QueryRowContext(ctx, "SELECT MIN(next_attempt_at) FROM calls WHERE state = ?", callStatePending).Scan(&next)
+	if err != nil {
+		return optional.None[time.Time](), fmt.Errorf("failed to query next due async call: %w", err)
+	}
+	if !next.Valid {
+		return optional.None[time.Time](), nil
+	}
+	return optional.Some(time.Unix(0, next.Int64)), nil
+}
+
+// Update persists the result of an attempt.
+//
+// Updates are only applied to calls that are still running, so a call
+// cancelled or retried while it was executing is left untouched.
+func (s *store) Update(ctx context.Context, c *call) error {
+	var response []byte
+	if r, ok := c.Response.Get(); ok {
+		response = r
+	}
+	_, err := s.db.ExecContext(ctx, `UPDATE calls
+		SET state = ?, attempts = ?, response = ?, error = ?, catch_verb = ?, updated_at = ?, next_attempt_at = ?
+		WHERE id = ? AND state = ?`,
+		c.State, c.Attempts, response, c.Error.Ptr(), nullRef(c.CatchVerb), c.UpdatedAt.UnixNano(), c.NextAttemptAt.UnixNano(),
+		c.ID, callStateRunning)
+	if err != nil {
+		return fmt.Errorf("failed to update async call %s: %w", c.ID, err)
+	}
+	return nil
+}
+
+// Retry reschedules a failed or cancelled call from scratch.
+func (s *store) Retry(ctx context.Context, id string, now time.Time) error {
+	return s.transition(ctx, id, []callState{callStateFailed, callStateCancelled}, `UPDATE calls
+		SET state = ?, attempts = 0, error = NULL, catch_verb = NULL, updated_at = ?, next_attempt_at = ?
+		WHERE id = ?`, callStatePending, now.UnixNano(), now.UnixNano(), id)
+}
+
+// Cancel a pending call.
+func (s *store) Cancel(ctx context.Context, id string, now time.Time) error {
+	return s.transition(ctx, id, []callState{callStatePending}, `UPDATE calls SET state = ?, updated_at = ? WHERE id = ?`,
+		callStateCancelled, now.UnixNano(), id)
+}
+
+// ResetRunning returns calls left running by a previous process to pending,
+// so they are executed again.
+func (s *store) ResetRunning(ctx context.Context) (int64, error) {
+	res, err := s.db.ExecContext(ctx, "UPDATE calls SET state = ? WHERE state = ?", callStatePending, callStateRunning)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset running async calls: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset running async calls: %w", err)
+	}
+	return n, nil
+}
+
+func (s *store) transition(ctx context.Context, id string, from []callState, query string, args ...any) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var state callState
+	err = tx.QueryRowContext(ctx, "SELECT state FROM calls WHERE id = ?", id).Scan(&state)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get async call %s: %w", id, err)
+	}
+	valid := false
+	for _, f := range from {
+		if state == f {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return &invalidStateError{id: id, state: state}
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to update async call %s: %w", id, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit async call %s: %w", id, err)
+	}
+	return nil
+}
+
+type invalidStateError struct {
+	id    string
+	state callState
+}
+
+func (e *invalidStateError) Error() string {
+	return fmt.Sprintf("async call %s is %s", e.id, e.state)
+}
+
+func nullString(s optional.Option[string]) sql.NullString {
+	if s, ok := s.Get(); ok {
+		return sql.NullString{String: s, Valid: true}
+	}
+	return sql.NullString{}
+}
+
+func nullRef(ref optional.Option[schema.RefKey]) sql.NullString {
+	if ref, ok := ref.Get(); ok {
+		return sql.NullString{String: ref.String(), Valid: true}
+	}
+	return sql.NullString{}
+}
+
+func parseNullRef(s sql.NullString) (optional.Option[schema.RefKey], error) {
+	if !s.Valid {
+		return optional.None[schema.RefKey](), nil
+	}
+	ref, err := schema.ParseRef(s.String)
+	if err != nil {
+		return optional.None[schema.RefKey](), fmt.Errorf("invalid verb reference %q: %w", s.String, err)
+	}
+	return optional.Some(ref.ToRefKey()), nil
+}
@@ -143,7 +143,7 @@ func (s *service) GetModules(ctx context.Context, req *connect.Request[consolepb
 			case *schema.Config:
 				configs = append(configs, configFromDecl(decl, mod.Name, nilMap))
 
-			case *schema.Database, *schema.Enum, *schema.TypeAlias, *schema.Topic:
+			case *schema.Database, *schema.Enum, *schema.TypeAlias, *schema.Topic, *schema.KV, *schema.ObjectStore:
 			}
 		}
 
@@ -232,6 +232,9 @@ func moduleFromDecls(decls []schema.Decl, sch *schema.Schema, module string, ref
 				return nil, err
 			}
 			verbs = append(verbs, verb)
+
+		case *schema.KV, *schema.ObjectStore:
+			// Key-value and object stores are not yet surfaced in the console beyond the module schema.
 		}
 	}
 
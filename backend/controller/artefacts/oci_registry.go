@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/alecthomas/types/optional"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	googleremote "github.com/google/go-containerregistry/pkg/v1/remote"
@@ -106,6 +107,50 @@ func (s *OCIArtefactService) GetDigestsKeys(ctx context.Context, digests []sha25
 
 // Upload uploads the specific artifact as a raw blob and links it to a manifest to prevent GC
 func (s *OCIArtefactService) Upload(ctx context.Context, artefact Artefact) (sha256.SHA256, error) {
+	return s.upload(ctx, artefact, optional.None[string]())
+}
+
+// UploadTagged uploads the artifact like [OCIArtefactService.Upload], but tags
+// the manifest with the given tag so it can later be retrieved with
+// [OCIArtefactService.DownloadTagged].
+func (s *OCIArtefactService) UploadTagged(ctx context.Context, tag string, artefact Artefact) (sha256.SHA256, error) {
+	return s.upload(ctx, artefact, optional.Some(tag))
+}
+
+// DownloadTagged downloads the artifact tagged with the given tag by
+// [OCIArtefactService.UploadTagged].
+//
+// Returns false if the tag does not exist.
+func (s *OCIArtefactService) DownloadTagged(ctx context.Context, tag string) (io.ReadCloser, bool, error) {
+	repo, err := s.repoFactory()
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to connect to repository '%s': %w", s.config.Registry, err)
+	}
+	_, manifestBlob, err := oras.FetchBytes(ctx, repo, tag, oras.DefaultFetchBytesOptions)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, fmt.Errorf("unable to fetch manifest for tag '%s': %w", tag, err)
+	}
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(manifestBlob, &manifest); err != nil {
+		return nil, false, fmt.Errorf("unable to parse manifest for tag '%s': %w", tag, err)
+	}
+	if len(manifest.Layers) != 1 {
+		return nil, false, fmt.Errorf("expected a single layer for tag '%s', found %d", tag, len(manifest.Layers))
+	}
+	dg, err := sha256.ParseSHA256(manifest.Layers[0].Digest.Hex())
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to parse sha %w", err)
+	}
+	reader, err := s.Download(ctx, dg)
+	if err != nil {
+		return nil, false, err
+	}
+	return reader, true, nil
+}
+
+func (s *OCIArtefactService) upload(ctx context.Context, artefact Artefact, tagOverride optional.Option[string]) (sha256.SHA256, error) {
 	repo, err := s.repoFactory()
 	logger := log.FromContext(ctx)
 	if err != nil {
@@ -120,13 +165,13 @@ func (s *OCIArtefactService) Upload(ctx context.Context, artefact Artefact) (sha
 	if err != nil {
 		return sha256.SHA256{}, fmt.Errorf("unable to push to in memory repository %w", err)
 	}
-	tag := desc.Digest.Hex()
-	parseSHA256, err := sha256.ParseSHA256(tag)
+	parseSHA256, err := sha256.ParseSHA256(desc.Digest.Hex())
 	if err != nil {
 		return sha256.SHA256{}, fmt.Errorf("unable to parse sha %w", err)
 	}
 	artefact.Digest = parseSHA256
-	logger.Debugf("Tagging module blob with digest '%s'", tag)
+	tag := tagOverride.Default(desc.Digest.Hex())
+	logger.Debugf("Tagging module blob with '%s'", tag)
 
 	fileDescriptors := []ocispec.Descriptor{desc}
 	var configBlob []byte
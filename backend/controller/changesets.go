@@ -0,0 +1,252 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/block/ftl/backend/controller/state"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/timeline"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+)
+
+func (s *Service) CreateChangeset(ctx context.Context, req *connect.Request[ftlv1.CreateChangesetRequest]) (*connect.Response[ftlv1.CreateChangesetResponse], error) {
+	logger := log.FromContext(ctx)
+	if len(req.Msg.Modules) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("changeset must contain at least one module"))
+	}
+	modules, err := slices.MapErr(req.Msg.Modules, schema.ModuleFromProto)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid module schema: %w", err))
+	}
+	view, err := s.controllerState.View(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get controller state: %w", err)
+	}
+	if err := validateChangesetSchema(view, modules); err != nil {
+		logger.Errorf(err, "Invalid changeset schema")
+		return nil, fmt.Errorf("invalid changeset schema: %w", err)
+	}
+
+	key := model.NewChangesetKey()
+	err = s.controllerState.Publish(ctx, &state.ChangesetCreatedEvent{
+		Key:       key,
+		CreatedAt: time.Now(),
+		Modules:   modules,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create changeset: %w", err)
+	}
+	logger.Debugf("Created changeset %s for %s", key, slices.Map(modules, func(m *schema.Module) string { return m.Name }))
+	return connect.NewResponse(&ftlv1.CreateChangesetResponse{Changeset: key.String()}), nil
+}
+
+func (s *Service) CommitChangeset(ctx context.Context, req *connect.Request[ftlv1.CommitChangesetRequest]) (*connect.Response[ftlv1.CommitChangesetResponse], error) {
+	logger := log.FromContext(ctx)
+	key, err := model.ParseChangesetKey(req.Msg.Changeset)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid changeset key: %w", err))
+	}
+	view, err := s.controllerState.View(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get controller state: %w", err)
+	}
+	changeset, err := view.GetChangeset(key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if changeset.State != state.ChangesetStateOpen {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("changeset %s is %s", key, changeset.State))
+	}
+
+	deployments := map[string]*state.Deployment{}
+	ordered := make([]*state.Deployment, 0, len(changeset.Deployments))
+	for _, dkey := range changeset.Deployments {
+		deployment, err := view.GetDeployment(dkey)
+		if err != nil {
+			return nil, fmt.Errorf("could not get deployment: %w", err)
+		}
+		if existing, ok := deployments[deployment.Module]; ok {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("changeset %s has multiple deployments of module %q: %s and %s", key, deployment.Module, existing.Key, dkey))
+		}
+		deployments[deployment.Module] = deployment
+		ordered = append(ordered, deployment)
+	}
+	for _, module := range changeset.Modules {
+		if _, ok := deployments[module.Name]; !ok {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("changeset %s has no deployment of module %q", key, module.Name))
+		}
+	}
+	// Other modules may have been deployed since the changeset was created.
+	if err := validateChangesetSchema(view, slices.Map(ordered, func(d *state.Deployment) *schema.Module { return d.Schema })); err != nil {
+		logger.Errorf(err, "Invalid changeset schema")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invalid changeset schema: %w", err))
+	}
+
+	replaced := map[string]model.DeploymentKey{}
+	for _, active := range view.GetActiveDeployments() {
+		if _, ok := deployments[active.Module]; ok {
+			replaced[active.Module] = active.Key
+		}
+	}
+	minReplicas := int(req.Msg.MinReplicas)
+	err = s.controllerState.Publish(ctx, &state.ChangesetCommittedEvent{
+		Key:         key,
+		ActivatedAt: time.Now(),
+		MinReplicas: minReplicas,
+		Replaced:    maps.Values(replaced),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not commit changeset: %w", err)
+	}
+
+	for _, deployment := range ordered {
+		replacedDeploymentKey := optional.None[model.DeploymentKey]()
+		if old, ok := replaced[deployment.Module]; ok {
+			replacedDeploymentKey = optional.Some(old)
+		}
+		s.timelineClient.Publish(ctx, timeline.DeploymentCreated{
+			DeploymentKey:      deployment.Key,
+			Language:           deployment.Language,
+			ModuleName:         deployment.Module,
+			MinReplicas:        minReplicas,
+			ReplacedDeployment: replacedDeploymentKey,
+		})
+	}
+	logger.Debugf("Committed changeset %s", key)
+	return connect.NewResponse(&ftlv1.CommitChangesetResponse{}), nil
+}
+
+func (s *Service) RollbackChangeset(ctx context.Context, req *connect.Request[ftlv1.RollbackChangesetRequest]) (*connect.Response[ftlv1.RollbackChangesetResponse], error) {
+	key, err := model.ParseChangesetKey(req.Msg.Changeset)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid changeset key: %w", err))
+	}
+	view, err := s.controllerState.View(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get controller state: %w", err)
+	}
+	changeset, err := view.GetChangeset(key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	switch changeset.State {
+	case state.ChangesetStateRolledBack:
+		return connect.NewResponse(&ftlv1.RollbackChangesetResponse{}), nil
+	case state.ChangesetStateCommitted:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("changeset %s is %s", key, changeset.State))
+	case state.ChangesetStateOpen:
+	}
+	if err := s.controllerState.Publish(ctx, &state.ChangesetRolledBackEvent{Key: key}); err != nil {
+		return nil, fmt.Errorf("could not roll back changeset: %w", err)
+	}
+	log.FromContext(ctx).Debugf("Rolled back changeset %s", key)
+	return connect.NewResponse(&ftlv1.RollbackChangesetResponse{}), nil
+}
+
+func (s *Service) DeployChangeset(ctx context.Context, req *connect.Request[ftlv1.DeployChangesetRequest]) (*connect.Response[ftlv1.DeployChangesetResponse], error) {
+	logger := log.FromContext(ctx)
+	created, err := s.CreateChangeset(ctx, connect.NewRequest(&ftlv1.CreateChangesetRequest{
+		Modules: slices.Map(req.Msg.Deployments, func(d *ftlv1.CreateDeploymentRequest) *schemapb.Module { return d.Schema }),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	changeset := created.Msg.Changeset
+	rollback := func(cause error) error {
+		if _, err := s.RollbackChangeset(ctx, connect.NewRequest(&ftlv1.RollbackChangesetRequest{Changeset: changeset})); err != nil {
+			logger.Errorf(err, "Could not roll back changeset %s", changeset)
+		}
+		return cause
+	}
+
+	keys := make([]string, 0, len(req.Msg.Deployments))
+	for _, deployment := range req.Msg.Deployments {
+		deployment.Changeset = proto.String(changeset)
+		resp, err := s.CreateDeployment(ctx, connect.NewRequest(deployment))
+		if err != nil {
+			return nil, rollback(err)
+		}
+		keys = append(keys, resp.Msg.DeploymentKey)
+	}
+	_, err = s.CommitChangeset(ctx, connect.NewRequest(&ftlv1.CommitChangesetRequest{Changeset: changeset, MinReplicas: req.Msg.MinReplicas}))
+	if err != nil {
+		return nil, rollback(err)
+	}
+	return connect.NewResponse(&ftlv1.DeployChangesetResponse{Changeset: changeset, DeploymentKeys: keys}), nil
+}
+
+// validateChangesetSchema validates the modules of a changeset together, in the context of the other active modules.
+func validateChangesetSchema(view state.State, modules []*schema.Module) error {
+	schemaMap := map[string]*schema.Module{}
+	for _, deployment := range view.GetActiveDeployments() {
+		schemaMap[deployment.Module] = deployment.Schema
+	}
+	seen := map[string]bool{}
+	for _, module := range modules {
+		if seen[module.Name] {
+			return fmt.Errorf("duplicate module %q", module.Name)
+		}
+		seen[module.Name] = true
+		schemaMap[module.Name] = module
+	}
+	fullSchema := &schema.Schema{Modules: maps.Values(schemaMap)}
+	for _, module := range modules {
+		if _, err := schema.ValidateModuleInSchema(fullSchema, optional.Some(module)); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+	return nil
+}
+
+// sendChangesetChanges sends the schema changes for a committed changeset as a single batch, so that
+// consumers never observe a partially applied changeset.
+func sendChangesetChanges(view state.State, key model.ChangesetKey, sendChange func(response *ftlv1.PullSchemaResponse) error) error {
+	changeset, err := view.GetChangeset(key)
+	if err != nil {
+		return fmt.Errorf("could not get changeset: %w", err)
+	}
+	var changes []*ftlv1.PullSchemaResponse
+	for _, dkey := range changeset.Deployments {
+		deployment, err := view.GetDeployment(dkey)
+		if err != nil {
+			return fmt.Errorf("could not get deployment: %w", err)
+		}
+		changes = append(changes, &ftlv1.PullSchemaResponse{
+			ModuleName:    deployment.Module,
+			DeploymentKey: proto.String(dkey.String()),
+			Schema:        deployment.Schema.ToProto(),
+			ChangeType:    ftlv1.DeploymentChangeType_DEPLOYMENT_CHANGE_TYPE_ADDED,
+		})
+	}
+	for _, dkey := range changeset.Replaced {
+		deployment, err := view.GetDeployment(dkey)
+		if err != nil {
+			return fmt.Errorf("could not get deployment: %w", err)
+		}
+		changes = append(changes, &ftlv1.PullSchemaResponse{
+			ModuleName:    deployment.Module,
+			DeploymentKey: proto.String(dkey.String()),
+			Schema:        deployment.Schema.ToProto(),
+			ChangeType:    ftlv1.DeploymentChangeType_DEPLOYMENT_CHANGE_TYPE_REMOVED,
+		})
+	}
+	for i, change := range changes {
+		change.More = i < len(changes)-1
+		if err := sendChange(change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
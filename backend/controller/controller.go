@@ -43,11 +43,15 @@ import (
 	"github.com/block/ftl/common/sha256"
 	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/channels"
+	"github.com/block/ftl/internal/circuitbreaker"
+	"github.com/block/ftl/internal/configuration"
 	"github.com/block/ftl/internal/deploymentcontext"
+	"github.com/block/ftl/internal/identity"
 	"github.com/block/ftl/internal/log"
 	ftlmaps "github.com/block/ftl/internal/maps"
 	"github.com/block/ftl/internal/model"
 	internalobservability "github.com/block/ftl/internal/observability"
+	"github.com/block/ftl/internal/rbac"
 	"github.com/block/ftl/internal/routing"
 	"github.com/block/ftl/internal/rpc"
 	"github.com/block/ftl/internal/rpc/headers"
@@ -70,6 +74,12 @@ type Config struct {
 	DeploymentReservationTimeout time.Duration       `help:"Deployment reservation timeout." default:"120s"`
 	ModuleUpdateFrequency        time.Duration       `help:"Frequency to send module updates." default:"30s"`
 	ArtefactChunkSize            int                 `help:"Size of each chunk streamed to the client." default:"1048576"`
+	CallerTokenTTL               time.Duration       `help:"Lifetime of the tokens that identify deployments to the verbs they call." default:"10m" env:"FTL_CALLER_TOKEN_TTL"`
+	CallerKeyRotation            time.Duration       `help:"Frequency to rotate generated caller token signing keys." default:"24h" env:"FTL_CALLER_KEY_ROTATION"`
+	CallerSigningKeys            []string            `help:"Base64 encoded Ed25519 seeds to sign caller tokens with. The first signs tokens and the rest only verify them. Keys are generated in memory and rotated if omitted." env:"FTL_CALLER_SIGNING_KEYS" placeholder:"KEY"`
+	RBACPolicy                   string              `help:"Path to a role-based access control policy. All requests are allowed if omitted." env:"FTL_RBAC_POLICY" placeholder:"FILE"`
+	RunnerCredentialKey          string              `help:"Base64 encoded key that the credentials identifying each deployment's runners are derived from. Runners are only identified by their client certificates if omitted." env:"FTL_RUNNER_CREDENTIAL_KEY" placeholder:"KEY"`
+	IdentityHeader               string              `help:"Header that an authenticating proxy sets to the subject of each request, for access control. The proxy must remove it from the requests it receives." env:"FTL_IDENTITY_HEADER" placeholder:"HEADER"`
 	CommonConfig
 }
 
@@ -77,6 +87,12 @@ func (c *Config) SetDefaults() {
 	if c.Advertise == nil {
 		c.Advertise = c.Bind
 	}
+	if c.CallerTokenTTL == 0 {
+		c.CallerTokenTTL = time.Minute * 10
+	}
+	if c.CallerKeyRotation == 0 {
+		c.CallerKeyRotation = time.Hour * 24
+	}
 }
 
 func (c *Config) OpenDBAndInstrument(dsn string) (*sql.DB, error) {
@@ -107,6 +123,26 @@ func Start(
 	if err != nil {
 		return err
 	}
+	options := []connect.HandlerOption{}
+	if config.RBACPolicy != "" {
+		policy, err := rbac.Load(config.RBACPolicy)
+		if err != nil {
+			return fmt.Errorf("failed to load access control policy: %w", err)
+		}
+		authenticators := []rpc.Authenticator{identity.AuthenticateCaller(svc.callerSigner)}
+		if config.RunnerCredentialKey != "" {
+			key, err := identity.ParseRunnerKey(config.RunnerCredentialKey)
+			if err != nil {
+				return fmt.Errorf("failed to load runner credential key: %w", err)
+			}
+			authenticators = append(authenticators, identity.AuthenticateRunner(key))
+		}
+		if config.IdentityHeader != "" {
+			authenticators = append(authenticators, rpc.AuthenticateHeader(config.IdentityHeader))
+		}
+		authz := rpc.AuthorizationInterceptor(policy, timeline.PublishAccessDenied(timelineClient), authenticators...)
+		options = append(options, connect.WithInterceptors(authz))
+	}
 	logger.Debugf("Listening on %s", config.Bind)
 	logger.Debugf("Advertising as %s", config.Advertise)
 
@@ -114,10 +150,10 @@ func Start(
 
 	g.Go(func() error {
 		return rpc.Serve(ctx, config.Bind,
-			rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc),
-			rpc.GRPC(deploymentconnect.NewDeploymentServiceHandler, svc),
-			rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc),
-			rpc.GRPC(ftlv1connect.NewSchemaServiceHandler, svc),
+			rpc.GRPC(ftlv1connect.NewVerbServiceHandler, svc, options...),
+			rpc.GRPC(deploymentconnect.NewDeploymentServiceHandler, svc, options...),
+			rpc.GRPC(ftlv1connect.NewControllerServiceHandler, svc, options...),
+			rpc.GRPC(ftlv1connect.NewSchemaServiceHandler, svc, options...),
 			rpc.PProf(),
 		)
 	})
@@ -151,6 +187,8 @@ type Service struct {
 
 	routeTable      *routing.RouteTable
 	controllerState state.ControllerState
+	callerSigner    *identity.Signer
+	breakers        *circuitbreaker.Breakers
 }
 
 func New(
@@ -178,6 +216,11 @@ func New(
 
 	routingTable := routing.New(ctx, schemaeventsource.New(ctx, rpc.ClientFromContext[ftlv1connect.SchemaServiceClient](ctx)))
 
+	callerSigner, err := identity.NewSigner(config.CallerTokenTTL, config.CallerSigningKeys...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create caller token signer: %w", err)
+	}
+
 	svc := &Service{
 		tasks:           scheduler,
 		timelineClient:  timelineClient,
@@ -189,6 +232,11 @@ func New(
 		storage:         storage,
 		controllerState: state.NewInMemoryState(),
 		adminClient:     adminClient,
+		callerSigner:    callerSigner,
+		breakers:        circuitbreaker.New(timeline.PublishCircuitBreakerTransitions(ctx, timelineClient, "controller")),
+	}
+	if len(config.CallerSigningKeys) == 0 {
+		go svc.rotateCallerKeys(ctx)
 	}
 
 	svc.deploymentLogsSink = newDeploymentLogsSink(ctx, timelineClient)
@@ -312,15 +360,25 @@ func (s *Service) Status(ctx context.Context, req *connect.Request[ftlv1.StatusR
 	if err != nil {
 		return nil, err
 	}
+	activeDeployments := slices.Filter(slices.Map(routes, func(r *ftlv1.StatusResponse_Route) string { return r.Deployment }),
+		func(key string) bool { return key != "" })
+	breakers, err := timeline.CircuitBreakers(ctx, s.timelineClient, activeDeployments)
+	if err != nil {
+		log.FromContext(ctx).Warnf("Failed to get circuit breaker states: %s", err)
+	}
+	breakers = slices.Filter(breakers, func(b *ftlv1.CircuitBreakerStatus) bool {
+		return b.State != ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED
+	})
 	resp := &ftlv1.StatusResponse{
 		Controllers: []*ftlv1.StatusResponse_Controller{{
 			Key:      controller.Key.String(),
 			Endpoint: controller.Endpoint,
 			Version:  ftl.Version,
 		}},
-		Runners:     protoRunners,
-		Deployments: deployments,
-		Routes:      routes,
+		Runners:         protoRunners,
+		Deployments:     deployments,
+		Routes:          routes,
+		CircuitBreakers: breakers,
 	}
 	return connect.NewResponse(resp), nil
 }
@@ -703,6 +761,11 @@ func (s *Service) GetDeploymentContext(ctx context.Context, req *connect.Request
 	callableModuleNames := maps.Keys(callableModules)
 	callableModuleNames = slices.Sort(callableModuleNames)
 	logger.Debugf("Modules %s can call %v", module, callableModuleNames)
+
+	// Tokens are refreshed once half their lifetime has passed, so the context must be checked at least that often.
+	updateFrequency := min(s.config.ModuleUpdateFrequency, s.callerSigner.TTL()/4)
+	var callerToken string
+	var callerTokenRefresh time.Time
 	for {
 		h := sha.New()
 
@@ -711,7 +774,7 @@ func (s *Service) GetDeploymentContext(ctx context.Context, req *connect.Request
 		if err != nil {
 			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not get configs: %w", err))
 		}
-		configs := configsResp.Msg.Values
+		configs := configuration.WithConfigDefaults(deployment.Schema, configsResp.Msg.Values)
 		routeTable := map[string]string{}
 		for _, module := range callableModuleNames {
 			deployment, ok := routeView.GetDeployment(module).Get()
@@ -742,11 +805,28 @@ func (s *Service) GetDeploymentContext(ctx context.Context, req *connect.Request
 			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not detect change on routes: %w", err))
 		}
 
+		if time.Now().After(callerTokenRefresh) {
+			token, expires, err := s.callerSigner.Mint(deployment.Key)
+			if err != nil {
+				return connect.NewError(connect.CodeInternal, fmt.Errorf("could not mint caller token: %w", err))
+			}
+			callerToken = token
+			callerTokenRefresh = expires.Add(-s.callerSigner.TTL() / 2)
+		}
+		callerKeys := s.callerSigner.PublicKeys()
+		if err := hashCallerIdentity(h, callerToken, callerKeys); err != nil {
+			return connect.NewError(connect.CodeInternal, fmt.Errorf("could not detect change on caller identity: %w", err))
+		}
+
 		checksum := int64(binary.BigEndian.Uint64((h.Sum(nil))[0:8]))
 
 		if checksum != lastChecksum {
 			logger.Debugf("Sending module context for: %s routes: %v", module, routeTable)
 			response := deploymentcontext.NewBuilder(module).AddConfigs(configs).AddSecrets(secrets).AddRoutes(routeTable).Build().ToProto()
+			response.CallerToken = callerToken
+			response.CallerKeys = slices.Map(callerKeys, func(key identity.PublicKey) *ftldeployment.GetDeploymentContextResponse_CallerKey {
+				return &ftldeployment.GetDeploymentContextResponse_CallerKey{Id: key.ID, PublicKey: key.Key}
+			})
 
 			if err := resp.Send(response); err != nil {
 				return connect.NewError(connect.CodeInternal, fmt.Errorf("could not send response: %w", err))
@@ -758,7 +838,7 @@ func (s *Service) GetDeploymentContext(ctx context.Context, req *connect.Request
 		select {
 		case <-ctx.Done():
 			return nil
-		case <-time.After(s.config.ModuleUpdateFrequency):
+		case <-time.After(updateFrequency):
 		case <-updates:
 
 		}
@@ -792,6 +872,19 @@ func hashRoutesTable(h hash.Hash, m map[string]string) error {
 	return nil
 }
 
+// hashCallerIdentity computes a checksum on the caller token and the keys it can be verified with
+func hashCallerIdentity(h hash.Hash, token string, keys []identity.PublicKey) error {
+	if _, err := h.Write([]byte(token)); err != nil {
+		return fmt.Errorf("error hashing caller token: %w", err)
+	}
+	for _, key := range keys {
+		if _, err := h.Write([]byte(key.ID)); err != nil {
+			return fmt.Errorf("error hashing caller keys: %w", err)
+		}
+	}
+	return nil
+}
+
 func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
 	return s.callWithRequest(ctx, headers.CopyRequestForForwarding(req), optional.None[model.RequestKey](), optional.None[model.RequestKey](), "")
 }
@@ -839,10 +932,8 @@ func (s *Service) callWithRequest(
 		return nil, err
 	}
 
-	var currentCaller *schema.Ref // might be nil but that's fine. just means that it's not a cal from another verb
-	if len(callers) > 0 {
-		currentCaller = callers[len(callers)-1]
-	}
+	// The caller might be missing, which just means that it's not a call from another verb.
+	caller := identity.ResolveCaller(ctx, s.callerSigner, req.Header(), callers)
 
 	var requestKey model.RequestKey
 	var isNewRequestKey bool
@@ -893,7 +984,7 @@ func (s *Service) callWithRequest(
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
 
-	if currentCaller != nil && currentCaller.Module != module && !verb.IsExported() {
+	if !identity.MayCallUnexported(caller, module) && !verb.IsExported() {
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("invalid request: verb not exported"))
 		err = connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", verbRef))
 		callEvent.Response = result.Err[*ftlv1.CallResponse](err)
@@ -918,7 +1009,17 @@ func (s *Service) callWithRequest(
 	ctx = rpc.WithVerbs(ctx, append(callers, verbRef))
 	headers.AddCaller(req.Header(), schema.RefFromProto(req.Msg.Verb))
 
-	response, err := client.verb.Call(ctx, req)
+	ctx, cancel := rpc.ContextWithVerbTimeout(ctx, verb)
+	defer cancel()
+	var response *connect.Response[ftlv1.CallResponse]
+	breakerKey := circuitbreaker.Key{Deployment: deployment, Verb: verbRef.ToRefKey()}
+	err = s.breakers.Call(breakerKey, verb, func() (err error) {
+		response, err = client.verb.Call(ctx, req)
+		return err //nolint:wrapcheck
+	})
+	if rpc.IsDeadlineExceeded(err) {
+		err = connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("call to verb %s timed out: %w", verbRef, err))
+	}
 	var resp *connect.Response[ftlv1.CallResponse]
 	if err == nil {
 		resp = connect.NewResponse(response.Msg)
@@ -926,7 +1027,7 @@ func (s *Service) callWithRequest(
 		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.None[string]())
 	} else {
 		callEvent.Response = result.Err[*ftlv1.CallResponse](err)
-		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb call failed"))
+		observability.Calls.RequestFailed(ctx, req.Msg.Verb, start, err)
 		logger.Errorf(err, "Call failed to verb %s for module %s", verbRef.String(), module)
 	}
 
@@ -990,11 +1091,33 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftl
 		logger.Errorf(err, "Invalid module schema")
 		return nil, fmt.Errorf("invalid module schema: %w", err)
 	}
-	module, err = s.validateModuleSchema(ctx, module)
+	var changeset optional.Option[*state.Changeset]
+	var changesetKey optional.Option[model.ChangesetKey]
+	if req.Msg.Changeset != nil {
+		key, err := model.ParseChangesetKey(*req.Msg.Changeset)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid changeset key: %w", err))
+		}
+		view, err := s.controllerState.View(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get controller state: %w", err)
+		}
+		cs, err := view.GetChangeset(key)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		changeset = optional.Some(cs)
+		changesetKey = optional.Some(key)
+	}
+	sch, module, err := s.validateModuleSchema(ctx, module, changeset)
 	if err != nil {
 		logger.Errorf(err, "Invalid module schema")
 		return nil, fmt.Errorf("invalid module schema: %w", err)
 	}
+	if err := s.checkModuleValues(ctx, sch, module); err != nil {
+		logger.Errorf(err, "Invalid configuration")
+		return nil, err
+	}
 
 	dkey := model.NewDeploymentKey(module.Name)
 	err = s.controllerState.Publish(ctx, &state.DeploymentCreatedEvent{
@@ -1004,6 +1127,7 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftl
 		Schema:    module,
 		Artefacts: artefacts,
 		Language:  ms.Runtime.Base.Language,
+		Changeset: changesetKey,
 	})
 	if err != nil {
 		logger.Errorf(err, "Could not create deployment event")
@@ -1029,20 +1153,47 @@ func stripNonAlphanumeric(s string) string {
 
 // Load schemas for existing modules, combine with our new one, and validate the new module in the context
 // of the whole schema.
-func (s *Service) validateModuleSchema(ctx context.Context, module *schema.Module) (*schema.Module, error) {
+//
+// If the module is part of a changeset, it is validated against the other modules in the changeset rather
+// than their existing deployments.
+func (s *Service) validateModuleSchema(ctx context.Context, module *schema.Module, changeset optional.Option[*state.Changeset]) (*schema.Schema, *schema.Module, error) {
 	view, err := s.controllerState.View(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get controller state: %w", err)
+		return nil, nil, fmt.Errorf("failed to get controller state: %w", err)
 	}
 	existingModules := view.GetActiveDeployments()
 	schemaMap := ftlmaps.FromSlice[string, *schema.Module, *state.Deployment](maps.Values(existingModules), func(el *state.Deployment) (string, *schema.Module) { return el.Module, el.Schema })
+	if changeset, ok := changeset.Get(); ok {
+		for _, m := range changeset.Modules {
+			schemaMap[m.Name] = m
+		}
+	}
 	schemaMap[module.Name] = module
 	fullSchema := &schema.Schema{Modules: maps.Values(schemaMap)}
 	schema, err := schema.ValidateModuleInSchema(fullSchema, optional.Some[*schema.Module](module))
 	if err != nil {
-		return nil, fmt.Errorf("invalid schema: %w", err)
+		return nil, nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return schema, schema.Module(module.Name).MustGet(), nil
+}
+
+// checkModuleValues verifies that every config value and secret used by the module is set and valid
+// for its declared type, so that a deployment is never activated with configuration it cannot use.
+func (s *Service) checkModuleValues(ctx context.Context, sch *schema.Schema, module *schema.Module) error {
+	configsResp, err := s.adminClient.MapConfigsForModule(ctx, connect.NewRequest(&ftlv1.MapConfigsForModuleRequest{Module: module.Name}))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not get configs: %w", err))
+	}
+	secretsResp, err := s.adminClient.MapSecretsForModule(ctx, connect.NewRequest(&ftlv1.MapSecretsForModuleRequest{Module: module.Name}))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("could not get secrets: %w", err))
 	}
-	return schema.Module(module.Name).MustGet(), nil
+	problems := configuration.CheckModuleValues(sch, module, configsResp.Msg.Values, secretsResp.Msg.Values)
+	if len(problems) == 0 {
+		return nil
+	}
+	errs := slices.Map(problems, func(p configuration.ValueProblem) error { return p })
+	return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invalid configuration for %s: %w", module.Name, errors.Join(errs...)))
 }
 
 func (s *Service) getDeployment(ctx context.Context, key string) (*state.Deployment, error) {
@@ -1105,6 +1256,25 @@ func (s *Service) reapStaleRunners(ctx context.Context) (time.Duration, error) {
 	return s.config.RunnerTimeout, nil
 }
 
+// rotateCallerKeys periodically rotates generated caller token signing keys.
+//
+// Deployment contexts are resent with the new keys, and tokens signed with them, on their next update.
+func (s *Service) rotateCallerKeys(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.config.CallerKeyRotation):
+		}
+		if err := s.callerSigner.Rotate(); err != nil {
+			logger.Errorf(err, "Failed to rotate caller token signing keys")
+			continue
+		}
+		logger.Debugf("Rotated caller token signing keys")
+	}
+}
+
 func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(response *ftlv1.PullSchemaResponse) error) error {
 	logger := log.FromContext(ctx)
 
@@ -1150,6 +1320,10 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 	for notification := range channels.IterContext(ctx, updates) {
 		switch event := notification.(type) {
 		case *state.DeploymentCreatedEvent:
+			if event.Changeset.Ok() {
+				// Deployments in a changeset are sent when the changeset is committed.
+				continue
+			}
 			err := sendChange(&ftlv1.PullSchemaResponse{ //nolint:forcetypeassert
 				ModuleName:    event.Module,
 				DeploymentKey: proto.String(event.Key.String()),
@@ -1169,6 +1343,9 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 				logger.Errorf(err, "Deployment not found: %s", event.Key)
 				continue
 			}
+			if view.IsPending(dep) {
+				continue
+			}
 			err = sendChange(&ftlv1.PullSchemaResponse{ //nolint:forcetypeassert
 				ModuleName:    dep.Module,
 				DeploymentKey: proto.String(event.Key.String()),
@@ -1189,6 +1366,9 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 				logger.Errorf(err, "Deployment not found: %s", event.Key)
 				continue
 			}
+			if view.IsPending(dep) {
+				continue
+			}
 			err = sendChange(&ftlv1.PullSchemaResponse{ //nolint:forcetypeassert
 				ModuleName:    dep.Module,
 				DeploymentKey: proto.String(event.Key.String()),
@@ -1198,6 +1378,14 @@ func (s *Service) watchModuleChanges(ctx context.Context, sendChange func(respon
 			if err != nil {
 				return err
 			}
+		case *state.ChangesetCommittedEvent:
+			view, err := s.controllerState.View(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get controller state: %w", err)
+			}
+			if err := sendChangesetChanges(view, event.Key, sendChange); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -2,6 +2,7 @@ package observability
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,7 +15,9 @@ import (
 
 	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
 	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/circuitbreaker"
 	"github.com/block/ftl/internal/observability"
+	"github.com/block/ftl/internal/rpc"
 )
 
 const (
@@ -80,3 +83,15 @@ func (m *CallMetrics) Request(ctx context.Context, verb *schemapb.Ref, startTime
 	attrs = append(attrs, attribute.String(callRunTimeBucketAttr, observability.LogBucket(4, msToComplete, optional.Some(3), optional.Some(7))))
 	m.requests.Add(ctx, 1, metric.WithAttributes(attrs...))
 }
+
+// RequestFailed records a failed verb call, distinguishing calls that exceeded
+// their deadline or were rejected by a circuit breaker from other failures.
+func (m *CallMetrics) RequestFailed(ctx context.Context, verb *schemapb.Ref, startTime time.Time, err error) {
+	failureMode := "verb call failed"
+	if rpc.IsDeadlineExceeded(err) {
+		failureMode = "verb call timed out"
+	} else if errors.Is(err, circuitbreaker.ErrOpen) {
+		failureMode = "circuit breaker open"
+	}
+	m.Request(ctx, verb, startTime, optional.Some(failureMode))
+}
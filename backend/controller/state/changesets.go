@@ -0,0 +1,148 @@
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/model"
+)
+
+type ChangesetState int
+
+const (
+	ChangesetStateOpen ChangesetState = iota
+	ChangesetStateCommitted
+	ChangesetStateRolledBack
+)
+
+func (c ChangesetState) String() string {
+	switch c {
+	case ChangesetStateOpen:
+		return "open"
+	case ChangesetStateCommitted:
+		return "committed"
+	case ChangesetStateRolledBack:
+		return "rolled back"
+	default:
+		return fmt.Sprintf("ChangesetState(%d)", int(c))
+	}
+}
+
+// Changeset is a set of deployments that are activated, or rolled back, together.
+type Changeset struct {
+	Key       model.ChangesetKey
+	CreatedAt time.Time
+	State     ChangesetState
+	// Modules are the schemas the changeset was created with, which
+	// deployments in the changeset are validated against.
+	Modules     []*schema.Module
+	Deployments []model.DeploymentKey
+	// Replaced are the deployments deactivated when the changeset was committed.
+	Replaced []model.DeploymentKey
+}
+
+func (r *State) GetChangeset(changeset model.ChangesetKey) (*Changeset, error) {
+	c, ok := r.changesets[changeset.String()]
+	if !ok {
+		return nil, fmt.Errorf("changeset %s not found", changeset)
+	}
+	return c, nil
+}
+
+// IsPending returns true if the deployment belongs to a changeset that has
+// not been committed.
+func (r *State) IsPending(deployment *Deployment) bool {
+	key, ok := deployment.Changeset.Get()
+	if !ok {
+		return false
+	}
+	changeset, ok := r.changesets[key.String()]
+	return !ok || changeset.State != ChangesetStateCommitted
+}
+
+var _ ControllerEvent = (*ChangesetCreatedEvent)(nil)
+var _ ControllerEvent = (*ChangesetCommittedEvent)(nil)
+var _ ControllerEvent = (*ChangesetRolledBackEvent)(nil)
+
+type ChangesetCreatedEvent struct {
+	Key       model.ChangesetKey
+	CreatedAt time.Time
+	Modules   []*schema.Module
+}
+
+func (r *ChangesetCreatedEvent) Handle(t State) (State, error) {
+	if existing := t.changesets[r.Key.String()]; existing != nil {
+		return t, nil
+	}
+	t.changesets[r.Key.String()] = &Changeset{
+		Key:       r.Key,
+		CreatedAt: r.CreatedAt,
+		State:     ChangesetStateOpen,
+		Modules:   r.Modules,
+	}
+	return t, nil
+}
+
+// ChangesetCommittedEvent activates every deployment in a changeset and
+// deactivates the deployments they replace.
+type ChangesetCommittedEvent struct {
+	Key         model.ChangesetKey
+	ActivatedAt time.Time
+	MinReplicas int
+	Replaced    []model.DeploymentKey
+}
+
+func (r *ChangesetCommittedEvent) Handle(t State) (State, error) {
+	changeset, ok := t.changesets[r.Key.String()]
+	if !ok {
+		return t, fmt.Errorf("changeset %s not found", r.Key)
+	}
+	if changeset.State != ChangesetStateOpen {
+		return t, fmt.Errorf("changeset %s is %s", r.Key, changeset.State)
+	}
+	for _, key := range r.Replaced {
+		existing, ok := t.deployments[key.String()]
+		if !ok {
+			return t, fmt.Errorf("deployment %s not found", key)
+		}
+		existing.MinReplicas = 0
+		delete(t.activeDeployments, key.String())
+	}
+	for _, key := range changeset.Deployments {
+		existing, ok := t.deployments[key.String()]
+		if !ok {
+			return t, fmt.Errorf("deployment %s not found", key)
+		}
+		existing.ActivatedAt = optional.Some(r.ActivatedAt)
+		setMinReplicas(existing, r.MinReplicas)
+		t.activeDeployments[key.String()] = existing
+	}
+	changeset.State = ChangesetStateCommitted
+	changeset.Replaced = r.Replaced
+	return t, nil
+}
+
+// ChangesetRolledBackEvent discards every deployment in a changeset.
+type ChangesetRolledBackEvent struct {
+	Key model.ChangesetKey
+}
+
+func (r *ChangesetRolledBackEvent) Handle(t State) (State, error) {
+	changeset, ok := t.changesets[r.Key.String()]
+	if !ok {
+		return t, fmt.Errorf("changeset %s not found", r.Key)
+	}
+	if changeset.State != ChangesetStateOpen {
+		return t, fmt.Errorf("changeset %s is %s", r.Key, changeset.State)
+	}
+	for _, key := range changeset.Deployments {
+		if existing, ok := t.deployments[key.String()]; ok {
+			existing.MinReplicas = 0
+		}
+	}
+	changeset.State = ChangesetStateRolledBack
+	return t, nil
+}
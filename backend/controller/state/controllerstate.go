@@ -10,6 +10,7 @@ type State struct {
 	runners             map[string]*Runner
 	runnersByDeployment map[string][]*Runner
 	artifacts           map[string]bool
+	changesets          map[string]*Changeset
 }
 
 type ControllerEvent interface {
@@ -22,5 +23,6 @@ func NewInMemoryState() ControllerState {
 	return eventstream.NewInMemory[State, ControllerEvent](State{
 		runners:             map[string]*Runner{},
 		runnersByDeployment: map[string][]*Runner{},
+		changesets:          map[string]*Changeset{},
 	})
 }
@@ -2,10 +2,13 @@ package state_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+	"golang.org/x/exp/maps"
 
 	"github.com/block/ftl/backend/controller/state"
 	"github.com/block/ftl/common/schema"
@@ -107,3 +110,80 @@ func TestDeploymentState(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 0, view.GetDeployments()[deploymentKey.String()].MinReplicas)
 }
+
+func TestChangesetState(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cs := state.NewInMemoryState()
+
+	oldKey := model.NewDeploymentKey("echo")
+	assert.NoError(t, cs.Publish(ctx, &state.DeploymentCreatedEvent{Key: oldKey, Module: "echo", Schema: &schema.Module{Name: "echo"}}))
+	assert.NoError(t, cs.Publish(ctx, &state.DeploymentActivatedEvent{Key: oldKey, ActivatedAt: time.Now(), MinReplicas: 1}))
+
+	changesetKey := model.NewChangesetKey()
+	assert.NoError(t, cs.Publish(ctx, &state.ChangesetCreatedEvent{
+		Key:     changesetKey,
+		Modules: []*schema.Module{{Name: "echo"}, {Name: "time"}},
+	}))
+	echoKey := model.NewDeploymentKey("echo")
+	timeKey := model.NewDeploymentKey("time")
+	for _, key := range []model.DeploymentKey{echoKey, timeKey} {
+		assert.NoError(t, cs.Publish(ctx, &state.DeploymentCreatedEvent{
+			Key:       key,
+			Module:    key.Payload.Module,
+			Schema:    &schema.Module{Name: key.Payload.Module},
+			Changeset: optional.Some(changesetKey),
+		}))
+	}
+
+	view, err := cs.View(ctx)
+	assert.NoError(t, err)
+	changeset, err := view.GetChangeset(changesetKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.DeploymentKey{echoKey, timeKey}, changeset.Deployments)
+	echo, err := view.GetDeployment(echoKey)
+	assert.NoError(t, err)
+	assert.True(t, view.IsPending(echo))
+	assert.Equal(t, []string{oldKey.String()}, maps.Keys(view.GetActiveDeployments()))
+
+	assert.NoError(t, cs.Publish(ctx, &state.ChangesetCommittedEvent{
+		Key:         changesetKey,
+		ActivatedAt: time.Now(),
+		MinReplicas: 2,
+		Replaced:    []model.DeploymentKey{oldKey},
+	}))
+	view, err = cs.View(ctx)
+	assert.NoError(t, err)
+	active := maps.Keys(view.GetActiveDeployments())
+	sort.Strings(active)
+	expected := []string{echoKey.String(), timeKey.String()}
+	sort.Strings(expected)
+	assert.Equal(t, expected, active)
+	echo, err = view.GetDeployment(echoKey)
+	assert.NoError(t, err)
+	assert.False(t, view.IsPending(echo))
+	assert.Equal(t, 2, echo.MinReplicas)
+	old, err := view.GetDeployment(oldKey)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, old.MinReplicas)
+
+	// Committed changesets can not be changed.
+	err = cs.Publish(ctx, &state.ChangesetRolledBackEvent{Key: changesetKey})
+	assert.Error(t, err)
+	err = cs.Publish(ctx, &state.DeploymentCreatedEvent{Key: model.NewDeploymentKey("echo"), Module: "echo", Schema: &schema.Module{Name: "echo"}, Changeset: optional.Some(changesetKey)})
+	assert.Error(t, err)
+
+	// Rolled back changesets leave the active deployments untouched.
+	rolledBackKey := model.NewChangesetKey()
+	assert.NoError(t, cs.Publish(ctx, &state.ChangesetCreatedEvent{Key: rolledBackKey, Modules: []*schema.Module{{Name: "echo"}}}))
+	failedKey := model.NewDeploymentKey("echo")
+	assert.NoError(t, cs.Publish(ctx, &state.DeploymentCreatedEvent{Key: failedKey, Module: "echo", Schema: &schema.Module{Name: "echo"}, Changeset: optional.Some(rolledBackKey)}))
+	assert.NoError(t, cs.Publish(ctx, &state.ChangesetRolledBackEvent{Key: rolledBackKey}))
+	view, err = cs.View(ctx)
+	assert.NoError(t, err)
+	failed, err := view.GetDeployment(failedKey)
+	assert.NoError(t, err)
+	assert.True(t, view.IsPending(failed))
+	active = maps.Keys(view.GetActiveDeployments())
+	sort.Strings(active)
+	assert.Equal(t, expected, active)
+}
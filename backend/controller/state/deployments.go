@@ -21,6 +21,8 @@ type Deployment struct {
 	ActivatedAt optional.Option[time.Time]
 	Artefacts   map[string]*DeploymentArtefact
 	Language    string
+	// Changeset the deployment was created in, if any.
+	Changeset optional.Option[model.ChangesetKey]
 }
 
 func (r *State) GetDeployment(deployment model.DeploymentKey) (*Deployment, error) {
@@ -57,12 +59,23 @@ type DeploymentCreatedEvent struct {
 	Schema    *schema.Module
 	Artefacts []*DeploymentArtefact
 	Language  string
+	Changeset optional.Option[model.ChangesetKey]
 }
 
 func (r *DeploymentCreatedEvent) Handle(t State) (State, error) {
 	if existing := t.deployments[r.Key.String()]; existing != nil {
 		return t, nil
 	}
+	if key, ok := r.Changeset.Get(); ok {
+		changeset, ok := t.changesets[key.String()]
+		if !ok {
+			return t, fmt.Errorf("changeset %s not found", key)
+		}
+		if changeset.State != ChangesetStateOpen {
+			return t, fmt.Errorf("changeset %s is %s", key, changeset.State)
+		}
+		changeset.Deployments = append(changeset.Deployments, r.Key)
+	}
 	n := Deployment{
 		Key:       r.Key,
 		CreatedAt: r.CreatedAt,
@@ -70,6 +83,7 @@ func (r *DeploymentCreatedEvent) Handle(t State) (State, error) {
 		Module:    r.Module,
 		Artefacts: map[string]*DeploymentArtefact{},
 		Language:  r.Language,
+		Changeset: r.Changeset,
 	}
 	for _, a := range r.Artefacts {
 		n.Artefacts[a.Digest.String()] = &DeploymentArtefact{
@@ -106,15 +120,19 @@ func (r *DeploymentReplicasUpdatedEvent) Handle(t State) (State, error) {
 	if !ok {
 		return t, fmt.Errorf("deployment %s not found", r.Key)
 	}
-	if existing.Schema.Runtime == nil {
-		existing.Schema.Runtime = &schema.ModuleRuntime{}
+	setMinReplicas(existing, r.Replicas)
+	return t, nil
+}
+
+func setMinReplicas(deployment *Deployment, replicas int) {
+	if deployment.Schema.Runtime == nil {
+		deployment.Schema.Runtime = &schema.ModuleRuntime{}
 	}
-	if existing.Schema.Runtime.Scaling == nil {
-		existing.Schema.Runtime.Scaling = &schema.ModuleRuntimeScaling{}
+	if deployment.Schema.Runtime.Scaling == nil {
+		deployment.Schema.Runtime.Scaling = &schema.ModuleRuntimeScaling{}
 	}
-	existing.Schema.Runtime.Scaling.MinReplicas = int32(r.Replicas)
-	existing.MinReplicas = r.Replicas
-	return t, nil
+	deployment.Schema.Runtime.Scaling.MinReplicas = int32(replicas)
+	deployment.MinReplicas = replicas
 }
 
 type DeploymentActivatedEvent struct {
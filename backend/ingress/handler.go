@@ -18,6 +18,7 @@ import (
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/model"
 	"github.com/block/ftl/internal/routing"
+	"github.com/block/ftl/internal/rpc/headers"
 )
 
 // handleHTTP HTTP ingress routes.
@@ -63,6 +64,9 @@ func (s *service) handleHTTP(startTime time.Time, sch *schema.Schema, requestKey
 		Verb:     verbRef,
 		Body:     body,
 	})
+	if key := r.Header.Get(headers.IdempotencyKeyHeader); key != "" {
+		creq.Msg.Metadata.Set(headers.IdempotencyKeyHeader, key)
+	}
 
 	resp, err := client.Call(r.Context(), creq)
 	if err != nil {
@@ -138,7 +142,7 @@ func (s *service) handleHTTP(startTime time.Time, sch *schema.Schema, requestKey
 		_, err = w.Write(responseBody)
 		if err == nil {
 			metrics.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.None[string]())
-			s.timelineClient.Publish(r.Context(), ingressEvent)
+			s.publish(r.Context(), ingressEvent)
 		} else {
 			logger.Errorf(err, "could not write response body")
 			metrics.Request(r.Context(), r.Method, r.URL.Path, optional.Some(verbRef), startTime, optional.Some("could not write response body"))
@@ -160,7 +164,14 @@ func (s *service) recordIngressErrorEvent(
 ) {
 	ingressEvent.ResponseStatus = statusCode
 	ingressEvent.Error = optional.Some(errorMsg)
-	s.timelineClient.Publish(ctx, ingressEvent)
+	s.publish(ctx, ingressEvent)
+}
+
+// publish an ingress event to the timeline, if the service has a timeline client.
+func (s *service) publish(ctx context.Context, ingressEvent timeline.Ingress) {
+	if s.timelineClient != nil {
+		s.timelineClient.Publish(ctx, ingressEvent)
+	}
 }
 
 // Copied from the Apache-licensed connect-go source.
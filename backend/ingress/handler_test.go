@@ -132,3 +132,47 @@ func (r *fakeVerbClient) Call(ctx context.Context, req *connect.Request[ftlv1.Ca
 	assert.NoError(r.t, err)
 	return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: body}}), nil
 }
+
+func TestNewHandler(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data PathParameterRequest {
+				username String
+			}
+
+			export verb getPath(HttpRequest<Unit, test.PathParameterRequest, Unit>) HttpResponse<String, String>
+				+ingress http GET /getPath/{username}
+		}
+	`)
+	assert.NoError(t, err)
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	client := &recordingVerbClient{response: HTTPResponse{Status: http.StatusCreated, Body: []byte(`"created"`)}}
+	handler := NewHandler(sch, client)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/getPath/alice", nil).WithContext(ctx))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "created", rec.Body.String())
+	assert.Equal(t, "test.getPath", client.verb)
+	assert.Contains(t, client.body, `"pathParameters":{"username":"alice"}`)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/missing", nil).WithContext(ctx))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+type recordingVerbClient struct {
+	response HTTPResponse
+	verb     string
+	body     string
+}
+
+func (r *recordingVerbClient) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
+	r.verb = req.Msg.Verb.Module + "." + req.Msg.Verb.Name
+	r.body = string(req.Msg.Body)
+	body, err := encoding.Marshal(r.response)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: body}}), nil
+}
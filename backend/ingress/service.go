@@ -14,6 +14,7 @@ import (
 
 	"github.com/block/ftl/backend/timeline"
 	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/cors"
 	ftlhttp "github.com/block/ftl/internal/http"
@@ -71,6 +72,17 @@ func Start(ctx context.Context, config Config, schemaEventSource schemaeventsour
 	return nil
 }
 
+// NewHandler returns a handler for the HTTP ingress routes in a schema, calling verbs with client.
+//
+// Unlike the ingress service, the schema is fixed and no timeline events are published. This allows tests to exercise
+// ingress verbs with the same request and response mapping as a deployed cluster.
+func NewHandler(sch *schema.Schema, client routing.CallClient) http.Handler {
+	return &service{
+		view:   atomic.New(extractIngressRoutingEntries(sch)),
+		client: client,
+	}
+}
+
 func (s *service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.URL.Path == "/healthz" {
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,954 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: xyz/block/ftl/asynccall/v1/asynccall.proto
+
+package asynccallpb
+
+import (
+	v11 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	v1 "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AsyncCallState int32
+
+const (
+	AsyncCallState_ASYNC_CALL_STATE_UNSPECIFIED AsyncCallState = 0
+	// The call is waiting to be executed, either for the first time or as a retry.
+	AsyncCallState_ASYNC_CALL_STATE_PENDING AsyncCallState = 1
+	// The call is currently executing.
+	AsyncCallState_ASYNC_CALL_STATE_RUNNING   AsyncCallState = 2
+	AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED AsyncCallState = 3
+	// The call and its catch verb, if any, failed and all retries are exhausted.
+	AsyncCallState_ASYNC_CALL_STATE_FAILED    AsyncCallState = 4
+	AsyncCallState_ASYNC_CALL_STATE_CANCELLED AsyncCallState = 5
+)
+
+// Enum value maps for AsyncCallState.
+var (
+	AsyncCallState_name = map[int32]string{
+		0: "ASYNC_CALL_STATE_UNSPECIFIED",
+		1: "ASYNC_CALL_STATE_PENDING",
+		2: "ASYNC_CALL_STATE_RUNNING",
+		3: "ASYNC_CALL_STATE_SUCCEEDED",
+		4: "ASYNC_CALL_STATE_FAILED",
+		5: "ASYNC_CALL_STATE_CANCELLED",
+	}
+	AsyncCallState_value = map[string]int32{
+		"ASYNC_CALL_STATE_UNSPECIFIED": 0,
+		"ASYNC_CALL_STATE_PENDING":     1,
+		"ASYNC_CALL_STATE_RUNNING":     2,
+		"ASYNC_CALL_STATE_SUCCEEDED":   3,
+		"ASYNC_CALL_STATE_FAILED":      4,
+		"ASYNC_CALL_STATE_CANCELLED":   5,
+	}
+)
+
+func (x AsyncCallState) Enum() *AsyncCallState {
+	p := new(AsyncCallState)
+	*p = x
+	return p
+}
+
+func (x AsyncCallState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AsyncCallState) Descriptor() protoreflect.EnumDescriptor {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_enumTypes[0].Descriptor()
+}
+
+func (AsyncCallState) Type() protoreflect.EnumType {
+	return &file_xyz_block_ftl_asynccall_v1_asynccall_proto_enumTypes[0]
+}
+
+func (x AsyncCallState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AsyncCallState.Descriptor instead.
+func (AsyncCallState) EnumDescriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{0}
+}
+
+type AsyncCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Verb *v1.Ref `protobuf:"bytes,2,opt,name=verb,proto3" json:"verb,omitempty"`
+	// The module that enqueued the call, as identified by its caller token.
+	CallerModule *string `protobuf:"bytes,14,opt,name=caller_module,json=callerModule,proto3,oneof" json:"caller_module,omitempty"`
+	// JSON encoded request.
+	Request []byte         `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	State   AsyncCallState `protobuf:"varint,5,opt,name=state,proto3,enum=xyz.block.ftl.asynccall.v1.AsyncCallState" json:"state,omitempty"`
+	// Number of attempts made so far, including attempts to call the catch verb.
+	Attempts int64 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// JSON encoded response, present once the call has succeeded.
+	Response []byte `protobuf:"bytes,7,opt,name=response,proto3,oneof" json:"response,omitempty"`
+	// The most recent error, if any.
+	Error *string `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Set once the callee's retries are exhausted and the catch verb is being called instead.
+	CatchVerb  *v1.Ref                `protobuf:"bytes,9,opt,name=catch_verb,json=catchVerb,proto3,oneof" json:"catch_verb,omitempty"`
+	RequestKey string                 `protobuf:"bytes,10,opt,name=request_key,json=requestKey,proto3" json:"request_key,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// When the next attempt will be made, present only for pending calls.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_attempt_at,json=nextAttemptAt,proto3,oneof" json:"next_attempt_at,omitempty"`
+}
+
+func (x *AsyncCall) Reset() {
+	*x = AsyncCall{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AsyncCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AsyncCall) ProtoMessage() {}
+
+func (x *AsyncCall) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AsyncCall.ProtoReflect.Descriptor instead.
+func (*AsyncCall) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AsyncCall) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AsyncCall) GetVerb() *v1.Ref {
+	if x != nil {
+		return x.Verb
+	}
+	return nil
+}
+
+func (x *AsyncCall) GetCallerModule() string {
+	if x != nil && x.CallerModule != nil {
+		return *x.CallerModule
+	}
+	return ""
+}
+
+func (x *AsyncCall) GetRequest() []byte {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AsyncCall) GetState() AsyncCallState {
+	if x != nil {
+		return x.State
+	}
+	return AsyncCallState_ASYNC_CALL_STATE_UNSPECIFIED
+}
+
+func (x *AsyncCall) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AsyncCall) GetResponse() []byte {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *AsyncCall) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *AsyncCall) GetCatchVerb() *v1.Ref {
+	if x != nil {
+		return x.CatchVerb
+	}
+	return nil
+}
+
+func (x *AsyncCall) GetRequestKey() string {
+	if x != nil {
+		return x.RequestKey
+	}
+	return ""
+}
+
+func (x *AsyncCall) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AsyncCall) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AsyncCall) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+type EnqueueCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verb *v1.Ref `protobuf:"bytes,1,opt,name=verb,proto3" json:"verb,omitempty"`
+	// JSON encoded request.
+	Body []byte `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// The verb making the call. The calling module is identified by the caller token of the request, so this is
+	// only used to restrict what may be called when the token is missing or invalid.
+	Caller *v1.Ref `protobuf:"bytes,3,opt,name=caller,proto3,oneof" json:"caller,omitempty"`
+}
+
+func (x *EnqueueCallRequest) Reset() {
+	*x = EnqueueCallRequest{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueCallRequest) ProtoMessage() {}
+
+func (x *EnqueueCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueCallRequest.ProtoReflect.Descriptor instead.
+func (*EnqueueCallRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnqueueCallRequest) GetVerb() *v1.Ref {
+	if x != nil {
+		return x.Verb
+	}
+	return nil
+}
+
+func (x *EnqueueCallRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *EnqueueCallRequest) GetCaller() *v1.Ref {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+type EnqueueCallResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EnqueueCallResponse) Reset() {
+	*x = EnqueueCallResponse{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnqueueCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnqueueCallResponse) ProtoMessage() {}
+
+func (x *EnqueueCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnqueueCallResponse.ProtoReflect.Descriptor instead.
+func (*EnqueueCallResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnqueueCallResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetCallRequest) Reset() {
+	*x = GetCallRequest{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallRequest) ProtoMessage() {}
+
+func (x *GetCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallRequest.ProtoReflect.Descriptor instead.
+func (*GetCallRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCallRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCallResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Call *AsyncCall `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *GetCallResponse) Reset() {
+	*x = GetCallResponse{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCallResponse) ProtoMessage() {}
+
+func (x *GetCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCallResponse.ProtoReflect.Descriptor instead.
+func (*GetCallResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCallResponse) GetCall() *AsyncCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type ListCallsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only calls to verbs in this module are returned.
+	Module *string `protobuf:"bytes,1,opt,name=module,proto3,oneof" json:"module,omitempty"`
+	// If set, only calls in this state are returned.
+	State *AsyncCallState `protobuf:"varint,2,opt,name=state,proto3,enum=xyz.block.ftl.asynccall.v1.AsyncCallState,oneof" json:"state,omitempty"`
+	// Maximum number of calls to return, most recent first. Defaults to 100.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListCallsRequest) Reset() {
+	*x = ListCallsRequest{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallsRequest) ProtoMessage() {}
+
+func (x *ListCallsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallsRequest.ProtoReflect.Descriptor instead.
+func (*ListCallsRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCallsRequest) GetModule() string {
+	if x != nil && x.Module != nil {
+		return *x.Module
+	}
+	return ""
+}
+
+func (x *ListCallsRequest) GetState() AsyncCallState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return AsyncCallState_ASYNC_CALL_STATE_UNSPECIFIED
+}
+
+func (x *ListCallsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCallsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calls []*AsyncCall `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+}
+
+func (x *ListCallsResponse) Reset() {
+	*x = ListCallsResponse{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallsResponse) ProtoMessage() {}
+
+func (x *ListCallsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallsResponse.ProtoReflect.Descriptor instead.
+func (*ListCallsResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCallsResponse) GetCalls() []*AsyncCall {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type RetryCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RetryCallRequest) Reset() {
+	*x = RetryCallRequest{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryCallRequest) ProtoMessage() {}
+
+func (x *RetryCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryCallRequest.ProtoReflect.Descriptor instead.
+func (*RetryCallRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RetryCallRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RetryCallResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RetryCallResponse) Reset() {
+	*x = RetryCallResponse{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryCallResponse) ProtoMessage() {}
+
+func (x *RetryCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryCallResponse.ProtoReflect.Descriptor instead.
+func (*RetryCallResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{8}
+}
+
+type CancelCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelCallRequest) Reset() {
+	*x = CancelCallRequest{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCallRequest) ProtoMessage() {}
+
+func (x *CancelCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCallRequest.ProtoReflect.Descriptor instead.
+func (*CancelCallRequest) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelCallRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelCallResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelCallResponse) Reset() {
+	*x = CancelCallResponse{}
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCallResponse) ProtoMessage() {}
+
+func (x *CancelCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCallResponse.ProtoReflect.Descriptor instead.
+func (*CancelCallResponse) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP(), []int{10}
+}
+
+var File_xyz_block_ftl_asynccall_v1_asynccall_proto protoreflect.FileDescriptor
+
+var file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x78, 0x79, 0x7a, 0x2f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x74, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x05, 0x0a, 0x09,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x28, 0x0a, 0x0d, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x01, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x03, 0x52, 0x09, 0x63,
+	0x61, 0x74, 0x63, 0x68, 0x56, 0x65, 0x72, 0x62, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x76,
+	0x65, 0x72, 0x62, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xa0, 0x01,
+	0x0a, 0x12, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x76, 0x65, 0x72, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66,
+	0x52, 0x04, 0x76, 0x65, 0x72, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x22, 0x25, 0x0a, 0x13, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x04,
+	0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61,
+	0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x48, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x22, 0x0a,
+	0x10, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0xcb, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x43, 0x61, 0x6c, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x41,
+	0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f,
+	0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x41,
+	0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x41, 0x4c, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x41, 0x4c, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x1e, 0x0a, 0x1a, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32,
+	0xf9, 0x04, 0x0a, 0x0c, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6e, 0x0a, 0x0b,
+	0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x2a, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6d, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c,
+	0x6c, 0x73, 0x12, 0x2c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x68, 0x0a, 0x09, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x6c,
+	0x6c, 0x12, 0x2c, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x2d, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x78, 0x79,
+	0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x4e, 0x50, 0x01, 0x5a,
+	0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66,
+	0x74, 0x6c, 0x2f, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x2f, 0x76, 0x31, 0x3b,
+	0x61, 0x73, 0x79, 0x6e, 0x63, 0x63, 0x61, 0x6c, 0x6c, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescOnce sync.Once
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescData = file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDesc
+)
+
+func file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescGZIP() []byte {
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescOnce.Do(func() {
+		file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescData = protoimpl.X.CompressGZIP(file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescData)
+	})
+	return file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDescData
+}
+
+var file_xyz_block_ftl_asynccall_v1_asynccall_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_xyz_block_ftl_asynccall_v1_asynccall_proto_goTypes = []any{
+	(AsyncCallState)(0),           // 0: xyz.block.ftl.asynccall.v1.AsyncCallState
+	(*AsyncCall)(nil),             // 1: xyz.block.ftl.asynccall.v1.AsyncCall
+	(*EnqueueCallRequest)(nil),    // 2: xyz.block.ftl.asynccall.v1.EnqueueCallRequest
+	(*EnqueueCallResponse)(nil),   // 3: xyz.block.ftl.asynccall.v1.EnqueueCallResponse
+	(*GetCallRequest)(nil),        // 4: xyz.block.ftl.asynccall.v1.GetCallRequest
+	(*GetCallResponse)(nil),       // 5: xyz.block.ftl.asynccall.v1.GetCallResponse
+	(*ListCallsRequest)(nil),      // 6: xyz.block.ftl.asynccall.v1.ListCallsRequest
+	(*ListCallsResponse)(nil),     // 7: xyz.block.ftl.asynccall.v1.ListCallsResponse
+	(*RetryCallRequest)(nil),      // 8: xyz.block.ftl.asynccall.v1.RetryCallRequest
+	(*RetryCallResponse)(nil),     // 9: xyz.block.ftl.asynccall.v1.RetryCallResponse
+	(*CancelCallRequest)(nil),     // 10: xyz.block.ftl.asynccall.v1.CancelCallRequest
+	(*CancelCallResponse)(nil),    // 11: xyz.block.ftl.asynccall.v1.CancelCallResponse
+	(*v1.Ref)(nil),                // 12: xyz.block.ftl.schema.v1.Ref
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*v11.PingRequest)(nil),       // 14: xyz.block.ftl.v1.PingRequest
+	(*v11.PingResponse)(nil),      // 15: xyz.block.ftl.v1.PingResponse
+}
+var file_xyz_block_ftl_asynccall_v1_asynccall_proto_depIdxs = []int32{
+	12, // 0: xyz.block.ftl.asynccall.v1.AsyncCall.verb:type_name -> xyz.block.ftl.schema.v1.Ref
+	0,  // 1: xyz.block.ftl.asynccall.v1.AsyncCall.state:type_name -> xyz.block.ftl.asynccall.v1.AsyncCallState
+	12, // 2: xyz.block.ftl.asynccall.v1.AsyncCall.catch_verb:type_name -> xyz.block.ftl.schema.v1.Ref
+	13, // 3: xyz.block.ftl.asynccall.v1.AsyncCall.created_at:type_name -> google.protobuf.Timestamp
+	13, // 4: xyz.block.ftl.asynccall.v1.AsyncCall.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 5: xyz.block.ftl.asynccall.v1.AsyncCall.next_attempt_at:type_name -> google.protobuf.Timestamp
+	12, // 6: xyz.block.ftl.asynccall.v1.EnqueueCallRequest.verb:type_name -> xyz.block.ftl.schema.v1.Ref
+	12, // 7: xyz.block.ftl.asynccall.v1.EnqueueCallRequest.caller:type_name -> xyz.block.ftl.schema.v1.Ref
+	1,  // 8: xyz.block.ftl.asynccall.v1.GetCallResponse.call:type_name -> xyz.block.ftl.asynccall.v1.AsyncCall
+	0,  // 9: xyz.block.ftl.asynccall.v1.ListCallsRequest.state:type_name -> xyz.block.ftl.asynccall.v1.AsyncCallState
+	1,  // 10: xyz.block.ftl.asynccall.v1.ListCallsResponse.calls:type_name -> xyz.block.ftl.asynccall.v1.AsyncCall
+	14, // 11: xyz.block.ftl.asynccall.v1.AsyncService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	2,  // 12: xyz.block.ftl.asynccall.v1.AsyncService.EnqueueCall:input_type -> xyz.block.ftl.asynccall.v1.EnqueueCallRequest
+	4,  // 13: xyz.block.ftl.asynccall.v1.AsyncService.GetCall:input_type -> xyz.block.ftl.asynccall.v1.GetCallRequest
+	6,  // 14: xyz.block.ftl.asynccall.v1.AsyncService.ListCalls:input_type -> xyz.block.ftl.asynccall.v1.ListCallsRequest
+	8,  // 15: xyz.block.ftl.asynccall.v1.AsyncService.RetryCall:input_type -> xyz.block.ftl.asynccall.v1.RetryCallRequest
+	10, // 16: xyz.block.ftl.asynccall.v1.AsyncService.CancelCall:input_type -> xyz.block.ftl.asynccall.v1.CancelCallRequest
+	15, // 17: xyz.block.ftl.asynccall.v1.AsyncService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	3,  // 18: xyz.block.ftl.asynccall.v1.AsyncService.EnqueueCall:output_type -> xyz.block.ftl.asynccall.v1.EnqueueCallResponse
+	5,  // 19: xyz.block.ftl.asynccall.v1.AsyncService.GetCall:output_type -> xyz.block.ftl.asynccall.v1.GetCallResponse
+	7,  // 20: xyz.block.ftl.asynccall.v1.AsyncService.ListCalls:output_type -> xyz.block.ftl.asynccall.v1.ListCallsResponse
+	9,  // 21: xyz.block.ftl.asynccall.v1.AsyncService.RetryCall:output_type -> xyz.block.ftl.asynccall.v1.RetryCallResponse
+	11, // 22: xyz.block.ftl.asynccall.v1.AsyncService.CancelCall:output_type -> xyz.block.ftl.asynccall.v1.CancelCallResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_xyz_block_ftl_asynccall_v1_asynccall_proto_init() }
+func file_xyz_block_ftl_asynccall_v1_asynccall_proto_init() {
+	if File_xyz_block_ftl_asynccall_v1_asynccall_proto != nil {
+		return
+	}
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[0].OneofWrappers = []any{}
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[1].OneofWrappers = []any{}
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_xyz_block_ftl_asynccall_v1_asynccall_proto_goTypes,
+		DependencyIndexes: file_xyz_block_ftl_asynccall_v1_asynccall_proto_depIdxs,
+		EnumInfos:         file_xyz_block_ftl_asynccall_v1_asynccall_proto_enumTypes,
+		MessageInfos:      file_xyz_block_ftl_asynccall_v1_asynccall_proto_msgTypes,
+	}.Build()
+	File_xyz_block_ftl_asynccall_v1_asynccall_proto = out.File
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_rawDesc = nil
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_goTypes = nil
+	file_xyz_block_ftl_asynccall_v1_asynccall_proto_depIdxs = nil
+}
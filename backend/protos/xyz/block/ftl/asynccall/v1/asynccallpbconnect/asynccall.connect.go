@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: xyz/block/ftl/asynccall/v1/asynccall.proto
+
+package asynccallpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v11 "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1"
+	v1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_7_0
+
+const (
+	// AsyncServiceName is the fully-qualified name of the AsyncService service.
+	AsyncServiceName = "xyz.block.ftl.asynccall.v1.AsyncService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AsyncServicePingProcedure is the fully-qualified name of the AsyncService's Ping RPC.
+	AsyncServicePingProcedure = "/xyz.block.ftl.asynccall.v1.AsyncService/Ping"
+	// AsyncServiceEnqueueCallProcedure is the fully-qualified name of the AsyncService's EnqueueCall
+	// RPC.
+	AsyncServiceEnqueueCallProcedure = "/xyz.block.ftl.asynccall.v1.AsyncService/EnqueueCall"
+	// AsyncServiceGetCallProcedure is the fully-qualified name of the AsyncService's GetCall RPC.
+	AsyncServiceGetCallProcedure = "/xyz.block.ftl.asynccall.v1.AsyncService/GetCall"
+	// AsyncServiceListCallsProcedure is the fully-qualified name of the AsyncService's ListCalls RPC.
+	AsyncServiceListCallsProcedure = "/xyz.block.ftl.asynccall.v1.AsyncService/ListCalls"
+	// AsyncServiceRetryCallProcedure is the fully-qualified name of the AsyncService's RetryCall RPC.
+	AsyncServiceRetryCallProcedure = "/xyz.block.ftl.asynccall.v1.AsyncService/RetryCall"
+	// AsyncServiceCancelCallProcedure is the fully-qualified name of the AsyncService's CancelCall RPC.
+	AsyncServiceCancelCallProcedure = "/xyz.block.ftl.asynccall.v1.AsyncService/CancelCall"
+)
+
+// AsyncServiceClient is a client for the xyz.block.ftl.asynccall.v1.AsyncService service.
+type AsyncServiceClient interface {
+	// Ping service for readiness.
+	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
+	// Enqueue a call to a verb, returning its ID.
+	EnqueueCall(context.Context, *connect.Request[v11.EnqueueCallRequest]) (*connect.Response[v11.EnqueueCallResponse], error)
+	// Get the current state of a call.
+	GetCall(context.Context, *connect.Request[v11.GetCallRequest]) (*connect.Response[v11.GetCallResponse], error)
+	// List calls, most recent first.
+	ListCalls(context.Context, *connect.Request[v11.ListCallsRequest]) (*connect.Response[v11.ListCallsResponse], error)
+	// Reschedule a failed or cancelled call for immediate execution.
+	RetryCall(context.Context, *connect.Request[v11.RetryCallRequest]) (*connect.Response[v11.RetryCallResponse], error)
+	// Cancel a pending call.
+	CancelCall(context.Context, *connect.Request[v11.CancelCallRequest]) (*connect.Response[v11.CancelCallResponse], error)
+}
+
+// NewAsyncServiceClient constructs a client for the xyz.block.ftl.asynccall.v1.AsyncService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAsyncServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AsyncServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &asyncServiceClient{
+		ping: connect.NewClient[v1.PingRequest, v1.PingResponse](
+			httpClient,
+			baseURL+AsyncServicePingProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		enqueueCall: connect.NewClient[v11.EnqueueCallRequest, v11.EnqueueCallResponse](
+			httpClient,
+			baseURL+AsyncServiceEnqueueCallProcedure,
+			opts...,
+		),
+		getCall: connect.NewClient[v11.GetCallRequest, v11.GetCallResponse](
+			httpClient,
+			baseURL+AsyncServiceGetCallProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listCalls: connect.NewClient[v11.ListCallsRequest, v11.ListCallsResponse](
+			httpClient,
+			baseURL+AsyncServiceListCallsProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		retryCall: connect.NewClient[v11.RetryCallRequest, v11.RetryCallResponse](
+			httpClient,
+			baseURL+AsyncServiceRetryCallProcedure,
+			opts...,
+		),
+		cancelCall: connect.NewClient[v11.CancelCallRequest, v11.CancelCallResponse](
+			httpClient,
+			baseURL+AsyncServiceCancelCallProcedure,
+			opts...,
+		),
+	}
+}
+
+// asyncServiceClient implements AsyncServiceClient.
+type asyncServiceClient struct {
+	ping        *connect.Client[v1.PingRequest, v1.PingResponse]
+	enqueueCall *connect.Client[v11.EnqueueCallRequest, v11.EnqueueCallResponse]
+	getCall     *connect.Client[v11.GetCallRequest, v11.GetCallResponse]
+	listCalls   *connect.Client[v11.ListCallsRequest, v11.ListCallsResponse]
+	retryCall   *connect.Client[v11.RetryCallRequest, v11.RetryCallResponse]
+	cancelCall  *connect.Client[v11.CancelCallRequest, v11.CancelCallResponse]
+}
+
+// Ping calls xyz.block.ftl.asynccall.v1.AsyncService.Ping.
+func (c *asyncServiceClient) Ping(ctx context.Context, req *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error) {
+	return c.ping.CallUnary(ctx, req)
+}
+
+// EnqueueCall calls xyz.block.ftl.asynccall.v1.AsyncService.EnqueueCall.
+func (c *asyncServiceClient) EnqueueCall(ctx context.Context, req *connect.Request[v11.EnqueueCallRequest]) (*connect.Response[v11.EnqueueCallResponse], error) {
+	return c.enqueueCall.CallUnary(ctx, req)
+}
+
+// GetCall calls xyz.block.ftl.asynccall.v1.AsyncService.GetCall.
+func (c *asyncServiceClient) GetCall(ctx context.Context, req *connect.Request[v11.GetCallRequest]) (*connect.Response[v11.GetCallResponse], error) {
+	return c.getCall.CallUnary(ctx, req)
+}
+
+// ListCalls calls xyz.block.ftl.asynccall.v1.AsyncService.ListCalls.
+func (c *asyncServiceClient) ListCalls(ctx context.Context, req *connect.Request[v11.ListCallsRequest]) (*connect.Response[v11.ListCallsResponse], error) {
+	return c.listCalls.CallUnary(ctx, req)
+}
+
+// RetryCall calls xyz.block.ftl.asynccall.v1.AsyncService.RetryCall.
+func (c *asyncServiceClient) RetryCall(ctx context.Context, req *connect.Request[v11.RetryCallRequest]) (*connect.Response[v11.RetryCallResponse], error) {
+	return c.retryCall.CallUnary(ctx, req)
+}
+
+// CancelCall calls xyz.block.ftl.asynccall.v1.AsyncService.CancelCall.
+func (c *asyncServiceClient) CancelCall(ctx context.Context, req *connect.Request[v11.CancelCallRequest]) (*connect.Response[v11.CancelCallResponse], error) {
+	return c.cancelCall.CallUnary(ctx, req)
+}
+
+// AsyncServiceHandler is an implementation of the xyz.block.ftl.asynccall.v1.AsyncService service.
+type AsyncServiceHandler interface {
+	// Ping service for readiness.
+	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
+	// Enqueue a call to a verb, returning its ID.
+	EnqueueCall(context.Context, *connect.Request[v11.EnqueueCallRequest]) (*connect.Response[v11.EnqueueCallResponse], error)
+	// Get the current state of a call.
+	GetCall(context.Context, *connect.Request[v11.GetCallRequest]) (*connect.Response[v11.GetCallResponse], error)
+	// List calls, most recent first.
+	ListCalls(context.Context, *connect.Request[v11.ListCallsRequest]) (*connect.Response[v11.ListCallsResponse], error)
+	// Reschedule a failed or cancelled call for immediate execution.
+	RetryCall(context.Context, *connect.Request[v11.RetryCallRequest]) (*connect.Response[v11.RetryCallResponse], error)
+	// Cancel a pending call.
+	CancelCall(context.Context, *connect.Request[v11.CancelCallRequest]) (*connect.Response[v11.CancelCallResponse], error)
+}
+
+// NewAsyncServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAsyncServiceHandler(svc AsyncServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	asyncServicePingHandler := connect.NewUnaryHandler(
+		AsyncServicePingProcedure,
+		svc.Ping,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	asyncServiceEnqueueCallHandler := connect.NewUnaryHandler(
+		AsyncServiceEnqueueCallProcedure,
+		svc.EnqueueCall,
+		opts...,
+	)
+	asyncServiceGetCallHandler := connect.NewUnaryHandler(
+		AsyncServiceGetCallProcedure,
+		svc.GetCall,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	asyncServiceListCallsHandler := connect.NewUnaryHandler(
+		AsyncServiceListCallsProcedure,
+		svc.ListCalls,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	asyncServiceRetryCallHandler := connect.NewUnaryHandler(
+		AsyncServiceRetryCallProcedure,
+		svc.RetryCall,
+		opts...,
+	)
+	asyncServiceCancelCallHandler := connect.NewUnaryHandler(
+		AsyncServiceCancelCallProcedure,
+		svc.CancelCall,
+		opts...,
+	)
+	return "/xyz.block.ftl.asynccall.v1.AsyncService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AsyncServicePingProcedure:
+			asyncServicePingHandler.ServeHTTP(w, r)
+		case AsyncServiceEnqueueCallProcedure:
+			asyncServiceEnqueueCallHandler.ServeHTTP(w, r)
+		case AsyncServiceGetCallProcedure:
+			asyncServiceGetCallHandler.ServeHTTP(w, r)
+		case AsyncServiceListCallsProcedure:
+			asyncServiceListCallsHandler.ServeHTTP(w, r)
+		case AsyncServiceRetryCallProcedure:
+			asyncServiceRetryCallHandler.ServeHTTP(w, r)
+		case AsyncServiceCancelCallProcedure:
+			asyncServiceCancelCallHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAsyncServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAsyncServiceHandler struct{}
+
+func (UnimplementedAsyncServiceHandler) Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.asynccall.v1.AsyncService.Ping is not implemented"))
+}
+
+func (UnimplementedAsyncServiceHandler) EnqueueCall(context.Context, *connect.Request[v11.EnqueueCallRequest]) (*connect.Response[v11.EnqueueCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.asynccall.v1.AsyncService.EnqueueCall is not implemented"))
+}
+
+func (UnimplementedAsyncServiceHandler) GetCall(context.Context, *connect.Request[v11.GetCallRequest]) (*connect.Response[v11.GetCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.asynccall.v1.AsyncService.GetCall is not implemented"))
+}
+
+func (UnimplementedAsyncServiceHandler) ListCalls(context.Context, *connect.Request[v11.ListCallsRequest]) (*connect.Response[v11.ListCallsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.asynccall.v1.AsyncService.ListCalls is not implemented"))
+}
+
+func (UnimplementedAsyncServiceHandler) RetryCall(context.Context, *connect.Request[v11.RetryCallRequest]) (*connect.Response[v11.RetryCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.asynccall.v1.AsyncService.RetryCall is not implemented"))
+}
+
+func (UnimplementedAsyncServiceHandler) CancelCall(context.Context, *connect.Request[v11.CancelCallRequest]) (*connect.Response[v11.CancelCallResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.asynccall.v1.AsyncService.CancelCall is not implemented"))
+}
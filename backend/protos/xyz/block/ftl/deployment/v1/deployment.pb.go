@@ -27,6 +27,7 @@ const (
 	GetDeploymentContextResponse_DB_TYPE_UNSPECIFIED GetDeploymentContextResponse_DbType = 0
 	GetDeploymentContextResponse_DB_TYPE_POSTGRES    GetDeploymentContextResponse_DbType = 1
 	GetDeploymentContextResponse_DB_TYPE_MYSQL       GetDeploymentContextResponse_DbType = 2
+	GetDeploymentContextResponse_DB_TYPE_SQLITE      GetDeploymentContextResponse_DbType = 3
 )
 
 // Enum value maps for GetDeploymentContextResponse_DbType.
@@ -35,11 +36,13 @@ var (
 		0: "DB_TYPE_UNSPECIFIED",
 		1: "DB_TYPE_POSTGRES",
 		2: "DB_TYPE_MYSQL",
+		3: "DB_TYPE_SQLITE",
 	}
 	GetDeploymentContextResponse_DbType_value = map[string]int32{
 		"DB_TYPE_UNSPECIFIED": 0,
 		"DB_TYPE_POSTGRES":    1,
 		"DB_TYPE_MYSQL":       2,
+		"DB_TYPE_SQLITE":      3,
 	}
 )
 
@@ -126,6 +129,10 @@ type GetDeploymentContextResponse struct {
 	Secrets    map[string][]byte                     `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Databases  []*GetDeploymentContextResponse_DSN   `protobuf:"bytes,5,rep,name=databases,proto3" json:"databases,omitempty"`
 	Routes     []*GetDeploymentContextResponse_Route `protobuf:"bytes,6,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Signed token identifying the deployment, attached by the runner to outbound calls.
+	CallerToken string `protobuf:"bytes,7,opt,name=caller_token,json=callerToken,proto3" json:"caller_token,omitempty"`
+	// Keys that caller tokens are verified with.
+	CallerKeys []*GetDeploymentContextResponse_CallerKey `protobuf:"bytes,8,rep,name=caller_keys,json=callerKeys,proto3" json:"caller_keys,omitempty"`
 }
 
 func (x *GetDeploymentContextResponse) Reset() {
@@ -200,6 +207,20 @@ func (x *GetDeploymentContextResponse) GetRoutes() []*GetDeploymentContextRespon
 	return nil
 }
 
+func (x *GetDeploymentContextResponse) GetCallerToken() string {
+	if x != nil {
+		return x.CallerToken
+	}
+	return ""
+}
+
+func (x *GetDeploymentContextResponse) GetCallerKeys() []*GetDeploymentContextResponse_CallerKey {
+	if x != nil {
+		return x.CallerKeys
+	}
+	return nil
+}
+
 type GetDeploymentContextResponse_DSN struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -314,6 +335,59 @@ func (x *GetDeploymentContextResponse_Route) GetUri() string {
 	return ""
 }
 
+type GetDeploymentContextResponse_CallerKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *GetDeploymentContextResponse_CallerKey) Reset() {
+	*x = GetDeploymentContextResponse_CallerKey{}
+	mi := &file_xyz_block_ftl_deployment_v1_deployment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeploymentContextResponse_CallerKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeploymentContextResponse_CallerKey) ProtoMessage() {}
+
+func (x *GetDeploymentContextResponse_CallerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_deployment_v1_deployment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeploymentContextResponse_CallerKey.ProtoReflect.Descriptor instead.
+func (*GetDeploymentContextResponse_CallerKey) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_deployment_v1_deployment_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *GetDeploymentContextResponse_CallerKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDeploymentContextResponse_CallerKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
 var File_xyz_block_ftl_deployment_v1_deployment_proto protoreflect.FileDescriptor
 
 var file_xyz_block_ftl_deployment_v1_deployment_proto_rawDesc = []byte{
@@ -327,7 +401,7 @@ var file_xyz_block_ftl_deployment_v1_deployment_proto_rawDesc = []byte{
 	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xd3, 0x06, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xac, 0x08, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65,
 	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
@@ -356,52 +430,66 @@ var file_xyz_block_ftl_deployment_v1_deployment_proto_rawDesc = []byte{
 	0x2e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
 	0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
 	0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x81, 0x01, 0x0a, 0x03, 0x44, 0x53,
-	0x4e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x54, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
-	0x66, 0x74, 0x6c, 0x2e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64,
-	0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x1a, 0x39, 0x0a,
-	0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c,
-	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x4a, 0x0a, 0x06, 0x44, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x42,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
-	0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x42, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x02, 0x32, 0xef, 0x01, 0x0a,
-	0x11, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a,
-	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x8d,
-	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x38, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x39, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
+	0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x64, 0x0a, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x43, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74,
 	0x6c, 0x2e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
 	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x50,
-	0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x73, 0x1a, 0x81, 0x01, 0x0a, 0x03, 0x44, 0x53, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x54,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x1a, 0x39, 0x0a, 0x05, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x1a, 0x3a, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x06, 0x44, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x17, 0x0a, 0x13, 0x44, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x42, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x44, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10,
+	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x42, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x51, 0x4c,
+	0x49, 0x54, 0x45, 0x10, 0x03, 0x32, 0xef, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50,
+	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x8d, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x38, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c,
+	0x2e, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x78, 0x79, 0x7a,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6c,
+	0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x50, 0x50, 0x01, 0x5a, 0x4c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74,
+	0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x64,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -417,33 +505,35 @@ func file_xyz_block_ftl_deployment_v1_deployment_proto_rawDescGZIP() []byte {
 }
 
 var file_xyz_block_ftl_deployment_v1_deployment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_xyz_block_ftl_deployment_v1_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_xyz_block_ftl_deployment_v1_deployment_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_xyz_block_ftl_deployment_v1_deployment_proto_goTypes = []any{
-	(GetDeploymentContextResponse_DbType)(0),   // 0: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbType
-	(*GetDeploymentContextRequest)(nil),        // 1: xyz.block.ftl.deployment.v1.GetDeploymentContextRequest
-	(*GetDeploymentContextResponse)(nil),       // 2: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse
-	(*GetDeploymentContextResponse_DSN)(nil),   // 3: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSN
-	(*GetDeploymentContextResponse_Route)(nil), // 4: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.Route
-	nil,                     // 5: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.ConfigsEntry
-	nil,                     // 6: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.SecretsEntry
-	(*v1.PingRequest)(nil),  // 7: xyz.block.ftl.v1.PingRequest
-	(*v1.PingResponse)(nil), // 8: xyz.block.ftl.v1.PingResponse
+	(GetDeploymentContextResponse_DbType)(0),       // 0: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbType
+	(*GetDeploymentContextRequest)(nil),            // 1: xyz.block.ftl.deployment.v1.GetDeploymentContextRequest
+	(*GetDeploymentContextResponse)(nil),           // 2: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse
+	(*GetDeploymentContextResponse_DSN)(nil),       // 3: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSN
+	(*GetDeploymentContextResponse_Route)(nil),     // 4: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.Route
+	(*GetDeploymentContextResponse_CallerKey)(nil), // 5: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.CallerKey
+	nil,                     // 6: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.ConfigsEntry
+	nil,                     // 7: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.SecretsEntry
+	(*v1.PingRequest)(nil),  // 8: xyz.block.ftl.v1.PingRequest
+	(*v1.PingResponse)(nil), // 9: xyz.block.ftl.v1.PingResponse
 }
 var file_xyz_block_ftl_deployment_v1_deployment_proto_depIdxs = []int32{
-	5, // 0: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.configs:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.ConfigsEntry
-	6, // 1: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.secrets:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.SecretsEntry
+	6, // 0: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.configs:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.ConfigsEntry
+	7, // 1: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.secrets:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.SecretsEntry
 	3, // 2: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.databases:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSN
 	4, // 3: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.routes:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.Route
-	0, // 4: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSN.type:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbType
-	7, // 5: xyz.block.ftl.deployment.v1.DeploymentService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
-	1, // 6: xyz.block.ftl.deployment.v1.DeploymentService.GetDeploymentContext:input_type -> xyz.block.ftl.deployment.v1.GetDeploymentContextRequest
-	8, // 7: xyz.block.ftl.deployment.v1.DeploymentService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	2, // 8: xyz.block.ftl.deployment.v1.DeploymentService.GetDeploymentContext:output_type -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 4: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.caller_keys:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.CallerKey
+	0, // 5: xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSN.type:type_name -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbType
+	8, // 6: xyz.block.ftl.deployment.v1.DeploymentService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
+	1, // 7: xyz.block.ftl.deployment.v1.DeploymentService.GetDeploymentContext:input_type -> xyz.block.ftl.deployment.v1.GetDeploymentContextRequest
+	9, // 8: xyz.block.ftl.deployment.v1.DeploymentService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	2, // 9: xyz.block.ftl.deployment.v1.DeploymentService.GetDeploymentContext:output_type -> xyz.block.ftl.deployment.v1.GetDeploymentContextResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_xyz_block_ftl_deployment_v1_deployment_proto_init() }
@@ -457,7 +547,7 @@ func file_xyz_block_ftl_deployment_v1_deployment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xyz_block_ftl_deployment_v1_deployment_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: xyz/block/ftl/kv/v1/kv.proto
+
+package kvpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v11 "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1"
+	v1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_7_0
+
+const (
+	// KVServiceName is the fully-qualified name of the KVService service.
+	KVServiceName = "xyz.block.ftl.kv.v1.KVService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KVServicePingProcedure is the fully-qualified name of the KVService's Ping RPC.
+	KVServicePingProcedure = "/xyz.block.ftl.kv.v1.KVService/Ping"
+	// KVServiceGetProcedure is the fully-qualified name of the KVService's Get RPC.
+	KVServiceGetProcedure = "/xyz.block.ftl.kv.v1.KVService/Get"
+	// KVServicePutProcedure is the fully-qualified name of the KVService's Put RPC.
+	KVServicePutProcedure = "/xyz.block.ftl.kv.v1.KVService/Put"
+	// KVServiceDeleteProcedure is the fully-qualified name of the KVService's Delete RPC.
+	KVServiceDeleteProcedure = "/xyz.block.ftl.kv.v1.KVService/Delete"
+	// KVServiceCompareAndSwapProcedure is the fully-qualified name of the KVService's CompareAndSwap
+	// RPC.
+	KVServiceCompareAndSwapProcedure = "/xyz.block.ftl.kv.v1.KVService/CompareAndSwap"
+)
+
+// KVServiceClient is a client for the xyz.block.ftl.kv.v1.KVService service.
+type KVServiceClient interface {
+	// Ping service for readiness.
+	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
+	// Get a value from a key-value store.
+	Get(context.Context, *connect.Request[v11.GetRequest]) (*connect.Response[v11.GetResponse], error)
+	// Put a value into a key-value store.
+	Put(context.Context, *connect.Request[v11.PutRequest]) (*connect.Response[v11.PutResponse], error)
+	// Delete a key from a key-value store.
+	Delete(context.Context, *connect.Request[v11.DeleteRequest]) (*connect.Response[v11.DeleteResponse], error)
+	// Atomically replace a value if it matches the expected value.
+	CompareAndSwap(context.Context, *connect.Request[v11.CompareAndSwapRequest]) (*connect.Response[v11.CompareAndSwapResponse], error)
+}
+
+// NewKVServiceClient constructs a client for the xyz.block.ftl.kv.v1.KVService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKVServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KVServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &kVServiceClient{
+		ping: connect.NewClient[v1.PingRequest, v1.PingResponse](
+			httpClient,
+			baseURL+KVServicePingProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		get: connect.NewClient[v11.GetRequest, v11.GetResponse](
+			httpClient,
+			baseURL+KVServiceGetProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		put: connect.NewClient[v11.PutRequest, v11.PutResponse](
+			httpClient,
+			baseURL+KVServicePutProcedure,
+			opts...,
+		),
+		delete: connect.NewClient[v11.DeleteRequest, v11.DeleteResponse](
+			httpClient,
+			baseURL+KVServiceDeleteProcedure,
+			opts...,
+		),
+		compareAndSwap: connect.NewClient[v11.CompareAndSwapRequest, v11.CompareAndSwapResponse](
+			httpClient,
+			baseURL+KVServiceCompareAndSwapProcedure,
+			opts...,
+		),
+	}
+}
+
+// kVServiceClient implements KVServiceClient.
+type kVServiceClient struct {
+	ping           *connect.Client[v1.PingRequest, v1.PingResponse]
+	get            *connect.Client[v11.GetRequest, v11.GetResponse]
+	put            *connect.Client[v11.PutRequest, v11.PutResponse]
+	delete         *connect.Client[v11.DeleteRequest, v11.DeleteResponse]
+	compareAndSwap *connect.Client[v11.CompareAndSwapRequest, v11.CompareAndSwapResponse]
+}
+
+// Ping calls xyz.block.ftl.kv.v1.KVService.Ping.
+func (c *kVServiceClient) Ping(ctx context.Context, req *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error) {
+	return c.ping.CallUnary(ctx, req)
+}
+
+// Get calls xyz.block.ftl.kv.v1.KVService.Get.
+func (c *kVServiceClient) Get(ctx context.Context, req *connect.Request[v11.GetRequest]) (*connect.Response[v11.GetResponse], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Put calls xyz.block.ftl.kv.v1.KVService.Put.
+func (c *kVServiceClient) Put(ctx context.Context, req *connect.Request[v11.PutRequest]) (*connect.Response[v11.PutResponse], error) {
+	return c.put.CallUnary(ctx, req)
+}
+
+// Delete calls xyz.block.ftl.kv.v1.KVService.Delete.
+func (c *kVServiceClient) Delete(ctx context.Context, req *connect.Request[v11.DeleteRequest]) (*connect.Response[v11.DeleteResponse], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// CompareAndSwap calls xyz.block.ftl.kv.v1.KVService.CompareAndSwap.
+func (c *kVServiceClient) CompareAndSwap(ctx context.Context, req *connect.Request[v11.CompareAndSwapRequest]) (*connect.Response[v11.CompareAndSwapResponse], error) {
+	return c.compareAndSwap.CallUnary(ctx, req)
+}
+
+// KVServiceHandler is an implementation of the xyz.block.ftl.kv.v1.KVService service.
+type KVServiceHandler interface {
+	// Ping service for readiness.
+	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
+	// Get a value from a key-value store.
+	Get(context.Context, *connect.Request[v11.GetRequest]) (*connect.Response[v11.GetResponse], error)
+	// Put a value into a key-value store.
+	Put(context.Context, *connect.Request[v11.PutRequest]) (*connect.Response[v11.PutResponse], error)
+	// Delete a key from a key-value store.
+	Delete(context.Context, *connect.Request[v11.DeleteRequest]) (*connect.Response[v11.DeleteResponse], error)
+	// Atomically replace a value if it matches the expected value.
+	CompareAndSwap(context.Context, *connect.Request[v11.CompareAndSwapRequest]) (*connect.Response[v11.CompareAndSwapResponse], error)
+}
+
+// NewKVServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKVServiceHandler(svc KVServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	kVServicePingHandler := connect.NewUnaryHandler(
+		KVServicePingProcedure,
+		svc.Ping,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	kVServiceGetHandler := connect.NewUnaryHandler(
+		KVServiceGetProcedure,
+		svc.Get,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	kVServicePutHandler := connect.NewUnaryHandler(
+		KVServicePutProcedure,
+		svc.Put,
+		opts...,
+	)
+	kVServiceDeleteHandler := connect.NewUnaryHandler(
+		KVServiceDeleteProcedure,
+		svc.Delete,
+		opts...,
+	)
+	kVServiceCompareAndSwapHandler := connect.NewUnaryHandler(
+		KVServiceCompareAndSwapProcedure,
+		svc.CompareAndSwap,
+		opts...,
+	)
+	return "/xyz.block.ftl.kv.v1.KVService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KVServicePingProcedure:
+			kVServicePingHandler.ServeHTTP(w, r)
+		case KVServiceGetProcedure:
+			kVServiceGetHandler.ServeHTTP(w, r)
+		case KVServicePutProcedure:
+			kVServicePutHandler.ServeHTTP(w, r)
+		case KVServiceDeleteProcedure:
+			kVServiceDeleteHandler.ServeHTTP(w, r)
+		case KVServiceCompareAndSwapProcedure:
+			kVServiceCompareAndSwapHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKVServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKVServiceHandler struct{}
+
+func (UnimplementedKVServiceHandler) Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.kv.v1.KVService.Ping is not implemented"))
+}
+
+func (UnimplementedKVServiceHandler) Get(context.Context, *connect.Request[v11.GetRequest]) (*connect.Response[v11.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.kv.v1.KVService.Get is not implemented"))
+}
+
+func (UnimplementedKVServiceHandler) Put(context.Context, *connect.Request[v11.PutRequest]) (*connect.Response[v11.PutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.kv.v1.KVService.Put is not implemented"))
+}
+
+func (UnimplementedKVServiceHandler) Delete(context.Context, *connect.Request[v11.DeleteRequest]) (*connect.Response[v11.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.kv.v1.KVService.Delete is not implemented"))
+}
+
+func (UnimplementedKVServiceHandler) CompareAndSwap(context.Context, *connect.Request[v11.CompareAndSwapRequest]) (*connect.Response[v11.CompareAndSwapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.kv.v1.KVService.CompareAndSwap is not implemented"))
+}
@@ -103,6 +103,9 @@ func ModuleConfigToProto(config moduleconfig.AbsModuleConfig) (*ModuleConfig, er
 	if config.DevModeBuild != "" {
 		proto.DevModeBuild = &config.DevModeBuild
 	}
+	if config.Test != "" {
+		proto.Test = &config.Test
+	}
 
 	langConfigProto, err := structpb.NewStruct(config.LanguageConfig)
 	if err != nil {
@@ -125,6 +128,7 @@ func ModuleConfigFromProto(proto *ModuleConfig) moduleconfig.AbsModuleConfig {
 		DevModeBuild:          proto.GetDevModeBuild(),
 		BuildLock:             proto.BuildLock,
 		SQLMigrationDirectory: proto.GetSqlMigrationDir(),
+		Test:                  proto.GetTest(),
 	}
 	if proto.LanguageConfig != nil {
 		config.LanguageConfig = proto.LanguageConfig.AsMap()
@@ -54,6 +54,9 @@ const (
 	// ProvisionerServiceReplaceDeployProcedure is the fully-qualified name of the ProvisionerService's
 	// ReplaceDeploy RPC.
 	ProvisionerServiceReplaceDeployProcedure = "/xyz.block.ftl.provisioner.v1beta1.ProvisionerService/ReplaceDeploy"
+	// ProvisionerServiceDeployChangesetProcedure is the fully-qualified name of the
+	// ProvisionerService's DeployChangeset RPC.
+	ProvisionerServiceDeployChangesetProcedure = "/xyz.block.ftl.provisioner.v1beta1.ProvisionerService/DeployChangeset"
 )
 
 // ProvisionerServiceClient is a client for the xyz.block.ftl.provisioner.v1beta1.ProvisionerService
@@ -66,6 +69,7 @@ type ProvisionerServiceClient interface {
 	CreateDeployment(context.Context, *connect.Request[v1.CreateDeploymentRequest]) (*connect.Response[v1.CreateDeploymentResponse], error)
 	UpdateDeploy(context.Context, *connect.Request[v1.UpdateDeployRequest]) (*connect.Response[v1.UpdateDeployResponse], error)
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	DeployChangeset(context.Context, *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error)
 }
 
 // NewProvisionerServiceClient constructs a client for the
@@ -115,6 +119,11 @@ func NewProvisionerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ProvisionerServiceReplaceDeployProcedure,
 			opts...,
 		),
+		deployChangeset: connect.NewClient[v1.DeployChangesetRequest, v1.DeployChangesetResponse](
+			httpClient,
+			baseURL+ProvisionerServiceDeployChangesetProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -127,6 +136,7 @@ type provisionerServiceClient struct {
 	createDeployment *connect.Client[v1.CreateDeploymentRequest, v1.CreateDeploymentResponse]
 	updateDeploy     *connect.Client[v1.UpdateDeployRequest, v1.UpdateDeployResponse]
 	replaceDeploy    *connect.Client[v1.ReplaceDeployRequest, v1.ReplaceDeployResponse]
+	deployChangeset  *connect.Client[v1.DeployChangesetRequest, v1.DeployChangesetResponse]
 }
 
 // Ping calls xyz.block.ftl.provisioner.v1beta1.ProvisionerService.Ping.
@@ -164,6 +174,11 @@ func (c *provisionerServiceClient) ReplaceDeploy(ctx context.Context, req *conne
 	return c.replaceDeploy.CallUnary(ctx, req)
 }
 
+// DeployChangeset calls xyz.block.ftl.provisioner.v1beta1.ProvisionerService.DeployChangeset.
+func (c *provisionerServiceClient) DeployChangeset(ctx context.Context, req *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error) {
+	return c.deployChangeset.CallUnary(ctx, req)
+}
+
 // ProvisionerServiceHandler is an implementation of the
 // xyz.block.ftl.provisioner.v1beta1.ProvisionerService service.
 type ProvisionerServiceHandler interface {
@@ -174,6 +189,7 @@ type ProvisionerServiceHandler interface {
 	CreateDeployment(context.Context, *connect.Request[v1.CreateDeploymentRequest]) (*connect.Response[v1.CreateDeploymentResponse], error)
 	UpdateDeploy(context.Context, *connect.Request[v1.UpdateDeployRequest]) (*connect.Response[v1.UpdateDeployResponse], error)
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	DeployChangeset(context.Context, *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error)
 }
 
 // NewProvisionerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -218,6 +234,11 @@ func NewProvisionerServiceHandler(svc ProvisionerServiceHandler, opts ...connect
 		svc.ReplaceDeploy,
 		opts...,
 	)
+	provisionerServiceDeployChangesetHandler := connect.NewUnaryHandler(
+		ProvisionerServiceDeployChangesetProcedure,
+		svc.DeployChangeset,
+		opts...,
+	)
 	return "/xyz.block.ftl.provisioner.v1beta1.ProvisionerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProvisionerServicePingProcedure:
@@ -234,6 +255,8 @@ func NewProvisionerServiceHandler(svc ProvisionerServiceHandler, opts ...connect
 			provisionerServiceUpdateDeployHandler.ServeHTTP(w, r)
 		case ProvisionerServiceReplaceDeployProcedure:
 			provisionerServiceReplaceDeployHandler.ServeHTTP(w, r)
+		case ProvisionerServiceDeployChangesetProcedure:
+			provisionerServiceDeployChangesetHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -270,3 +293,7 @@ func (UnimplementedProvisionerServiceHandler) UpdateDeploy(context.Context, *con
 func (UnimplementedProvisionerServiceHandler) ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.provisioner.v1beta1.ProvisionerService.ReplaceDeploy is not implemented"))
 }
+
+func (UnimplementedProvisionerServiceHandler) DeployChangeset(context.Context, *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.provisioner.v1beta1.ProvisionerService.DeployChangeset is not implemented"))
+}
@@ -32,7 +32,7 @@ var file_xyz_block_ftl_provisioner_v1beta1_service_proto_rawDesc = []byte{
 	0x66, 0x74, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f,
 	0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x74, 0x6c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xa9, 0x05, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6f, 0x74, 0x6f, 0x32, 0x91, 0x06, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
 	0x6e, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x50, 0x69,
 	0x6e, 0x67, 0x12, 0x1d, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
 	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
@@ -74,13 +74,20 @@ var file_xyz_block_ftl_provisioner_v1beta1_service_proto_rawDesc = []byte{
 	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f,
 	0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x57, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
-	0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x66, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x28, 0x2e, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66,
+	0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x78,
+	0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x57, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74,
+	0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x72, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_xyz_block_ftl_provisioner_v1beta1_service_proto_goTypes = []any{
@@ -91,13 +98,15 @@ var file_xyz_block_ftl_provisioner_v1beta1_service_proto_goTypes = []any{
 	(*v1.CreateDeploymentRequest)(nil),  // 4: xyz.block.ftl.v1.CreateDeploymentRequest
 	(*v1.UpdateDeployRequest)(nil),      // 5: xyz.block.ftl.v1.UpdateDeployRequest
 	(*v1.ReplaceDeployRequest)(nil),     // 6: xyz.block.ftl.v1.ReplaceDeployRequest
-	(*v1.PingResponse)(nil),             // 7: xyz.block.ftl.v1.PingResponse
-	(*v1.StatusResponse)(nil),           // 8: xyz.block.ftl.v1.StatusResponse
-	(*v1.GetArtefactDiffsResponse)(nil), // 9: xyz.block.ftl.v1.GetArtefactDiffsResponse
-	(*v1.UploadArtefactResponse)(nil),   // 10: xyz.block.ftl.v1.UploadArtefactResponse
-	(*v1.CreateDeploymentResponse)(nil), // 11: xyz.block.ftl.v1.CreateDeploymentResponse
-	(*v1.UpdateDeployResponse)(nil),     // 12: xyz.block.ftl.v1.UpdateDeployResponse
-	(*v1.ReplaceDeployResponse)(nil),    // 13: xyz.block.ftl.v1.ReplaceDeployResponse
+	(*v1.DeployChangesetRequest)(nil),   // 7: xyz.block.ftl.v1.DeployChangesetRequest
+	(*v1.PingResponse)(nil),             // 8: xyz.block.ftl.v1.PingResponse
+	(*v1.StatusResponse)(nil),           // 9: xyz.block.ftl.v1.StatusResponse
+	(*v1.GetArtefactDiffsResponse)(nil), // 10: xyz.block.ftl.v1.GetArtefactDiffsResponse
+	(*v1.UploadArtefactResponse)(nil),   // 11: xyz.block.ftl.v1.UploadArtefactResponse
+	(*v1.CreateDeploymentResponse)(nil), // 12: xyz.block.ftl.v1.CreateDeploymentResponse
+	(*v1.UpdateDeployResponse)(nil),     // 13: xyz.block.ftl.v1.UpdateDeployResponse
+	(*v1.ReplaceDeployResponse)(nil),    // 14: xyz.block.ftl.v1.ReplaceDeployResponse
+	(*v1.DeployChangesetResponse)(nil),  // 15: xyz.block.ftl.v1.DeployChangesetResponse
 }
 var file_xyz_block_ftl_provisioner_v1beta1_service_proto_depIdxs = []int32{
 	0,  // 0: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.Ping:input_type -> xyz.block.ftl.v1.PingRequest
@@ -107,15 +116,17 @@ var file_xyz_block_ftl_provisioner_v1beta1_service_proto_depIdxs = []int32{
 	4,  // 4: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.CreateDeployment:input_type -> xyz.block.ftl.v1.CreateDeploymentRequest
 	5,  // 5: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.UpdateDeploy:input_type -> xyz.block.ftl.v1.UpdateDeployRequest
 	6,  // 6: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.ReplaceDeploy:input_type -> xyz.block.ftl.v1.ReplaceDeployRequest
-	7,  // 7: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
-	8,  // 8: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.Status:output_type -> xyz.block.ftl.v1.StatusResponse
-	9,  // 9: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.GetArtefactDiffs:output_type -> xyz.block.ftl.v1.GetArtefactDiffsResponse
-	10, // 10: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.UploadArtefact:output_type -> xyz.block.ftl.v1.UploadArtefactResponse
-	11, // 11: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.CreateDeployment:output_type -> xyz.block.ftl.v1.CreateDeploymentResponse
-	12, // 12: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.UpdateDeploy:output_type -> xyz.block.ftl.v1.UpdateDeployResponse
-	13, // 13: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.ReplaceDeploy:output_type -> xyz.block.ftl.v1.ReplaceDeployResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	7,  // 7: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.DeployChangeset:input_type -> xyz.block.ftl.v1.DeployChangesetRequest
+	8,  // 8: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.Ping:output_type -> xyz.block.ftl.v1.PingResponse
+	9,  // 9: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.Status:output_type -> xyz.block.ftl.v1.StatusResponse
+	10, // 10: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.GetArtefactDiffs:output_type -> xyz.block.ftl.v1.GetArtefactDiffsResponse
+	11, // 11: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.UploadArtefact:output_type -> xyz.block.ftl.v1.UploadArtefactResponse
+	12, // 12: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.CreateDeployment:output_type -> xyz.block.ftl.v1.CreateDeploymentResponse
+	13, // 13: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.UpdateDeploy:output_type -> xyz.block.ftl.v1.UpdateDeployResponse
+	14, // 14: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.ReplaceDeploy:output_type -> xyz.block.ftl.v1.ReplaceDeployResponse
+	15, // 15: xyz.block.ftl.provisioner.v1beta1.ProvisionerService.DeployChangeset:output_type -> xyz.block.ftl.v1.DeployChangesetResponse
+	8,  // [8:16] is the sub-list for method output_type
+	0,  // [0:8] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
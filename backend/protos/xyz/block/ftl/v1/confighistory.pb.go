@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        (unknown)
+// source: xyz/block/ftl/v1/confighistory.proto
+
+package ftlv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigChangeOperation int32
+
+const (
+	ConfigChangeOperation_CONFIG_CHANGE_OPERATION_UNSPECIFIED ConfigChangeOperation = 0
+	ConfigChangeOperation_CONFIG_CHANGE_OPERATION_SET         ConfigChangeOperation = 1
+	ConfigChangeOperation_CONFIG_CHANGE_OPERATION_UNSET       ConfigChangeOperation = 2
+)
+
+// Enum value maps for ConfigChangeOperation.
+var (
+	ConfigChangeOperation_name = map[int32]string{
+		0: "CONFIG_CHANGE_OPERATION_UNSPECIFIED",
+		1: "CONFIG_CHANGE_OPERATION_SET",
+		2: "CONFIG_CHANGE_OPERATION_UNSET",
+	}
+	ConfigChangeOperation_value = map[string]int32{
+		"CONFIG_CHANGE_OPERATION_UNSPECIFIED": 0,
+		"CONFIG_CHANGE_OPERATION_SET":         1,
+		"CONFIG_CHANGE_OPERATION_UNSET":       2,
+	}
+)
+
+func (x ConfigChangeOperation) Enum() *ConfigChangeOperation {
+	p := new(ConfigChangeOperation)
+	*p = x
+	return p
+}
+
+func (x ConfigChangeOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigChangeOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_xyz_block_ftl_v1_confighistory_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfigChangeOperation) Type() protoreflect.EnumType {
+	return &file_xyz_block_ftl_v1_confighistory_proto_enumTypes[0]
+}
+
+func (x ConfigChangeOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigChangeOperation.Descriptor instead.
+func (ConfigChangeOperation) EnumDescriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_confighistory_proto_rawDescGZIP(), []int{0}
+}
+
+// A change to a configuration value or secret, as recorded in the audit log.
+type ConfigChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the change in the audit history.
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The authenticated identity of the client that made the change, if known.
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The key of the provider the value was stored in.
+	Provider  string                `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Operation ConfigChangeOperation `protobuf:"varint,5,opt,name=operation,proto3,enum=xyz.block.ftl.v1.ConfigChangeOperation" json:"operation,omitempty"`
+	// The JSON encoded value that was set. Only recorded for configuration.
+	Value []byte `protobuf:"bytes,6,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	// The hex encoded HMAC-SHA256 of the value that was set, keyed with a key held by the admin service.
+	// Equal values have equal fingerprints, but a value can't be guessed from its fingerprint.
+	Fingerprint *string `protobuf:"bytes,7,opt,name=fingerprint,proto3,oneof" json:"fingerprint,omitempty"`
+	// The ID of the change whose value was restored, if any.
+	RestoredFrom *int64 `protobuf:"varint,8,opt,name=restored_from,json=restoredFrom,proto3,oneof" json:"restored_from,omitempty"`
+}
+
+func (x *ConfigChange) Reset() {
+	*x = ConfigChange{}
+	mi := &file_xyz_block_ftl_v1_confighistory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChange) ProtoMessage() {}
+
+func (x *ConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_xyz_block_ftl_v1_confighistory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigChange.ProtoReflect.Descriptor instead.
+func (*ConfigChange) Descriptor() ([]byte, []int) {
+	return file_xyz_block_ftl_v1_confighistory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigChange) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ConfigChange) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetOperation() ConfigChangeOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return ConfigChangeOperation_CONFIG_CHANGE_OPERATION_UNSPECIFIED
+}
+
+func (x *ConfigChange) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ConfigChange) GetFingerprint() string {
+	if x != nil && x.Fingerprint != nil {
+		return *x.Fingerprint
+	}
+	return ""
+}
+
+func (x *ConfigChange) GetRestoredFrom() int64 {
+	if x != nil && x.RestoredFrom != nil {
+		return *x.RestoredFrom
+	}
+	return 0
+}
+
+var File_xyz_block_ftl_v1_confighistory_proto protoreflect.FileDescriptor
+
+var file_xyz_block_ftl_v1_confighistory_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x78, 0x79, 0x7a, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x02, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x78, 0x79, 0x7a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x66, 0x74, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x2a, 0x84, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x23, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x02, 0x42, 0x3e, 0x50, 0x01,
+	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2f, 0x66, 0x74, 0x6c, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x79, 0x7a, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f,
+	0x66, 0x74, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x74, 0x6c, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xyz_block_ftl_v1_confighistory_proto_rawDescOnce sync.Once
+	file_xyz_block_ftl_v1_confighistory_proto_rawDescData = file_xyz_block_ftl_v1_confighistory_proto_rawDesc
+)
+
+func file_xyz_block_ftl_v1_confighistory_proto_rawDescGZIP() []byte {
+	file_xyz_block_ftl_v1_confighistory_proto_rawDescOnce.Do(func() {
+		file_xyz_block_ftl_v1_confighistory_proto_rawDescData = protoimpl.X.CompressGZIP(file_xyz_block_ftl_v1_confighistory_proto_rawDescData)
+	})
+	return file_xyz_block_ftl_v1_confighistory_proto_rawDescData
+}
+
+var file_xyz_block_ftl_v1_confighistory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xyz_block_ftl_v1_confighistory_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_xyz_block_ftl_v1_confighistory_proto_goTypes = []any{
+	(ConfigChangeOperation)(0),    // 0: xyz.block.ftl.v1.ConfigChangeOperation
+	(*ConfigChange)(nil),          // 1: xyz.block.ftl.v1.ConfigChange
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_xyz_block_ftl_v1_confighistory_proto_depIdxs = []int32{
+	2, // 0: xyz.block.ftl.v1.ConfigChange.timestamp:type_name -> google.protobuf.Timestamp
+	0, // 1: xyz.block.ftl.v1.ConfigChange.operation:type_name -> xyz.block.ftl.v1.ConfigChangeOperation
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_xyz_block_ftl_v1_confighistory_proto_init() }
+func file_xyz_block_ftl_v1_confighistory_proto_init() {
+	if File_xyz_block_ftl_v1_confighistory_proto != nil {
+		return
+	}
+	file_xyz_block_ftl_v1_confighistory_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xyz_block_ftl_v1_confighistory_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xyz_block_ftl_v1_confighistory_proto_goTypes,
+		DependencyIndexes: file_xyz_block_ftl_v1_confighistory_proto_depIdxs,
+		EnumInfos:         file_xyz_block_ftl_v1_confighistory_proto_enumTypes,
+		MessageInfos:      file_xyz_block_ftl_v1_confighistory_proto_msgTypes,
+	}.Build()
+	File_xyz_block_ftl_v1_confighistory_proto = out.File
+	file_xyz_block_ftl_v1_confighistory_proto_rawDesc = nil
+	file_xyz_block_ftl_v1_confighistory_proto_goTypes = nil
+	file_xyz_block_ftl_v1_confighistory_proto_depIdxs = nil
+}
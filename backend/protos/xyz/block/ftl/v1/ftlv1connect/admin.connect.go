@@ -44,6 +44,15 @@ const (
 	// AdminServiceConfigUnsetProcedure is the fully-qualified name of the AdminService's ConfigUnset
 	// RPC.
 	AdminServiceConfigUnsetProcedure = "/xyz.block.ftl.v1.AdminService/ConfigUnset"
+	// AdminServiceConfigHistoryProcedure is the fully-qualified name of the AdminService's
+	// ConfigHistory RPC.
+	AdminServiceConfigHistoryProcedure = "/xyz.block.ftl.v1.AdminService/ConfigHistory"
+	// AdminServiceConfigRestoreProcedure is the fully-qualified name of the AdminService's
+	// ConfigRestore RPC.
+	AdminServiceConfigRestoreProcedure = "/xyz.block.ftl.v1.AdminService/ConfigRestore"
+	// AdminServiceConfigCheckProcedure is the fully-qualified name of the AdminService's ConfigCheck
+	// RPC.
+	AdminServiceConfigCheckProcedure = "/xyz.block.ftl.v1.AdminService/ConfigCheck"
 	// AdminServiceSecretsListProcedure is the fully-qualified name of the AdminService's SecretsList
 	// RPC.
 	AdminServiceSecretsListProcedure = "/xyz.block.ftl.v1.AdminService/SecretsList"
@@ -54,12 +63,18 @@ const (
 	// AdminServiceSecretUnsetProcedure is the fully-qualified name of the AdminService's SecretUnset
 	// RPC.
 	AdminServiceSecretUnsetProcedure = "/xyz.block.ftl.v1.AdminService/SecretUnset"
+	// AdminServiceSecretHistoryProcedure is the fully-qualified name of the AdminService's
+	// SecretHistory RPC.
+	AdminServiceSecretHistoryProcedure = "/xyz.block.ftl.v1.AdminService/SecretHistory"
 	// AdminServiceMapConfigsForModuleProcedure is the fully-qualified name of the AdminService's
 	// MapConfigsForModule RPC.
 	AdminServiceMapConfigsForModuleProcedure = "/xyz.block.ftl.v1.AdminService/MapConfigsForModule"
 	// AdminServiceMapSecretsForModuleProcedure is the fully-qualified name of the AdminService's
 	// MapSecretsForModule RPC.
 	AdminServiceMapSecretsForModuleProcedure = "/xyz.block.ftl.v1.AdminService/MapSecretsForModule"
+	// AdminServiceGetCircuitBreakersProcedure is the fully-qualified name of the AdminService's
+	// GetCircuitBreakers RPC.
+	AdminServiceGetCircuitBreakersProcedure = "/xyz.block.ftl.v1.AdminService/GetCircuitBreakers"
 )
 
 // AdminServiceClient is a client for the xyz.block.ftl.v1.AdminService service.
@@ -73,6 +88,12 @@ type AdminServiceClient interface {
 	ConfigSet(context.Context, *connect.Request[v1.ConfigSetRequest]) (*connect.Response[v1.ConfigSetResponse], error)
 	// Unset a config value.
 	ConfigUnset(context.Context, *connect.Request[v1.ConfigUnsetRequest]) (*connect.Response[v1.ConfigUnsetResponse], error)
+	// Get the history of changes to a config value.
+	ConfigHistory(context.Context, *connect.Request[v1.ConfigHistoryRequest]) (*connect.Response[v1.ConfigHistoryResponse], error)
+	// Restore a config value to the value set by a previous change.
+	ConfigRestore(context.Context, *connect.Request[v1.ConfigRestoreRequest]) (*connect.Response[v1.ConfigRestoreResponse], error)
+	// Check that every config value and secret used by modules is set and valid.
+	ConfigCheck(context.Context, *connect.Request[v1.ConfigCheckRequest]) (*connect.Response[v1.ConfigCheckResponse], error)
 	// List secrets.
 	SecretsList(context.Context, *connect.Request[v1.SecretsListRequest]) (*connect.Response[v1.SecretsListResponse], error)
 	// Get a secret.
@@ -81,12 +102,16 @@ type AdminServiceClient interface {
 	SecretSet(context.Context, *connect.Request[v1.SecretSetRequest]) (*connect.Response[v1.SecretSetResponse], error)
 	// Unset a secret.
 	SecretUnset(context.Context, *connect.Request[v1.SecretUnsetRequest]) (*connect.Response[v1.SecretUnsetResponse], error)
+	// Get the history of changes to a secret.
+	SecretHistory(context.Context, *connect.Request[v1.SecretHistoryRequest]) (*connect.Response[v1.SecretHistoryResponse], error)
 	// MapForModule combines all configuration values visible to the module.
 	// Local values take precedence.
 	MapConfigsForModule(context.Context, *connect.Request[v1.MapConfigsForModuleRequest]) (*connect.Response[v1.MapConfigsForModuleResponse], error)
 	// MapSecretsForModule combines all secrets visible to the module.
 	// Local values take precedence.
 	MapSecretsForModule(context.Context, *connect.Request[v1.MapSecretsForModuleRequest]) (*connect.Response[v1.MapSecretsForModuleResponse], error)
+	// GetCircuitBreakers returns the state of the circuit breakers for verbs in active deployments.
+	GetCircuitBreakers(context.Context, *connect.Request[v1.GetCircuitBreakersRequest]) (*connect.Response[v1.GetCircuitBreakersResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the xyz.block.ftl.v1.AdminService service. By
@@ -125,6 +150,23 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+AdminServiceConfigUnsetProcedure,
 			opts...,
 		),
+		configHistory: connect.NewClient[v1.ConfigHistoryRequest, v1.ConfigHistoryResponse](
+			httpClient,
+			baseURL+AdminServiceConfigHistoryProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		configRestore: connect.NewClient[v1.ConfigRestoreRequest, v1.ConfigRestoreResponse](
+			httpClient,
+			baseURL+AdminServiceConfigRestoreProcedure,
+			opts...,
+		),
+		configCheck: connect.NewClient[v1.ConfigCheckRequest, v1.ConfigCheckResponse](
+			httpClient,
+			baseURL+AdminServiceConfigCheckProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		secretsList: connect.NewClient[v1.SecretsListRequest, v1.SecretsListResponse](
 			httpClient,
 			baseURL+AdminServiceSecretsListProcedure,
@@ -145,6 +187,12 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+AdminServiceSecretUnsetProcedure,
 			opts...,
 		),
+		secretHistory: connect.NewClient[v1.SecretHistoryRequest, v1.SecretHistoryResponse](
+			httpClient,
+			baseURL+AdminServiceSecretHistoryProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		mapConfigsForModule: connect.NewClient[v1.MapConfigsForModuleRequest, v1.MapConfigsForModuleResponse](
 			httpClient,
 			baseURL+AdminServiceMapConfigsForModuleProcedure,
@@ -155,6 +203,12 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+AdminServiceMapSecretsForModuleProcedure,
 			opts...,
 		),
+		getCircuitBreakers: connect.NewClient[v1.GetCircuitBreakersRequest, v1.GetCircuitBreakersResponse](
+			httpClient,
+			baseURL+AdminServiceGetCircuitBreakersProcedure,
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -165,12 +219,17 @@ type adminServiceClient struct {
 	configGet           *connect.Client[v1.ConfigGetRequest, v1.ConfigGetResponse]
 	configSet           *connect.Client[v1.ConfigSetRequest, v1.ConfigSetResponse]
 	configUnset         *connect.Client[v1.ConfigUnsetRequest, v1.ConfigUnsetResponse]
+	configHistory       *connect.Client[v1.ConfigHistoryRequest, v1.ConfigHistoryResponse]
+	configRestore       *connect.Client[v1.ConfigRestoreRequest, v1.ConfigRestoreResponse]
+	configCheck         *connect.Client[v1.ConfigCheckRequest, v1.ConfigCheckResponse]
 	secretsList         *connect.Client[v1.SecretsListRequest, v1.SecretsListResponse]
 	secretGet           *connect.Client[v1.SecretGetRequest, v1.SecretGetResponse]
 	secretSet           *connect.Client[v1.SecretSetRequest, v1.SecretSetResponse]
 	secretUnset         *connect.Client[v1.SecretUnsetRequest, v1.SecretUnsetResponse]
+	secretHistory       *connect.Client[v1.SecretHistoryRequest, v1.SecretHistoryResponse]
 	mapConfigsForModule *connect.Client[v1.MapConfigsForModuleRequest, v1.MapConfigsForModuleResponse]
 	mapSecretsForModule *connect.Client[v1.MapSecretsForModuleRequest, v1.MapSecretsForModuleResponse]
+	getCircuitBreakers  *connect.Client[v1.GetCircuitBreakersRequest, v1.GetCircuitBreakersResponse]
 }
 
 // Ping calls xyz.block.ftl.v1.AdminService.Ping.
@@ -198,6 +257,21 @@ func (c *adminServiceClient) ConfigUnset(ctx context.Context, req *connect.Reque
 	return c.configUnset.CallUnary(ctx, req)
 }
 
+// ConfigHistory calls xyz.block.ftl.v1.AdminService.ConfigHistory.
+func (c *adminServiceClient) ConfigHistory(ctx context.Context, req *connect.Request[v1.ConfigHistoryRequest]) (*connect.Response[v1.ConfigHistoryResponse], error) {
+	return c.configHistory.CallUnary(ctx, req)
+}
+
+// ConfigRestore calls xyz.block.ftl.v1.AdminService.ConfigRestore.
+func (c *adminServiceClient) ConfigRestore(ctx context.Context, req *connect.Request[v1.ConfigRestoreRequest]) (*connect.Response[v1.ConfigRestoreResponse], error) {
+	return c.configRestore.CallUnary(ctx, req)
+}
+
+// ConfigCheck calls xyz.block.ftl.v1.AdminService.ConfigCheck.
+func (c *adminServiceClient) ConfigCheck(ctx context.Context, req *connect.Request[v1.ConfigCheckRequest]) (*connect.Response[v1.ConfigCheckResponse], error) {
+	return c.configCheck.CallUnary(ctx, req)
+}
+
 // SecretsList calls xyz.block.ftl.v1.AdminService.SecretsList.
 func (c *adminServiceClient) SecretsList(ctx context.Context, req *connect.Request[v1.SecretsListRequest]) (*connect.Response[v1.SecretsListResponse], error) {
 	return c.secretsList.CallUnary(ctx, req)
@@ -218,6 +292,11 @@ func (c *adminServiceClient) SecretUnset(ctx context.Context, req *connect.Reque
 	return c.secretUnset.CallUnary(ctx, req)
 }
 
+// SecretHistory calls xyz.block.ftl.v1.AdminService.SecretHistory.
+func (c *adminServiceClient) SecretHistory(ctx context.Context, req *connect.Request[v1.SecretHistoryRequest]) (*connect.Response[v1.SecretHistoryResponse], error) {
+	return c.secretHistory.CallUnary(ctx, req)
+}
+
 // MapConfigsForModule calls xyz.block.ftl.v1.AdminService.MapConfigsForModule.
 func (c *adminServiceClient) MapConfigsForModule(ctx context.Context, req *connect.Request[v1.MapConfigsForModuleRequest]) (*connect.Response[v1.MapConfigsForModuleResponse], error) {
 	return c.mapConfigsForModule.CallUnary(ctx, req)
@@ -228,6 +307,11 @@ func (c *adminServiceClient) MapSecretsForModule(ctx context.Context, req *conne
 	return c.mapSecretsForModule.CallUnary(ctx, req)
 }
 
+// GetCircuitBreakers calls xyz.block.ftl.v1.AdminService.GetCircuitBreakers.
+func (c *adminServiceClient) GetCircuitBreakers(ctx context.Context, req *connect.Request[v1.GetCircuitBreakersRequest]) (*connect.Response[v1.GetCircuitBreakersResponse], error) {
+	return c.getCircuitBreakers.CallUnary(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the xyz.block.ftl.v1.AdminService service.
 type AdminServiceHandler interface {
 	Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error)
@@ -239,6 +323,12 @@ type AdminServiceHandler interface {
 	ConfigSet(context.Context, *connect.Request[v1.ConfigSetRequest]) (*connect.Response[v1.ConfigSetResponse], error)
 	// Unset a config value.
 	ConfigUnset(context.Context, *connect.Request[v1.ConfigUnsetRequest]) (*connect.Response[v1.ConfigUnsetResponse], error)
+	// Get the history of changes to a config value.
+	ConfigHistory(context.Context, *connect.Request[v1.ConfigHistoryRequest]) (*connect.Response[v1.ConfigHistoryResponse], error)
+	// Restore a config value to the value set by a previous change.
+	ConfigRestore(context.Context, *connect.Request[v1.ConfigRestoreRequest]) (*connect.Response[v1.ConfigRestoreResponse], error)
+	// Check that every config value and secret used by modules is set and valid.
+	ConfigCheck(context.Context, *connect.Request[v1.ConfigCheckRequest]) (*connect.Response[v1.ConfigCheckResponse], error)
 	// List secrets.
 	SecretsList(context.Context, *connect.Request[v1.SecretsListRequest]) (*connect.Response[v1.SecretsListResponse], error)
 	// Get a secret.
@@ -247,12 +337,16 @@ type AdminServiceHandler interface {
 	SecretSet(context.Context, *connect.Request[v1.SecretSetRequest]) (*connect.Response[v1.SecretSetResponse], error)
 	// Unset a secret.
 	SecretUnset(context.Context, *connect.Request[v1.SecretUnsetRequest]) (*connect.Response[v1.SecretUnsetResponse], error)
+	// Get the history of changes to a secret.
+	SecretHistory(context.Context, *connect.Request[v1.SecretHistoryRequest]) (*connect.Response[v1.SecretHistoryResponse], error)
 	// MapForModule combines all configuration values visible to the module.
 	// Local values take precedence.
 	MapConfigsForModule(context.Context, *connect.Request[v1.MapConfigsForModuleRequest]) (*connect.Response[v1.MapConfigsForModuleResponse], error)
 	// MapSecretsForModule combines all secrets visible to the module.
 	// Local values take precedence.
 	MapSecretsForModule(context.Context, *connect.Request[v1.MapSecretsForModuleRequest]) (*connect.Response[v1.MapSecretsForModuleResponse], error)
+	// GetCircuitBreakers returns the state of the circuit breakers for verbs in active deployments.
+	GetCircuitBreakers(context.Context, *connect.Request[v1.GetCircuitBreakersRequest]) (*connect.Response[v1.GetCircuitBreakersResponse], error)
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -287,6 +381,23 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		svc.ConfigUnset,
 		opts...,
 	)
+	adminServiceConfigHistoryHandler := connect.NewUnaryHandler(
+		AdminServiceConfigHistoryProcedure,
+		svc.ConfigHistory,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceConfigRestoreHandler := connect.NewUnaryHandler(
+		AdminServiceConfigRestoreProcedure,
+		svc.ConfigRestore,
+		opts...,
+	)
+	adminServiceConfigCheckHandler := connect.NewUnaryHandler(
+		AdminServiceConfigCheckProcedure,
+		svc.ConfigCheck,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceSecretsListHandler := connect.NewUnaryHandler(
 		AdminServiceSecretsListProcedure,
 		svc.SecretsList,
@@ -307,6 +418,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		svc.SecretUnset,
 		opts...,
 	)
+	adminServiceSecretHistoryHandler := connect.NewUnaryHandler(
+		AdminServiceSecretHistoryProcedure,
+		svc.SecretHistory,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	adminServiceMapConfigsForModuleHandler := connect.NewUnaryHandler(
 		AdminServiceMapConfigsForModuleProcedure,
 		svc.MapConfigsForModule,
@@ -317,6 +434,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		svc.MapSecretsForModule,
 		opts...,
 	)
+	adminServiceGetCircuitBreakersHandler := connect.NewUnaryHandler(
+		AdminServiceGetCircuitBreakersProcedure,
+		svc.GetCircuitBreakers,
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/xyz.block.ftl.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServicePingProcedure:
@@ -329,6 +452,12 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceConfigSetHandler.ServeHTTP(w, r)
 		case AdminServiceConfigUnsetProcedure:
 			adminServiceConfigUnsetHandler.ServeHTTP(w, r)
+		case AdminServiceConfigHistoryProcedure:
+			adminServiceConfigHistoryHandler.ServeHTTP(w, r)
+		case AdminServiceConfigRestoreProcedure:
+			adminServiceConfigRestoreHandler.ServeHTTP(w, r)
+		case AdminServiceConfigCheckProcedure:
+			adminServiceConfigCheckHandler.ServeHTTP(w, r)
 		case AdminServiceSecretsListProcedure:
 			adminServiceSecretsListHandler.ServeHTTP(w, r)
 		case AdminServiceSecretGetProcedure:
@@ -337,10 +466,14 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceSecretSetHandler.ServeHTTP(w, r)
 		case AdminServiceSecretUnsetProcedure:
 			adminServiceSecretUnsetHandler.ServeHTTP(w, r)
+		case AdminServiceSecretHistoryProcedure:
+			adminServiceSecretHistoryHandler.ServeHTTP(w, r)
 		case AdminServiceMapConfigsForModuleProcedure:
 			adminServiceMapConfigsForModuleHandler.ServeHTTP(w, r)
 		case AdminServiceMapSecretsForModuleProcedure:
 			adminServiceMapSecretsForModuleHandler.ServeHTTP(w, r)
+		case AdminServiceGetCircuitBreakersProcedure:
+			adminServiceGetCircuitBreakersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -370,6 +503,18 @@ func (UnimplementedAdminServiceHandler) ConfigUnset(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.ConfigUnset is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) ConfigHistory(context.Context, *connect.Request[v1.ConfigHistoryRequest]) (*connect.Response[v1.ConfigHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.ConfigHistory is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ConfigRestore(context.Context, *connect.Request[v1.ConfigRestoreRequest]) (*connect.Response[v1.ConfigRestoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.ConfigRestore is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ConfigCheck(context.Context, *connect.Request[v1.ConfigCheckRequest]) (*connect.Response[v1.ConfigCheckResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.ConfigCheck is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) SecretsList(context.Context, *connect.Request[v1.SecretsListRequest]) (*connect.Response[v1.SecretsListResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.SecretsList is not implemented"))
 }
@@ -386,6 +531,10 @@ func (UnimplementedAdminServiceHandler) SecretUnset(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.SecretUnset is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) SecretHistory(context.Context, *connect.Request[v1.SecretHistoryRequest]) (*connect.Response[v1.SecretHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.SecretHistory is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) MapConfigsForModule(context.Context, *connect.Request[v1.MapConfigsForModuleRequest]) (*connect.Response[v1.MapConfigsForModuleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.MapConfigsForModule is not implemented"))
 }
@@ -393,3 +542,7 @@ func (UnimplementedAdminServiceHandler) MapConfigsForModule(context.Context, *co
 func (UnimplementedAdminServiceHandler) MapSecretsForModule(context.Context, *connect.Request[v1.MapSecretsForModuleRequest]) (*connect.Response[v1.MapSecretsForModuleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.MapSecretsForModule is not implemented"))
 }
+
+func (UnimplementedAdminServiceHandler) GetCircuitBreakers(context.Context, *connect.Request[v1.GetCircuitBreakersRequest]) (*connect.Response[v1.GetCircuitBreakersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.AdminService.GetCircuitBreakers is not implemented"))
+}
@@ -65,6 +65,18 @@ const (
 	// ControllerServiceReplaceDeployProcedure is the fully-qualified name of the ControllerService's
 	// ReplaceDeploy RPC.
 	ControllerServiceReplaceDeployProcedure = "/xyz.block.ftl.v1.ControllerService/ReplaceDeploy"
+	// ControllerServiceCreateChangesetProcedure is the fully-qualified name of the ControllerService's
+	// CreateChangeset RPC.
+	ControllerServiceCreateChangesetProcedure = "/xyz.block.ftl.v1.ControllerService/CreateChangeset"
+	// ControllerServiceCommitChangesetProcedure is the fully-qualified name of the ControllerService's
+	// CommitChangeset RPC.
+	ControllerServiceCommitChangesetProcedure = "/xyz.block.ftl.v1.ControllerService/CommitChangeset"
+	// ControllerServiceRollbackChangesetProcedure is the fully-qualified name of the
+	// ControllerService's RollbackChangeset RPC.
+	ControllerServiceRollbackChangesetProcedure = "/xyz.block.ftl.v1.ControllerService/RollbackChangeset"
+	// ControllerServiceDeployChangesetProcedure is the fully-qualified name of the ControllerService's
+	// DeployChangeset RPC.
+	ControllerServiceDeployChangesetProcedure = "/xyz.block.ftl.v1.ControllerService/DeployChangeset"
 	// ControllerServiceStreamDeploymentLogsProcedure is the fully-qualified name of the
 	// ControllerService's StreamDeploymentLogs RPC.
 	ControllerServiceStreamDeploymentLogsProcedure = "/xyz.block.ftl.v1.ControllerService/StreamDeploymentLogs"
@@ -102,6 +114,20 @@ type ControllerServiceClient interface {
 	// If a deployment already exists for the module of the new deployment,
 	// it will be scaled down and replaced by the new one.
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	// Create a changeset for deploying several modules atomically.
+	//
+	// The schemas of the modules are validated together against the schemas of
+	// the other active modules.
+	CreateChangeset(context.Context, *connect.Request[v1.CreateChangesetRequest]) (*connect.Response[v1.CreateChangesetResponse], error)
+	// Activate all deployments in a changeset, replacing any existing
+	// deployments of the same modules.
+	CommitChangeset(context.Context, *connect.Request[v1.CommitChangesetRequest]) (*connect.Response[v1.CommitChangesetResponse], error)
+	// Discard all deployments in a changeset.
+	RollbackChangeset(context.Context, *connect.Request[v1.RollbackChangesetRequest]) (*connect.Response[v1.RollbackChangesetResponse], error)
+	// Create and activate deployments of several modules atomically.
+	//
+	// If any deployment fails, none of them are activated.
+	DeployChangeset(context.Context, *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error)
 	// Stream logs from a deployment
 	StreamDeploymentLogs(context.Context) *connect.ClientStreamForClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
 }
@@ -172,6 +198,26 @@ func NewControllerServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			baseURL+ControllerServiceReplaceDeployProcedure,
 			opts...,
 		),
+		createChangeset: connect.NewClient[v1.CreateChangesetRequest, v1.CreateChangesetResponse](
+			httpClient,
+			baseURL+ControllerServiceCreateChangesetProcedure,
+			opts...,
+		),
+		commitChangeset: connect.NewClient[v1.CommitChangesetRequest, v1.CommitChangesetResponse](
+			httpClient,
+			baseURL+ControllerServiceCommitChangesetProcedure,
+			opts...,
+		),
+		rollbackChangeset: connect.NewClient[v1.RollbackChangesetRequest, v1.RollbackChangesetResponse](
+			httpClient,
+			baseURL+ControllerServiceRollbackChangesetProcedure,
+			opts...,
+		),
+		deployChangeset: connect.NewClient[v1.DeployChangesetRequest, v1.DeployChangesetResponse](
+			httpClient,
+			baseURL+ControllerServiceDeployChangesetProcedure,
+			opts...,
+		),
 		streamDeploymentLogs: connect.NewClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse](
 			httpClient,
 			baseURL+ControllerServiceStreamDeploymentLogsProcedure,
@@ -193,6 +239,10 @@ type controllerServiceClient struct {
 	registerRunner         *connect.Client[v1.RegisterRunnerRequest, v1.RegisterRunnerResponse]
 	updateDeploy           *connect.Client[v1.UpdateDeployRequest, v1.UpdateDeployResponse]
 	replaceDeploy          *connect.Client[v1.ReplaceDeployRequest, v1.ReplaceDeployResponse]
+	createChangeset        *connect.Client[v1.CreateChangesetRequest, v1.CreateChangesetResponse]
+	commitChangeset        *connect.Client[v1.CommitChangesetRequest, v1.CommitChangesetResponse]
+	rollbackChangeset      *connect.Client[v1.RollbackChangesetRequest, v1.RollbackChangesetResponse]
+	deployChangeset        *connect.Client[v1.DeployChangesetRequest, v1.DeployChangesetResponse]
 	streamDeploymentLogs   *connect.Client[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse]
 }
 
@@ -251,6 +301,26 @@ func (c *controllerServiceClient) ReplaceDeploy(ctx context.Context, req *connec
 	return c.replaceDeploy.CallUnary(ctx, req)
 }
 
+// CreateChangeset calls xyz.block.ftl.v1.ControllerService.CreateChangeset.
+func (c *controllerServiceClient) CreateChangeset(ctx context.Context, req *connect.Request[v1.CreateChangesetRequest]) (*connect.Response[v1.CreateChangesetResponse], error) {
+	return c.createChangeset.CallUnary(ctx, req)
+}
+
+// CommitChangeset calls xyz.block.ftl.v1.ControllerService.CommitChangeset.
+func (c *controllerServiceClient) CommitChangeset(ctx context.Context, req *connect.Request[v1.CommitChangesetRequest]) (*connect.Response[v1.CommitChangesetResponse], error) {
+	return c.commitChangeset.CallUnary(ctx, req)
+}
+
+// RollbackChangeset calls xyz.block.ftl.v1.ControllerService.RollbackChangeset.
+func (c *controllerServiceClient) RollbackChangeset(ctx context.Context, req *connect.Request[v1.RollbackChangesetRequest]) (*connect.Response[v1.RollbackChangesetResponse], error) {
+	return c.rollbackChangeset.CallUnary(ctx, req)
+}
+
+// DeployChangeset calls xyz.block.ftl.v1.ControllerService.DeployChangeset.
+func (c *controllerServiceClient) DeployChangeset(ctx context.Context, req *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error) {
+	return c.deployChangeset.CallUnary(ctx, req)
+}
+
 // StreamDeploymentLogs calls xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs.
 func (c *controllerServiceClient) StreamDeploymentLogs(ctx context.Context) *connect.ClientStreamForClient[v1.StreamDeploymentLogsRequest, v1.StreamDeploymentLogsResponse] {
 	return c.streamDeploymentLogs.CallClientStream(ctx)
@@ -288,6 +358,20 @@ type ControllerServiceHandler interface {
 	// If a deployment already exists for the module of the new deployment,
 	// it will be scaled down and replaced by the new one.
 	ReplaceDeploy(context.Context, *connect.Request[v1.ReplaceDeployRequest]) (*connect.Response[v1.ReplaceDeployResponse], error)
+	// Create a changeset for deploying several modules atomically.
+	//
+	// The schemas of the modules are validated together against the schemas of
+	// the other active modules.
+	CreateChangeset(context.Context, *connect.Request[v1.CreateChangesetRequest]) (*connect.Response[v1.CreateChangesetResponse], error)
+	// Activate all deployments in a changeset, replacing any existing
+	// deployments of the same modules.
+	CommitChangeset(context.Context, *connect.Request[v1.CommitChangesetRequest]) (*connect.Response[v1.CommitChangesetResponse], error)
+	// Discard all deployments in a changeset.
+	RollbackChangeset(context.Context, *connect.Request[v1.RollbackChangesetRequest]) (*connect.Response[v1.RollbackChangesetResponse], error)
+	// Create and activate deployments of several modules atomically.
+	//
+	// If any deployment fails, none of them are activated.
+	DeployChangeset(context.Context, *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error)
 	// Stream logs from a deployment
 	StreamDeploymentLogs(context.Context, *connect.ClientStream[v1.StreamDeploymentLogsRequest]) (*connect.Response[v1.StreamDeploymentLogsResponse], error)
 }
@@ -354,6 +438,26 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 		svc.ReplaceDeploy,
 		opts...,
 	)
+	controllerServiceCreateChangesetHandler := connect.NewUnaryHandler(
+		ControllerServiceCreateChangesetProcedure,
+		svc.CreateChangeset,
+		opts...,
+	)
+	controllerServiceCommitChangesetHandler := connect.NewUnaryHandler(
+		ControllerServiceCommitChangesetProcedure,
+		svc.CommitChangeset,
+		opts...,
+	)
+	controllerServiceRollbackChangesetHandler := connect.NewUnaryHandler(
+		ControllerServiceRollbackChangesetProcedure,
+		svc.RollbackChangeset,
+		opts...,
+	)
+	controllerServiceDeployChangesetHandler := connect.NewUnaryHandler(
+		ControllerServiceDeployChangesetProcedure,
+		svc.DeployChangeset,
+		opts...,
+	)
 	controllerServiceStreamDeploymentLogsHandler := connect.NewClientStreamHandler(
 		ControllerServiceStreamDeploymentLogsProcedure,
 		svc.StreamDeploymentLogs,
@@ -383,6 +487,14 @@ func NewControllerServiceHandler(svc ControllerServiceHandler, opts ...connect.H
 			controllerServiceUpdateDeployHandler.ServeHTTP(w, r)
 		case ControllerServiceReplaceDeployProcedure:
 			controllerServiceReplaceDeployHandler.ServeHTTP(w, r)
+		case ControllerServiceCreateChangesetProcedure:
+			controllerServiceCreateChangesetHandler.ServeHTTP(w, r)
+		case ControllerServiceCommitChangesetProcedure:
+			controllerServiceCommitChangesetHandler.ServeHTTP(w, r)
+		case ControllerServiceRollbackChangesetProcedure:
+			controllerServiceRollbackChangesetHandler.ServeHTTP(w, r)
+		case ControllerServiceDeployChangesetProcedure:
+			controllerServiceDeployChangesetHandler.ServeHTTP(w, r)
 		case ControllerServiceStreamDeploymentLogsProcedure:
 			controllerServiceStreamDeploymentLogsHandler.ServeHTTP(w, r)
 		default:
@@ -438,6 +550,22 @@ func (UnimplementedControllerServiceHandler) ReplaceDeploy(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.ReplaceDeploy is not implemented"))
 }
 
+func (UnimplementedControllerServiceHandler) CreateChangeset(context.Context, *connect.Request[v1.CreateChangesetRequest]) (*connect.Response[v1.CreateChangesetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.CreateChangeset is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) CommitChangeset(context.Context, *connect.Request[v1.CommitChangesetRequest]) (*connect.Response[v1.CommitChangesetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.CommitChangeset is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) RollbackChangeset(context.Context, *connect.Request[v1.RollbackChangesetRequest]) (*connect.Response[v1.RollbackChangesetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.RollbackChangeset is not implemented"))
+}
+
+func (UnimplementedControllerServiceHandler) DeployChangeset(context.Context, *connect.Request[v1.DeployChangesetRequest]) (*connect.Response[v1.DeployChangesetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.DeployChangeset is not implemented"))
+}
+
 func (UnimplementedControllerServiceHandler) StreamDeploymentLogs(context.Context, *connect.ClientStream[v1.StreamDeploymentLogsRequest]) (*connect.Response[v1.StreamDeploymentLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("xyz.block.ftl.v1.ControllerService.StreamDeploymentLogs is not implemented"))
 }
@@ -0,0 +1,66 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/alecthomas/types/optional"
+)
+
+type changesetContextKey struct{}
+
+// changeset tracks actions that provisioners defer until a changeset is committed or rolled back.
+type changeset struct {
+	key string
+
+	lock       sync.Mutex
+	onCommit   []func(ctx context.Context) error
+	onRollback []func(ctx context.Context) error
+}
+
+func contextWithChangeset(ctx context.Context, cs *changeset) context.Context {
+	return context.WithValue(ctx, changesetContextKey{}, cs)
+}
+
+// changesetFromContext returns the changeset being provisioned, if any.
+func changesetFromContext(ctx context.Context) optional.Option[*changeset] {
+	cs, ok := ctx.Value(changesetContextKey{}).(*changeset)
+	return optional.From(cs, ok)
+}
+
+// OnCommit registers an action to run once the changeset has been committed.
+func (c *changeset) OnCommit(fn func(ctx context.Context) error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.onCommit = append(c.onCommit, fn)
+}
+
+// OnRollback registers an action to undo provisioning if the changeset is rolled back.
+func (c *changeset) OnRollback(fn func(ctx context.Context) error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.onRollback = append(c.onRollback, fn)
+}
+
+func (c *changeset) commit(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return runAll(ctx, c.onCommit)
+}
+
+func (c *changeset) rollback(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return runAll(ctx, c.onRollback)
+}
+
+func runAll(ctx context.Context, fns []func(ctx context.Context) error) error {
+	var errs []error
+	for _, fn := range fns {
+		if err := fn(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -36,10 +36,14 @@ func NewControllerProvisioner(client ftlv1connect.ControllerServiceClient) *InMe
 				}
 			}
 
-			resp, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{
+			req := &ftlv1.CreateDeploymentRequest{
 				Schema:    module.ToProto(),
 				Artefacts: artefacts,
-			}))
+			}
+			if cs, ok := changesetFromContext(ctx).Get(); ok {
+				req.Changeset = &cs.key
+			}
+			resp, err := client.CreateDeployment(ctx, connect.NewRequest(req))
 			if err != nil {
 				return nil, fmt.Errorf("failed to create deployment: %w", err)
 			}
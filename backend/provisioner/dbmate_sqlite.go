@@ -0,0 +1,162 @@
+package provisioner
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/amacneil/dbmate/v2/pkg/dbmate"
+	"github.com/amacneil/dbmate/v2/pkg/dbutil"
+	_ "modernc.org/sqlite" // SQL driver
+)
+
+// The upstream dbmate SQLite driver requires cgo, which we build without, so
+// we register a minimal equivalent backed by the pure Go modernc.org/sqlite.
+func init() {
+	dbmate.RegisterDriver(newSQLiteDBMateDriver, "sqlite")
+}
+
+type sqliteDBMateDriver struct {
+	migrationsTableName string
+	path                string
+	log                 io.Writer
+}
+
+var _ dbmate.Driver = (*sqliteDBMateDriver)(nil)
+
+func newSQLiteDBMateDriver(config dbmate.DriverConfig) dbmate.Driver {
+	return &sqliteDBMateDriver{
+		migrationsTableName: config.MigrationsTableName,
+		path:                sqlitePathFromURL(config.DatabaseURL),
+		log:                 config.Log,
+	}
+}
+
+// sqlitePathFromURL extracts the file path from a "sqlite:/path/to/db" or "sqlite:relative/path" URL.
+func sqlitePathFromURL(u *url.URL) string {
+	if u.Opaque != "" {
+		return u.Opaque
+	}
+	return "/" + strings.TrimLeft(u.Host+u.Path, "/")
+}
+
+func (d *sqliteDBMateDriver) Open() (*sql.DB, error) {
+	db, err := sql.Open("sqlite", "file:"+d.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database %q: %w", d.path, err)
+	}
+	return db, nil
+}
+
+func (d *sqliteDBMateDriver) DatabaseExists() (bool, error) {
+	_, err := os.Stat(d.path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to stat sqlite database %q: %w", d.path, err)
+	}
+	return true, nil
+}
+
+func (d *sqliteDBMateDriver) CreateDatabase() error {
+	fmt.Fprintf(d.log, "Creating: %s\n", d.path)
+	return d.Ping()
+}
+
+func (d *sqliteDBMateDriver) DropDatabase() error {
+	fmt.Fprintf(d.log, "Dropping: %s\n", d.path)
+	if err := os.Remove(d.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove sqlite database %q: %w", d.path, err)
+	}
+	return nil
+}
+
+func (d *sqliteDBMateDriver) DumpSchema(*sql.DB) ([]byte, error) {
+	return nil, fmt.Errorf("schema dumps are not supported for sqlite")
+}
+
+func (d *sqliteDBMateDriver) MigrationsTableExists(db *sql.DB) (bool, error) {
+	exists := false
+	err := db.QueryRow("SELECT 1 FROM sqlite_master WHERE type='table' AND name=?", d.migrationsTableName).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to query migrations table: %w", err)
+	}
+	return exists, nil
+}
+
+func (d *sqliteDBMateDriver) CreateMigrationsTable(db *sql.DB) error {
+	_, err := db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version varchar(128) PRIMARY KEY)", d.quotedMigrationsTableName()))
+	if err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+	return nil
+}
+
+func (d *sqliteDBMateDriver) SelectMigrations(db *sql.DB, limit int) (map[string]bool, error) {
+	query := fmt.Sprintf("SELECT version FROM %s ORDER BY version DESC", d.quotedMigrationsTableName())
+	if limit >= 0 {
+		query = fmt.Sprintf("%s LIMIT %d", query, limit)
+	}
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select migrations: %w", err)
+	}
+	defer dbutil.MustClose(rows)
+
+	migrations := map[string]bool{}
+	for rows.Next() {
+		var version string
+		if err := rows.Scan(&version); err != nil {
+			return nil, fmt.Errorf("failed to scan migration: %w", err)
+		}
+		migrations[version] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to select migrations: %w", err)
+	}
+	return migrations, nil
+}
+
+func (d *sqliteDBMateDriver) InsertMigration(db dbutil.Transaction, version string) error {
+	_, err := db.Exec(fmt.Sprintf("INSERT INTO %s (version) VALUES (?)", d.quotedMigrationsTableName()), version)
+	if err != nil {
+		return fmt.Errorf("failed to insert migration: %w", err)
+	}
+	return nil
+}
+
+func (d *sqliteDBMateDriver) DeleteMigration(db dbutil.Transaction, version string) error {
+	_, err := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE version = ?", d.quotedMigrationsTableName()), version)
+	if err != nil {
+		return fmt.Errorf("failed to delete migration: %w", err)
+	}
+	return nil
+}
+
+// Ping creates the database file if it does not already exist.
+func (d *sqliteDBMateDriver) Ping() error {
+	db, err := d.Open()
+	if err != nil {
+		return err
+	}
+	defer dbutil.MustClose(db)
+	if err := db.Ping(); err != nil {
+		return fmt.Errorf("failed to ping sqlite database %q: %w", d.path, err)
+	}
+	return nil
+}
+
+func (d *sqliteDBMateDriver) quotedMigrationsTableName() string {
+	return `"` + strings.ReplaceAll(d.migrationsTableName, `"`, `""`) + `"`
+}
+
+func (d *sqliteDBMateDriver) QueryError(query string, err error) error {
+	return &dbmate.QueryError{Err: err, Query: query}
+}
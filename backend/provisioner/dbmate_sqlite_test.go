@@ -0,0 +1,70 @@
+package provisioner
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/dsn"
+	"github.com/block/ftl/internal/log"
+)
+
+func TestSQLiteMigration(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	dir := t.TempDir()
+
+	event, err := provisionSQLite(filepath.Join(dir, "dbs"), false)(ctx, "echo", &schema.Database{Name: "cache", Type: schema.SQLiteDatabaseType})
+	assert.NoError(t, err)
+	connector := event.Database.Payload.(*schema.DatabaseRuntimeConnectionsEvent).Connections.Write //nolint:forcetypeassert
+	d, err := dsn.ResolveSQLiteDSN(ctx, connector)
+	assert.NoError(t, err)
+	assert.Equal(t, dsn.SQLiteDSN(filepath.Join(dir, "dbs", "echo_cache.sqlite")), d)
+
+	migrationDir := filepath.Join(dir, "db", "cache")
+	assert.NoError(t, os.MkdirAll(migrationDir, 0700))
+	err = os.WriteFile(filepath.Join(migrationDir, "20240101000000_init.sql"), []byte(`-- migrate:up
+CREATE TABLE entries (key TEXT PRIMARY KEY, value TEXT NOT NULL);
+-- migrate:down
+DROP TABLE entries;
+`), 0600)
+	assert.NoError(t, err)
+
+	// Running twice should be a no-op the second time.
+	assert.NoError(t, RunSQLiteMigration(ctx, d, dir, "cache"))
+	assert.NoError(t, RunSQLiteMigration(ctx, d, dir, "cache"))
+
+	db, err := sql.Open("sqlite", d)
+	assert.NoError(t, err)
+	defer db.Close()
+	_, err = db.ExecContext(ctx, "INSERT INTO entries (key, value) VALUES ('a', 'b')")
+	assert.NoError(t, err)
+	var count int
+	err = db.QueryRowContext(ctx, "SELECT COUNT(*) FROM schema_migrations").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestProvisionSQLiteForTest(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	d, err := ProvisionSQLiteForTest(ctx, "echo", "cache")
+	assert.NoError(t, err)
+	path := filepath.Join(os.TempDir(), "ftl-sqlite-test", "echo_cache_test.sqlite")
+	assert.Equal(t, dsn.SQLiteDSN(path), d)
+	db, err := sql.Open("sqlite", d)
+	assert.NoError(t, err)
+	_, err = db.ExecContext(ctx, "CREATE TABLE entries (id INTEGER)")
+	assert.NoError(t, err)
+	assert.NoError(t, db.Close())
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+
+	assert.NoError(t, DeprovisionSQLiteForTest(ctx, "echo", "cache"))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "database should be deleted")
+}
@@ -104,6 +104,12 @@ func (t *Task) Progress(ctx context.Context) error {
 				case *provisioner.ProvisioningEvent_VerbRuntimeEvent:
 					verbEvent := schema.VerbRuntimeEventFromProto(event.GetVerbRuntimeEvent())
 					verbEvent.ApplyTo(module)
+
+				case *provisioner.ProvisioningEvent_KvRuntimeEvent:
+					schema.KVRuntimeEventFromProto(event.GetKvRuntimeEvent()).ApplyTo(module)
+
+				case *provisioner.ProvisioningEvent_ObjectStoreRuntimeEvent:
+					schema.ObjectStoreRuntimeEventFromProto(event.GetObjectStoreRuntimeEvent()).ApplyTo(module)
 				}
 			}
 			return nil
@@ -2,7 +2,12 @@ package provisioner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -21,19 +26,25 @@ import (
 var redPandaBrokers = []string{"127.0.0.1:19092"}
 
 // NewDevProvisioner creates a new provisioner that provisions resources locally when running FTL in dev mode
-func NewDevProvisioner(postgresPort int, mysqlPort int, recreate bool) *InMemProvisioner {
+//
+// "prefix" is prepended to the names of databases, topics and consumer groups, which live in services shared by all
+// local clusters. SQLite databases, KV stores and object stores are isolated by their directory and port instead.
+func NewDevProvisioner(postgresPort int, mysqlPort int, sqliteDir string, kvDir string, objectStoreDir string, objectStorePort int, prefix string, recreate bool) *InMemProvisioner {
 	return NewEmbeddedProvisioner(map[schema.ResourceType]InMemResourceProvisionerFn{
-		schema.ResourceTypePostgres:     provisionPostgres(postgresPort, recreate),
-		schema.ResourceTypeMysql:        provisionMysql(mysqlPort, recreate),
-		schema.ResourceTypeTopic:        provisionTopic(),
-		schema.ResourceTypeSubscription: provisionSubscription(),
+		schema.ResourceTypePostgres:     provisionPostgres(postgresPort, prefix, recreate),
+		schema.ResourceTypeMysql:        provisionMysql(mysqlPort, prefix, recreate),
+		schema.ResourceTypeSQLite:       provisionSQLite(sqliteDir, recreate),
+		schema.ResourceTypeTopic:        provisionTopic(prefix),
+		schema.ResourceTypeKV:           provisionKV(kvDir, recreate),
+		schema.ResourceTypeObjectStore:  provisionObjectStore(objectStoreDir, objectStorePort, recreate),
+		schema.ResourceTypeSubscription: provisionSubscription(prefix),
 	})
 }
-func provisionMysql(mysqlPort int, recreate bool) InMemResourceProvisionerFn {
+func provisionMysql(mysqlPort int, prefix string, recreate bool) InMemResourceProvisionerFn {
 	return func(ctx context.Context, moduleName string, res schema.Provisioned) (*RuntimeEvent, error) {
 		logger := log.FromContext(ctx)
 
-		dbName := strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(res.ResourceID())
+		dbName := prefix + strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(res.ResourceID())
 
 		logger.Infof("Provisioning mysql database: %s", dbName)
 
@@ -103,7 +114,7 @@ func establishMySQLDB(ctx context.Context, mysqlDSN string, dbName string, mysql
 
 func ProvisionPostgresForTest(ctx context.Context, moduleName string, id string) (string, error) {
 	node := &schema.Database{Name: id + "_test"}
-	event, err := provisionPostgres(15432, true)(ctx, moduleName, node)
+	event, err := provisionPostgres(15432, "", true)(ctx, moduleName, node)
 	if err != nil {
 		return "", err
 	}
@@ -113,7 +124,7 @@ func ProvisionPostgresForTest(ctx context.Context, moduleName string, id string)
 
 func ProvisionMySQLForTest(ctx context.Context, moduleName string, id string) (string, error) {
 	node := &schema.Database{Name: id + "_test"}
-	event, err := provisionMysql(13306, true)(ctx, moduleName, node)
+	event, err := provisionMysql(13306, "", true)(ctx, moduleName, node)
 	if err != nil {
 		return "", err
 	}
@@ -121,11 +132,276 @@ func ProvisionMySQLForTest(ctx context.Context, moduleName string, id string) (s
 
 }
 
-func provisionPostgres(postgresPort int, recreate bool) InMemResourceProvisionerFn {
+// sqliteTestDir is where test SQLite databases are created. Like the other test databases they are recreated each
+// time they are provisioned, so the directory does not grow with the number of test runs.
+func sqliteTestDir() string {
+	return filepath.Join(os.TempDir(), "ftl-sqlite-test")
+}
+
+func ProvisionSQLiteForTest(ctx context.Context, moduleName string, id string) (string, error) {
+	node := &schema.Database{Name: id + "_test"}
+	event, err := provisionSQLite(sqliteTestDir(), true)(ctx, moduleName, node)
+	if err != nil {
+		return "", err
+	}
+	return event.Database.Payload.(*schema.DatabaseRuntimeConnectionsEvent).Connections.Write.(*schema.DSNDatabaseConnector).DSN, nil //nolint:forcetypeassert
+}
+
+// DeprovisionSQLiteForTest deletes a database created by ProvisionSQLiteForTest.
+func DeprovisionSQLiteForTest(ctx context.Context, moduleName string, id string) error {
+	path := filepath.Join(sqliteTestDir(), strcase.ToLowerSnake(moduleName)+"_"+strcase.ToLowerSnake(id+"_test")+".sqlite")
+	var errs []error
+	for _, file := range []string{path, path + "-wal", path + "-shm"} {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, fmt.Errorf("failed to delete sqlite database %q: %w", file, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeprovisionPostgresForTest drops a database created by ProvisionPostgresForTest.
+func DeprovisionPostgresForTest(ctx context.Context, moduleName string, id string) error {
+	dbName := strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(id+"_test")
+	conn, err := otelsql.Open("pgx", dsn.PostgresDSN("ftl", dsn.Port(15432)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, `
+		SELECT pid, pg_terminate_backend(pid)
+		FROM pg_stat_activity
+		WHERE datname = $1 AND pid <> pg_backend_pid()`,
+		dbName)
+	if err != nil {
+		return fmt.Errorf("failed to kill existing backends: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "DROP DATABASE IF EXISTS "+dbName); err != nil {
+		return fmt.Errorf("failed to drop database %q: %w", dbName, err)
+	}
+	return nil
+}
+
+// DeprovisionMySQLForTest drops a database created by ProvisionMySQLForTest.
+func DeprovisionMySQLForTest(ctx context.Context, moduleName string, id string) error {
+	dbName := strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(id+"_test")
+	conn, err := otelsql.Open("mysql", dsn.MySQLDSN("ftl", dsn.Port(13306)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to mysql: %w", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "DROP DATABASE IF EXISTS "+dbName); err != nil {
+		return fmt.Errorf("failed to drop database %q: %w", dbName, err)
+	}
+	return nil
+}
+
+// DeprovisionDevResources drops the databases and deletes the topics created by a dev provisioner with the given
+// prefix.
+//
+// Services that are not running are skipped, as they cannot hold any resources.
+func DeprovisionDevResources(ctx context.Context, prefix string, postgresPort int, mysqlPort int) error {
+	if prefix == "" {
+		return errors.New("refusing to delete dev resources without a prefix")
+	}
+	logger := log.FromContext(ctx)
+	var errs []error
+	if isListening(fmt.Sprintf("127.0.0.1:%d", postgresPort)) {
+		if err := dropPrefixedDatabases(ctx, "pgx", dsn.PostgresDSN("ftl", dsn.Port(postgresPort)), prefix,
+			"SELECT datname FROM pg_catalog.pg_database",
+			"SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()"); err != nil {
+			errs = append(errs, fmt.Errorf("postgres: %w", err))
+		}
+	}
+	if isListening(fmt.Sprintf("127.0.0.1:%d", mysqlPort)) {
+		if err := dropPrefixedDatabases(ctx, "mysql", dsn.MySQLDSN("ftl", dsn.Port(mysqlPort)), prefix,
+			"SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA", ""); err != nil {
+			errs = append(errs, fmt.Errorf("mysql: %w", err))
+		}
+	}
+	if isListening(redPandaBrokers[0]) {
+		admin, err := sarama.NewClusterAdmin(redPandaBrokers, sarama.NewConfig())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to create cluster admin: %w", err))
+		} else {
+			defer admin.Close()
+			topics, err := admin.ListTopics()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to list topics: %w", err))
+			}
+			for topic := range topics {
+				if !strings.HasPrefix(topic, prefix) {
+					continue
+				}
+				logger.Infof("Deleting topic: %s", topic)
+				if err := admin.DeleteTopic(topic); err != nil {
+					errs = append(errs, fmt.Errorf("failed to delete topic %q: %w", topic, err))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// dropPrefixedDatabases drops every database returned by the list query whose name starts with prefix, first running
+// terminate, if given, to close any connections to it.
+func dropPrefixedDatabases(ctx context.Context, driver, adminDSN, prefix, list, terminate string) error {
+	conn, err := otelsql.Open(driver, adminDSN)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close()
+	rows, err := conn.QueryContext(ctx, list)
+	if err != nil {
+		return fmt.Errorf("failed to list databases: %w", err)
+	}
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to list databases: %w", err)
+		}
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to list databases: %w", err)
+	}
+	logger := log.FromContext(ctx)
+	for _, name := range names {
+		logger.Infof("Dropping database: %s", name)
+		if terminate != "" {
+			if _, err := conn.ExecContext(ctx, terminate, name); err != nil {
+				return fmt.Errorf("failed to kill existing backends: %w", err)
+			}
+		}
+		if _, err := conn.ExecContext(ctx, "DROP DATABASE IF EXISTS "+name); err != nil {
+			return fmt.Errorf("failed to drop database %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func isListening(address string) bool {
+	conn, err := net.DialTimeout("tcp", address, time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+// provisionSQLite provisions a SQLite database as a file under dir. SQLite
+// creates the file on first connection, so we only need to ensure the
+// directory exists and remove any previous file if recreating.
+func provisionSQLite(dir string, recreate bool) InMemResourceProvisionerFn {
 	return func(ctx context.Context, moduleName string, resource schema.Provisioned) (*RuntimeEvent, error) {
 		logger := log.FromContext(ctx)
 
 		dbName := strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(resource.ResourceID())
+		logger.Infof("Provisioning sqlite database: %s", dbName)
+
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create sqlite directory %q: %w", dir, err)
+		}
+		path, err := filepath.Abs(filepath.Join(dir, dbName+".sqlite"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve sqlite path: %w", err)
+		}
+		if recreate {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to remove sqlite database %q: %w", path, err)
+			}
+		}
+
+		dsn := dsn.SQLiteDSN(path)
+		return &RuntimeEvent{
+			Database: &schema.DatabaseRuntimeEvent{
+				ID: resource.ResourceID(),
+				Payload: &schema.DatabaseRuntimeConnectionsEvent{
+					Connections: &schema.DatabaseRuntimeConnections{
+						Write: &schema.DSNDatabaseConnector{DSN: dsn},
+						Read:  &schema.DSNDatabaseConnector{DSN: dsn},
+					},
+				},
+			},
+		}, nil
+	}
+}
+
+// provisionKV provisions a file-backed key-value store under dir. The runner
+// creates the file when it first opens the store.
+func provisionKV(dir string, recreate bool) InMemResourceProvisionerFn {
+	return func(ctx context.Context, moduleName string, resource schema.Provisioned) (*RuntimeEvent, error) {
+		logger := log.FromContext(ctx)
+
+		name := strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(resource.ResourceID())
+		logger.Infof("Provisioning kv store: %s", name)
+
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create kv directory %q: %w", dir, err)
+		}
+		path, err := filepath.Abs(filepath.Join(dir, name+".kv"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve kv path: %w", err)
+		}
+		if recreate {
+			for _, suffix := range []string{"", "-wal", "-shm"} {
+				if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+					return nil, fmt.Errorf("failed to remove kv store %q: %w", path, err)
+				}
+			}
+		}
+
+		return &RuntimeEvent{
+			KV: &schema.KVRuntimeEvent{
+				ID:      resource.ResourceID(),
+				Payload: &schema.KVRuntime{Path: path},
+			},
+		}, nil
+	}
+}
+
+func provisionObjectStore(dir string, port int, recreate bool) InMemResourceProvisionerFn {
+	return func(ctx context.Context, moduleName string, resource schema.Provisioned) (*RuntimeEvent, error) {
+		logger := log.FromContext(ctx)
+
+		bucket := strcase.ToLowerKebab(moduleName) + "-" + strcase.ToLowerKebab(resource.ResourceID())
+		logger.Infof("Provisioning object store: %s", bucket)
+
+		server, endpoint, err := dev.SetupObjectStore(ctx, dir, port)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start object store: %w", err)
+		}
+		if recreate {
+			if err := server.DeleteBucket(bucket); err != nil {
+				return nil, fmt.Errorf("failed to delete bucket %q: %w", bucket, err)
+			}
+		}
+		if err := server.CreateBucket(bucket); err != nil {
+			return nil, fmt.Errorf("failed to create bucket %q: %w", bucket, err)
+		}
+
+		return &RuntimeEvent{
+			ObjectStore: &schema.ObjectStoreRuntimeEvent{
+				ID: resource.ResourceID(),
+				Payload: &schema.ObjectStoreRuntime{
+					Endpoint: endpoint,
+					Bucket:   bucket,
+					Region:   "us-east-1",
+				},
+			},
+		}, nil
+	}
+}
+
+func provisionPostgres(postgresPort int, prefix string, recreate bool) InMemResourceProvisionerFn {
+	return func(ctx context.Context, moduleName string, resource schema.Provisioned) (*RuntimeEvent, error) {
+		logger := log.FromContext(ctx)
+
+		dbName := prefix + strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(resource.ResourceID())
 		logger.Infof("Provisioning postgres database: %s", dbName)
 
 		// We assume that the DB has already been started when running in dev mode
@@ -186,7 +462,7 @@ func provisionPostgres(postgresPort int, recreate bool) InMemResourceProvisioner
 
 }
 
-func provisionTopic() InMemResourceProvisionerFn {
+func provisionTopic(prefix string) InMemResourceProvisionerFn {
 	return func(ctx context.Context, moduleName string, res schema.Provisioned) (*RuntimeEvent, error) {
 		logger := log.FromContext(ctx)
 		if err := dev.SetUpRedPanda(ctx); err != nil {
@@ -197,7 +473,7 @@ func provisionTopic() InMemResourceProvisionerFn {
 			panic(fmt.Errorf("unexpected resource type: %T", res))
 		}
 
-		topicID := fmt.Sprintf("%s.%s", moduleName, topic.Name)
+		topicID := prefix + fmt.Sprintf("%s.%s", moduleName, topic.Name)
 		logger.Infof("Provisioning topic: %s", topicID)
 
 		config := sarama.NewConfig()
@@ -240,20 +516,22 @@ func provisionTopic() InMemResourceProvisionerFn {
 	}
 }
 
-func provisionSubscription() InMemResourceProvisionerFn {
+func provisionSubscription(prefix string) InMemResourceProvisionerFn {
 	return func(ctx context.Context, moduleName string, res schema.Provisioned) (*RuntimeEvent, error) {
 		logger := log.FromContext(ctx)
 		verb, ok := res.(*schema.Verb)
 		if !ok {
 			panic(fmt.Errorf("unexpected resource type: %T", res))
 		}
-		for range slices.FilterVariants[*schema.MetadataSubscriber](verb.Metadata) {
+		for subscriber := range slices.FilterVariants[*schema.MetadataSubscriber](verb.Metadata) {
 			logger.Infof("Provisioning subscription for verb: %s", verb.Name)
 			return &RuntimeEvent{
 				Verb: &schema.VerbRuntimeEvent{
 					ID: verb.Name,
 					Payload: &schema.VerbRuntimeSubscription{
-						KafkaBrokers: redPandaBrokers,
+						KafkaBrokers:    redPandaBrokers,
+						TopicID:         prefix + subscriber.Topic.String(),
+						ConsumerGroupID: prefix + schema.RefKey{Module: moduleName, Name: verb.Name}.String(),
 					},
 				},
 			}, nil
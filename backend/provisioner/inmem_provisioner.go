@@ -45,10 +45,12 @@ type inMemProvisioningStep struct {
 // RuntimeEvent is a union type of all runtime events
 // TODO: Remove once we have fully typed provisioners
 type RuntimeEvent struct {
-	Module   schema.ModuleRuntimeEvent
-	Database *schema.DatabaseRuntimeEvent
-	Topic    *schema.TopicRuntimeEvent
-	Verb     *schema.VerbRuntimeEvent
+	Module      schema.ModuleRuntimeEvent
+	Database    *schema.DatabaseRuntimeEvent
+	Topic       *schema.TopicRuntimeEvent
+	Verb        *schema.VerbRuntimeEvent
+	KV          *schema.KVRuntimeEvent
+	ObjectStore *schema.ObjectStoreRuntimeEvent
 }
 
 type InMemResourceProvisionerFn func(ctx context.Context, module string, resource schema.Provisioned) (*RuntimeEvent, error)
@@ -193,6 +195,10 @@ func eventsToProto(events []*RuntimeEvent) []*provisioner.ProvisioningEvent {
 			return &provisioner.ProvisioningEvent{Value: &provisioner.ProvisioningEvent_TopicRuntimeEvent{TopicRuntimeEvent: e.Topic.ToProto()}}
 		case e.Verb != nil:
 			return &provisioner.ProvisioningEvent{Value: &provisioner.ProvisioningEvent_VerbRuntimeEvent{VerbRuntimeEvent: e.Verb.ToProto()}}
+		case e.KV != nil:
+			return &provisioner.ProvisioningEvent{Value: &provisioner.ProvisioningEvent_KvRuntimeEvent{KvRuntimeEvent: e.KV.ToProto()}}
+		case e.ObjectStore != nil:
+			return &provisioner.ProvisioningEvent{Value: &provisioner.ProvisioningEvent_ObjectStoreRuntimeEvent{ObjectStoreRuntimeEvent: e.ObjectStore.ToProto()}}
 		default:
 			panic("unknown event type")
 		}
@@ -56,6 +56,12 @@ func provisionRunner(scaling scaling.RunnerScaling) InMemResourceProvisionerFn {
 				}
 			}
 		}
+		cs, inChangeset := changesetFromContext(ctx).Get()
+		if inChangeset {
+			cs.OnRollback(func(ctx context.Context) error {
+				return scaling.TerminateDeployment(ctx, module.Name, deployment)
+			})
+		}
 		if err := scaling.StartDeployment(ctx, module.Name, deployment, module, cron, http); err != nil {
 			logger.Infof("failed to start deployment: %v", err)
 			return nil, fmt.Errorf("failed to start deployment: %w", err)
@@ -88,8 +94,13 @@ func provisionRunner(scaling scaling.RunnerScaling) InMemResourceProvisionerFn {
 		schemaClient := rpc.ClientFromContext[ftlv1connect.SchemaServiceClient](ctx)
 		controllerClient := rpc.ClientFromContext[ftlv1connect.ControllerServiceClient](ctx)
 
-		deps, err := scaling.TerminatePreviousDeployments(ctx, module.Name, deployment)
-		if err != nil {
+		if inChangeset {
+			// Previous deployments keep serving until the changeset is committed, which deactivates them.
+			cs.OnCommit(func(ctx context.Context) error {
+				_, err := scaling.TerminatePreviousDeployments(ctx, module.Name, deployment)
+				return err //nolint:wrapcheck
+			})
+		} else if deps, err := scaling.TerminatePreviousDeployments(ctx, module.Name, deployment); err != nil {
 			logger.Errorf(err, "failed to terminate previous deployments")
 		} else {
 			var zero int32
@@ -29,7 +29,9 @@ import (
 	"github.com/block/ftl/backend/provisioner/scaling"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/identity"
 	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
 )
 
 const controllerDeploymentName = "ftl-controller"
@@ -47,6 +49,8 @@ var _ scaling.RunnerScaling = &k8sScaling{}
 type k8sScaling struct {
 	disableIstio bool
 	controller   string
+	// Key that the credentials identifying each deployment's runners to the controller are derived from, if any.
+	runnerCredentialKey []byte
 
 	client    *kubernetes.Clientset
 	namespace string
@@ -55,8 +59,12 @@ type k8sScaling struct {
 	istioSecurity    optional.Option[istioclient.Clientset]
 }
 
-func NewK8sScaling(disableIstio bool, controllerURL string) scaling.RunnerScaling {
-	return &k8sScaling{disableIstio: disableIstio, controller: controllerURL}
+// NewK8sScaling creates a RunnerScaling that runs each deployment as a Kubernetes deployment.
+//
+// If runnerCredentialKey is not empty, each deployment's runners are given a credential derived from it, with
+// which the controller identifies them.
+func NewK8sScaling(disableIstio bool, controllerURL string, runnerCredentialKey []byte) scaling.RunnerScaling {
+	return &k8sScaling{disableIstio: disableIstio, controller: controllerURL, runnerCredentialKey: runnerCredentialKey}
 }
 
 func (r *k8sScaling) StartDeployment(ctx context.Context, module string, deploymentKey string, sch *schema.Module, hasCron bool, hasIngress bool) error {
@@ -125,6 +133,16 @@ func (r *k8sScaling) TerminatePreviousDeployments(ctx context.Context, module st
 	return ret, nil
 }
 
+func (r *k8sScaling) TerminateDeployment(ctx context.Context, module string, deploymentKey string) error {
+	logger := log.FromContext(ctx).Module(module)
+	logger.Debugf("Deleting deployment %s", deploymentKey)
+	err := r.client.AppsV1().Deployments(r.namespace).Delete(ctx, deploymentKey, v1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete deployment %s: %w", deploymentKey, err)
+	}
+	return nil
+}
+
 func (r *k8sScaling) Start(ctx context.Context) error {
 	logger := log.FromContext(ctx).Scope("K8sScaling")
 	clientset, err := CreateClientSet()
@@ -506,6 +524,13 @@ func (r *k8sScaling) syncDeployment(ctx context.Context, thisImage string, deplo
 	}
 	changes = r.updateEnvVar(deployment, "FTL_DEPLOYMENT", deployment.Name, changes)
 	changes = r.updateEnvVar(deployment, "FTL_ENDPOINT", r.controller, changes)
+	if len(r.runnerCredentialKey) > 0 {
+		deploymentKey, err := model.ParseDeploymentKey(deployment.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse deployment key %s: %w", deployment.Name, err)
+		}
+		changes = r.updateEnvVar(deployment, "FTL_RUNNER_CREDENTIAL", identity.RunnerCredential(r.runnerCredentialKey, deploymentKey), changes)
+	}
 	return changes, nil
 }
 
@@ -39,11 +39,14 @@ type localScaling struct {
 	debugPorts          map[string]*localdebug.DebugInfo
 	controllerAddresses []*url.URL
 	leaseAddress        *url.URL
+	asyncAddress        *url.URL
 
 	prevRunnerSuffix int
 	ideSupport       optional.Option[localdebug.IDEIntegration]
 	storage          *artefacts.OCIArtefactService
 	enableOtel       bool
+	// captureSensitivePayloads reports whether to disable redaction of sensitive fields in the runners' timeline events.
+	captureSensitivePayloads func() bool
 
 	devModeEndpointsUpdates <-chan dev.LocalEndpoint
 	devModeEndpoints        map[string]*devModeRunner
@@ -99,6 +102,11 @@ func (l *localScaling) TerminatePreviousDeployments(ctx context.Context, module
 	return ret, nil
 }
 
+func (l *localScaling) TerminateDeployment(ctx context.Context, module string, deployment string) error {
+	log.FromContext(ctx).Debugf("Terminating deployment %s", deployment)
+	return l.setReplicas(module, deployment, "", 0)
+}
+
 type devModeRunner struct {
 	uri url.URL
 	// The deployment key of the deployment that is currently running
@@ -180,10 +188,12 @@ func NewLocalScaling(
 	ctx context.Context,
 	controllerAddresses []*url.URL,
 	leaseAddress *url.URL,
+	asyncAddress *url.URL,
 	configPath string,
 	enableIDEIntegration bool,
 	storage *artefacts.OCIArtefactService,
 	enableOtel bool,
+	captureSensitivePayloads func() bool,
 	devModeEndpoints <-chan dev.LocalEndpoint,
 ) (scaling.RunnerScaling, error) {
 	cacheDir, err := os.UserCacheDir()
@@ -191,18 +201,20 @@ func NewLocalScaling(
 		return nil, err
 	}
 	local := localScaling{
-		ctx:                     ctx,
-		lock:                    sync.Mutex{},
-		cacheDir:                cacheDir,
-		runners:                 map[string]map[string]*deploymentInfo{},
-		controllerAddresses:     controllerAddresses,
-		leaseAddress:            leaseAddress,
-		prevRunnerSuffix:        -1,
-		debugPorts:              map[string]*localdebug.DebugInfo{},
-		storage:                 storage,
-		enableOtel:              enableOtel,
-		devModeEndpointsUpdates: devModeEndpoints,
-		devModeEndpoints:        map[string]*devModeRunner{},
+		ctx:                      ctx,
+		lock:                     sync.Mutex{},
+		cacheDir:                 cacheDir,
+		runners:                  map[string]map[string]*deploymentInfo{},
+		controllerAddresses:      controllerAddresses,
+		leaseAddress:             leaseAddress,
+		asyncAddress:             asyncAddress,
+		prevRunnerSuffix:         -1,
+		debugPorts:               map[string]*localdebug.DebugInfo{},
+		storage:                  storage,
+		enableOtel:               enableOtel,
+		captureSensitivePayloads: captureSensitivePayloads,
+		devModeEndpointsUpdates:  devModeEndpoints,
+		devModeEndpoints:         map[string]*devModeRunner{},
 	}
 	if enableIDEIntegration && configPath != "" {
 		local.ideSupport = optional.Ptr(localdebug.NewIDEIntegration(configPath))
@@ -300,11 +312,14 @@ func (l *localScaling) startRunner(ctx context.Context, deploymentKey model.Depl
 		Bind:               bindURL,
 		ControllerEndpoint: controllerEndpoint,
 		LeaseEndpoint:      l.leaseAddress,
+		AsyncEndpoint:      l.asyncAddress,
 		Key:                model.NewLocalRunnerKey(keySuffix),
 		Deployment:         deploymentKey,
 		DebugPort:          debugPort,
 		DevEndpoint:        devURI,
 		DevRunnerInfoFile:  devRunnerInfoFile,
+
+		CaptureSensitivePayloads: l.captureSensitivePayloads,
 	}
 
 	simpleName := fmt.Sprintf("runner%d", keySuffix)
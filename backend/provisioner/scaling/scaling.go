@@ -17,4 +17,7 @@ type RunnerScaling interface {
 	StartDeployment(ctx context.Context, module string, deployment string, sch *schema.Module, hasCron bool, hasIngress bool) error
 
 	TerminatePreviousDeployments(ctx context.Context, module string, currentDeployment string) ([]string, error)
+
+	// TerminateDeployment stops the runners of a single deployment.
+	TerminateDeployment(ctx context.Context, module string, deployment string) error
 }
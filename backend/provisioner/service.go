@@ -18,16 +18,32 @@ import (
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
 	ftlv1connect "github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/provisioner/scaling"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
 	"github.com/block/ftl/common/reflect"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/rpc"
+	"github.com/block/ftl/internal/vault"
 )
 
 // CommonProvisionerConfig is shared config between the production controller and development server.
 type CommonProvisionerConfig struct {
 	PluginConfigFile *os.File `name:"provisioner-plugin-config" help:"Path to the plugin configuration file." env:"FTL_PROVISIONER_PLUGIN_CONFIG_FILE"`
+	// Vault is used to resolve credentials for databases provisioned by the "vault" provisioner, such as when running migrations.
+	Vault vault.Config `embed:"" prefix:"vault-"`
+}
+
+// ContextWithVault returns a context that credentials for Vault database connectors are resolved with, if Vault is configured.
+func (c CommonProvisionerConfig) ContextWithVault(ctx context.Context) (context.Context, error) {
+	if !c.Vault.Enabled() {
+		return ctx, nil
+	}
+	client, err := vault.New(c.Vault)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vault client: %w", err)
+	}
+	return vault.ContextWithDatabaseCredentials(ctx, vault.NewDatabaseCredentials(ctx, client)), nil
 }
 
 type Config struct {
@@ -68,14 +84,79 @@ func (s *Service) Ping(context.Context, *connect.Request[ftlv1.PingRequest]) (*c
 }
 
 func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftlv1.CreateDeploymentRequest]) (*connect.Response[ftlv1.CreateDeploymentResponse], error) {
+	deploymentKey, err := s.provision(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&ftlv1.CreateDeploymentResponse{
+		DeploymentKey: deploymentKey,
+	}), nil
+}
+
+// DeployChangeset provisions several modules and activates them together.
+//
+// If provisioning any module fails, everything provisioned for the changeset is torn down and none
+// of the modules are activated.
+func (s *Service) DeployChangeset(ctx context.Context, req *connect.Request[ftlv1.DeployChangesetRequest]) (*connect.Response[ftlv1.DeployChangesetResponse], error) {
+	logger := log.FromContext(ctx)
+	created, err := s.controllerClient.CreateChangeset(ctx, connect.NewRequest(&ftlv1.CreateChangesetRequest{
+		Modules: slices.Map(req.Msg.Deployments, func(d *ftlv1.CreateDeploymentRequest) *schemapb.Module { return d.Schema }),
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("call to ftl-controller failed: %w", err)
+	}
+	cs := &changeset{key: created.Msg.Changeset}
+	logger.Debugf("Provisioning changeset %s", cs.key)
+
+	keys := make([]string, len(req.Msg.Deployments))
+	wg, wctx := errgroup.WithContext(contextWithChangeset(ctx, cs))
+	for i, deployment := range req.Msg.Deployments {
+		wg.Go(func() error {
+			key, err := s.provision(wctx, deployment)
+			if err != nil {
+				return fmt.Errorf("%s: %w", deployment.Schema.Name, err)
+			}
+			keys[i] = key
+			return nil
+		})
+	}
+	err = wg.Wait()
+	if err == nil {
+		_, err = s.controllerClient.CommitChangeset(ctx, connect.NewRequest(&ftlv1.CommitChangesetRequest{
+			Changeset:   cs.key,
+			MinReplicas: req.Msg.MinReplicas,
+		}))
+	}
+	if err != nil {
+		logger.Warnf("Rolling back changeset %s: %s", cs.key, err)
+		if _, rerr := s.controllerClient.RollbackChangeset(ctx, connect.NewRequest(&ftlv1.RollbackChangesetRequest{Changeset: cs.key})); rerr != nil {
+			logger.Errorf(rerr, "Could not roll back changeset %s", cs.key)
+		}
+		if rerr := cs.rollback(ctx); rerr != nil {
+			logger.Errorf(rerr, "Could not tear down changeset %s", cs.key)
+		}
+		return nil, fmt.Errorf("changeset %s failed: %w", cs.key, err)
+	}
+	if err := cs.commit(ctx); err != nil {
+		logger.Errorf(err, "Could not terminate deployments replaced by changeset %s", cs.key)
+	}
+	logger.Debugf("Committed changeset %s", cs.key)
+	return connect.NewResponse(&ftlv1.DeployChangesetResponse{
+		Changeset:      cs.key,
+		DeploymentKeys: keys,
+	}), nil
+}
+
+// provision a single module, returning its deployment key.
+func (s *Service) provision(ctx context.Context, req *ftlv1.CreateDeploymentRequest) (string, error) {
 	logger := log.FromContext(ctx)
 	// TODO: Block deployments to make sure only one module is modified at a time
-	moduleName := req.Msg.Schema.Name
+	moduleName := req.Schema.Name
 
 	existingModule, _ := s.currentModules.Load(moduleName)
-	desiredModule, err := schema.ModuleFromProto(req.Msg.Schema)
+	desiredModule, err := schema.ModuleFromProto(req.Schema)
 	if err != nil {
-		return nil, fmt.Errorf("error converting module to schema: %w", err)
+		return "", fmt.Errorf("error converting module to schema: %w", err)
 	}
 
 	if existingModule != nil {
@@ -87,7 +168,7 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftl
 		_, ok := m.(*schema.MetadataArtefact)
 		return !ok
 	})
-	for _, artefact := range req.Msg.Artefacts {
+	for _, artefact := range req.Artefacts {
 		desiredModule.Metadata = append(desiredModule.Metadata, &schema.MetadataArtefact{
 			Path:       artefact.Path,
 			Digest:     artefact.Digest,
@@ -102,15 +183,12 @@ func (s *Service) CreateDeployment(ctx context.Context, req *connect.Request[ftl
 		running, err = deployment.Progress(ctx)
 		if err != nil {
 			// TODO: Deal with failed deployments
-			return nil, fmt.Errorf("error running a provisioner: %w", err)
+			return "", fmt.Errorf("error running a provisioner: %w", err)
 		}
 	}
 	logger.Debugf("Finished deployment for module %s", moduleName)
 
-	deploymentKey := deployment.Module.Runtime.Deployment.DeploymentKey
-	return connect.NewResponse(&ftlv1.CreateDeploymentResponse{
-		DeploymentKey: deploymentKey,
-	}), nil
+	return deployment.Module.Runtime.Deployment.DeploymentKey, nil
 }
 
 // Start the Provisioner. Blocks until the context is cancelled.
@@ -221,6 +299,14 @@ func syncExistingRuntimes(existingModule, desiredModule *schema.Module) {
 				if existing, ok := existing.(*schema.Topic); ok {
 					desired.Runtime = reflect.DeepCopy(existing.Runtime)
 				}
+			case *schema.KV:
+				if existing, ok := existing.(*schema.KV); ok {
+					desired.Runtime = reflect.DeepCopy(existing.Runtime)
+				}
+			case *schema.ObjectStore:
+				if existing, ok := existing.(*schema.ObjectStore); ok {
+					desired.Runtime = reflect.DeepCopy(existing.Runtime)
+				}
 			case *schema.Verb:
 				if existing, ok := existing.(*schema.Verb); ok {
 					desired.Runtime = reflect.DeepCopy(existing.Runtime)
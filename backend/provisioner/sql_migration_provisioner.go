@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/amacneil/dbmate/v2/pkg/dbmate"
 	_ "github.com/amacneil/dbmate/v2/pkg/driver/mysql"
@@ -70,6 +71,12 @@ func provisionSQLMigration(storage *artefacts.OCIArtefactService) InMemResourceP
 				// strip the tcp part
 				exp := regexp.MustCompile(`tcp\((.*?)\)`)
 				d = exp.ReplaceAllString(d, "$1")
+			case schema.SQLiteDatabaseType:
+				d, err = dsn.ResolveSQLiteDSN(ctx, db.Runtime.Connections.Write)
+				if err != nil {
+					return nil, fmt.Errorf("failed to resolve sqlite DSN: %w", err)
+				}
+				d = sqliteMigrationURL(d)
 			}
 			u, err := url.Parse(d)
 			if err != nil {
@@ -100,6 +107,16 @@ func RunPostgresMigration(ctx context.Context, dsn string, moduleDir string, nam
 	return runDBMateMigration(ctx, dsn, moduleDir, name)
 }
 
+func RunSQLiteMigration(ctx context.Context, dsn string, moduleDir string, name string) error {
+	return runDBMateMigration(ctx, sqliteMigrationURL(dsn), moduleDir, name)
+}
+
+// sqliteMigrationURL converts a "file:" SQLite DSN into the URL form expected by dbmate.
+func sqliteMigrationURL(dsn string) string {
+	path, _, _ := strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+	return "sqlite:" + path
+}
+
 func runDBMateMigration(ctx context.Context, dsn string, moduleDir string, name string) error {
 	migrationDir := filepath.Join(moduleDir, "db", name)
 	_, err := os.Stat(migrationDir)
@@ -0,0 +1,83 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/XSAM/otelsql"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/strcase"
+	"github.com/block/ftl/internal/dsn"
+	"github.com/block/ftl/internal/log"
+)
+
+// VaultDatabaseConfig configures the PostgreSQL server and Vault database secrets engine role used by [NewVaultProvisioner].
+type VaultDatabaseConfig struct {
+	PostgresEndpoint string `help:"Host and port of the PostgreSQL server that Vault issues database credentials for." env:"FTL_VAULT_POSTGRES_ENDPOINT" required:""`
+	DatabaseMount    string `help:"Mount path of the Vault database secrets engine." env:"FTL_VAULT_DATABASE_MOUNT" default:"database"`
+	DatabaseRole     string `help:"Role in the Vault database secrets engine to create and connect to databases with." env:"FTL_VAULT_DATABASE_ROLE" default:"ftl"`
+}
+
+// NewVaultProvisioner creates a provisioner for PostgreSQL databases that are
+// connected to with short-lived credentials from a Vault database secrets engine.
+//
+// Credentials are leased with the [vault.DatabaseCredentials] in the context.
+func NewVaultProvisioner(config VaultDatabaseConfig) *InMemProvisioner {
+	return NewEmbeddedProvisioner(map[schema.ResourceType]InMemResourceProvisionerFn{
+		schema.ResourceTypePostgres: provisionVaultPostgres(config),
+	})
+}
+
+func provisionVaultPostgres(config VaultDatabaseConfig) InMemResourceProvisionerFn {
+	return func(ctx context.Context, moduleName string, resource schema.Provisioned) (*RuntimeEvent, error) {
+		// Provisioning continues after the request that started it has completed.
+		ctx = context.WithoutCancel(ctx)
+		logger := log.FromContext(ctx)
+
+		dbName := strcase.ToLowerSnake(moduleName) + "_" + strcase.ToLowerSnake(resource.ResourceID())
+		logger.Infof("Provisioning postgres database: %s", dbName)
+
+		connector := func(database string) *schema.VaultDatabaseConnector {
+			return &schema.VaultDatabaseConnector{
+				Endpoint: config.PostgresEndpoint,
+				Database: database,
+				Mount:    config.DatabaseMount,
+				Role:     config.DatabaseRole,
+			}
+		}
+		postgresDSN, err := dsn.ResolvePostgresDSN(ctx, connector("postgres"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve postgres DSN: %w", err)
+		}
+		conn, err := otelsql.Open("pgx", postgresDSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+		}
+		defer conn.Close()
+
+		var exists bool
+		err = conn.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_database WHERE datname=$1)", dbName).Scan(&exists)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query database: %w", err)
+		}
+		if !exists {
+			_, err = conn.ExecContext(ctx, "CREATE DATABASE "+dbName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create database %q: %w", dbName, err)
+			}
+		}
+
+		return &RuntimeEvent{
+			Database: &schema.DatabaseRuntimeEvent{
+				ID: resource.ResourceID(),
+				Payload: &schema.DatabaseRuntimeConnectionsEvent{
+					Connections: &schema.DatabaseRuntimeConnections{
+						Write: connector(dbName),
+						Read:  connector(dbName),
+					},
+				},
+			},
+		}, nil
+	}
+}
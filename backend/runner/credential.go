@@ -0,0 +1,40 @@
+package runner
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+// credentialInterceptor identifies the deployment of the runner to the controller with its runner credential.
+//
+// It is only used for requests that are specific to the deployment, because the credential takes precedence over
+// the client certificate that the runner shares with the rest of the cluster.
+type credentialInterceptor struct {
+	credential string
+}
+
+var _ connect.Interceptor = credentialInterceptor{}
+
+func (c credentialInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			headers.SetRunnerCredential(req.Header(), c.credential)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (c credentialInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		headers.SetRunnerCredential(conn.RequestHeader(), c.credential)
+		return conn
+	}
+}
+
+func (c credentialInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
@@ -0,0 +1,156 @@
+// Package idempotency deduplicates calls to idempotent verbs.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/schema"
+	sl "github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+// CallFunc executes a call to a verb.
+type CallFunc func(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error)
+
+type entryKey struct {
+	verb string
+	key  string
+}
+
+type entry struct {
+	digest [32]byte
+	// Closed once the first call has completed.
+	done chan struct{}
+	// Set if the first call succeeded, otherwise the entry is discarded.
+	response  *ftlv1.CallResponse
+	expiresAt time.Time
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return e.response != nil && !now.Before(e.expiresAt)
+}
+
+// Cache retains the first successful response to each call to an idempotent
+// verb that carries an idempotency key, and returns it for later calls with
+// the same key instead of executing the verb again.
+//
+// Failed calls are not retained, so they may be retried with the same key.
+// Concurrent calls with the same key wait for the first to complete.
+type Cache struct {
+	module  string
+	windows map[string]time.Duration
+
+	lock      sync.Mutex
+	entries   map[entryKey]*entry
+	lastSweep time.Time
+}
+
+// New creates a Cache for the idempotent verbs in module.
+func New(module *schema.Module) (*Cache, error) {
+	windows := map[string]time.Duration{}
+	for verb := range sl.FilterVariants[*schema.Verb](module.Decls) {
+		md, ok := verb.GetMetadataIdempotent().Get()
+		if !ok {
+			continue
+		}
+		window, err := md.WindowDuration()
+		if err != nil {
+			return nil, fmt.Errorf("verb %s: %w", verb.Name, err)
+		}
+		windows[verb.Name] = window
+	}
+	return &Cache{
+		module:  module.Name,
+		windows: windows,
+		entries: map[entryKey]*entry{},
+	}, nil
+}
+
+// Call executes req with call, unless it is a replay of an earlier successful
+// call to an idempotent verb, in which case the earlier response is returned.
+//
+// Reusing a key with a different request is an error.
+func (c *Cache) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest], call CallFunc) (*connect.Response[ftlv1.CallResponse], error) {
+	if req.Msg.Verb == nil || req.Msg.Verb.Module != c.module || req.Msg.Metadata == nil {
+		return call(ctx, req)
+	}
+	window, ok := c.windows[req.Msg.Verb.Name]
+	if !ok {
+		return call(ctx, req)
+	}
+	key, ok := req.Msg.Metadata.Get(headers.IdempotencyKeyHeader).Get()
+	if !ok || key == "" {
+		return call(ctx, req)
+	}
+	ek := entryKey{verb: req.Msg.Verb.Name, key: key}
+	digest := sha256.Sum256(req.Msg.Body)
+	logger := log.FromContext(ctx)
+
+	for {
+		c.lock.Lock()
+		now := time.Now()
+		c.sweep(now)
+		existing, ok := c.entries[ek]
+		if ok && existing.expired(now) {
+			delete(c.entries, ek)
+			ok = false
+		}
+		if !ok {
+			e := &entry{digest: digest, done: make(chan struct{})}
+			c.entries[ek] = e
+			c.lock.Unlock()
+			return c.execute(ctx, req, call, ek, e, window)
+		}
+		c.lock.Unlock()
+
+		if existing.digest != digest {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("idempotency key %q was already used with a different request to %s.%s", key, c.module, ek.verb))
+		}
+		select {
+		case <-existing.done:
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeCanceled, context.Cause(ctx))
+		}
+		if existing.response != nil {
+			logger.Debugf("Replaying response to %s.%s for idempotency key %q", c.module, ek.verb, key)
+			return connect.NewResponse(existing.response), nil
+		}
+		// The first call failed and was discarded, so try again.
+	}
+}
+
+func (c *Cache) execute(ctx context.Context, req *connect.Request[ftlv1.CallRequest], call CallFunc, ek entryKey, e *entry, window time.Duration) (*connect.Response[ftlv1.CallResponse], error) {
+	resp, err := call(ctx, req)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	defer close(e.done)
+	if err != nil || resp.Msg.GetError() != nil {
+		delete(c.entries, ek)
+		return resp, err
+	}
+	e.response = resp.Msg
+	e.expiresAt = time.Now().Add(window)
+	return resp, nil
+}
+
+// sweep removes expired entries at most once a minute. It must be called
+// with the lock held.
+func (c *Cache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < time.Minute {
+		return
+	}
+	c.lastSweep = now
+	for k, e := range c.entries {
+		if e.expired(now) {
+			delete(c.entries, k)
+		}
+	}
+}
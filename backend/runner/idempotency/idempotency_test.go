@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+func newCache(t *testing.T) *Cache {
+	t.Helper()
+	cache, err := New(&schema.Module{
+		Name: "test",
+		Decls: []schema.Decl{
+			&schema.Verb{Name: "charge", Request: &schema.String{}, Response: &schema.String{}, Metadata: []schema.Metadata{&schema.MetadataIdempotent{}}},
+			&schema.Verb{Name: "echo", Request: &schema.String{}, Response: &schema.String{}},
+		},
+	})
+	assert.NoError(t, err)
+	return cache
+}
+
+func request(verb, key, body string) *connect.Request[ftlv1.CallRequest] {
+	req := &ftlv1.CallRequest{
+		Verb:     (&schema.Ref{Module: "test", Name: verb}).ToProto(),
+		Body:     []byte(body),
+		Metadata: &ftlv1.Metadata{},
+	}
+	if key != "" {
+		req.Metadata.Set(headers.IdempotencyKeyHeader, key)
+	}
+	return connect.NewRequest(req)
+}
+
+// counter returns a CallFunc that responds with the number of times it has been called.
+func counter(calls *atomic.Int32, fail *atomic.Bool) CallFunc {
+	return func(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
+		n := calls.Add(1)
+		if fail != nil && fail.Load() {
+			return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: "boom"}}}), nil
+		}
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: []byte{byte('0' + n)}}}), nil
+	}
+}
+
+func TestReplay(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cache := newCache(t)
+	calls := &atomic.Int32{}
+
+	for range 3 {
+		resp, err := cache.Call(ctx, request("charge", "k1", `"a"`), counter(calls, nil))
+		assert.NoError(t, err)
+		assert.Equal(t, "1", string(resp.Msg.GetBody()))
+	}
+	resp, err := cache.Call(ctx, request("charge", "k2", `"a"`), counter(calls, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "2", string(resp.Msg.GetBody()))
+
+	_, err = cache.Call(ctx, request("charge", "k1", `"b"`), counter(calls, nil))
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestNotIdempotent(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cache := newCache(t)
+	calls := &atomic.Int32{}
+
+	// Verbs without +idempotent and calls without a key are always executed.
+	for range 2 {
+		_, err := cache.Call(ctx, request("echo", "k1", `"a"`), counter(calls, nil))
+		assert.NoError(t, err)
+		_, err = cache.Call(ctx, request("charge", "", `"a"`), counter(calls, nil))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestFailuresAreNotRetained(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cache := newCache(t)
+	calls := &atomic.Int32{}
+	fail := &atomic.Bool{}
+	fail.Store(true)
+
+	resp, err := cache.Call(ctx, request("charge", "k1", `"a"`), counter(calls, fail))
+	assert.NoError(t, err)
+	assert.Equal(t, "boom", resp.Msg.GetError().GetMessage())
+
+	fail.Store(false)
+	resp, err = cache.Call(ctx, request("charge", "k1", `"a"`), counter(calls, fail))
+	assert.NoError(t, err)
+	assert.Equal(t, "2", string(resp.Msg.GetBody()))
+}
+
+func TestConcurrentCallsWait(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	cache := newCache(t)
+	calls := &atomic.Int32{}
+	release := make(chan struct{})
+	slow := func(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
+		<-release
+		return counter(calls, nil)(ctx, req)
+	}
+
+	wg := sync.WaitGroup{}
+	bodies := make([]string, 5)
+	for i := range bodies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := cache.Call(ctx, request("charge", "k1", `"a"`), slow)
+			assert.NoError(t, err)
+			bodies[i] = string(resp.Msg.GetBody())
+		}()
+	}
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, []string{"1", "1", "1", "1", "1"}, bodies)
+}
@@ -0,0 +1,186 @@
+package kv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1"
+	pbconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1/kvpbconnect"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/timeline"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/schema"
+	sl "github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/rpc"
+)
+
+// Service serves the key-value stores declared by a module.
+type Service struct {
+	moduleName     string
+	deployment     model.DeploymentKey
+	stores         map[string]*store
+	timelineClient *timeline.Client
+}
+
+var _ pbconnect.KVServiceHandler = (*Service)(nil)
+
+func New(ctx context.Context, module *schema.Module, deployment model.DeploymentKey, timelineClient *timeline.Client) (*Service, error) {
+	stores := map[string]*store{}
+	for kv := range sl.FilterVariants[*schema.KV](module.Decls) {
+		if kv.Runtime == nil {
+			return nil, fmt.Errorf("kv %s has no runtime", kv.Name)
+		}
+		s, err := openStore(ctx, kv.Runtime.Path)
+		if err != nil {
+			return nil, err
+		}
+		stores[kv.Name] = s
+	}
+	return &Service{
+		moduleName:     module.Name,
+		deployment:     deployment,
+		stores:         stores,
+		timelineClient: timelineClient,
+	}, nil
+}
+
+// Close all open stores.
+func (s *Service) Close() error {
+	var errs []error
+	for _, st := range s.stores {
+		if err := st.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
+	return connect.NewResponse(&ftlv1.PingResponse{}), nil
+}
+
+func (s *Service) Get(ctx context.Context, req *connect.Request[pb.GetRequest]) (*connect.Response[pb.GetResponse], error) {
+	st, err := s.store(req.Msg.Kv)
+	if err != nil {
+		return nil, err
+	}
+	value, err := st.Get(ctx, req.Msg.Key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	resp := &pb.GetResponse{}
+	if v, ok := value.Get(); ok {
+		// Presence of optional bytes is tracked by nil-ness, so ensure a present empty value is non-nil.
+		resp.Value = append([]byte{}, v...)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Service) Put(ctx context.Context, req *connect.Request[pb.PutRequest]) (*connect.Response[pb.PutResponse], error) {
+	st, err := s.store(req.Msg.Kv)
+	if err != nil {
+		return nil, err
+	}
+	event := s.newWriteEvent(ctx, req.Msg.Kv, req.Msg.Caller, timeline.KVWriteOperationPut, req.Msg.Key)
+	err = st.Put(ctx, req.Msg.Key, req.Msg.Value, ttlFromProto(req.Msg))
+	s.publish(ctx, event, true, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.PutResponse{}), nil
+}
+
+func (s *Service) Delete(ctx context.Context, req *connect.Request[pb.DeleteRequest]) (*connect.Response[pb.DeleteResponse], error) {
+	st, err := s.store(req.Msg.Kv)
+	if err != nil {
+		return nil, err
+	}
+	event := s.newWriteEvent(ctx, req.Msg.Kv, req.Msg.Caller, timeline.KVWriteOperationDelete, req.Msg.Key)
+	err = st.Delete(ctx, req.Msg.Key)
+	s.publish(ctx, event, true, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.DeleteResponse{}), nil
+}
+
+func (s *Service) CompareAndSwap(ctx context.Context, req *connect.Request[pb.CompareAndSwapRequest]) (*connect.Response[pb.CompareAndSwapResponse], error) {
+	st, err := s.store(req.Msg.Kv)
+	if err != nil {
+		return nil, err
+	}
+	event := s.newWriteEvent(ctx, req.Msg.Kv, req.Msg.Caller, timeline.KVWriteOperationCompareAndSwap, req.Msg.Key)
+	swapped, err := st.CompareAndSwap(ctx, req.Msg.Key, bytesFromProto(req.Msg.Old), req.Msg.New, ttlFromProto(req.Msg))
+	s.publish(ctx, event, swapped, err)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&pb.CompareAndSwapResponse{Swapped: swapped}), nil
+}
+
+func (s *Service) store(ref *schemapb.Ref) (*store, error) {
+	if ref == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("missing kv reference"))
+	}
+	// Each runner only serves the stores of its own module.
+	if ref.Module != s.moduleName {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("kv %s.%s is not owned by module %s", ref.Module, ref.Name, s.moduleName))
+	}
+	st, ok := s.stores[ref.Name]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("kv %s not found", ref.Name))
+	}
+	return st, nil
+}
+
+func (s *Service) newWriteEvent(ctx context.Context, ref *schemapb.Ref, caller string, op timeline.KVWriteOperation, key []byte) timeline.KVWrite {
+	var requestKeyStr optional.Option[string]
+	if requestKey, err := rpc.RequestKeyFromContext(ctx); err == nil {
+		if r, ok := requestKey.Get(); ok {
+			requestKeyStr = optional.Some(r.String())
+		}
+	}
+	return timeline.KVWrite{
+		DeploymentKey: s.deployment,
+		RequestKey:    requestKeyStr,
+		Time:          time.Now(),
+		SourceVerb:    schema.Ref{Module: s.moduleName, Name: caller},
+		KV:            schema.Ref{Module: s.moduleName, Name: ref.Name},
+		Operation:     op,
+		Key:           key,
+	}
+}
+
+func (s *Service) publish(ctx context.Context, event timeline.KVWrite, applied bool, err error) {
+	if err != nil {
+		event.Error = optional.Some(err.Error())
+		applied = false
+	}
+	event.Applied = applied
+	s.timelineClient.Publish(ctx, event)
+}
+
+type ttlRequest interface {
+	GetTtl() *durationpb.Duration
+}
+
+func ttlFromProto(req ttlRequest) optional.Option[time.Duration] {
+	if ttl := req.GetTtl(); ttl != nil {
+		return optional.Some(ttl.AsDuration())
+	}
+	return optional.None[time.Duration]()
+}
+
+func bytesFromProto(b []byte) optional.Option[[]byte] {
+	if b == nil {
+		return optional.None[[]byte]()
+	}
+	return optional.Some(b)
+}
@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1"
+	"github.com/block/ftl/backend/timeline"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+)
+
+func TestService(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	t.Cleanup(cancel)
+	path := filepath.Join(t.TempDir(), "test_sessions.kv")
+	module := &schema.Module{
+		Name: "test",
+		Decls: []schema.Decl{
+			&schema.KV{Name: "sessions", Key: &schema.String{}, Value: &schema.String{}, Runtime: &schema.KVRuntime{Path: path}},
+		},
+	}
+	timelineClient := timeline.NewClient(ctx, &url.URL{Scheme: "http", Host: "127.0.0.1:0"})
+	service, err := New(ctx, module, model.NewDeploymentKey("test"), timelineClient)
+	assert.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, service.Close()) })
+
+	ref := (&schema.Ref{Module: "test", Name: "sessions"}).ToProto()
+	get := func(key string) []byte {
+		t.Helper()
+		resp, err := service.Get(ctx, connect.NewRequest(&pb.GetRequest{Kv: ref, Key: []byte(key)}))
+		assert.NoError(t, err)
+		return resp.Msg.Value
+	}
+	cas := func(key string, old []byte, value string) bool {
+		t.Helper()
+		resp, err := service.CompareAndSwap(ctx, connect.NewRequest(&pb.CompareAndSwapRequest{Kv: ref, Key: []byte(key), Old: old, New: []byte(value), Caller: "login"}))
+		assert.NoError(t, err)
+		return resp.Msg.Swapped
+	}
+
+	assert.Zero(t, get(`"a"`))
+
+	_, err = service.Put(ctx, connect.NewRequest(&pb.PutRequest{Kv: ref, Key: []byte(`"a"`), Value: []byte(`"1"`), Caller: "login"}))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`"1"`), get(`"a"`))
+
+	assert.False(t, cas(`"a"`, nil, `"2"`), "swap should fail when the key exists")
+	assert.False(t, cas(`"a"`, []byte(`"0"`), `"2"`), "swap should fail when the value differs")
+	assert.True(t, cas(`"a"`, []byte(`"1"`), `"2"`))
+	assert.Equal(t, []byte(`"2"`), get(`"a"`))
+	assert.True(t, cas(`"b"`, nil, `"3"`))
+
+	_, err = service.Delete(ctx, connect.NewRequest(&pb.DeleteRequest{Kv: ref, Key: []byte(`"a"`), Caller: "login"}))
+	assert.NoError(t, err)
+	assert.Zero(t, get(`"a"`))
+	assert.Equal(t, []byte(`"3"`), get(`"b"`))
+
+	_, err = service.Put(ctx, connect.NewRequest(&pb.PutRequest{Kv: ref, Key: []byte(`"c"`), Value: []byte(`"4"`), Ttl: durationpb.New(time.Millisecond), Caller: "login"}))
+	assert.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	assert.Zero(t, get(`"c"`))
+	assert.True(t, cas(`"c"`, nil, `"5"`), "expired entries should be treated as absent")
+
+	_, err = service.Get(ctx, connect.NewRequest(&pb.GetRequest{Kv: (&schema.Ref{Module: "test", Name: "missing"}).ToProto(), Key: []byte(`"a"`)}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	_, err = service.Get(ctx, connect.NewRequest(&pb.GetRequest{Kv: (&schema.Ref{Module: "other", Name: "sessions"}).ToProto(), Key: []byte(`"b"`)}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err), "stores of other modules should not be reachable")
+}
@@ -0,0 +1,127 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	_ "modernc.org/sqlite" // SQL driver
+)
+
+// store is a file-backed key-value store.
+//
+// Entries whose expiry has passed are treated as absent and are replaced by
+// subsequent writes.
+type store struct {
+	db *sql.DB
+}
+
+func openStore(ctx context.Context, path string) (*store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create directory for kv store %q: %w", path, err)
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open kv store %q: %w", path, err)
+	}
+	// Serialise all access through a single connection so compare-and-swap is atomic.
+	db.SetMaxOpenConns(1)
+	_, err = db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS entries (
+		key BLOB PRIMARY KEY,
+		value BLOB NOT NULL,
+		expires_at INTEGER
+	)`)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialise kv store %q: %w", path, err)
+	}
+	return &store{db: db}, nil
+}
+
+func (s *store) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("failed to close kv store: %w", err)
+	}
+	return nil
+}
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func get(ctx context.Context, q querier, key []byte, now time.Time) (optional.Option[[]byte], error) {
+	var value []byte
+	var expiresAt sql.NullInt64
+	err := q.QueryRowContext(ctx, "SELECT value, expires_at FROM entries WHERE key = ?", key).Scan(&value, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return optional.None[[]byte](), nil
+	}
+	if err != nil {
+		return optional.None[[]byte](), fmt.Errorf("failed to get key: %w", err)
+	}
+	if expiresAt.Valid && expiresAt.Int64 <= now.UnixNano() {
+		return optional.None[[]byte](), nil
+	}
+	return optional.Some(value), nil
+}
+
+func (s *store) Get(ctx context.Context, key []byte) (optional.Option[[]byte], error) {
+	return get(ctx, s.db, key, time.Now())
+}
+
+func (s *store) Put(ctx context.Context, key, value []byte, ttl optional.Option[time.Duration]) error {
+	_, err := s.db.ExecContext(ctx, "INSERT OR REPLACE INTO entries (key, value, expires_at) VALUES (?, ?, ?)", key, value, expiresAt(ttl))
+	if err != nil {
+		return fmt.Errorf("failed to put key: %w", err)
+	}
+	return nil
+}
+
+func (s *store) Delete(ctx context.Context, key []byte) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM entries WHERE key = ?", key)
+	if err != nil {
+		return fmt.Errorf("failed to delete key: %w", err)
+	}
+	return nil
+}
+
+// CompareAndSwap sets key to value if its current value is equal to old, or
+// if old is None and the key does not exist.
+func (s *store) CompareAndSwap(ctx context.Context, key []byte, old optional.Option[[]byte], value []byte, ttl optional.Option[time.Duration]) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	current, err := get(ctx, tx, key, time.Now())
+	if err != nil {
+		return false, err
+	}
+	expected, hasExpected := old.Get()
+	actual, hasActual := current.Get()
+	if hasExpected != hasActual || !bytes.Equal(expected, actual) {
+		return false, nil
+	}
+	_, err = tx.ExecContext(ctx, "INSERT OR REPLACE INTO entries (key, value, expires_at) VALUES (?, ?, ?)", key, value, expiresAt(ttl))
+	if err != nil {
+		return false, fmt.Errorf("failed to swap key: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit swap: %w", err)
+	}
+	return true, nil
+}
+
+func expiresAt(ttl optional.Option[time.Duration]) sql.NullInt64 {
+	if ttl, ok := ttl.Get(); ok {
+		return sql.NullInt64{Int64: time.Now().Add(ttl).UnixNano(), Valid: true}
+	}
+	return sql.NullInt64{}
+}
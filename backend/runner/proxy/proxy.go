@@ -3,14 +3,18 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/atomic"
 	"github.com/alecthomas/types/optional"
 	"github.com/alecthomas/types/result"
 	"github.com/puzpuzpuz/xsync/v3"
 
 	"github.com/block/ftl/backend/controller/observability"
+	asyncpb "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1"
+	asyncconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
 	ftldeployment "github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1"
 	ftldeploymentconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1/deploymentpbconnect"
 	ftllease "github.com/block/ftl/backend/protos/xyz/block/ftl/lease/v1"
@@ -19,6 +23,8 @@ import (
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/identity"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/model"
 	"github.com/block/ftl/internal/rpc"
@@ -27,6 +33,7 @@ import (
 
 var _ ftlv1connect.VerbServiceHandler = &Service{}
 var _ ftldeploymentconnect.DeploymentServiceHandler = &Service{}
+var _ asyncconnect.AsyncServiceHandler = &Service{}
 
 type moduleVerbService struct {
 	client     ftlv1connect.VerbServiceClient
@@ -36,20 +43,30 @@ type moduleVerbService struct {
 type Service struct {
 	controllerDeploymentService ftldeploymentconnect.DeploymentServiceClient
 	controllerLeaseService      ftlleaseconnect.LeaseServiceClient
+	asyncService                asyncconnect.AsyncServiceClient
 	moduleVerbService           *xsync.MapOf[string, moduleVerbService]
 	timelineClient              *timeline.Client
+	callerToken                 atomic.Value[string]
+	callerKeys                  *identity.KeySet
 }
 
-func New(controllerModuleService ftldeploymentconnect.DeploymentServiceClient, leaseClient ftlleaseconnect.LeaseServiceClient, timelineClient *timeline.Client) *Service {
+func New(controllerModuleService ftldeploymentconnect.DeploymentServiceClient, leaseClient ftlleaseconnect.LeaseServiceClient, asyncClient asyncconnect.AsyncServiceClient, timelineClient *timeline.Client) *Service {
 	proxy := &Service{
 		controllerDeploymentService: controllerModuleService,
 		controllerLeaseService:      leaseClient,
+		asyncService:                asyncClient,
 		moduleVerbService:           xsync.NewMapOf[string, moduleVerbService](),
 		timelineClient:              timelineClient,
+		callerKeys:                  identity.NewKeySet(),
 	}
 	return proxy
 }
 
+// CallerKeys are the keys that tokens identifying other deployments are verified with.
+func (r *Service) CallerKeys() identity.Verifier {
+	return r.callerKeys
+}
+
 func (r *Service) GetDeploymentContext(ctx context.Context, c *connect.Request[ftldeployment.GetDeploymentContextRequest], c2 *connect.ServerStream[ftldeployment.GetDeploymentContextResponse]) error {
 	moduleContext, err := r.controllerDeploymentService.GetDeploymentContext(ctx, connect.NewRequest(c.Msg))
 	logger := log.FromContext(ctx)
@@ -61,6 +78,13 @@ func (r *Service) GetDeploymentContext(ctx context.Context, c *connect.Request[f
 
 		if rcv {
 			logger.Debugf("Received DeploymentContext from module: %v", moduleContext.Msg())
+			// The caller token is attached to outbound calls by the proxy, so the module has no need for it.
+			r.callerToken.Store(moduleContext.Msg().CallerToken)
+			r.callerKeys.Set(slices.Map(moduleContext.Msg().CallerKeys, func(key *ftldeployment.GetDeploymentContextResponse_CallerKey) identity.PublicKey {
+				return identity.PublicKey{ID: key.Id, Key: key.PublicKey}
+			}))
+			moduleContext.Msg().CallerToken = ""
+			moduleContext.Msg().CallerKeys = nil
 			for _, route := range moduleContext.Msg().Routes {
 				logger.Debugf("Adding route: %s -> %s", route.Deployment, route.Uri)
 
@@ -151,11 +175,13 @@ func (r *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 		Request:       req.Msg,
 	}
 
-	originalResp, err := verbService.client.Call(ctx, headers.CopyRequestForForwarding(req))
+	forwarded := headers.CopyRequestForForwarding(req)
+	r.setCallerToken(forwarded.Header())
+	originalResp, err := verbService.client.Call(ctx, forwarded)
 	if err != nil {
 		callEvent.Response = result.Err[*ftlv1.CallResponse](err)
 		r.timelineClient.Publish(ctx, callEvent)
-		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb call failed"))
+		observability.Calls.RequestFailed(ctx, req.Msg.Verb, start, err)
 		return nil, fmt.Errorf("failed to proxy verb: %w", err)
 	}
 	resp := connect.NewResponse(originalResp.Msg)
@@ -164,3 +190,55 @@ func (r *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	observability.Calls.Request(ctx, req.Msg.Verb, start, optional.None[string]())
 	return resp, nil
 }
+
+// setCallerToken identifies the deployment to the receiver of an outbound request, replacing any token set by
+// the module.
+func (r *Service) setCallerToken(header http.Header) {
+	if token := r.callerToken.Load(); token != "" {
+		headers.SetCallerToken(header, token)
+	} else {
+		header.Del(headers.CallerTokenHeader)
+	}
+}
+
+func (r *Service) EnqueueCall(ctx context.Context, req *connect.Request[asyncpb.EnqueueCallRequest]) (*connect.Response[asyncpb.EnqueueCallResponse], error) {
+	forwarded := headers.CopyRequestForForwarding(req)
+	r.setCallerToken(forwarded.Header())
+	resp, err := r.asyncService.EnqueueCall(ctx, forwarded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to proxy async call: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *Service) GetCall(ctx context.Context, req *connect.Request[asyncpb.GetCallRequest]) (*connect.Response[asyncpb.GetCallResponse], error) {
+	resp, err := r.asyncService.GetCall(ctx, headers.CopyRequestForForwarding(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to proxy async call lookup: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *Service) ListCalls(ctx context.Context, req *connect.Request[asyncpb.ListCallsRequest]) (*connect.Response[asyncpb.ListCallsResponse], error) {
+	resp, err := r.asyncService.ListCalls(ctx, headers.CopyRequestForForwarding(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to proxy async call listing: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *Service) RetryCall(ctx context.Context, req *connect.Request[asyncpb.RetryCallRequest]) (*connect.Response[asyncpb.RetryCallResponse], error) {
+	resp, err := r.asyncService.RetryCall(ctx, headers.CopyRequestForForwarding(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to proxy async call retry: %w", err)
+	}
+	return resp, nil
+}
+
+func (r *Service) CancelCall(ctx context.Context, req *connect.Request[asyncpb.CancelCallRequest]) (*connect.Response[asyncpb.CancelCallResponse], error) {
+	resp, err := r.asyncService.CancelCall(ctx, headers.CopyRequestForForwarding(req))
+	if err != nil {
+		return nil, fmt.Errorf("failed to proxy async call cancellation: %w", err)
+	}
+	return resp, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/block/ftl/internal/channels"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/rpc/headers"
 )
 
 type consumer struct {
@@ -84,10 +85,16 @@ func newConsumer(moduleName string, verb *schema.Verb, subscriber *schema.Metada
 }
 
 func kafkaConsumerGroupID(moduleName string, verb *schema.Verb) string {
+	if id := verb.Runtime.Subscription.ConsumerGroupID; id != "" {
+		return id
+	}
 	return schema.RefKey{Module: moduleName, Name: verb.Name}.String()
 }
 
 func (c *consumer) kafkaTopicID() string {
+	if id := c.verb.Runtime.Subscription.TopicID; id != "" {
+		return id
+	}
 	return c.subscriber.Topic.String()
 }
 
@@ -206,9 +213,12 @@ func (c *consumer) call(ctx context.Context, body []byte, partition, offset int)
 		Name:   c.verb.Name,
 	}
 	req := &ftlv1.CallRequest{
-		Verb: schema.RefKey{Module: c.moduleName, Name: c.verb.Name}.ToProto(),
-		Body: body,
+		Verb:     schema.RefKey{Module: c.moduleName, Name: c.verb.Name}.ToProto(),
+		Body:     body,
+		Metadata: &ftlv1.Metadata{},
 	}
+	// Redeliveries of the same message share an idempotency key.
+	req.Metadata.Set(headers.IdempotencyKeyHeader, fmt.Sprintf("%s:%d:%d", c.subscriber.Topic, partition, offset))
 	consumeEvent := timeline.PubSubConsume{
 		DeploymentKey: c.deployment,
 		RequestKey:    optional.Some(requestKey.String()),
@@ -239,7 +249,7 @@ func (c *consumer) call(ctx context.Context, body []byte, partition, offset int)
 	if callErr != nil {
 		consumeEvent.Error = optional.Some(callErr.Error())
 		callEvent.Response = result.Err[*ftlv1.CallResponse](callErr)
-		observability.Calls.Request(ctx, req.Verb, start, optional.Some("verb call failed"))
+		observability.Calls.RequestFailed(ctx, req.Verb, start, callErr)
 		return callErr
 	}
 	callEvent.Response = result.Ok(resp.Msg)
@@ -31,27 +31,36 @@ import (
 
 	mysql "github.com/block/ftl-mysql-auth-proxy"
 	"github.com/block/ftl/backend/controller/artefacts"
+	asyncconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
 	ftldeploymentconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1/deploymentpbconnect"
+	kvconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1/kvpbconnect"
 	ftlleaseconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/lease/v1/leasepbconnect"
 	pubconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/publish/v1/publishpbconnect"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/backend/runner/idempotency"
+	"github.com/block/ftl/backend/runner/kv"
 	"github.com/block/ftl/backend/runner/observability"
 	"github.com/block/ftl/backend/runner/proxy"
 	"github.com/block/ftl/backend/runner/pubsub"
 	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/common/plugin"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/download"
 	"github.com/block/ftl/internal/dsn"
 	"github.com/block/ftl/internal/exec"
+	"github.com/block/ftl/internal/identity"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/model"
 	ftlobservability "github.com/block/ftl/internal/observability"
 	"github.com/block/ftl/internal/pgproxy"
 	"github.com/block/ftl/internal/rpc"
+	"github.com/block/ftl/internal/rpc/headers"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
 	"github.com/block/ftl/internal/unstoppable"
+	"github.com/block/ftl/internal/vault"
 )
 
 type Config struct {
@@ -60,6 +69,7 @@ type Config struct {
 	Key                   model.RunnerKey          `help:"Runner key (auto)."`
 	ControllerEndpoint    *url.URL                 `name:"ftl-endpoint" help:"Controller endpoint." env:"FTL_ENDPOINT" default:"http://127.0.0.1:8892"`
 	LeaseEndpoint         *url.URL                 `name:"ftl-lease-endpoint" help:"Lease endpoint endpoint." env:"FTL_LEASE_ENDPOINT" default:"http://127.0.0.1:8895"`
+	AsyncEndpoint         *url.URL                 `name:"ftl-async-endpoint" help:"Async call service endpoint." env:"FTL_ASYNC_ENDPOINT" default:"http://127.0.0.1:8897"`
 	TimelineEndpoint      *url.URL                 `help:"Timeline endpoint." env:"FTL_TIMELINE_ENDPOINT" default:"http://127.0.0.1:8894"`
 	TemplateDir           string                   `help:"Template directory to copy into each deployment, if any." type:"existingdir"`
 	DeploymentDir         string                   `help:"Directory to store deployments in." default:"${deploymentdir}"`
@@ -67,9 +77,13 @@ type Config struct {
 	HeartbeatPeriod       time.Duration            `help:"Minimum period between heartbeats." default:"3s"`
 	HeartbeatJitter       time.Duration            `help:"Jitter to add to heartbeat period." default:"2s"`
 	Deployment            model.DeploymentKey      `help:"The deployment this runner is for." env:"FTL_DEPLOYMENT"`
+	Credential            string                   `help:"Credential that identifies the deployment of this runner to the controller." env:"FTL_RUNNER_CREDENTIAL"`
 	DebugPort             int                      `help:"The port to use for debugging." env:"FTL_DEBUG_PORT"`
 	DevEndpoint           optional.Option[url.URL] `help:"An existing endpoint to connect to in development mode" hidden:""`
 	DevRunnerInfoFile     optional.Option[string]  `help:"The path to a file that we write dev endpoint information to." hidden:""`
+	// CaptureSensitivePayloads reports whether to record sensitive fields in timeline payloads. It is only set by local development clusters.
+	CaptureSensitivePayloads func() bool  `kong:"-"`
+	Vault                    vault.Config `embed:"" prefix:"vault-"`
 }
 
 func Start(ctx context.Context, config Config, storage *artefacts.OCIArtefactService) error {
@@ -99,6 +113,16 @@ func Start(ctx context.Context, config Config, storage *artefacts.OCIArtefactSer
 
 	controllerClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, config.ControllerEndpoint.String(), log.Error)
 
+	if config.Vault.Enabled() {
+		vaultClient, err := vault.New(config.Vault)
+		if err != nil {
+			observability.Runner.StartupFailed(ctx)
+			return fmt.Errorf("failed to create vault client: %w", err)
+		}
+		// Leased database credentials are used by the database proxies.
+		ctx = vault.ContextWithDatabaseCredentials(ctx, vault.NewDatabaseCredentials(ctx, vaultClient))
+	}
+
 	key := config.Key
 	if key.IsZero() {
 		key = model.NewRunnerKey(config.Bind.Hostname(), config.Bind.Port())
@@ -131,10 +155,17 @@ func Start(ctx context.Context, config Config, storage *artefacts.OCIArtefactSer
 		return fmt.Errorf("failed to get module: %w", err)
 	}
 
+	dbAddresses := xsync.NewMapOf[string, string]()
+	if err := svc.setupSQLite(ctx, module, dbAddresses); err != nil {
+		return err
+	}
+	if err := setupObjectStores(module); err != nil {
+		return err
+	}
+
 	startedLatch := &sync.WaitGroup{}
 	startedLatch.Add(2)
 	g, ctx := errgroup.WithContext(ctx)
-	dbAddresses := xsync.NewMapOf[string, string]()
 	g.Go(func() error {
 		return svc.startPgProxy(ctx, module, startedLatch, dbAddresses)
 	})
@@ -239,6 +270,23 @@ type deployment struct {
 	cmd      optional.Option[exec.Cmd]
 	endpoint *url.URL // The endpoint the plugin is listening on.
 	client   ftlv1connect.VerbServiceClient
+	module   *schema.Module
+}
+
+// verb returns the declaration of a verb in the deployed module.
+func (d *deployment) verb(ref *schemapb.Ref) optional.Option[*schema.Verb] {
+	if ref == nil {
+		return optional.None[*schema.Verb]()
+	}
+	decl := d.module.Resolve(schema.Ref{Name: ref.Name})
+	if decl == nil {
+		return optional.None[*schema.Verb]()
+	}
+	verb, ok := decl.Symbol.(*schema.Verb)
+	if !ok {
+		return optional.None[*schema.Verb]()
+	}
+	return optional.Some(verb)
 }
 
 type Service struct {
@@ -259,6 +307,8 @@ type Service struct {
 	devRunnerInfoFile   optional.Option[string]
 	proxy               *proxy.Service
 	pubSub              *pubsub.Service
+	kv                  *kv.Service
+	idempotency         *idempotency.Cache
 	proxyBindAddress    *url.URL
 }
 
@@ -267,7 +317,18 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnavailable, errors.New("no deployment"))
 	}
-	response, err := deployment.client.Call(ctx, req)
+	if err := s.checkCallerCanCall(ctx, deployment, req); err != nil {
+		return nil, err
+	}
+	if verb, ok := deployment.verb(req.Msg.Verb).Get(); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = rpc.ContextWithVerbTimeout(ctx, verb)
+		defer cancel()
+	}
+	response, err := s.idempotency.Call(ctx, req, deployment.client.Call)
+	if rpc.IsDeadlineExceeded(err) {
+		err = connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("call to verb %s timed out: %w", schema.RefFromProto(req.Msg.Verb), err))
+	}
 	if err != nil {
 		deploymentLogger := s.getDeploymentLogger(ctx, deployment.key)
 		deploymentLogger.Errorf(err, "Call to deployments %s failed to perform gRPC call", deployment.key)
@@ -282,6 +343,25 @@ func (s *Service) Call(ctx context.Context, req *connect.Request[ftlv1.CallReque
 	return connect.NewResponse(response.Msg), nil
 }
 
+// checkCallerCanCall denies calls from other modules to verbs that are not exported.
+//
+// The calling module is taken from the caller token, as the caller headers can be forged. Callers
+// without a valid token are treated as another module.
+func (s *Service) checkCallerCanCall(ctx context.Context, deployment *deployment, req *connect.Request[ftlv1.CallRequest]) error {
+	callers, err := headers.GetCallers(req.Header())
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	caller := identity.ResolveCaller(ctx, s.proxy.CallerKeys(), req.Header(), callers)
+	if identity.MayCallUnexported(caller, deployment.module.Name) {
+		return nil
+	}
+	if verb, ok := deployment.verb(req.Msg.Verb).Get(); ok && !verb.IsExported() {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("verb %q is not exported", schema.RefFromProto(req.Msg.Verb)))
+	}
+	return nil
+}
+
 func (s *Service) Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error) {
 	return connect.NewResponse(&ftlv1.PingResponse{}), nil
 }
@@ -337,13 +417,23 @@ func (s *Service) deploy(ctx context.Context, key model.DeploymentKey, module *s
 		}
 	}
 
-	deploymentServiceClient := rpc.Dial(ftldeploymentconnect.NewDeploymentServiceClient, s.config.ControllerEndpoint.String(), log.Error)
+	// The deployment service serves the deployment's secrets, so the runner identifies its deployment to it.
+	deploymentClientOptions := []connect.ClientOption{}
+	if s.config.Credential != "" {
+		deploymentClientOptions = append(deploymentClientOptions, connect.WithInterceptors(credentialInterceptor{credential: s.config.Credential}))
+	}
+	deploymentServiceClient := rpc.Dial(ftldeploymentconnect.NewDeploymentServiceClient, s.config.ControllerEndpoint.String(), log.Error, deploymentClientOptions...)
 	ctx = rpc.ContextWithClient(ctx, deploymentServiceClient)
 
 	leaseServiceClient := rpc.Dial(ftlleaseconnect.NewLeaseServiceClient, s.config.LeaseEndpoint.String(), log.Error)
+	asyncServiceClient := rpc.Dial(asyncconnect.NewAsyncServiceClient, s.config.AsyncEndpoint.String(), log.Error)
 
-	timelineClient := timeline.NewClient(ctx, s.config.TimelineEndpoint)
-	s.proxy = proxy.New(deploymentServiceClient, leaseServiceClient, timelineClient)
+	schemaClient := rpc.Dial(ftlv1connect.NewSchemaServiceClient, s.config.ControllerEndpoint.String(), log.Error)
+	timelineClient := timeline.NewClient(ctx, s.config.TimelineEndpoint,
+		timeline.WithSchema(schemaeventsource.New(ctx, schemaClient).ViewOnly()),
+		timeline.WithSensitivePayloads(s.config.CaptureSensitivePayloads),
+	)
+	s.proxy = proxy.New(deploymentServiceClient, leaseServiceClient, asyncServiceClient, timelineClient)
 
 	pubSub, err := pubsub.New(module, key, s, timelineClient)
 	if err != nil {
@@ -352,6 +442,20 @@ func (s *Service) deploy(ctx context.Context, key model.DeploymentKey, module *s
 	}
 	s.pubSub = pubSub
 
+	kvService, err := kv.New(ctx, module, key, timelineClient)
+	if err != nil {
+		observability.Deployment.Failure(ctx, optional.Some(key.String()))
+		return fmt.Errorf("failed to create kv service: %w", err)
+	}
+	s.kv = kvService
+
+	idempotencyCache, err := idempotency.New(module)
+	if err != nil {
+		observability.Deployment.Failure(ctx, optional.Some(key.String()))
+		return fmt.Errorf("failed to create idempotency cache: %w", err)
+	}
+	s.idempotency = idempotencyCache
+
 	parse, err := url.Parse("http://127.0.0.1:0")
 	if err != nil {
 		return fmt.Errorf("failed to parse url: %w", err)
@@ -360,7 +464,9 @@ func (s *Service) deploy(ctx context.Context, key model.DeploymentKey, module *s
 		rpc.GRPC(ftlv1connect.NewVerbServiceHandler, s.proxy),
 		rpc.GRPC(ftldeploymentconnect.NewDeploymentServiceHandler, s.proxy),
 		rpc.GRPC(ftlleaseconnect.NewLeaseServiceHandler, s.proxy),
+		rpc.GRPC(asyncconnect.NewAsyncServiceHandler, s.proxy),
 		rpc.GRPC(pubconnect.NewPublishServiceHandler, s.pubSub),
+		rpc.GRPC(kvconnect.NewKVServiceHandler, s.kv),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create server: %w", err)
@@ -437,6 +543,7 @@ func (s *Service) deploy(ctx context.Context, key model.DeploymentKey, module *s
 		dep = s.makeDeployment(cmdCtx, key, deployment)
 	}
 
+	dep.module = module
 	s.readyTime.Store(time.Now().Add(time.Second * 2)) // Istio is a bit flakey, add a small delay for readiness
 	s.deployment.Store(optional.Some(dep))
 	logger.Debugf("Deployed %s", key)
@@ -483,6 +590,11 @@ func (s *Service) Close() error {
 		}
 	}
 	s.deployment.Store(optional.None[*deployment]())
+	if s.kv != nil {
+		if err := s.kv.Close(); err != nil {
+			return fmt.Errorf("failed to close kv stores: %w", err)
+		}
+	}
 	return nil
 
 }
@@ -721,6 +833,42 @@ func (s *Service) startMySQLProxy(ctx context.Context, module *schema.Module, la
 	return nil
 }
 
+// setupSQLite exposes the DSNs of any SQLite databases to the module. SQLite
+// databases are local files, so unlike Postgres and MySQL no proxy is needed.
+func (s *Service) setupSQLite(ctx context.Context, module *schema.Module, addresses *xsync.MapOf[string, string]) error {
+	logger := log.FromContext(ctx)
+	for db := range slices.FilterVariants[*schema.Database](module.Decls) {
+		if db.Type != schema.SQLiteDatabaseType {
+			continue
+		}
+		if db.Runtime == nil || db.Runtime.Connections == nil {
+			return fmt.Errorf("sqlite database %s has not been provisioned", db.Name)
+		}
+		dsn, err := dsn.ResolveSQLiteDSN(ctx, db.Runtime.Connections.Write)
+		if err != nil {
+			return fmt.Errorf("failed to resolve sqlite DSN: %w", err)
+		}
+		logger.Debugf("Using SQLite database for %s: %s", db.Name, dsn)
+		addresses.Store(db.Name, dsn)
+		os.Setenv(strings.ToUpper("FTL_SQLITE_DSN_"+db.Name), dsn)
+	}
+	return nil
+}
+
+// setupObjectStores exposes the location of any object stores to the module.
+func setupObjectStores(module *schema.Module) error {
+	for store := range slices.FilterVariants[*schema.ObjectStore](module.Decls) {
+		if store.Runtime == nil {
+			return fmt.Errorf("object store %s has not been provisioned", store.Name)
+		}
+		name := strings.ToUpper(store.Name)
+		os.Setenv("FTL_OBJECTSTORE_ENDPOINT_"+name, store.Runtime.Endpoint)
+		os.Setenv("FTL_OBJECTSTORE_BUCKET_"+name, store.Runtime.Bucket)
+		os.Setenv("FTL_OBJECTSTORE_REGION_"+name, store.Runtime.Region)
+	}
+	return nil
+}
+
 var _ mysql.Logger = (*mysqlLogger)(nil)
 
 type mysqlLogger struct {
@@ -7,12 +7,14 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/alecthomas/atomic"
+	"github.com/alecthomas/types/optional"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/rpc"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
 )
 
 const (
@@ -26,14 +28,35 @@ type Client struct {
 	entries          chan *timelinepb.CreateEventsRequest_EventEntry
 	lastDroppedError atomic.Value[time.Time]
 	lastFailedError  atomic.Value[time.Time]
+
+	schema                   optional.Option[schemaeventsource.View]
+	captureSensitivePayloads func() bool
+}
+
+type ClientOption func(*Client)
+
+// WithSchema redacts fields marked as sensitive in the schema from the payloads of
+// published call, ingress and publish events.
+func WithSchema(view schemaeventsource.View) ClientOption {
+	return func(c *Client) { c.schema = optional.Some(view) }
+}
+
+// WithSensitivePayloads records payloads in full, including sensitive fields, while capture returns true.
+//
+// This must only be used in development clusters.
+func WithSensitivePayloads(capture func() bool) ClientOption {
+	return func(c *Client) { c.captureSensitivePayloads = capture }
 }
 
-func NewClient(ctx context.Context, endpoint *url.URL) *Client {
+func NewClient(ctx context.Context, endpoint *url.URL, options ...ClientOption) *Client {
 	c := rpc.Dial(timelinepbconnect.NewTimelineServiceClient, endpoint.String(), log.Error)
 	client := &Client{
 		TimelineServiceClient: c,
 		entries:               make(chan *timelinepb.CreateEventsRequest_EventEntry, 1000),
 	}
+	for _, option := range options {
+		option(client)
+	}
 	go client.processEvents(ctx)
 	return client
 }
@@ -47,11 +70,16 @@ type Event interface {
 // Publish asynchronously enqueues an event for publication to the timeline.
 func (c *Client) Publish(ctx context.Context, event Event) {
 	entry, err := event.ToEntry()
-	entry.Timestamp = timestamppb.New(time.Now())
 	if err != nil {
 		log.FromContext(ctx).Warnf("failed to create request to publish %T event: %v", event, err)
 		return
 	}
+	entry.Timestamp = timestamppb.New(time.Now())
+	if view, ok := c.schema.Get(); ok && (c.captureSensitivePayloads == nil || !c.captureSensitivePayloads()) {
+		if sch := view.Get(); sch != nil {
+			redactEntry(sch, entry)
+		}
+	}
 	select {
 	case c.entries <- entry:
 	default:
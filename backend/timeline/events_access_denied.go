@@ -0,0 +1,54 @@
+package timeline
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/internal/rpc"
+)
+
+// AccessDenied is published when a request is denied by the cluster's access control policy.
+type AccessDenied struct {
+	Time       time.Time
+	Procedure  string
+	Identity   string
+	Permission string
+	Modules    []string
+	Reason     string
+}
+
+var _ Event = AccessDenied{}
+
+func (AccessDenied) clientEvent() {}
+func (a AccessDenied) ToEntry() (*timelinepb.CreateEventsRequest_EventEntry, error) {
+	return &timelinepb.CreateEventsRequest_EventEntry{
+		Entry: &timelinepb.CreateEventsRequest_EventEntry_AccessDenied{
+			AccessDenied: &timelinepb.AccessDeniedEvent{
+				Timestamp:  timestamppb.New(a.Time),
+				Procedure:  a.Procedure,
+				Identity:   a.Identity,
+				Permission: a.Permission,
+				Modules:    a.Modules,
+				Reason:     a.Reason,
+			},
+		},
+	}, nil
+}
+
+// PublishAccessDenied returns a function that publishes requests denied by an
+// [rpc.AuthorizationInterceptor] to the timeline.
+func PublishAccessDenied(client *Client) func(ctx context.Context, denied rpc.AccessDenied) {
+	return func(ctx context.Context, denied rpc.AccessDenied) {
+		client.Publish(ctx, AccessDenied{
+			Time:       time.Now(),
+			Procedure:  denied.Procedure,
+			Identity:   denied.Identity,
+			Permission: string(denied.Permission),
+			Modules:    denied.Modules,
+			Reason:     denied.Reason,
+		})
+	}
+}
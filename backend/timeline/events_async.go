@@ -18,6 +18,7 @@ const (
 	AsyncExecuteEventTypeUnkown AsyncExecuteEventType = "unknown"
 	AsyncExecuteEventTypeCron   AsyncExecuteEventType = "cron"
 	AsyncExecuteEventTypePubSub AsyncExecuteEventType = "pubsub"
+	AsyncExecuteEventTypeCall   AsyncExecuteEventType = "call"
 )
 
 func asyncExecuteEventTypeToProto(eventType AsyncExecuteEventType) timelinepb.AsyncExecuteEventType {
@@ -26,6 +27,8 @@ func asyncExecuteEventTypeToProto(eventType AsyncExecuteEventType) timelinepb.As
 		return timelinepb.AsyncExecuteEventType_ASYNC_EXECUTE_EVENT_TYPE_CRON
 	case AsyncExecuteEventTypePubSub:
 		return timelinepb.AsyncExecuteEventType_ASYNC_EXECUTE_EVENT_TYPE_PUBSUB
+	case AsyncExecuteEventTypeCall:
+		return timelinepb.AsyncExecuteEventType_ASYNC_EXECUTE_EVENT_TYPE_ASYNC_CALL
 	case AsyncExecuteEventTypeUnkown:
 		return timelinepb.AsyncExecuteEventType_ASYNC_EXECUTE_EVENT_TYPE_UNSPECIFIED
 
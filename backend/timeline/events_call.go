@@ -13,6 +13,7 @@ import (
 	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/rpc"
 )
 
 type Call struct {
@@ -33,10 +34,12 @@ func (c Call) ToEntry() (*timelinepb.CreateEventsRequest_EventEntry, error) {
 	var respError *string
 	var responseBody []byte
 	var stack *string
+	var timedOut bool
 	resp, err := c.Response.Result()
 	if err != nil {
 		errStr := err.Error()
 		respError = &errStr
+		timedOut = rpc.IsDeadlineExceeded(err)
 	} else {
 		responseBody = resp.GetBody()
 		if callError := resp.GetError(); callError != nil {
@@ -62,6 +65,7 @@ func (c Call) ToEntry() (*timelinepb.CreateEventsRequest_EventEntry, error) {
 				Duration:           durationpb.New(time.Since(c.StartTime)),
 				Request:            string(c.Request.GetBody()),
 				Stack:              stack,
+				TimedOut:           timedOut,
 			},
 		},
 	}, nil
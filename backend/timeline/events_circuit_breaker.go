@@ -0,0 +1,156 @@
+package timeline
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/circuitbreaker"
+	"github.com/block/ftl/internal/log"
+)
+
+// CircuitBreaker is published when a circuit breaker changes state.
+type CircuitBreaker struct {
+	circuitbreaker.Transition
+	// Service holding the breaker, as each service that routes calls has its own breakers.
+	Service string
+	// Instance of the service holding the breaker, as each replica has its own breakers.
+	Instance string
+}
+
+var _ Event = CircuitBreaker{}
+
+func (CircuitBreaker) clientEvent() {}
+func (c CircuitBreaker) ToEntry() (*timelinepb.CreateEventsRequest_EventEntry, error) {
+	verb := schema.Ref{Module: c.Key.Verb.Module, Name: c.Key.Verb.Name}
+	return &timelinepb.CreateEventsRequest_EventEntry{
+		Entry: &timelinepb.CreateEventsRequest_EventEntry_CircuitBreaker{
+			CircuitBreaker: &timelinepb.CircuitBreakerEvent{
+				DeploymentKey: c.Key.Deployment.String(),
+				VerbRef:       verb.ToProto(), //nolint:forcetypeassert
+				Timestamp:     timestamppb.New(c.Time),
+				PreviousState: circuitBreakerStateToProto(c.From),
+				State:         circuitBreakerStateToProto(c.To),
+				Requests:      int64(c.Requests),
+				Failures:      int64(c.Failures),
+				Service:       c.Service,
+				Cooldown:      durationpb.New(c.Cooldown),
+				Instance:      c.Instance,
+			},
+		},
+	}, nil
+}
+
+func circuitBreakerStateToProto(state circuitbreaker.State) ftlv1.CircuitBreakerState {
+	switch state {
+	case circuitbreaker.Closed:
+		return ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED
+	case circuitbreaker.Open:
+		return ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN
+	case circuitbreaker.HalfOpen:
+		return ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN
+	default:
+		panic(fmt.Sprintf("unknown circuit breaker state %s", state))
+	}
+}
+
+// circuitBreakerHistoryLimit is the number of recent transitions considered when determining breaker states.
+const circuitBreakerHistoryLimit = 1000
+
+// CircuitBreakers returns the state of each circuit breaker in the given deployments, as of its most recent transition.
+//
+// Breakers are held by each instance of each service that makes calls, so the state of a verb's breaker is returned
+// for each instance. A breaker only changes state when it is called, so an open breaker whose cooldown has elapsed is
+// returned as half-open from the end of its cooldown, because it allows the next call through.
+func CircuitBreakers(ctx context.Context, client timelinepbconnect.TimelineServiceClient, deployments []string) ([]*ftlv1.CircuitBreakerStatus, error) {
+	if len(deployments) == 0 {
+		return nil, nil
+	}
+	resp, err := client.GetTimeline(ctx, connect.NewRequest(&timelinepb.GetTimelineRequest{
+		Filters: []*timelinepb.GetTimelineRequest_Filter{
+			{Filter: &timelinepb.GetTimelineRequest_Filter_EventTypes{EventTypes: &timelinepb.GetTimelineRequest_EventTypeFilter{
+				EventTypes: []timelinepb.EventType{timelinepb.EventType_EVENT_TYPE_CIRCUIT_BREAKER},
+			}}},
+			{Filter: &timelinepb.GetTimelineRequest_Filter_Deployments{Deployments: &timelinepb.GetTimelineRequest_DeploymentFilter{
+				Deployments: deployments,
+			}}},
+		},
+		Limit: circuitBreakerHistoryLimit,
+		Order: timelinepb.GetTimelineRequest_ORDER_DESC,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get circuit breaker events: %w", err)
+	}
+	now := time.Now()
+	seen := map[string]bool{}
+	out := []*ftlv1.CircuitBreakerStatus{}
+	for _, event := range resp.Msg.Events {
+		entry, ok := event.Entry.(*timelinepb.Event_CircuitBreaker)
+		if !ok {
+			continue
+		}
+		cb := entry.CircuitBreaker
+		key := cb.DeploymentKey + ":" + schema.RefFromProto(cb.VerbRef).String() + ":" + cb.Service + ":" + cb.Instance
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		state, since := cb.State, cb.Timestamp
+		// Breakers published by older versions have no cooldown.
+		if cooldown := cb.GetCooldown().AsDuration(); state == ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN && cooldown > 0 {
+			if retry := cb.Timestamp.AsTime().Add(cooldown); !now.Before(retry) {
+				state, since = ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN, timestamppb.New(retry)
+			}
+		}
+		out = append(out, &ftlv1.CircuitBreakerStatus{
+			DeploymentKey: cb.DeploymentKey,
+			VerbRef:       cb.VerbRef,
+			State:         state,
+			Since:         since,
+			Requests:      cb.Requests,
+			Failures:      cb.Failures,
+			Service:       cb.Service,
+			Instance:      cb.Instance,
+		})
+	}
+	return out, nil
+}
+
+// PublishCircuitBreakerTransitions returns a function, suitable for passing to
+// [circuitbreaker.New], that logs each transition of a service's breakers and
+// publishes it to the timeline.
+func PublishCircuitBreakerTransitions(ctx context.Context, client *Client, service string) func(circuitbreaker.Transition) {
+	logger := log.FromContext(ctx)
+	instance := breakersInstance()
+	return func(t circuitbreaker.Transition) {
+		if t.To == circuitbreaker.Open {
+			logger.Warnf("Circuit breaker for %s opened after %d of %d calls failed", t.Key, t.Failures, t.Requests)
+		} else {
+			logger.Infof("Circuit breaker for %s is %s", t.Key, t.To)
+		}
+		client.Publish(ctx, CircuitBreaker{Transition: t, Service: service, Instance: instance})
+	}
+}
+
+// breakersInstance returns an ID for a set of breakers, which is the host name with a random suffix,
+// because several instances of a service may run on the same host, such as in a development cluster.
+func breakersInstance() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	suffix := make([]byte, 3)
+	_, _ = rand.Read(suffix) //nolint:errcheck
+	return hostname + "-" + hex.EncodeToString(suffix)
+}
@@ -0,0 +1,98 @@
+package timeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/circuitbreaker"
+	"github.com/block/ftl/internal/model"
+)
+
+// serviceClient reads from an in-memory timeline service.
+type serviceClient struct {
+	timelinepbconnect.TimelineServiceClient
+	service *service
+}
+
+func (c serviceClient) GetTimeline(ctx context.Context, req *connect.Request[timelinepb.GetTimelineRequest]) (*connect.Response[timelinepb.GetTimelineResponse], error) {
+	return c.service.GetTimeline(ctx, req)
+}
+
+func TestCircuitBreakersArePerService(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc := &service{}
+	deployment := model.NewDeploymentKey("echo")
+	key := circuitbreaker.Key{Deployment: deployment, Verb: schema.RefKey{Module: "echo", Name: "echo"}}
+	now := time.Now()
+
+	entries := []*timelinepb.CreateEventsRequest_EventEntry{}
+	for _, transition := range []CircuitBreaker{
+		{Transition: circuitbreaker.Transition{Key: key, From: circuitbreaker.Closed, To: circuitbreaker.Open, Time: now}, Service: "ingress"},
+		{Transition: circuitbreaker.Transition{Key: key, From: circuitbreaker.Closed, To: circuitbreaker.Open, Time: now.Add(time.Second)}, Service: "cron"},
+		{Transition: circuitbreaker.Transition{Key: key, From: circuitbreaker.Open, To: circuitbreaker.HalfOpen, Time: now.Add(2 * time.Second)}, Service: "cron"},
+	} {
+		entry, err := transition.ToEntry()
+		assert.NoError(t, err)
+		entry.Timestamp = entry.GetCircuitBreaker().Timestamp
+		entries = append(entries, entry)
+	}
+	_, err := svc.CreateEvents(ctx, connect.NewRequest(&timelinepb.CreateEventsRequest{Entries: entries}))
+	assert.NoError(t, err)
+
+	statuses, err := CircuitBreakers(ctx, serviceClient{service: svc}, []string{deployment.String()})
+	assert.NoError(t, err)
+	states := map[string]ftlv1.CircuitBreakerState{}
+	for _, status := range statuses {
+		states[status.Service] = status.State
+	}
+	assert.Equal(t, map[string]ftlv1.CircuitBreakerState{
+		"ingress": ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN,
+		"cron":    ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN,
+	}, states)
+}
+
+func TestCircuitBreakersArePerInstanceAndReopenAfterCooldown(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	svc := &service{}
+	deployment := model.NewDeploymentKey("echo")
+	key := circuitbreaker.Key{Deployment: deployment, Verb: schema.RefKey{Module: "echo", Name: "echo"}}
+	opened := time.Now().Add(-time.Minute)
+
+	entries := []*timelinepb.CreateEventsRequest_EventEntry{}
+	for _, transition := range []CircuitBreaker{
+		{Transition: circuitbreaker.Transition{Key: key, From: circuitbreaker.Closed, To: circuitbreaker.Open, Time: opened, Cooldown: 30 * time.Second}, Service: "ingress", Instance: "a"},
+		{Transition: circuitbreaker.Transition{Key: key, From: circuitbreaker.Closed, To: circuitbreaker.Open, Time: opened, Cooldown: time.Hour}, Service: "ingress", Instance: "b"},
+	} {
+		entry, err := transition.ToEntry()
+		assert.NoError(t, err)
+		entry.Timestamp = entry.GetCircuitBreaker().Timestamp
+		entries = append(entries, entry)
+	}
+	_, err := svc.CreateEvents(ctx, connect.NewRequest(&timelinepb.CreateEventsRequest{Entries: entries}))
+	assert.NoError(t, err)
+
+	statuses, err := CircuitBreakers(ctx, serviceClient{service: svc}, []string{deployment.String()})
+	assert.NoError(t, err)
+	states := map[string]ftlv1.CircuitBreakerState{}
+	since := map[string]time.Time{}
+	for _, status := range statuses {
+		states[status.Instance] = status.State
+		since[status.Instance] = status.Since.AsTime()
+	}
+	assert.Equal(t, map[string]ftlv1.CircuitBreakerState{
+		"a": ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN,
+		"b": ftlv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN,
+	}, states)
+	assert.True(t, since["a"].Equal(opened.Add(30*time.Second)), "half-open from the end of the cooldown")
+	assert.True(t, since["b"].Equal(opened))
+}
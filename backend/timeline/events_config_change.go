@@ -0,0 +1,79 @@
+package timeline
+
+import (
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/configuration"
+)
+
+type ConfigChangeOperation string
+
+const (
+	ConfigChangeOperationSet   ConfigChangeOperation = "set"
+	ConfigChangeOperationUnset ConfigChangeOperation = "unset"
+)
+
+// ToProto converts the operation to its protobuf representation.
+func (op ConfigChangeOperation) ToProto() ftlv1.ConfigChangeOperation {
+	switch op {
+	case ConfigChangeOperationSet:
+		return ftlv1.ConfigChangeOperation_CONFIG_CHANGE_OPERATION_SET
+	case ConfigChangeOperationUnset:
+		return ftlv1.ConfigChangeOperation_CONFIG_CHANGE_OPERATION_UNSET
+	default:
+		panic("unknown config change operation")
+	}
+}
+
+// ConfigChange is published when a configuration value or secret is set or unset.
+//
+// The value of a secret is never published, only its fingerprint.
+type ConfigChange struct {
+	// ID of the change in the admin service's audit history.
+	ID        int64
+	Ref       configuration.Ref
+	Secret    bool
+	Time      time.Time
+	Actor     string
+	Provider  configuration.ProviderKey
+	Operation ConfigChangeOperation
+	// Value is the JSON encoded value that was set.
+	Value []byte
+	// Fingerprint of the value that was set, keyed by the admin service.
+	Fingerprint  string
+	RestoredFrom optional.Option[int64]
+}
+
+var _ Event = ConfigChange{}
+
+func (ConfigChange) clientEvent() {}
+func (c ConfigChange) ToEntry() (*timelinepb.CreateEventsRequest_EventEntry, error) {
+	ref := schema.Ref{Module: c.Ref.Module.Default(""), Name: c.Ref.Name}
+	event := &timelinepb.ConfigChangeEvent{
+		Ref:          ref.ToProto(), //nolint:forcetypeassert
+		Secret:       c.Secret,
+		Timestamp:    timestamppb.New(c.Time),
+		Actor:        c.Actor,
+		Provider:     string(c.Provider),
+		Operation:    c.Operation.ToProto(),
+		RestoredFrom: c.RestoredFrom.Ptr(),
+		ChangeId:     c.ID,
+	}
+	if c.Operation == ConfigChangeOperationSet {
+		event.Fingerprint = &c.Fingerprint
+		if !c.Secret {
+			event.Value = c.Value
+		}
+	}
+	return &timelinepb.CreateEventsRequest_EventEntry{
+		Entry: &timelinepb.CreateEventsRequest_EventEntry_ConfigChange{
+			ConfigChange: event,
+		},
+	}, nil
+}
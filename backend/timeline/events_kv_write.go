@@ -0,0 +1,68 @@
+package timeline
+
+import (
+	"time"
+
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/model"
+)
+
+type KVWriteOperation string
+
+const (
+	KVWriteOperationPut            KVWriteOperation = "put"
+	KVWriteOperationDelete         KVWriteOperation = "delete"
+	KVWriteOperationCompareAndSwap KVWriteOperation = "compare-and-swap"
+)
+
+func kvWriteOperationToProto(op KVWriteOperation) timelinepb.KVWriteOperation {
+	switch op {
+	case KVWriteOperationPut:
+		return timelinepb.KVWriteOperation_KV_WRITE_OPERATION_PUT
+	case KVWriteOperationDelete:
+		return timelinepb.KVWriteOperation_KV_WRITE_OPERATION_DELETE
+	case KVWriteOperationCompareAndSwap:
+		return timelinepb.KVWriteOperation_KV_WRITE_OPERATION_COMPARE_AND_SWAP
+	default:
+		panic("unknown kv write operation")
+	}
+}
+
+type KVWrite struct {
+	DeploymentKey model.DeploymentKey
+	RequestKey    optional.Option[string]
+	Time          time.Time
+	SourceVerb    schema.Ref
+	KV            schema.Ref
+	Operation     KVWriteOperation
+	Key           []byte
+	Applied       bool
+	Error         optional.Option[string]
+}
+
+var _ Event = KVWrite{}
+
+func (KVWrite) clientEvent() {}
+func (k KVWrite) ToEntry() (*timelinepb.CreateEventsRequest_EventEntry, error) {
+	return &timelinepb.CreateEventsRequest_EventEntry{
+		Entry: &timelinepb.CreateEventsRequest_EventEntry_KvWrite{
+			KvWrite: &timelinepb.KVWriteEvent{
+				DeploymentKey: k.DeploymentKey.String(),
+				RequestKey:    k.RequestKey.Ptr(),
+				VerbRef:       (&k.SourceVerb).ToProto(), //nolint:forcetypeassert
+				Timestamp:     timestamppb.New(k.Time),
+				Duration:      durationpb.New(time.Since(k.Time)),
+				Kv:            (&k.KV).ToProto(), //nolint:forcetypeassert
+				Operation:     kvWriteOperationToProto(k.Operation),
+				Key:           string(k.Key),
+				Applied:       k.Applied,
+				Error:         k.Error.Ptr(),
+			},
+		},
+	}, nil
+}
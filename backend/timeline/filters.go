@@ -68,6 +68,20 @@ func FilterModule(filters []*timelinepb.GetTimelineRequest_ModuleFilter) Timelin
 		case *timelinepb.Event_PubsubConsume:
 			module = *entry.PubsubConsume.DestVerbModule
 			verb = *entry.PubsubConsume.DestVerbName
+		case *timelinepb.Event_KvWrite:
+			module = entry.KvWrite.VerbRef.Module
+			verb = entry.KvWrite.VerbRef.Name
+		case *timelinepb.Event_CircuitBreaker:
+			module = entry.CircuitBreaker.VerbRef.Module
+			verb = entry.CircuitBreaker.VerbRef.Name
+		case *timelinepb.Event_ConfigChange:
+			module = entry.ConfigChange.Ref.Module
+		case *timelinepb.Event_AccessDenied:
+			// Allow event if any module filter without a verb matches any of its modules.
+			_, ok := islices.Find(filters, func(f *timelinepb.GetTimelineRequest_ModuleFilter) bool {
+				return f.Verb == nil && slices.Contains(entry.AccessDenied.Modules, f.Module)
+			})
+			return ok
 		case *timelinepb.Event_Log, *timelinepb.Event_DeploymentCreated, *timelinepb.Event_DeploymentUpdated, *timelinepb.Event_CronScheduled:
 			// Block all other event types.
 			return false
@@ -113,6 +127,12 @@ func FilterDeployments(filters []*timelinepb.GetTimelineRequest_DeploymentFilter
 			deployment = entry.PubsubPublish.DeploymentKey
 		case *timelinepb.Event_PubsubConsume:
 			deployment = entry.PubsubConsume.DeploymentKey
+		case *timelinepb.Event_KvWrite:
+			deployment = entry.KvWrite.DeploymentKey
+		case *timelinepb.Event_CircuitBreaker:
+			deployment = entry.CircuitBreaker.DeploymentKey
+		case *timelinepb.Event_ConfigChange, *timelinepb.Event_AccessDenied:
+			// Config changes and denied requests are not associated with a deployment.
 		default:
 			panic(fmt.Sprintf("unexpected event type: %T", event.Entry))
 		}
@@ -139,7 +159,9 @@ func FilterRequests(filters []*timelinepb.GetTimelineRequest_RequestFilter) Time
 			request = entry.PubsubPublish.RequestKey
 		case *timelinepb.Event_PubsubConsume:
 			request = entry.PubsubConsume.RequestKey
-		case *timelinepb.Event_DeploymentCreated, *timelinepb.Event_DeploymentUpdated, *timelinepb.Event_CronScheduled:
+		case *timelinepb.Event_KvWrite:
+			request = entry.KvWrite.RequestKey
+		case *timelinepb.Event_DeploymentCreated, *timelinepb.Event_DeploymentUpdated, *timelinepb.Event_CronScheduled, *timelinepb.Event_CircuitBreaker, *timelinepb.Event_ConfigChange, *timelinepb.Event_AccessDenied:
 		default:
 			panic(fmt.Sprintf("unexpected event type: %T", event.Entry))
 		}
@@ -179,6 +201,14 @@ func FilterTypes(filters ...*timelinepb.GetTimelineRequest_EventTypeFilter) Time
 			eventType = timelinepb.EventType_EVENT_TYPE_PUBSUB_PUBLISH
 		case *timelinepb.Event_PubsubConsume:
 			eventType = timelinepb.EventType_EVENT_TYPE_PUBSUB_CONSUME
+		case *timelinepb.Event_KvWrite:
+			eventType = timelinepb.EventType_EVENT_TYPE_KV_WRITE
+		case *timelinepb.Event_CircuitBreaker:
+			eventType = timelinepb.EventType_EVENT_TYPE_CIRCUIT_BREAKER
+		case *timelinepb.Event_ConfigChange:
+			eventType = timelinepb.EventType_EVENT_TYPE_CONFIG_CHANGE
+		case *timelinepb.Event_AccessDenied:
+			eventType = timelinepb.EventType_EVENT_TYPE_ACCESS_DENIED
 		default:
 			panic(fmt.Sprintf("unexpected event type: %T", event.Entry))
 		}
@@ -0,0 +1,92 @@
+package timeline
+
+import (
+	"net/http"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/common/schema"
+)
+
+// redactEntry replaces the values of fields marked as sensitive in the payloads of an event.
+//
+// Payloads whose types can't be resolved in the schema are left unchanged.
+func redactEntry(sch *schema.Schema, entry *timelinepb.CreateEventsRequest_EventEntry) {
+	switch entry := entry.Entry.(type) {
+	case *timelinepb.CreateEventsRequest_EventEntry_Call:
+		call := entry.Call
+		verb, ok := resolveVerb(sch, call.DestinationVerbRef)
+		if !ok {
+			return
+		}
+		call.Request = redactPayload(sch, verb.Request, call.Request)
+		call.Response = redactPayload(sch, verb.Response, call.Response)
+
+	case *timelinepb.CreateEventsRequest_EventEntry_Ingress:
+		ingress := entry.Ingress
+		verb, ok := resolveVerb(sch, ingress.VerbRef)
+		if !ok {
+			return
+		}
+		// Ingress payloads are the bodies of the builtin.HttpRequest and builtin.HttpResponse types.
+		if body, ok := typeArgument(verb.Request, 0); ok {
+			ingress.Request = redactPayload(sch, body, ingress.Request)
+		}
+		responseBody := 0
+		if ingress.StatusCode >= http.StatusBadRequest {
+			responseBody = 1
+		}
+		if body, ok := typeArgument(verb.Response, responseBody); ok {
+			ingress.Response = redactPayload(sch, body, ingress.Response)
+		}
+
+	case *timelinepb.CreateEventsRequest_EventEntry_PubsubPublish:
+		publish := entry.PubsubPublish
+		if publish.VerbRef == nil {
+			return
+		}
+		topic, ok := sch.Resolve(&schema.Ref{Module: publish.VerbRef.Module, Name: publish.Topic}).Get()
+		if !ok {
+			return
+		}
+		if topic, ok := topic.(*schema.Topic); ok {
+			publish.Request = redactPayload(sch, topic.Event, publish.Request)
+		}
+
+	case *timelinepb.CreateEventsRequest_EventEntry_Log, *timelinepb.CreateEventsRequest_EventEntry_DeploymentCreated,
+		*timelinepb.CreateEventsRequest_EventEntry_DeploymentUpdated, *timelinepb.CreateEventsRequest_EventEntry_CronScheduled,
+		*timelinepb.CreateEventsRequest_EventEntry_AsyncExecute, *timelinepb.CreateEventsRequest_EventEntry_PubsubConsume,
+		*timelinepb.CreateEventsRequest_EventEntry_KvWrite, *timelinepb.CreateEventsRequest_EventEntry_CircuitBreaker,
+		*timelinepb.CreateEventsRequest_EventEntry_ConfigChange, *timelinepb.CreateEventsRequest_EventEntry_AccessDenied:
+	}
+}
+
+func resolveVerb(sch *schema.Schema, ref interface {
+	GetModule() string
+	GetName() string
+}) (*schema.Verb, bool) {
+	decl, ok := sch.Resolve(&schema.Ref{Module: ref.GetModule(), Name: ref.GetName()}).Get()
+	if !ok {
+		return nil, false
+	}
+	verb, ok := decl.(*schema.Verb)
+	return verb, ok
+}
+
+func typeArgument(t schema.Type, index int) (schema.Type, bool) {
+	ref, ok := t.(*schema.Ref)
+	if !ok || len(ref.TypeParameters) <= index {
+		return nil, false
+	}
+	return ref.TypeParameters[index], true
+}
+
+func redactPayload(sch *schema.Schema, t schema.Type, payload string) string {
+	if payload == "" {
+		return payload
+	}
+	redacted, err := schema.RedactJSON(sch, t, []byte(payload))
+	if err != nil {
+		return payload
+	}
+	return string(redacted)
+}
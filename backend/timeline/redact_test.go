@@ -0,0 +1,55 @@
+package timeline
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/schema"
+)
+
+func TestRedactEntry(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module test {
+			data Login {
+				user String
+				password String +sensitive
+			}
+
+			data Session {
+				token String +sensitive
+			}
+
+			topic logins test.Login
+
+			verb login(test.Login) test.Session
+		}
+	`)
+	assert.NoError(t, err)
+
+	call := &timelinepb.CallEvent{
+		DestinationVerbRef: &schemapb.Ref{Module: "test", Name: "login"},
+		Request:            `{"user":"alice","password":"hunter2"}`,
+		Response:           `{"token":"abc123"}`,
+	}
+	redactEntry(sch, &timelinepb.CreateEventsRequest_EventEntry{Entry: &timelinepb.CreateEventsRequest_EventEntry_Call{Call: call}})
+	assert.Equal(t, `{"password":"[redacted]","user":"alice"}`, call.Request)
+	assert.Equal(t, `{"token":"[redacted]"}`, call.Response)
+
+	publish := &timelinepb.PubSubPublishEvent{
+		VerbRef: &schemapb.Ref{Module: "test", Name: "login"},
+		Topic:   "logins",
+		Request: `{"user":"alice","password":"hunter2"}`,
+	}
+	redactEntry(sch, &timelinepb.CreateEventsRequest_EventEntry{Entry: &timelinepb.CreateEventsRequest_EventEntry_PubsubPublish{PubsubPublish: publish}})
+	assert.Equal(t, `{"password":"[redacted]","user":"alice"}`, publish.Request)
+
+	unknown := &timelinepb.CallEvent{
+		DestinationVerbRef: &schemapb.Ref{Module: "other", Name: "login"},
+		Request:            `{"password":"hunter2"}`,
+	}
+	redactEntry(sch, &timelinepb.CreateEventsRequest_EventEntry{Entry: &timelinepb.CreateEventsRequest_EventEntry_Call{Call: unknown}})
+	assert.Equal(t, `{"password":"hunter2"}`, unknown.Request)
+}
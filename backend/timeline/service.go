@@ -125,6 +125,22 @@ func (s *service) CreateEvents(ctx context.Context, req *connect.Request[timelin
 			event.Entry = &timelinepb.Event_PubsubConsume{
 				PubsubConsume: entry.PubsubConsume,
 			}
+		case *timelinepb.CreateEventsRequest_EventEntry_KvWrite:
+			event.Entry = &timelinepb.Event_KvWrite{
+				KvWrite: entry.KvWrite,
+			}
+		case *timelinepb.CreateEventsRequest_EventEntry_CircuitBreaker:
+			event.Entry = &timelinepb.Event_CircuitBreaker{
+				CircuitBreaker: entry.CircuitBreaker,
+			}
+		case *timelinepb.CreateEventsRequest_EventEntry_ConfigChange:
+			event.Entry = &timelinepb.Event_ConfigChange{
+				ConfigChange: entry.ConfigChange,
+			}
+		case *timelinepb.CreateEventsRequest_EventEntry_AccessDenied:
+			event.Entry = &timelinepb.Event_AccessDenied{
+				AccessDenied: entry.AccessDenied,
+			}
 		}
 		s.events = append(s.events, event)
 		s.nextID++
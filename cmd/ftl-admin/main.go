@@ -12,7 +12,9 @@ import (
 	"github.com/block/ftl"
 	"github.com/block/ftl/backend/admin"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/backend/timeline"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	cf "github.com/block/ftl/internal/configuration"
 	"github.com/block/ftl/internal/configuration/manager"
 	"github.com/block/ftl/internal/configuration/providers"
@@ -21,16 +23,21 @@ import (
 	"github.com/block/ftl/internal/observability"
 	"github.com/block/ftl/internal/rpc"
 	"github.com/block/ftl/internal/schema/schemaeventsource"
+	"github.com/block/ftl/internal/vault"
 )
 
 var cli struct {
 	Version              kong.VersionFlag     `help:"Show version."`
 	ObservabilityConfig  observability.Config `embed:"" prefix:"o11y-"`
 	LogConfig            log.Config           `embed:"" prefix:"log-"`
+	TLSConfig            certs.Config         `embed:"" prefix:"tls-"`
 	AdminConfig          admin.Config         `embed:"" prefix:"admin-"`
 	SchemaServerEndpoint *url.URL             `name:"ftl-endpoint" help:"Controller endpoint." env:"FTL_ENDPOINT" default:"http://127.0.0.1:8892"`
 	Config               string               `help:"Path to FTL configuration file." env:"FTL_CONFIG" required:""`
 	Secrets              string               `help:"Path to FTL secrets file." env:"FTL_SECRETS" required:""`
+	VaultConfig          vault.Config         `embed:"" prefix:"vault-"`
+	VaultKVMount         string               `name:"vault-kv-mount" help:"Mount path of the Vault KV v2 secrets engine to store secrets in." env:"FTL_VAULT_KV_MOUNT" default:"secret"`
+	VaultKVPrefix        string               `name:"vault-kv-prefix" help:"Path prefix to store secrets under in the Vault KV v2 secrets engine." env:"FTL_VAULT_KV_PREFIX" default:"ftl"`
 }
 
 func main() {
@@ -45,22 +52,33 @@ func main() {
 	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
 	err := observability.Init(ctx, false, "", "ftl-admin", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 
 	configResolver := routers.NewFileRouter[cf.Configuration](cli.Config)
 	cm, err := manager.New(ctx, configResolver, providers.NewInline[cf.Configuration]())
 	kctx.FatalIfErrorf(err)
 
-	// FTL currently only supports AWS Secrets Manager as a secrets provider.
-	awsConfig, err := config.LoadDefaultConfig(ctx)
-	kctx.FatalIfErrorf(err)
-	asmSecretProvider := providers.NewASM(secretsmanager.NewFromConfig(awsConfig))
+	// Secrets are stored in Vault if it is configured, otherwise in AWS Secrets Manager.
+	var secretProvider cf.Provider[cf.Secrets]
+	if cli.VaultConfig.Enabled() {
+		vaultClient, err := vault.New(cli.VaultConfig)
+		kctx.FatalIfErrorf(err, "failed to create vault client")
+		secretProvider = providers.NewVault(vaultClient, cli.VaultKVMount, cli.VaultKVPrefix)
+	} else {
+		awsConfig, err := config.LoadDefaultConfig(ctx)
+		kctx.FatalIfErrorf(err)
+		secretProvider = providers.NewASM(secretsmanager.NewFromConfig(awsConfig))
+	}
 	dbSecretResolver := routers.NewFileRouter[cf.Secrets](cli.Secrets)
-	sm, err := manager.New[cf.Secrets](ctx, dbSecretResolver, asmSecretProvider)
+	sm, err := manager.New[cf.Secrets](ctx, dbSecretResolver, secretProvider)
 	kctx.FatalIfErrorf(err)
 
 	schemaClient := rpc.Dial(ftlv1connect.NewSchemaServiceClient, cli.SchemaServerEndpoint.String(), log.Error)
 	eventSource := schemaeventsource.New(ctx, schemaClient)
 
-	err = admin.Start(ctx, cli.AdminConfig, cm, sm, admin.NewSchemaRetreiver(eventSource))
+	timelineClient := timeline.NewClient(ctx, cli.AdminConfig.TimelineEndpoint)
+
+	err = admin.Start(ctx, cli.AdminConfig, cm, sm, admin.NewSchemaRetreiver(eventSource), timelineClient)
 	kctx.FatalIfErrorf(err, "failed to start timeline service")
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/timeline"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/observability"
 	"github.com/block/ftl/internal/routing"
@@ -23,6 +24,7 @@ var cli struct {
 	Version               kong.VersionFlag     `help:"Show version."`
 	ObservabilityConfig   observability.Config `embed:"" prefix:"o11y-"`
 	LogConfig             log.Config           `embed:"" prefix:"log-"`
+	TLSConfig             certs.Config         `embed:"" prefix:"tls-"`
 	ConsoleConfig         console.Config       `embed:"" prefix:"console-"`
 	TimelineEndpoint      *url.URL             `help:"Timeline endpoint." env:"FTL_TIMELINE_ENDPOINT" default:"http://127.0.0.1:8894"`
 	SchemaServiceEndpoint *url.URL             `help:"Schema service endpoint." env:"FTL_SCHEMA_SERVICE_ENDPOINT" default:"http://127.0.0.1:8893"`
@@ -41,14 +43,16 @@ func main() {
 	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
 	err := observability.Init(ctx, false, "", "ftl-console", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 
-	timelineClient := timeline.NewClient(ctx, cli.TimelineEndpoint)
 	schemaClient := rpc.Dial(ftlv1connect.NewSchemaServiceClient, cli.SchemaServiceEndpoint.String(), log.Error)
+	timelineClient := timeline.NewClient(ctx, cli.TimelineEndpoint, timeline.WithSchema(schemaeventsource.New(ctx, schemaClient).ViewOnly()))
 	controllerClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, cli.ControllerEndpoint.String(), log.Error)
 	adminClient := rpc.Dial(ftlv1connect.NewAdminServiceClient, cli.AdminEndpoint.String(), log.Error)
 	eventSource := schemaeventsource.New(ctx, schemaClient)
 
-	routeManager := routing.NewVerbRouter(ctx, schemaeventsource.New(ctx, schemaClient), timelineClient)
+	routeManager := routing.NewVerbRouter(ctx, schemaeventsource.New(ctx, schemaClient), timelineClient, "console")
 
 	err = console.Start(ctx, cli.ConsoleConfig, eventSource, controllerClient, timelineClient, adminClient, routeManager)
 	kctx.FatalIfErrorf(err, "failed to start console service")
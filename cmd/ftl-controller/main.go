@@ -14,15 +14,18 @@ import (
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/timeline"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/observability"
 	"github.com/block/ftl/internal/rpc"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
 )
 
 var cli struct {
 	Version             kong.VersionFlag         `help:"Show version."`
 	ObservabilityConfig observability.Config     `embed:"" prefix:"o11y-"`
 	LogConfig           log.Config               `embed:"" prefix:"log-"`
+	TLSConfig           certs.Config             `embed:"" prefix:"tls-"`
 	RegistryConfig      artefacts.RegistryConfig `embed:"" prefix:"oci-"`
 	ControllerConfig    controller.Config        `embed:""`
 	ConfigFlag          string                   `name:"config" short:"C" help:"Path to FTL project cf file." env:"FTL_CONFIG" placeholder:"FILE"`
@@ -45,6 +48,8 @@ func main() {
 	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
 	err := observability.Init(ctx, false, "", "ftl-controller", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 
 	storage, err := artefacts.NewOCIRegistryStorage(cli.RegistryConfig)
 	kctx.FatalIfErrorf(err, "failed to create OCI registry storage")
@@ -60,7 +65,7 @@ func main() {
 
 	kctx.FatalIfErrorf(err)
 
-	timelineClient := timeline.NewClient(ctx, cli.TimelineEndpoint)
+	timelineClient := timeline.NewClient(ctx, cli.TimelineEndpoint, timeline.WithSchema(schemaeventsource.New(ctx, schemaClient).ViewOnly()))
 	err = controller.Start(ctx, cli.ControllerConfig, storage, adminClient, timelineClient, false)
 	kctx.FatalIfErrorf(err)
 }
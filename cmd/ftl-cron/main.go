@@ -11,6 +11,7 @@ import (
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/timeline"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/observability"
 	"github.com/block/ftl/internal/routing"
@@ -22,6 +23,7 @@ var cli struct {
 	Version             kong.VersionFlag     `help:"Show version."`
 	ObservabilityConfig observability.Config `embed:"" prefix:"o11y-"`
 	LogConfig           log.Config           `embed:"" prefix:"log-"`
+	TLSConfig           certs.Config         `embed:"" prefix:"tls-"`
 	CronConfig          cron.Config          `embed:""`
 }
 
@@ -35,12 +37,14 @@ func main() {
 	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
 	err := observability.Init(ctx, false, "", "ftl-cron", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 
 	schemaClient := rpc.Dial(ftlv1connect.NewSchemaServiceClient, cli.CronConfig.SchemaServiceEndpoint.String(), log.Error)
 	eventSource := schemaeventsource.New(ctx, schemaClient)
 
-	timelineClient := timeline.NewClient(ctx, cli.CronConfig.TimelineEndpoint)
-	routeManager := routing.NewVerbRouter(ctx, schemaeventsource.New(ctx, schemaClient), timelineClient)
+	timelineClient := timeline.NewClient(ctx, cli.CronConfig.TimelineEndpoint, timeline.WithSchema(schemaeventsource.New(ctx, schemaClient).ViewOnly()))
+	routeManager := routing.NewVerbRouter(ctx, schemaeventsource.New(ctx, schemaClient), timelineClient, "cron")
 
 	err = cron.Start(ctx, eventSource, routeManager, timelineClient)
 	kctx.FatalIfErrorf(err, "failed to start cron")
@@ -9,14 +9,17 @@ import (
 	"github.com/block/ftl"
 	"github.com/block/ftl/backend/lease"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/observability"
+	"github.com/block/ftl/internal/rpc"
 )
 
 var cli struct {
 	Version             kong.VersionFlag     `help:"Show version."`
 	ObservabilityConfig observability.Config `embed:"" prefix:"o11y-"`
 	LogConfig           log.Config           `embed:"" prefix:"log-"`
+	TLSConfig           certs.Config         `embed:"" prefix:"tls-"`
 	LeaseConfig         lease.Config         `embed:"" `
 }
 
@@ -30,6 +33,8 @@ func main() {
 	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
 	err := observability.Init(ctx, false, "", "ftl-lease", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 
 	err = lease.Start(ctx, cli.LeaseConfig)
 	kctx.FatalIfErrorf(err, "failed to start lease service")
@@ -1,6 +1,7 @@
 package main
 
 const (
-	ResourceKindPostgres = "p"
-	ResourceKindMySQL    = "m"
+	ResourceKindPostgres    = "p"
+	ResourceKindMySQL       = "m"
+	ResourceKindObjectStore = "o"
 )
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	goformation "github.com/awslabs/goformation/v7/cloudformation"
+	"github.com/awslabs/goformation/v7/cloudformation/policies"
+	"github.com/awslabs/goformation/v7/cloudformation/s3"
+
+	provisioner "github.com/block/ftl/backend/protos/xyz/block/ftl/provisioner/v1beta1"
+	"github.com/block/ftl/common/schema"
+)
+
+type ObjectStoreTemplater struct {
+	resourceID string
+	cluster    string
+	module     string
+}
+
+var _ ResourceTemplater = (*ObjectStoreTemplater)(nil)
+
+func (o *ObjectStoreTemplater) AddToTemplate(template *goformation.Template) error {
+	bucketID := cloudformationResourceID(o.resourceID, "bucket")
+	template.Resources[bucketID] = &s3.Bucket{
+		PublicAccessBlockConfiguration: &s3.Bucket_PublicAccessBlockConfiguration{
+			BlockPublicAcls:       ptr(true),
+			BlockPublicPolicy:     ptr(true),
+			IgnorePublicAcls:      ptr(true),
+			RestrictPublicBuckets: ptr(true),
+		},
+		Tags: ftlTags(o.cluster, o.module),
+		// Never delete user data when a module or object store is removed.
+		AWSCloudFormationDeletionPolicy: policies.DeletionPolicy("Retain"),
+	}
+	addOutput(template.Outputs, goformation.Ref(bucketID), &CloudformationOutputKey{
+		ResourceID:   o.resourceID,
+		PropertyName: PropertyObjectStoreBucket,
+		ResourceKind: ResourceKindObjectStore,
+	})
+	addOutput(template.Outputs, goformation.Ref("AWS::Region"), &CloudformationOutputKey{
+		ResourceID:   o.resourceID,
+		PropertyName: PropertyObjectStoreRegion,
+		ResourceKind: ResourceKindObjectStore,
+	})
+	return nil
+}
+
+func updateObjectStoreOutputs(_ context.Context, resourceID string, outputs []types.Output) ([]*provisioner.ProvisioningEvent, error) {
+	byName, err := outputsByPropertyName(outputs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to group outputs by property name: %w", err)
+	}
+
+	bucket, err := outputValue(byName, PropertyObjectStoreBucket)
+	if err != nil {
+		return nil, err
+	}
+	region, err := outputValue(byName, PropertyObjectStoreRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	event := schema.ObjectStoreRuntimeEvent{
+		ID: resourceID,
+		Payload: &schema.ObjectStoreRuntime{
+			Bucket: bucket,
+			Region: region,
+		},
+	}
+	return []*provisioner.ProvisioningEvent{{
+		Value: &provisioner.ProvisioningEvent_ObjectStoreRuntimeEvent{
+			ObjectStoreRuntimeEvent: event.ToProto(),
+		},
+	}}, nil
+}
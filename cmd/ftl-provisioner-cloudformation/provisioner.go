@@ -32,6 +32,8 @@ const (
 	PropertyMySQLReadEndpoint  = "mysql:read_endpoint"
 	PropertyMySQLWriteEndpoint = "mysql:write_endpoint"
 	PropertyMySQLMasterUserARN = "mysql:master_user_secret_arn"
+	PropertyObjectStoreBucket  = "objectstore:bucket"
+	PropertyObjectStoreRegion  = "objectstore:region"
 )
 
 type Config struct {
@@ -166,6 +168,12 @@ func (c *CloudformationProvisioner) createTemplate(req *provisioner.ProvisionReq
 					module:     req.DesiredModule.Name,
 					config:     c.confg,
 				}
+			case schema.ResourceTypeObjectStore:
+				templater = &ObjectStoreTemplater{
+					resourceID: provisioned.ResourceID(),
+					cluster:    req.FtlClusterId,
+					module:     req.DesiredModule.Name,
+				}
 			default:
 				continue
 			}
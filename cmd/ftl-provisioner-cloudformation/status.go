@@ -85,6 +85,15 @@ func outputsByPropertyName(outputs []types.Output) (map[string]types.Output, err
 	return m, nil
 }
 
+// outputValue returns the value of the output for a property, or an error if the stack has no such output.
+func outputValue(byName map[string]types.Output, propertyName string) (string, error) {
+	output, ok := byName[propertyName]
+	if !ok || output.OutputValue == nil {
+		return "", fmt.Errorf("missing output for property %q", propertyName)
+	}
+	return *output.OutputValue, nil
+}
+
 func (c *CloudformationProvisioner) updateResources(ctx context.Context, outputs []types.Output) ([]*provisioner.ProvisioningEvent, error) {
 	byKind, err := outputsByKind(outputs)
 	if err != nil {
@@ -108,6 +117,12 @@ func (c *CloudformationProvisioner) updateResources(ctx context.Context, outputs
 				events = append(events, e...)
 			case ResourceKindMySQL:
 				panic("mysql not implemented")
+			case ResourceKindObjectStore:
+				e, err := updateObjectStoreOutputs(ctx, id, outputs)
+				if err != nil {
+					return nil, fmt.Errorf("failed to update object store outputs: %w", err)
+				}
+				events = append(events, e...)
 			}
 		}
 	}
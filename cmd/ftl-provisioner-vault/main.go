@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	provisionerconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/provisioner/v1beta1/provisionerpbconnect"
+	"github.com/block/ftl/backend/provisioner"
+	"github.com/block/ftl/common/plugin"
+	"github.com/block/ftl/internal/vault"
+)
+
+type Config struct {
+	Vault vault.Config `embed:"" prefix:"vault-"`
+	provisioner.VaultDatabaseConfig
+}
+
+// NewVaultProvisioner creates a provisioner for PostgreSQL databases with credentials from Vault.
+func NewVaultProvisioner(ctx context.Context, config Config) (context.Context, *provisioner.InMemProvisioner, error) {
+	client, err := vault.New(config.Vault)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create vault client: %w", err)
+	}
+	ctx = vault.ContextWithDatabaseCredentials(ctx, vault.NewDatabaseCredentials(ctx, client))
+	return ctx, provisioner.NewVaultProvisioner(config.VaultDatabaseConfig), nil
+}
+
+func main() {
+	plugin.Start(
+		context.Background(),
+		"ftl-provisioner-vault",
+		NewVaultProvisioner,
+		"",
+		provisionerconnect.NewProvisionerPluginServiceHandler,
+	)
+}
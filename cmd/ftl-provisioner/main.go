@@ -11,6 +11,8 @@ import (
 	"github.com/block/ftl/backend/provisioner"
 	"github.com/block/ftl/backend/provisioner/scaling/k8sscaling"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
+	"github.com/block/ftl/internal/identity"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/observability"
 	"github.com/block/ftl/internal/rpc"
@@ -20,8 +22,10 @@ var cli struct {
 	Version             kong.VersionFlag     `help:"Show version."`
 	ObservabilityConfig observability.Config `embed:"" prefix:"o11y-"`
 	LogConfig           log.Config           `embed:"" prefix:"log-"`
+	TLSConfig           certs.Config         `embed:"" prefix:"tls-"`
 	ProvisionerConfig   provisioner.Config   `embed:""`
 	ConfigFlag          string               `name:"config" short:"C" help:"Path to FTL project cf file." env:"FTL_CONFIG" placeholder:"FILE"`
+	RunnerCredentialKey string               `help:"Base64 encoded key to derive the credentials that identify each deployment's runners to the controller from. Must match the controller's key." env:"FTL_RUNNER_CREDENTIAL_KEY" placeholder:"KEY"`
 }
 
 func main() {
@@ -35,13 +39,22 @@ func main() {
 	ctx := log.ContextWithLogger(context.Background(), log.Configure(os.Stderr, cli.LogConfig))
 	err := observability.Init(ctx, false, "", "ftl-provisioner", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialize observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 
 	controllerClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, cli.ProvisionerConfig.ControllerEndpoint.String(), log.Error)
 	ctx = rpc.ContextWithClient(ctx, controllerClient)
 
 	schemaClient := rpc.Dial(ftlv1connect.NewSchemaServiceClient, cli.ProvisionerConfig.ControllerEndpoint.String(), log.Error)
 	ctx = rpc.ContextWithClient(ctx, schemaClient)
-	scaling := k8sscaling.NewK8sScaling(false, cli.ProvisionerConfig.ControllerEndpoint.String())
+	ctx, err = cli.ProvisionerConfig.ContextWithVault(ctx)
+	kctx.FatalIfErrorf(err)
+	var runnerCredentialKey []byte
+	if cli.RunnerCredentialKey != "" {
+		runnerCredentialKey, err = identity.ParseRunnerKey(cli.RunnerCredentialKey)
+		kctx.FatalIfErrorf(err)
+	}
+	scaling := k8sscaling.NewK8sScaling(false, cli.ProvisionerConfig.ControllerEndpoint.String(), runnerCredentialKey)
 	err = scaling.Start(ctx)
 	kctx.FatalIfErrorf(err, "error starting k8s scaling")
 	registry, err := provisioner.RegistryFromConfigFile(ctx, cli.ProvisionerConfig.PluginConfigFile, controllerClient, scaling)
@@ -11,13 +11,16 @@ import (
 	"github.com/block/ftl/backend/controller/artefacts"
 	"github.com/block/ftl/backend/runner"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/observability"
+	"github.com/block/ftl/internal/rpc"
 )
 
 var cli struct {
 	Version             kong.VersionFlag         `help:"Show version."`
 	LogConfig           log.Config               `prefix:"log-" embed:""`
+	TLSConfig           certs.Config             `embed:"" prefix:"tls-"`
 	RegistryConfig      artefacts.RegistryConfig `prefix:"oci-" embed:""`
 	ObservabilityConfig observability.Config     `prefix:"o11y-" embed:""`
 	RunnerConfig        runner.Config            `embed:""`
@@ -41,6 +44,8 @@ and route to user code.
 	ctx := log.ContextWithLogger(context.Background(), logger)
 	err = observability.Init(ctx, false, "", "ftl-runner", ftl.Version, cli.ObservabilityConfig)
 	kctx.FatalIfErrorf(err, "failed to initialise observability")
+	err = rpc.InitialiseTLS(cli.TLSConfig)
+	kctx.FatalIfErrorf(err, "failed to initialise TLS")
 	storage, err := artefacts.NewOCIRegistryStorage(cli.RegistryConfig)
 	kctx.FatalIfErrorf(err, "failed to create OCI registry storage")
 	// Substitute in the runner key into the deployment directory.
@@ -30,6 +30,15 @@ func protoSlicef[P, T any](values []T, f func(T) P) []P {
 	return out
 }
 
+// protoOptionalf converts an optional value to an optional protobuf value using a mapping function.
+func protoOptionalf[P, T any](value *T, f func(T) P) *P {
+	if value == nil {
+		return nil
+	}
+	out := f(*value)
+	return &out
+}
+
 func protoMust[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
@@ -72,7 +81,7 @@ func (x *Root) ToProto() *destpb.Root {
 		Enum:           x.Enum.ToProto(),
 		SumType:        SumTypeToProto(x.SumType),
 		OptionalInt:    proto.Int64(int64(x.OptionalInt)),
-		OptionalIntPtr: proto.Int64(int64(*x.OptionalIntPtr)),
+		OptionalIntPtr: protoOptionalf(x.OptionalIntPtr, func(v int) int64 { return int64(v) }),
 		OptionalMsg:    x.OptionalMsg.ToProto(),
 		RepeatedInt:    protoSlicef(x.RepeatedInt, func(v int) int64 { return int64(v) }),
 		RepeatedMsg:    protoSlice[*destpb.Message](x.RepeatedMsg),
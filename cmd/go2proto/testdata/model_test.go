@@ -43,3 +43,9 @@ func TestModel(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, pb.String(), out.String())
 }
+
+func TestModelNilOptional(t *testing.T) {
+	model := Root{URL: must.Get(url.Parse("http://127.0.0.1"))}
+	pb := model.ToProto()
+	assert.Zero(t, pb.OptionalIntPtr)
+}
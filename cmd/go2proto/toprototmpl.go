@@ -76,6 +76,15 @@ func protoSlicef[P, T any](values []T, f func(T) P) []P {
 	return out
 }
 
+// protoOptionalf converts an optional value to an optional protobuf value using a mapping function.
+func protoOptionalf[P, T any](value *T, f func(T) P) *P {
+	if value == nil {
+		return nil
+	}
+	out := f(*value)
+	return &out
+}
+
 func protoMust[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
@@ -92,8 +101,10 @@ func (x *{{ .Name }}) ToProto() *destpb.{{ .Name }} {
 	return &destpb.{{ .Name }}{
 {{- range $field := .Fields }}
 {{- if . | isBuiltin }}
-{{- if $field.Optional}}
-		{{ $field.EscapedName }}: proto.{{ $field.ProtoGoType | toUpperCamel }}({{ $field.ProtoGoType }}({{if $field.Pointer}}*{{end}}x.{{ $field.Name }})),
+{{- if and $field.Optional $field.Pointer}}
+		{{ $field.EscapedName }}: protoOptionalf(x.{{ $field.Name }}, func(v {{ $field.OriginType }}) {{ $field.ProtoGoType }} { return {{ $field.ProtoGoType }}(v) }),
+{{- else if $field.Optional}}
+		{{ $field.EscapedName }}: proto.{{ $field.ProtoGoType | toUpperCamel }}({{ $field.ProtoGoType }}(x.{{ $field.Name }})),
 {{- else if .Repeated}}
 		{{ $field.EscapedName }}: protoSlicef(x.{{ $field.Name }}, func(v {{ $field.OriginType }}) {{ $field.ProtoGoType }} { return {{ $field.ProtoGoType }}(v) }),
 {{- else }}
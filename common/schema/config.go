@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
@@ -14,6 +15,8 @@ type Config struct {
 	Comments []string `parser:"@Comment*" protobuf:"2"`
 	Name     string   `parser:"'config' @Ident" protobuf:"3"`
 	Type     Type     `parser:"@@" protobuf:"4"`
+	// Default is the JSON encoded value used when no value is set.
+	Default *string `parser:"('=' @String)?" protobuf:"5,optional"`
 }
 
 var _ Decl = (*Config)(nil)
@@ -27,6 +30,9 @@ func (s *Config) String() string {
 
 	fmt.Fprint(w, EncodeComments(s.Comments))
 	fmt.Fprintf(w, "config %s %s", s.Name, s.Type)
+	if s.Default != nil {
+		fmt.Fprintf(w, " = %s", strconv.Quote(*s.Default))
+	}
 
 	return w.String()
 }
@@ -41,5 +47,6 @@ func ConfigFromProto(p *schemapb.Config) *Config {
 		Comments: p.Comments,
 		Name:     p.Name,
 		Type:     TypeFromProto(p.Type),
+		Default:  p.Default,
 	}
 }
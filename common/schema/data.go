@@ -118,7 +118,7 @@ func (d *Data) Monomorphise(ref *Ref) (*Data, error) {
 			n.Type = t
 
 		case Type, Metadata, Value, IngressPathComponent, DatabaseConnector,
-			*Module, *Schema, *TypeParameter, *Verb, *Enum, *TypeAlias, *Topic, *Database,
+			*Module, *Schema, *TypeParameter, *Verb, *Enum, *TypeAlias, *Topic, *KV, *ObjectStore, *Database,
 			*DatabaseRuntimeConnections, *Data, *EnumVariant, *DatabaseRuntime:
 		}
 		return next()
@@ -10,6 +10,7 @@ import (
 
 const PostgresDatabaseType = "postgres"
 const MySQLDatabaseType = "mysql"
+const SQLiteDatabaseType = "sqlite"
 
 //protobuf:3
 type Database struct {
@@ -17,7 +18,7 @@ type Database struct {
 	Runtime *DatabaseRuntime `parser:"" protobuf:"31634,optional"`
 
 	Comments []string   `parser:"@Comment*" protobuf:"2"`
-	Type     string     `parser:"'database' @('postgres'|'mysql')" protobuf:"4"`
+	Type     string     `parser:"'database' @('postgres'|'mysql'|'sqlite')" protobuf:"4"`
 	Name     string     `parser:"@Ident" protobuf:"3"`
 	Metadata []Metadata `parser:"@@*" protobuf:"5"`
 }
@@ -50,8 +51,11 @@ func (d *Database) IsExported() bool { return false }
 
 func (d *Database) GetProvisioned() ResourceSet {
 	kind := ResourceTypeMysql
-	if d.Type == PostgresDatabaseType {
+	switch d.Type {
+	case PostgresDatabaseType:
 		kind = ResourceTypePostgres
+	case SQLiteDatabaseType:
+		kind = ResourceTypeSQLite
 	}
 	result := []*ProvisionedResource{{
 		Kind:   kind,
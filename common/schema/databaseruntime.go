@@ -93,6 +93,29 @@ func (d *AWSIAMAuthDatabaseConnector) String() string {
 
 func (d *AWSIAMAuthDatabaseConnector) schemaChildren() []Node { return nil }
 
+// VaultDatabaseConnector connects with short-lived credentials leased from a
+// Vault database secrets engine.
+//
+//protobuf:3
+type VaultDatabaseConnector struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Endpoint string `parser:"" protobuf:"2"`
+	Database string `parser:"" protobuf:"3"`
+	Mount    string `parser:"" protobuf:"4"`
+	Role     string `parser:"" protobuf:"5"`
+}
+
+var _ DatabaseConnector = (*VaultDatabaseConnector)(nil)
+
+func (d *VaultDatabaseConnector) Position() Position { return d.Pos }
+func (d *VaultDatabaseConnector) databaseConnector() {}
+func (d *VaultDatabaseConnector) String() string {
+	return fmt.Sprintf("vault:%s/%s@%s/%s", d.Mount, d.Role, d.Endpoint, d.Database)
+}
+
+func (d *VaultDatabaseConnector) schemaChildren() []Node { return nil }
+
 func DatabaseRuntimeFromProto(s *schemapb.DatabaseRuntime) *DatabaseRuntime {
 	if s == nil {
 		return nil
@@ -118,6 +141,13 @@ func DatabaseConnectorFromProto(s *schemapb.DatabaseConnector) DatabaseConnector
 			Endpoint: s.AwsiamAuthDatabaseConnector.Endpoint,
 			Database: s.AwsiamAuthDatabaseConnector.Database,
 		}
+	case *schemapb.DatabaseConnector_VaultDatabaseConnector:
+		return &VaultDatabaseConnector{
+			Endpoint: s.VaultDatabaseConnector.Endpoint,
+			Database: s.VaultDatabaseConnector.Database,
+			Mount:    s.VaultDatabaseConnector.Mount,
+			Role:     s.VaultDatabaseConnector.Role,
+		}
 	default:
 		panic(fmt.Sprintf("unknown database connector type: %T", s))
 	}
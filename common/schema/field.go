@@ -7,6 +7,7 @@ import (
 	"github.com/alecthomas/types/optional"
 
 	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/slices"
 )
 
 type Field struct {
@@ -49,6 +50,12 @@ func (f *Field) Alias(kind AliasKind) optional.Option[string] {
 	return optional.None[string]()
 }
 
+// IsSensitive returns true if the field is marked as containing sensitive information.
+func (f *Field) IsSensitive() bool {
+	_, ok := slices.FindVariant[*MetadataSensitive](f.Metadata)
+	return ok
+}
+
 func fieldListToSchema(s []*schemapb.Field) []*Field {
 	var out []*Field
 	for _, n := range s {
@@ -30,6 +30,15 @@ func protoSlicef[P, T any](values []T, f func(T) P) []P {
 	return out
 }
 
+// protoOptionalf converts an optional value to an optional protobuf value using a mapping function.
+func protoOptionalf[P, T any](value *T, f func(T) P) *P {
+	if value == nil {
+		return nil
+	}
+	out := f(*value)
+	return &out
+}
+
 func protoMust[T any](v T, err error) T {
 	if err != nil {
 		panic(err)
@@ -99,6 +108,7 @@ func (x *Config) ToProto() *destpb.Config {
 		Comments: protoSlicef(x.Comments, func(v string) string { return string(v) }),
 		Name:     string(x.Name),
 		Type:     TypeToProto(x.Type),
+		Default:  protoOptionalf(x.Default, func(v string) string { return string(v) }),
 	}
 }
 
@@ -154,6 +164,10 @@ func DatabaseConnectorToProto(value DatabaseConnector) *destpb.DatabaseConnector
 		return &destpb.DatabaseConnector{
 			Value: &destpb.DatabaseConnector_DsnDatabaseConnector{value.ToProto()},
 		}
+	case *VaultDatabaseConnector:
+		return &destpb.DatabaseConnector{
+			Value: &destpb.DatabaseConnector_VaultDatabaseConnector{value.ToProto()},
+		}
 	default:
 		panic(fmt.Sprintf("unknown variant: %T", value))
 	}
@@ -232,6 +246,14 @@ func DeclToProto(value Decl) *destpb.Decl {
 		return &destpb.Decl{
 			Value: &destpb.Decl_Enum{value.ToProto()},
 		}
+	case *KV:
+		return &destpb.Decl{
+			Value: &destpb.Decl_Kv{value.ToProto()},
+		}
+	case *ObjectStore:
+		return &destpb.Decl{
+			Value: &destpb.Decl_ObjectStore{value.ToProto()},
+		}
 	case *Secret:
 		return &destpb.Decl{
 			Value: &destpb.Decl_Secret{value.ToProto()},
@@ -362,6 +384,39 @@ func (x *IntValue) ToProto() *destpb.IntValue {
 	}
 }
 
+func (x *KV) ToProto() *destpb.KV {
+	if x == nil {
+		return nil
+	}
+	return &destpb.KV{
+		Pos:      x.Pos.ToProto(),
+		Runtime:  x.Runtime.ToProto(),
+		Comments: protoSlicef(x.Comments, func(v string) string { return string(v) }),
+		Name:     string(x.Name),
+		Key:      TypeToProto(x.Key),
+		Value:    TypeToProto(x.Value),
+	}
+}
+
+func (x *KVRuntime) ToProto() *destpb.KVRuntime {
+	if x == nil {
+		return nil
+	}
+	return &destpb.KVRuntime{
+		Path: string(x.Path),
+	}
+}
+
+func (x *KVRuntimeEvent) ToProto() *destpb.KVRuntimeEvent {
+	if x == nil {
+		return nil
+	}
+	return &destpb.KVRuntimeEvent{
+		Id:      string(x.ID),
+		Payload: x.Payload.ToProto(),
+	}
+}
+
 func (x *Map) ToProto() *destpb.Map {
 	if x == nil {
 		return nil
@@ -390,6 +445,10 @@ func MetadataToProto(value Metadata) *destpb.Metadata {
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Calls{value.ToProto()},
 		}
+	case *MetadataCircuitBreaker:
+		return &destpb.Metadata{
+			Value: &destpb.Metadata_CircuitBreaker{value.ToProto()},
+		}
 	case *MetadataConfig:
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Config{value.ToProto()},
@@ -406,10 +465,22 @@ func MetadataToProto(value Metadata) *destpb.Metadata {
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Encoding{value.ToProto()},
 		}
+	case *MetadataIdempotent:
+		return &destpb.Metadata{
+			Value: &destpb.Metadata_Idempotent{value.ToProto()},
+		}
 	case *MetadataIngress:
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Ingress{value.ToProto()},
 		}
+	case *MetadataKV:
+		return &destpb.Metadata{
+			Value: &destpb.Metadata_Kv{value.ToProto()},
+		}
+	case *MetadataObjectStore:
+		return &destpb.Metadata{
+			Value: &destpb.Metadata_ObjectStore{value.ToProto()},
+		}
 	case *MetadataPublisher:
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Publisher{value.ToProto()},
@@ -426,10 +497,18 @@ func MetadataToProto(value Metadata) *destpb.Metadata {
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Secrets{value.ToProto()},
 		}
+	case *MetadataSensitive:
+		return &destpb.Metadata{
+			Value: &destpb.Metadata_Sensitive{value.ToProto()},
+		}
 	case *MetadataSubscriber:
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_Subscriber{value.ToProto()},
 		}
+	case *MetadataTimeout:
+		return &destpb.Metadata{
+			Value: &destpb.Metadata_Timeout{value.ToProto()},
+		}
 	case *MetadataTypeMap:
 		return &destpb.Metadata{
 			Value: &destpb.Metadata_TypeMap{value.ToProto()},
@@ -472,6 +551,18 @@ func (x *MetadataCalls) ToProto() *destpb.MetadataCalls {
 	}
 }
 
+func (x *MetadataCircuitBreaker) ToProto() *destpb.MetadataCircuitBreaker {
+	if x == nil {
+		return nil
+	}
+	return &destpb.MetadataCircuitBreaker{
+		Pos:          x.Pos.ToProto(),
+		ErrorPercent: protoOptionalf(x.ErrorPercent, func(v int) int64 { return int64(v) }),
+		Latency:      string(x.Latency),
+		Cooldown:     string(x.Cooldown),
+	}
+}
+
 func (x *MetadataConfig) ToProto() *destpb.MetadataConfig {
 	if x == nil {
 		return nil
@@ -513,6 +604,16 @@ func (x *MetadataEncoding) ToProto() *destpb.MetadataEncoding {
 	}
 }
 
+func (x *MetadataIdempotent) ToProto() *destpb.MetadataIdempotent {
+	if x == nil {
+		return nil
+	}
+	return &destpb.MetadataIdempotent{
+		Pos:    x.Pos.ToProto(),
+		Window: string(x.Window),
+	}
+}
+
 func (x *MetadataIngress) ToProto() *destpb.MetadataIngress {
 	if x == nil {
 		return nil
@@ -525,6 +626,26 @@ func (x *MetadataIngress) ToProto() *destpb.MetadataIngress {
 	}
 }
 
+func (x *MetadataKV) ToProto() *destpb.MetadataKV {
+	if x == nil {
+		return nil
+	}
+	return &destpb.MetadataKV{
+		Pos:   x.Pos.ToProto(),
+		Calls: protoSlice[*destpb.Ref](x.Calls),
+	}
+}
+
+func (x *MetadataObjectStore) ToProto() *destpb.MetadataObjectStore {
+	if x == nil {
+		return nil
+	}
+	return &destpb.MetadataObjectStore{
+		Pos:   x.Pos.ToProto(),
+		Calls: protoSlice[*destpb.Ref](x.Calls),
+	}
+}
+
 func (x *MetadataPublisher) ToProto() *destpb.MetadataPublisher {
 	if x == nil {
 		return nil
@@ -541,7 +662,7 @@ func (x *MetadataRetry) ToProto() *destpb.MetadataRetry {
 	}
 	return &destpb.MetadataRetry{
 		Pos:        x.Pos.ToProto(),
-		Count:      proto.Int64(int64(*x.Count)),
+		Count:      protoOptionalf(x.Count, func(v int) int64 { return int64(v) }),
 		MinBackoff: string(x.MinBackoff),
 		MaxBackoff: string(x.MaxBackoff),
 		Catch:      x.Catch.ToProto(),
@@ -568,6 +689,15 @@ func (x *MetadataSecrets) ToProto() *destpb.MetadataSecrets {
 	}
 }
 
+func (x *MetadataSensitive) ToProto() *destpb.MetadataSensitive {
+	if x == nil {
+		return nil
+	}
+	return &destpb.MetadataSensitive{
+		Pos: x.Pos.ToProto(),
+	}
+}
+
 func (x *MetadataSubscriber) ToProto() *destpb.MetadataSubscriber {
 	if x == nil {
 		return nil
@@ -580,6 +710,16 @@ func (x *MetadataSubscriber) ToProto() *destpb.MetadataSubscriber {
 	}
 }
 
+func (x *MetadataTimeout) ToProto() *destpb.MetadataTimeout {
+	if x == nil {
+		return nil
+	}
+	return &destpb.MetadataTimeout{
+		Pos:      x.Pos.ToProto(),
+		Duration: string(x.Duration),
+	}
+}
+
 func (x *MetadataTypeMap) ToProto() *destpb.MetadataTypeMap {
 	if x == nil {
 		return nil
@@ -671,6 +811,39 @@ func (x *ModuleRuntimeScaling) ToProto() *destpb.ModuleRuntimeScaling {
 	}
 }
 
+func (x *ObjectStore) ToProto() *destpb.ObjectStore {
+	if x == nil {
+		return nil
+	}
+	return &destpb.ObjectStore{
+		Pos:      x.Pos.ToProto(),
+		Runtime:  x.Runtime.ToProto(),
+		Comments: protoSlicef(x.Comments, func(v string) string { return string(v) }),
+		Name:     string(x.Name),
+	}
+}
+
+func (x *ObjectStoreRuntime) ToProto() *destpb.ObjectStoreRuntime {
+	if x == nil {
+		return nil
+	}
+	return &destpb.ObjectStoreRuntime{
+		Endpoint: string(x.Endpoint),
+		Bucket:   string(x.Bucket),
+		Region:   string(x.Region),
+	}
+}
+
+func (x *ObjectStoreRuntimeEvent) ToProto() *destpb.ObjectStoreRuntimeEvent {
+	if x == nil {
+		return nil
+	}
+	return &destpb.ObjectStoreRuntimeEvent{
+		Id:      string(x.ID),
+		Payload: x.Payload.ToProto(),
+	}
+}
+
 func (x *Optional) ToProto() *destpb.Optional {
 	if x == nil {
 		return nil
@@ -713,6 +886,10 @@ func RuntimeEventToProto(value RuntimeEvent) *destpb.RuntimeEvent {
 		return &destpb.RuntimeEvent{
 			Value: &destpb.RuntimeEvent_DatabaseRuntimeEvent{value.ToProto()},
 		}
+	case *KVRuntimeEvent:
+		return &destpb.RuntimeEvent{
+			Value: &destpb.RuntimeEvent_KvRuntimeEvent{value.ToProto()},
+		}
 	case *ModuleRuntimeBase:
 		return &destpb.RuntimeEvent{
 			Value: &destpb.RuntimeEvent_ModuleRuntimeBase{value.ToProto()},
@@ -725,6 +902,10 @@ func RuntimeEventToProto(value RuntimeEvent) *destpb.RuntimeEvent {
 		return &destpb.RuntimeEvent{
 			Value: &destpb.RuntimeEvent_ModuleRuntimeScaling{value.ToProto()},
 		}
+	case *ObjectStoreRuntimeEvent:
+		return &destpb.RuntimeEvent{
+			Value: &destpb.RuntimeEvent_ObjectStoreRuntimeEvent{value.ToProto()},
+		}
 	case *TopicRuntimeEvent:
 		return &destpb.RuntimeEvent{
 			Value: &destpb.RuntimeEvent_TopicRuntimeEvent{value.ToProto()},
@@ -945,6 +1126,19 @@ func ValueToProto(value Value) *destpb.Value {
 	}
 }
 
+func (x *VaultDatabaseConnector) ToProto() *destpb.VaultDatabaseConnector {
+	if x == nil {
+		return nil
+	}
+	return &destpb.VaultDatabaseConnector{
+		Pos:      x.Pos.ToProto(),
+		Endpoint: string(x.Endpoint),
+		Database: string(x.Database),
+		Mount:    string(x.Mount),
+		Role:     string(x.Role),
+	}
+}
+
 func (x *Verb) ToProto() *destpb.Verb {
 	if x == nil {
 		return nil
@@ -1014,6 +1208,8 @@ func (x *VerbRuntimeSubscription) ToProto() *destpb.VerbRuntimeSubscription {
 		return nil
 	}
 	return &destpb.VerbRuntimeSubscription{
-		KafkaBrokers: protoSlicef(x.KafkaBrokers, func(v string) string { return string(v) }),
+		KafkaBrokers:    protoSlicef(x.KafkaBrokers, func(v string) string { return string(v) }),
+		TopicId:         string(x.TopicID),
+		ConsumerGroupId: string(x.ConsumerGroupID),
 	}
 }
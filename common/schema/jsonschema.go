@@ -52,7 +52,7 @@ func RequestResponseToJSONSchema(sch *Schema, ref Ref) (*jsonschema.Schema, erro
 		case *TypeAlias:
 			root.Definitions[r.String()] = jsonschema.SchemaOrBool{TypeObject: nodeToJSSchema(n.Type, refs)}
 
-		case *Config, *Database, *Secret, *Verb, *Topic:
+		case *Config, *Database, *Secret, *Verb, *Topic, *KV, *ObjectStore:
 			return nil, fmt.Errorf("reference to unsupported node type %T", decl)
 		}
 	}
@@ -191,7 +191,7 @@ func nodeToJSSchema(node Node, refs map[RefKey]*Ref) *jsonschema.Schema {
 
 	case Metadata, IngressPathComponent, DatabaseConnector, Type, Value,
 		*Module, *Field, *Schema, *Database, *Verb, *EnumVariant,
-		*Config, *Secret, *Topic, *DatabaseRuntime, *DatabaseRuntimeConnections:
+		*Config, *Secret, *Topic, *KV, *ObjectStore, *DatabaseRuntime, *DatabaseRuntimeConnections:
 		panic(fmt.Sprintf("unsupported node type %T", node))
 
 	default:
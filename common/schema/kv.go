@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/slices"
+)
+
+// KV is a provisioned key-value store.
+//
+//protobuf:10
+type KV struct {
+	Pos     Position   `parser:"" protobuf:"1,optional"`
+	Runtime *KVRuntime `parser:"" protobuf:"31634,optional"`
+
+	Comments []string `parser:"@Comment*" protobuf:"2"`
+	Name     string   `parser:"'kv' @Ident" protobuf:"3"`
+	Key      Type     `parser:"'<' @@" protobuf:"4"`
+	Value    Type     `parser:"',' @@ '>'" protobuf:"5"`
+}
+
+var _ Decl = (*KV)(nil)
+var _ Symbol = (*KV)(nil)
+var _ Provisioned = (*KV)(nil)
+
+func (k *KV) Position() Position { return k.Pos }
+func (*KV) schemaDecl()          {}
+func (*KV) schemaSymbol()        {}
+func (k *KV) provisioned()       {}
+func (k *KV) schemaChildren() []Node {
+	out := []Node{}
+	if k.Key != nil {
+		out = append(out, k.Key)
+	}
+	if k.Value != nil {
+		out = append(out, k.Value)
+	}
+	return out
+}
+
+func (k *KV) GetName() string  { return k.Name }
+func (k *KV) IsExported() bool { return false }
+
+func (k *KV) String() string {
+	w := &strings.Builder{}
+	fmt.Fprint(w, EncodeComments(k.Comments))
+	fmt.Fprintf(w, "kv %s<%s, %s>", k.Name, k.Key, k.Value)
+	return w.String()
+}
+
+func (k *KV) GetProvisioned() ResourceSet {
+	return ResourceSet{
+		{Kind: ResourceTypeKV, Config: &KV{Name: k.Name}},
+	}
+}
+
+func (k *KV) ResourceID() string {
+	return k.Name
+}
+
+func KVFromProto(k *schemapb.KV) *KV {
+	return &KV{
+		Pos:     PosFromProto(k.Pos),
+		Runtime: KVRuntimeFromProto(k.Runtime),
+
+		Comments: k.Comments,
+		Name:     k.Name,
+		Key:      TypeFromProto(k.Key),
+		Value:    TypeFromProto(k.Value),
+	}
+}
+
+// KVRuntime holds the runtime information for a provisioned key-value store.
+type KVRuntime struct {
+	// Path to the file backing the store.
+	Path string `parser:"" protobuf:"1"`
+}
+
+func KVRuntimeFromProto(k *schemapb.KVRuntime) *KVRuntime {
+	if k == nil {
+		return nil
+	}
+	return &KVRuntime{
+		Path: k.Path,
+	}
+}
+
+//protobuf:7 RuntimeEvent
+type KVRuntimeEvent struct {
+	ID      string     `parser:"" protobuf:"1"`
+	Payload *KVRuntime `parser:"" protobuf:"2"`
+}
+
+var _ RuntimeEvent = (*KVRuntimeEvent)(nil)
+
+func (k *KVRuntimeEvent) runtimeEvent() {}
+
+func KVRuntimeEventFromProto(k *schemapb.KVRuntimeEvent) *KVRuntimeEvent {
+	return &KVRuntimeEvent{
+		ID:      k.Id,
+		Payload: KVRuntimeFromProto(k.Payload),
+	}
+}
+
+func (k *KVRuntimeEvent) ApplyTo(m *Module) {
+	for kv := range slices.FilterVariants[*KV](m.Decls) {
+		if kv.Name == k.ID {
+			kv.Runtime = k.Payload
+		}
+	}
+}
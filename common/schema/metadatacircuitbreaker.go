@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/block/ftl/common/duration"
+)
+
+const (
+	DefaultCircuitBreakerErrorPercent = 50
+	DefaultCircuitBreakerCooldown     = 30 * time.Second
+)
+
+// MetadataCircuitBreaker stops calls being sent to a verb in a deployment
+// while too many of them are failing.
+//
+//	+circuitbreaker [errors=<percent>] [latency=<duration>] [cooldown=<duration>]
+//
+// Calls that take longer than the latency threshold, if one is set, count as
+// failures.
+//
+//protobuf:19
+type MetadataCircuitBreaker struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	ErrorPercent *int   `parser:"'+' 'circuitbreaker' ('errors' '=' @Number)?" protobuf:"2,optional"`
+	Latency      string `parser:"('latency' '=' @(Number (?! Whitespace) Ident))?" protobuf:"3"`
+	Cooldown     string `parser:"('cooldown' '=' @(Number (?! Whitespace) Ident))?" protobuf:"4"`
+}
+
+var _ Metadata = (*MetadataCircuitBreaker)(nil)
+
+func (*MetadataCircuitBreaker) schemaMetadata()          {}
+func (m *MetadataCircuitBreaker) schemaChildren() []Node { return nil }
+func (m *MetadataCircuitBreaker) Position() Position     { return m.Pos }
+func (m *MetadataCircuitBreaker) String() string {
+	components := []string{"+circuitbreaker"}
+	if m.ErrorPercent != nil {
+		components = append(components, "errors="+strconv.Itoa(*m.ErrorPercent))
+	}
+	if m.Latency != "" {
+		components = append(components, "latency="+m.Latency)
+	}
+	if m.Cooldown != "" {
+		components = append(components, "cooldown="+m.Cooldown)
+	}
+	return strings.Join(components, " ")
+}
+
+type CircuitBreakerParams struct {
+	// ErrorRate is the fraction of calls, between 0 and 1, that must fail to open the breaker.
+	ErrorRate float64
+	// Latency is the duration after which a call counts as a failure, or zero if slow calls do not count.
+	Latency time.Duration
+	// Cooldown is how long the breaker stays open before allowing a call through to test for recovery.
+	Cooldown time.Duration
+}
+
+// Params returns the parsed parameters, applying defaults for any that are omitted.
+func (m *MetadataCircuitBreaker) Params() (CircuitBreakerParams, error) {
+	params := CircuitBreakerParams{
+		ErrorRate: float64(DefaultCircuitBreakerErrorPercent) / 100,
+		Cooldown:  DefaultCircuitBreakerCooldown,
+	}
+	if m.ErrorPercent != nil {
+		if *m.ErrorPercent < 1 || *m.ErrorPercent > 100 {
+			return CircuitBreakerParams{}, fmt.Errorf("circuit breaker error percentage must be between 1 and 100")
+		}
+		params.ErrorRate = float64(*m.ErrorPercent) / 100
+	}
+	if m.Latency != "" {
+		latency, err := duration.Parse(m.Latency)
+		if err != nil {
+			return CircuitBreakerParams{}, fmt.Errorf("could not parse circuit breaker latency: %w", err)
+		}
+		if latency <= 0 {
+			return CircuitBreakerParams{}, fmt.Errorf("circuit breaker latency must be positive")
+		}
+		params.Latency = latency
+	}
+	if m.Cooldown != "" {
+		cooldown, err := duration.Parse(m.Cooldown)
+		if err != nil {
+			return CircuitBreakerParams{}, fmt.Errorf("could not parse circuit breaker cooldown: %w", err)
+		}
+		if cooldown <= 0 {
+			return CircuitBreakerParams{}, fmt.Errorf("circuit breaker cooldown must be positive")
+		}
+		params.Cooldown = cooldown
+	}
+	return params, nil
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/block/ftl/common/duration"
+)
+
+// DefaultIdempotencyWindow is how long the response to an idempotent verb is
+// retained if the verb does not specify a window.
+const DefaultIdempotencyWindow = 24 * time.Hour
+
+// MetadataIdempotent marks a verb as idempotent.
+//
+// The first successful response to a call carrying an idempotency key is
+// retained for the window, and returned for any subsequent call with the same
+// key instead of executing the verb again.
+//
+//protobuf:17
+type MetadataIdempotent struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Window string `parser:"'+' 'idempotent' @(Number (?! Whitespace) Ident)?" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataIdempotent)(nil)
+
+func (*MetadataIdempotent) schemaMetadata()          {}
+func (m *MetadataIdempotent) schemaChildren() []Node { return nil }
+func (m *MetadataIdempotent) Position() Position     { return m.Pos }
+func (m *MetadataIdempotent) String() string {
+	if m.Window == "" {
+		return "+idempotent"
+	}
+	return fmt.Sprintf("+idempotent %s", m.Window)
+}
+
+// WindowDuration returns the parsed window, or [DefaultIdempotencyWindow] if
+// none is specified.
+func (m *MetadataIdempotent) WindowDuration() (time.Duration, error) {
+	if m.Window == "" {
+		return DefaultIdempotencyWindow, nil
+	}
+	dur, err := duration.Parse(m.Window)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse idempotency window: %w", err)
+	}
+	if dur <= 0 {
+		return 0, fmt.Errorf("idempotency window must be positive")
+	}
+	return dur, nil
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+//protobuf:15
+type MetadataKV struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Calls []*Ref `parser:"'+' 'kv' 'calls' @@ (',' @@)*" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataKV)(nil)
+
+func (m *MetadataKV) Position() Position { return m.Pos }
+func (m *MetadataKV) String() string {
+	out := &strings.Builder{}
+	fmt.Fprint(out, "+kv calls ")
+	w := 6
+	for i, call := range m.Calls {
+		if i > 0 {
+			fmt.Fprint(out, ", ")
+			w += 2
+		}
+		str := call.String()
+		if w+len(str) > 70 {
+			w = 6
+			fmt.Fprint(out, "\n      ")
+		}
+		w += len(str)
+		fmt.Fprint(out, str)
+	}
+	return out.String()
+}
+
+func (m *MetadataKV) schemaChildren() []Node {
+	out := make([]Node, 0, len(m.Calls))
+	for _, ref := range m.Calls {
+		out = append(out, ref)
+	}
+	return out
+}
+func (*MetadataKV) schemaMetadata() {}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+//protobuf:16
+type MetadataObjectStore struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Calls []*Ref `parser:"'+' 'objectstore' 'calls' @@ (',' @@)*" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataObjectStore)(nil)
+
+func (m *MetadataObjectStore) Position() Position { return m.Pos }
+func (m *MetadataObjectStore) String() string {
+	out := &strings.Builder{}
+	fmt.Fprint(out, "+objectstore calls ")
+	w := 6
+	for i, call := range m.Calls {
+		if i > 0 {
+			fmt.Fprint(out, ", ")
+			w += 2
+		}
+		str := call.String()
+		if w+len(str) > 70 {
+			w = 6
+			fmt.Fprint(out, "\n      ")
+		}
+		w += len(str)
+		fmt.Fprint(out, str)
+	}
+	fmt.Fprint(out)
+	return out.String()
+}
+
+func (m *MetadataObjectStore) schemaChildren() []Node {
+	out := make([]Node, 0, len(m.Calls))
+	for _, ref := range m.Calls {
+		out = append(out, ref)
+	}
+	return out
+}
+func (*MetadataObjectStore) schemaMetadata() {}
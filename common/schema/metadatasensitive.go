@@ -0,0 +1,19 @@
+package schema
+
+// MetadataSensitive marks a data field as containing sensitive information.
+//
+// Sensitive fields are redacted from payloads recorded in the timeline.
+//
+//protobuf:20
+type MetadataSensitive struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Sensitive bool `parser:"'+' @'sensitive'" protobuf:"-"`
+}
+
+var _ Metadata = (*MetadataSensitive)(nil)
+
+func (*MetadataSensitive) schemaMetadata()          {}
+func (m *MetadataSensitive) schemaChildren() []Node { return nil }
+func (m *MetadataSensitive) Position() Position     { return m.Pos }
+func (m *MetadataSensitive) String() string         { return "+sensitive" }
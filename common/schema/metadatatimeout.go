@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/block/ftl/common/duration"
+)
+
+// MetadataTimeout limits how long a call to a verb may take.
+//
+// The timeout also bounds any calls the verb makes, which inherit whatever
+// remains of the caller's deadline.
+//
+//protobuf:18
+type MetadataTimeout struct {
+	Pos Position `parser:"" protobuf:"1,optional"`
+
+	Duration string `parser:"'+' 'timeout' @(Number (?! Whitespace) Ident)" protobuf:"2"`
+}
+
+var _ Metadata = (*MetadataTimeout)(nil)
+
+func (*MetadataTimeout) schemaMetadata()          {}
+func (m *MetadataTimeout) schemaChildren() []Node { return nil }
+func (m *MetadataTimeout) Position() Position     { return m.Pos }
+func (m *MetadataTimeout) String() string         { return fmt.Sprintf("+timeout %s", m.Duration) }
+
+// Timeout returns the parsed timeout.
+func (m *MetadataTimeout) Timeout() (time.Duration, error) {
+	dur, err := duration.Parse(m.Duration)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse timeout: %w", err)
+	}
+	if dur <= 0 {
+		return 0, fmt.Errorf("timeout must be positive")
+	}
+	return dur, nil
+}
@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/slices"
+)
+
+// ObjectStore is a provisioned blob/object store.
+//
+//protobuf:11
+type ObjectStore struct {
+	Pos     Position            `parser:"" protobuf:"1,optional"`
+	Runtime *ObjectStoreRuntime `parser:"" protobuf:"31634,optional"`
+
+	Comments []string `parser:"@Comment*" protobuf:"2"`
+	Name     string   `parser:"'objectstore' @Ident" protobuf:"3"`
+}
+
+var _ Decl = (*ObjectStore)(nil)
+var _ Symbol = (*ObjectStore)(nil)
+var _ Provisioned = (*ObjectStore)(nil)
+
+func (o *ObjectStore) Position() Position     { return o.Pos }
+func (*ObjectStore) schemaDecl()              {}
+func (*ObjectStore) schemaSymbol()            {}
+func (o *ObjectStore) provisioned()           {}
+func (o *ObjectStore) schemaChildren() []Node { return nil }
+
+func (o *ObjectStore) GetName() string  { return o.Name }
+func (o *ObjectStore) IsExported() bool { return false }
+
+func (o *ObjectStore) String() string {
+	w := &strings.Builder{}
+	fmt.Fprint(w, EncodeComments(o.Comments))
+	fmt.Fprintf(w, "objectstore %s", o.Name)
+	return w.String()
+}
+
+func (o *ObjectStore) GetProvisioned() ResourceSet {
+	return ResourceSet{
+		{Kind: ResourceTypeObjectStore, Config: &ObjectStore{Name: o.Name}},
+	}
+}
+
+func (o *ObjectStore) ResourceID() string {
+	return o.Name
+}
+
+func ObjectStoreFromProto(o *schemapb.ObjectStore) *ObjectStore {
+	return &ObjectStore{
+		Pos:     PosFromProto(o.Pos),
+		Runtime: ObjectStoreRuntimeFromProto(o.Runtime),
+
+		Comments: o.Comments,
+		Name:     o.Name,
+	}
+}
+
+// ObjectStoreRuntime holds the runtime information for a provisioned object store.
+type ObjectStoreRuntime struct {
+	// Endpoint of an S3-compatible API. If empty the default AWS endpoint for
+	// the region is used.
+	Endpoint string `parser:"" protobuf:"1"`
+	Bucket   string `parser:"" protobuf:"2"`
+	Region   string `parser:"" protobuf:"3"`
+}
+
+func ObjectStoreRuntimeFromProto(o *schemapb.ObjectStoreRuntime) *ObjectStoreRuntime {
+	if o == nil {
+		return nil
+	}
+	return &ObjectStoreRuntime{
+		Endpoint: o.Endpoint,
+		Bucket:   o.Bucket,
+		Region:   o.Region,
+	}
+}
+
+//protobuf:8 RuntimeEvent
+type ObjectStoreRuntimeEvent struct {
+	ID      string              `parser:"" protobuf:"1"`
+	Payload *ObjectStoreRuntime `parser:"" protobuf:"2"`
+}
+
+var _ RuntimeEvent = (*ObjectStoreRuntimeEvent)(nil)
+
+func (o *ObjectStoreRuntimeEvent) runtimeEvent() {}
+
+func ObjectStoreRuntimeEventFromProto(o *schemapb.ObjectStoreRuntimeEvent) *ObjectStoreRuntimeEvent {
+	return &ObjectStoreRuntimeEvent{
+		ID:      o.Id,
+		Payload: ObjectStoreRuntimeFromProto(o.Payload),
+	}
+}
+
+func (o *ObjectStoreRuntimeEvent) ApplyTo(m *Module) {
+	for store := range slices.FilterVariants[*ObjectStore](m.Decls) {
+		if store.Name == o.ID {
+			store.Runtime = o.Payload
+		}
+	}
+}
@@ -37,6 +37,10 @@ func declListToSchema(s []*schemapb.Decl) []Decl {
 			out = append(out, SecretFromProto(n.Secret))
 		case *schemapb.Decl_Topic:
 			out = append(out, TopicFromProto(n.Topic))
+		case *schemapb.Decl_Kv:
+			out = append(out, KVFromProto(n.Kv))
+		case *schemapb.Decl_ObjectStore:
+			out = append(out, ObjectStoreFromProto(n.ObjectStore))
 		}
 	}
 	return out
@@ -205,6 +209,49 @@ func metadataToSchema(s *schemapb.Metadata) Metadata {
 			Digest:     s.Artefact.Digest,
 		}
 
+	case *schemapb.Metadata_Kv:
+		return &MetadataKV{
+			Pos:   PosFromProto(s.Kv.Pos),
+			Calls: refListToSchema(s.Kv.Calls),
+		}
+
+	case *schemapb.Metadata_ObjectStore:
+		return &MetadataObjectStore{
+			Pos:   PosFromProto(s.ObjectStore.Pos),
+			Calls: refListToSchema(s.ObjectStore.Calls),
+		}
+
+	case *schemapb.Metadata_Idempotent:
+		return &MetadataIdempotent{
+			Pos:    PosFromProto(s.Idempotent.Pos),
+			Window: s.Idempotent.Window,
+		}
+
+	case *schemapb.Metadata_Sensitive:
+		return &MetadataSensitive{
+			Pos:       PosFromProto(s.Sensitive.Pos),
+			Sensitive: true,
+		}
+
+	case *schemapb.Metadata_Timeout:
+		return &MetadataTimeout{
+			Pos:      PosFromProto(s.Timeout.Pos),
+			Duration: s.Timeout.Duration,
+		}
+
+	case *schemapb.Metadata_CircuitBreaker:
+		var errorPercent *int
+		if s.CircuitBreaker.ErrorPercent != nil {
+			errorPercentValue := int(*s.CircuitBreaker.ErrorPercent)
+			errorPercent = &errorPercentValue
+		}
+		return &MetadataCircuitBreaker{
+			Pos:          PosFromProto(s.CircuitBreaker.Pos),
+			ErrorPercent: errorPercent,
+			Latency:      s.CircuitBreaker.Latency,
+			Cooldown:     s.CircuitBreaker.Cooldown,
+		}
+
 	default:
 		panic(fmt.Sprintf("unhandled metadata type: %T", s))
 	}
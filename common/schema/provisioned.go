@@ -16,9 +16,12 @@ const (
 	ResourceTypeUnknown      ResourceType = "unknown"
 	ResourceTypePostgres     ResourceType = "postgres"
 	ResourceTypeMysql        ResourceType = "mysql"
+	ResourceTypeSQLite       ResourceType = "sqlite"
 	ResourceTypeModule       ResourceType = "module"
 	ResourceTypeSQLMigration ResourceType = "sql-migration"
 	ResourceTypeTopic        ResourceType = "topic"
+	ResourceTypeKV           ResourceType = "kv"
+	ResourceTypeObjectStore  ResourceType = "objectstore"
 	ResourceTypeSubscription ResourceType = "subscription"
 	ResourceTypeRunner       ResourceType = "runner"
 )
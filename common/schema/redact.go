@@ -1,6 +1,12 @@
 package schema
 
-import "github.com/block/ftl/common/reflect"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/block/ftl/common/reflect"
+)
 
 type Redactable interface {
 	Redact()
@@ -19,3 +25,115 @@ func Redact[T Node](n T) T {
 	})
 	return n
 }
+
+// RedactedValue replaces the value of sensitive fields in redacted JSON.
+const RedactedValue = "[redacted]"
+
+// RedactJSON replaces the value of every field marked as sensitive in a JSON
+// encoded value of type t with [RedactedValue].
+//
+// Fields are matched by their JSON alias or name. Parts of the value that do not
+// match the schema are left unchanged, and if nothing is redacted the original
+// data is returned.
+func RedactJSON(sch *Schema, t Type, data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("could not decode JSON value: %w", err)
+	}
+	if !redactJSONValue(sch, t, value) {
+		return data, nil
+	}
+	out, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode redacted JSON value: %w", err)
+	}
+	return out, nil
+}
+
+// redactJSONValue redacts sensitive fields in value in place, returning true if any were redacted.
+func redactJSONValue(sch *Schema, t Type, value any) bool {
+	changed := false
+	switch t := t.(type) {
+	case *Optional:
+		return redactJSONValue(sch, t.Type, value)
+
+	case *Array:
+		elements, ok := value.([]any)
+		if !ok {
+			return false
+		}
+		for _, element := range elements {
+			changed = redactJSONValue(sch, t.Element, element) || changed
+		}
+
+	case *Map:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		for _, entry := range entries {
+			changed = redactJSONValue(sch, t.Value, entry) || changed
+		}
+
+	case *Ref:
+		decl, ok := sch.Resolve(t).Get()
+		if !ok {
+			return false
+		}
+		switch decl := decl.(type) {
+		case *Data:
+			data, err := sch.ResolveMonomorphised(t)
+			if err != nil {
+				return false
+			}
+			object, ok := value.(map[string]any)
+			if !ok {
+				return false
+			}
+			for _, field := range data.Fields {
+				names := []string{field.Name}
+				if alias, ok := field.Alias(AliasKindJSON).Get(); ok && alias != field.Name {
+					names = append(names, alias)
+				}
+				for _, name := range names {
+					fieldValue, ok := object[name]
+					if !ok {
+						continue
+					}
+					if field.IsSensitive() {
+						if fieldValue != nil {
+							object[name] = RedactedValue
+							changed = true
+						}
+						continue
+					}
+					changed = redactJSONValue(sch, field.Type, fieldValue) || changed
+				}
+			}
+
+		case *Enum:
+			if decl.IsValueEnum() {
+				return false
+			}
+			object, ok := value.(map[string]any)
+			if !ok {
+				return false
+			}
+			for _, variant := range decl.Variants {
+				if typeValue, ok := variant.Value.(*TypeValue); ok && object["name"] == variant.Name {
+					return redactJSONValue(sch, typeValue.Value, object["value"])
+				}
+			}
+
+		case *TypeAlias:
+			return redactJSONValue(sch, decl.Type, value)
+
+		case *Config, *Database, *Secret, *Verb, *Topic, *KV, *ObjectStore:
+		}
+
+	case *Any, *Bool, *Bytes, *Float, *Int, *String, *Time, *Unit:
+	}
+	return changed
+}
@@ -1,5 +1,56 @@
 package schema
 
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestRedactJSON(t *testing.T) {
+	sch, err := ParseString("", `
+		module test {
+			data Credentials {
+				user String
+				password String +sensitive
+				token String? +alias json "apiToken" +sensitive
+			}
+
+			data Wrapper<T> {
+				value T
+			}
+
+			enum Auth {
+				Basic test.Credentials
+				Anonymous Unit
+			}
+
+			data Request {
+				primary test.Wrapper<test.Credentials>
+				others [test.Credentials]
+				auth test.Auth
+				count Int
+			}
+		}
+	`)
+	assert.NoError(t, err)
+
+	redacted, err := RedactJSON(sch, &Ref{Module: "test", Name: "Request"}, []byte(`{
+		"primary": {"value": {"user": "alice", "password": "hunter2", "apiToken": "abc"}},
+		"others": [{"user": "bob", "password": "swordfish", "apiToken": null}],
+		"auth": {"name": "Basic", "value": {"user": "carol", "password": "letmein"}},
+		"count": 12345678901234567890
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"auth":{"name":"Basic","value":{"password":"[redacted]","user":"carol"}},"count":12345678901234567890,`+
+		`"others":[{"apiToken":null,"password":"[redacted]","user":"bob"}],`+
+		`"primary":{"value":{"apiToken":"[redacted]","password":"[redacted]","user":"alice"}}}`, string(redacted))
+
+	unchanged := []byte(`{"user": "alice"}`)
+	redacted, err = RedactJSON(sch, &Ref{Module: "test", Name: "Credentials"}, unchanged)
+	assert.NoError(t, err)
+	assert.Equal(t, unchanged, redacted)
+}
+
 // func TestRedact(t *testing.T) {
 // 	module := &Module{
 // 		Decls: []Decl{
@@ -239,6 +239,7 @@ func TestParserRoundTrip(t *testing.T) {
 
 //nolint:maintidx
 func TestParsing(t *testing.T) {
+	twentyFive := 25
 	tests := []struct {
 		name     string
 		input    string
@@ -275,6 +276,215 @@ func TestParsing(t *testing.T) {
 					},
 				},
 			}},
+		{name: "SQLiteDatabase",
+			input: `
+				module test {
+					database sqlite cache
+						+migration sha256:8cc04c75ab7967eb2ec82e11e886831e00b7cb00507e9a8ecf400bdc599eccfd
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Database{
+							Type: SQLiteDatabaseType,
+							Name: "cache",
+							Metadata: []Metadata{
+								&MetadataSQLMigration{Digest: "8cc04c75ab7967eb2ec82e11e886831e00b7cb00507e9a8ecf400bdc599eccfd"},
+							},
+						},
+					},
+				}},
+			}},
+		{name: "KV",
+			input: `
+				module test {
+					kv sessions<String, test.Session>
+
+					data Session {
+						user String
+					}
+
+					verb login(Unit) Unit
+						+kv calls test.sessions
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&KV{
+							Name:  "sessions",
+							Key:   &String{},
+							Value: &Ref{Module: "test", Name: "Session"},
+						},
+						&Data{
+							Name:   "Session",
+							Fields: []*Field{{Name: "user", Type: &String{}}},
+						},
+						&Verb{
+							Name:     "login",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{
+								&MetadataKV{Calls: []*Ref{{Module: "test", Name: "sessions"}}},
+							},
+						},
+					},
+				}},
+			}},
+		{name: "InvalidKVName",
+			input: `module test { kv Sessions<String, String> }`,
+			errors: []string{
+				"1:15: invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter.",
+			}},
+		{name: "ObjectStore",
+			input: `
+				module test {
+					objectstore uploads
+
+					verb upload(Unit) Unit
+						+objectstore calls test.uploads
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&ObjectStore{Name: "uploads"},
+						&Verb{
+							Name:     "upload",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{
+								&MetadataObjectStore{Calls: []*Ref{{Module: "test", Name: "uploads"}}},
+							},
+						},
+					},
+				}},
+			}},
+		{name: "InvalidObjectStoreName",
+			input: `module test { objectstore Uploads }`,
+			errors: []string{
+				"1:15: invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter.",
+			}},
+		{name: "Idempotent",
+			input: `
+				module test {
+					verb charge(Unit) Unit
+						+idempotent
+
+					verb refund(Unit) Unit
+						+idempotent 1h
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "charge",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataIdempotent{}},
+						},
+						&Verb{
+							Name:     "refund",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataIdempotent{Window: "1h"}},
+						},
+					},
+				}},
+			}},
+		{name: "InvalidIdempotentWindow",
+			input: `module test { verb charge(Unit) Unit +idempotent 0s }`,
+			errors: []string{
+				"1:38: verb charge: idempotency window must be positive",
+			}},
+		{name: "Timeout",
+			input: `
+				module test {
+					verb charge(Unit) Unit
+						+timeout 5s
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "charge",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataTimeout{Duration: "5s"}},
+						},
+					},
+				}},
+			}},
+		{name: "SensitiveField",
+			input: `
+				module test {
+					data Login {
+						user String
+						password String +sensitive
+					}
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Data{
+							Name: "Login",
+							Fields: []*Field{
+								{Name: "user", Type: &String{}},
+								{Name: "password", Type: &String{}, Metadata: []Metadata{&MetadataSensitive{Sensitive: true}}},
+							},
+						},
+					},
+				}},
+			}},
+		{name: "InvalidTimeout",
+			input: `module test { verb charge(Unit) Unit +timeout 0s }`,
+			errors: []string{
+				"1:38: verb charge: timeout must be positive",
+			}},
+		{name: "CircuitBreaker",
+			input: `
+				module test {
+					verb charge(Unit) Unit
+						+circuitbreaker
+
+					verb refund(Unit) Unit
+						+circuitbreaker errors=25 latency=2s cooldown=1m
+				}
+			`,
+			expected: &Schema{
+				Modules: []*Module{{
+					Name: "test",
+					Decls: []Decl{
+						&Verb{
+							Name:     "charge",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataCircuitBreaker{}},
+						},
+						&Verb{
+							Name:     "refund",
+							Request:  &Unit{Unit: true},
+							Response: &Unit{Unit: true},
+							Metadata: []Metadata{&MetadataCircuitBreaker{ErrorPercent: &twentyFive, Latency: "2s", Cooldown: "1m"}},
+						},
+					},
+				}},
+			}},
+		{name: "InvalidCircuitBreakerErrorPercent",
+			input: `module test { verb charge(Unit) Unit +circuitbreaker errors=101 }`,
+			errors: []string{
+				"1:38: verb charge: circuit breaker error percentage must be between 1 and 100",
+			}},
 		{name: "InvalidRequestRef",
 			input: `module test { verb test(InvalidRequest) InvalidResponse}`,
 			errors: []string{
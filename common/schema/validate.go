@@ -2,6 +2,7 @@
 package schema
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -180,7 +181,7 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 						validateRetries(module, md, optional.Some(n.Request), scopes, optional.Some(schema))
 
 					case *MetadataCronJob, *MetadataCalls, *MetadataConfig, *MetadataDatabases, *MetadataAlias, *MetadataTypeMap,
-						*MetadataEncoding, *MetadataSecrets, *MetadataPublisher, *MetadataSQLMigration, *MetadataArtefact, DatabaseConnector:
+						*MetadataEncoding, *MetadataSecrets, *MetadataPublisher, *MetadataSQLMigration, *MetadataArtefact, *MetadataKV, *MetadataObjectStore, *MetadataIdempotent, *MetadataTimeout, *MetadataCircuitBreaker, *MetadataSensitive, DatabaseConnector:
 					}
 				}
 			case *Database:
@@ -216,10 +217,20 @@ func ValidateModuleInSchema(schema *Schema, m optional.Option[*Module]) (*Schema
 				}
 				return next()
 
+			case *Config:
+				if n.Default != nil {
+					var v any
+					if err := json.Unmarshal([]byte(*n.Default), &v); err != nil {
+						merr = append(merr, errorf(n, "config %q default is not valid JSON: %s", n.Name, err))
+					} else if err := ValidateJSONValue(n.Type, []string{n.Name}, v, schema); err != nil {
+						merr = append(merr, errorf(n, "config %q default is invalid: %s", n.Name, err))
+					}
+				}
+
 			case
 				IngressPathComponent, Metadata, Value, Type, DatabaseConnector,
 				*Module, *Optional, *Schema, *TypeAlias, *String, *Time, *Unit, *Any, *TypeParameter,
-				*EnumVariant, *Config, *Secret, *Topic, *DatabaseRuntime, *DatabaseRuntimeConnections,
+				*EnumVariant, *Secret, *Topic, *KV, *ObjectStore, *DatabaseRuntime, *DatabaseRuntimeConnections,
 				*Data, *Field:
 			}
 			return next()
@@ -290,7 +301,7 @@ func ValidateModule(module *Module) error {
 					moduleName = m.Name
 				}
 				switch decl := mdecl.Symbol.(type) {
-				case *Verb, *Enum, *Database, *Config, *Secret, *Topic:
+				case *Verb, *Enum, *Database, *Config, *Secret, *Topic, *KV, *ObjectStore:
 					if n.Module == "" {
 						n.Module = moduleName
 					}
@@ -331,7 +342,9 @@ func ValidateModule(module *Module) error {
 
 		case *Field:
 			for _, md := range n.Metadata {
-				if _, ok := md.(*MetadataAlias); !ok {
+				switch md.(type) {
+				case *MetadataAlias, *MetadataSensitive:
+				default:
 					merr = append(merr, errorf(md, "metadata %q is not valid on fields", strings.TrimSpace(md.String())))
 				}
 			}
@@ -344,6 +357,19 @@ func ValidateModule(module *Module) error {
 				merr = append(merr, errorf(n, "invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter."))
 			}
 
+		case *KV:
+			if !ValidateName(n.Name) || !unicode.IsLower(rune(n.Name[0])) {
+				merr = append(merr, errorf(n, "invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter."))
+			}
+			if _, ok := n.Key.(*Optional); ok {
+				merr = append(merr, errorf(n.Key, "kv %q key type must not be optional", n.Name))
+			}
+
+		case *ObjectStore:
+			if !ValidateName(n.Name) || !unicode.IsLower(rune(n.Name[0])) {
+				merr = append(merr, errorf(n, "invalid name: must consist of only letters, numbers and underscores, and start with a lowercase letter."))
+			}
+
 		case *TypeAlias:
 			for _, md := range n.Metadata {
 				if _, ok := md.(*MetadataTypeMap); !ok {
@@ -396,14 +422,18 @@ func getDeclSortingPriority(decl Decl) int {
 		priority = 3
 	case *Topic:
 		priority = 4
-	case *TypeAlias:
+	case *KV:
 		priority = 5
-	case *Enum:
+	case *ObjectStore:
 		priority = 6
-	case *Data:
+	case *TypeAlias:
 		priority = 7
-	case *Verb:
+	case *Enum:
 		priority = 8
+	case *Data:
+		priority = 9
+	case *Verb:
+		priority = 10
 	}
 	return priority
 }
@@ -459,6 +489,18 @@ func sortMetadataType(md Metadata) {
 		return
 	case *MetadataArtefact:
 		return
+	case *MetadataKV:
+		sortRefs(m.Calls)
+	case *MetadataObjectStore:
+		sortRefs(m.Calls)
+	case *MetadataIdempotent:
+		return
+	case *MetadataTimeout:
+		return
+	case *MetadataCircuitBreaker:
+		return
+	case *MetadataSensitive:
+		return
 	}
 }
 
@@ -493,6 +535,18 @@ func getMetadataSortingPriority(metadata Metadata) int {
 		priority = 13
 	case *MetadataArtefact:
 		priority = 14
+	case *MetadataKV:
+		priority = 15
+	case *MetadataObjectStore:
+		priority = 16
+	case *MetadataIdempotent:
+		priority = 17
+	case *MetadataTimeout:
+		priority = 18
+	case *MetadataCircuitBreaker:
+		priority = 19
+	case *MetadataSensitive:
+		priority = 20
 	}
 	return priority
 }
@@ -677,10 +731,14 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 				merr = append(merr, errorf(md, "verb %s: cron job can not have a response type", n.Name))
 			}
 		case *MetadataRetry:
-			// Only allow retries on pubsub subscribers for now
-			_, isSubscriber := islices.FindVariant[*MetadataSubscriber](n.Metadata)
-			if !isSubscriber {
-				merr = append(merr, errorf(md, `retries can only be added to subscribers`))
+			// Retries apply to subscribers and asynchronous calls. Cron jobs and
+			// ingress verbs are always called synchronously and never retried.
+			if _, isCron := islices.FindVariant[*MetadataCronJob](n.Metadata); isCron {
+				merr = append(merr, errorf(md, `retries can not be added to cron jobs`))
+				return
+			}
+			if _, isIngress := islices.FindVariant[*MetadataIngress](n.Metadata); isIngress {
+				merr = append(merr, errorf(md, `retries can not be added to ingress verbs`))
 				return
 			}
 
@@ -690,8 +748,20 @@ func validateVerbMetadata(scopes Scopes, module *Module, n *Verb) (merr []error)
 		case *MetadataSubscriber:
 			subErrs := validateVerbSubscriptions(module, n, md, scopes)
 			merr = append(merr, subErrs...)
+		case *MetadataIdempotent:
+			if _, err := md.WindowDuration(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+		case *MetadataTimeout:
+			if _, err := md.Timeout(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
+		case *MetadataCircuitBreaker:
+			if _, err := md.Params(); err != nil {
+				merr = append(merr, errorf(md, "verb %s: %v", n.Name, err))
+			}
 		case *MetadataCalls, *MetadataConfig, *MetadataDatabases, *MetadataAlias, *MetadataTypeMap, *MetadataEncoding,
-			*MetadataSecrets, *MetadataPublisher, *MetadataSQLMigration, *MetadataArtefact:
+			*MetadataSecrets, *MetadataPublisher, *MetadataSQLMigration, *MetadataArtefact, *MetadataKV, *MetadataObjectStore:
 		}
 	}
 	return
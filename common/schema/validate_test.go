@@ -269,19 +269,22 @@ func TestValidate(t *testing.T) {
 				`,
 			errs: []string{"4:7: enum variant \"A\" of type Int cannot have a value of type \"String\""},
 		},
-		{name: "NonSubscriberVerbsWithRetry",
+		{name: "SynchronousVerbsWithRetry",
 			schema: `
 				module one {
-					verb A(Empty) Unit
+					verb A(Unit) Unit
 						+retry 10 5s 20m
-					verb B(Empty) Unit
+						+cron * * * * * * *
+					verb B(builtin.HttpRequest<Unit, Unit, Unit>) builtin.HttpResponse<Unit, Unit>
 						+retry 1m5s 20m30s
+						+ingress http GET /b
 					verb C(Empty) Unit
+						+retry 1m5s 20m30s
 				}
 				`,
 			errs: []string{
-				`4:7: retries can only be added to subscribers`,
-				`6:7: retries can only be added to subscribers`,
+				`4:7: retries can not be added to cron jobs`,
+				`7:7: retries can not be added to ingress verbs`,
 			},
 		},
 		{name: "InvalidRetryDurations",
@@ -464,6 +467,28 @@ func TestValidate(t *testing.T) {
 				`9:6: verb can not subscribe to multiple topics`,
 			},
 		},
+		{
+			name: "ConfigDefaults",
+			schema: `
+			module one {
+				data Settings {
+					retries Int
+				}
+
+				config port Int = "8080"
+				config name String = "\"one\""
+				config settings one.Settings = "{\"retries\": 3}"
+				config badPort Int = "\"eighty\""
+				config badSettings one.Settings = "{}"
+				config invalid Bool = "nope"
+			}
+		`,
+			errs: []string{
+				`10:5: config "badPort" default is invalid: badPort has wrong type, expected Int found string`,
+				`11:5: config "badSettings" default is invalid: badSettings.retries is required`,
+				`12:5: config "invalid" default is not valid JSON: invalid character 'o' in literal null (expecting 'u')`,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -143,6 +143,24 @@ func (v *Verb) AddTopicPublish(topic *Ref) {
 	v.Metadata = append(v.Metadata, &MetadataPublisher{Topics: []*Ref{topic}})
 }
 
+// AddKV adds a key-value store that this Verb uses.
+func (v *Verb) AddKV(kv *Ref) {
+	if c, ok := slices.FindVariant[*MetadataKV](v.Metadata); ok {
+		c.Calls = append(c.Calls, kv)
+		return
+	}
+	v.Metadata = append(v.Metadata, &MetadataKV{Calls: []*Ref{kv}})
+}
+
+// AddObjectStore adds an object store that this Verb uses.
+func (v *Verb) AddObjectStore(store *Ref) {
+	if c, ok := slices.FindVariant[*MetadataObjectStore](v.Metadata); ok {
+		c.Calls = append(c.Calls, store)
+		return
+	}
+	v.Metadata = append(v.Metadata, &MetadataObjectStore{Calls: []*Ref{store}})
+}
+
 func (v *Verb) SortMetadata() {
 	sortMetadata(v.Metadata)
 }
@@ -161,6 +179,27 @@ func (v *Verb) GetMetadataCronJob() optional.Option[*MetadataCronJob] {
 	return optional.None[*MetadataCronJob]()
 }
 
+func (v *Verb) GetMetadataIdempotent() optional.Option[*MetadataIdempotent] {
+	if m, ok := slices.FindVariant[*MetadataIdempotent](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataIdempotent]()
+}
+
+func (v *Verb) GetMetadataTimeout() optional.Option[*MetadataTimeout] {
+	if m, ok := slices.FindVariant[*MetadataTimeout](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataTimeout]()
+}
+
+func (v *Verb) GetMetadataCircuitBreaker() optional.Option[*MetadataCircuitBreaker] {
+	if m, ok := slices.FindVariant[*MetadataCircuitBreaker](v.Metadata); ok {
+		return optional.Some(m)
+	}
+	return optional.None[*MetadataCircuitBreaker]()
+}
+
 func (v *Verb) GetProvisioned() ResourceSet {
 	var result ResourceSet
 	for sub := range slices.FilterVariants[*MetadataSubscriber](v.Metadata) {
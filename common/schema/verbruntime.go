@@ -86,6 +86,10 @@ func VerbRuntimeBaseFromProto(s *schemapb.VerbRuntimeBase) *VerbRuntimeBase {
 //protobuf:2
 type VerbRuntimeSubscription struct {
 	KafkaBrokers []string `protobuf:"1"`
+	// TopicID is the Kafka topic to consume from, defaulting to the topic's reference if empty.
+	TopicID string `protobuf:"2"`
+	// ConsumerGroupID is the Kafka consumer group, defaulting to the verb's reference if empty.
+	ConsumerGroupID string `protobuf:"3"`
 }
 
 func (*VerbRuntimeSubscription) verbRuntime() {}
@@ -95,6 +99,8 @@ func VerbRuntimeSubscriptionFromProto(s *schemapb.VerbRuntimeSubscription) *Verb
 		return nil
 	}
 	return &VerbRuntimeSubscription{
-		KafkaBrokers: s.KafkaBrokers,
+		KafkaBrokers:    s.KafkaBrokers,
+		TopicID:         s.TopicId,
+		ConsumerGroupID: s.ConsumerGroupId,
 	}
 }
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	jsonpb "google.golang.org/protobuf/encoding/protojson"
+
+	asyncpb "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
+	"github.com/block/ftl/common/schema"
+)
+
+type asyncCmd struct {
+	List   asyncListCmd   `cmd:"" help:"List asynchronous calls, most recent first."`
+	Retry  asyncRetryCmd  `cmd:"" help:"Retry a failed or cancelled asynchronous call."`
+	Cancel asyncCancelCmd `cmd:"" help:"Cancel a pending asynchronous call."`
+}
+
+type asyncListCmd struct {
+	Module string `help:"Only show calls to verbs in this module."`
+	State  string `help:"Only show calls in this state: pending, running, succeeded, failed or cancelled." enum:"pending,running,succeeded,failed,cancelled," default:""`
+	Limit  int32  `help:"Maximum number of calls to show." default:"100"`
+	JSON   bool   `help:"Output JSON."`
+}
+
+func (a *asyncListCmd) Run(ctx context.Context, client asynccallpbconnect.AsyncServiceClient) error {
+	req := &asyncpb.ListCallsRequest{Limit: a.Limit}
+	if a.Module != "" {
+		req.Module = &a.Module
+	}
+	if a.State != "" {
+		value, ok := asyncpb.AsyncCallState_value["ASYNC_CALL_STATE_"+strings.ToUpper(a.State)]
+		if !ok {
+			return fmt.Errorf("unknown state %q", a.State)
+		}
+		req.State = asyncpb.AsyncCallState(value).Enum()
+	}
+	resp, err := client.ListCalls(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list async calls: %w", err)
+	}
+	if a.JSON {
+		marshaller := jsonpb.MarshalOptions{Indent: "  "}
+		for _, call := range resp.Msg.Calls {
+			data, err := marshaller.Marshal(call)
+			if err != nil {
+				return fmt.Errorf("failed to marshal async call: %w", err)
+			}
+			fmt.Printf("%s\n", data)
+		}
+		return nil
+	}
+	format := "%-45s %-30s %-10s %-8s %-20s %s\n"
+	fmt.Printf(format, "ID", "VERB", "STATE", "ATTEMPTS", "UPDATED", "ERROR")
+	for _, call := range resp.Msg.Calls {
+		verb := schema.RefFromProto(call.Verb).String()
+		if call.CatchVerb != nil {
+			verb += " (catch " + schema.RefFromProto(call.CatchVerb).String() + ")"
+		}
+		state := strings.ToLower(strings.TrimPrefix(call.State.String(), "ASYNC_CALL_STATE_"))
+		fmt.Printf(format, call.Id, verb, state, fmt.Sprint(call.Attempts), call.UpdatedAt.AsTime().Local().Format(time.DateTime), call.GetError())
+	}
+	return nil
+}
+
+type asyncRetryCmd struct {
+	ID string `arg:"" help:"ID of the call to retry."`
+}
+
+func (a *asyncRetryCmd) Run(ctx context.Context, client asynccallpbconnect.AsyncServiceClient) error {
+	_, err := client.RetryCall(ctx, connect.NewRequest(&asyncpb.RetryCallRequest{Id: a.ID}))
+	if err != nil {
+		return fmt.Errorf("failed to retry async call: %w", err)
+	}
+	return nil
+}
+
+type asyncCancelCmd struct {
+	ID string `arg:"" help:"ID of the call to cancel."`
+}
+
+func (a *asyncCancelCmd) Run(ctx context.Context, client asynccallpbconnect.AsyncServiceClient) error {
+	_, err := client.CancelCall(ctx, connect.NewRequest(&asyncpb.CancelCallRequest{Id: a.ID}))
+	if err != nil {
+		return fmt.Errorf("failed to cancel async call: %w", err)
+	}
+	return nil
+}
@@ -3,19 +3,20 @@ package main
 import (
 	"context"
 	"fmt"
-	"math"
-	"sort"
-	"sync/atomic"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/jpillora/backoff"
-	"github.com/titanous/json5"
-	"golang.org/x/sync/errgroup"
 
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/bench"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/rpc"
 )
@@ -25,103 +26,188 @@ type benchCmd struct {
 	Parallelism int            `short:"j" help:"Number of concurrent benchmarks to create." default:"${numcpu}"`
 	Wait        time.Duration  `short:"w" help:"Wait up to this elapsed time for the FTL cluster to become available." default:"1m"`
 	Verb        reflection.Ref `arg:"" required:"" help:"Full path of Verb to call." predictor:"verbs"`
-	Request     string         `arg:"" optional:"" help:"JSON5 request payload." default:"{}"`
+	Request     string         `arg:"" optional:"" help:"JSON5 request payload, which may contain template actions such as {{.Seq}}, {{uuid}} or {{fake \"email\"}}." default:"{}"`
+	Generate    bool           `help:"Generate a value for each field of the request from the Verb's schema. Fields in the request payload override generated values."`
+
+	Rate        float64       `placeholder:"RATE" help:"Run an open-loop load test making this many requests per second, regardless of latency. With --stage, this is the initial rate." group:"Load test:"`
+	Duration    time.Duration `help:"Duration of a load test at a constant --rate." default:"30s" group:"Load test:"`
+	Stages      []bench.Stage `name:"stage" placeholder:"DURATION:RATE" help:"Ramp the request rate linearly to RATE over DURATION, as DURATION:RATE. May be repeated, eg. --stage=30s:100 --stage=2m:100 --stage=30s:0." group:"Load test:"`
+	MaxInFlight int           `help:"Maximum number of requests in flight during a load test, beyond which requests are dropped." default:"1000" group:"Load test:"`
+	Interval    time.Duration `help:"Interval to report latencies over." default:"1s" group:"Load test:"`
+	Output      string        `short:"o" help:"Write results to this file." type:"path" group:"Load test:"`
+	Format      string        `help:"Format of the results written to --output. Defaults to csv for files with a .csv extension, otherwise json." enum:"auto,json,csv" default:"auto" group:"Load test:"`
 }
 
-func (c *benchCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient) error {
+func (c *benchCmd) Run(ctx context.Context, client ftlv1connect.VerbServiceClient, schemaClient ftlv1connect.SchemaServiceClient) error {
 	if err := rpc.Wait(ctx, backoff.Backoff{Max: time.Second * 2}, c.Wait, client); err != nil {
 		return fmt.Errorf("FTL cluster did not become ready: %w", err)
 	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	if c.MaxInFlight <= 0 {
+		return fmt.Errorf("--max-in-flight must be positive")
+	}
 	logger := log.FromContext(ctx)
-	request := map[string]any{}
-	err := json5.Unmarshal([]byte(c.Request), &request)
+	generator, err := bench.NewGenerator(c.Request)
 	if err != nil {
-		return fmt.Errorf("invalid request: %w", err)
+		return err //nolint:wrapcheck
+	}
+	if c.Generate {
+		if err := c.generateFromSchema(ctx, schemaClient, generator); err != nil {
+			return err
+		}
+	}
+
+	openLoop := c.Rate > 0 || len(c.Stages) > 0
+	profile := bench.Profile{InitialRate: c.Rate, Stages: c.Stages}
+	if len(profile.Stages) == 0 {
+		profile.Stages = []bench.Stage{{Duration: c.Duration, Rate: c.Rate}}
 	}
 
 	fmt.Printf("Starting benchmark\n")
 	fmt.Printf("  Verb: %s\n", c.Verb)
-	fmt.Printf("  Count: %d\n", c.Count)
-	fmt.Printf("  Parallelism: %d\n", c.Parallelism)
+	if openLoop {
+		fmt.Printf("  Duration: %s\n", profile.Duration())
+		fmt.Printf("  Initial rate: %g/s\n", profile.InitialRate)
+		for _, stage := range profile.Stages {
+			fmt.Printf("  Stage: %g/s over %s\n", stage.Rate, stage.Duration)
+		}
+	} else {
+		fmt.Printf("  Count: %d\n", c.Count)
+		fmt.Printf("  Parallelism: %d\n", c.Parallelism)
+	}
 
-	var errors int64
-	var success int64
-	wg := errgroup.Group{}
-	timings := make([][]time.Duration, c.Parallelism)
-	for job := range c.Parallelism {
-		wg.Go(func() error {
-			for range c.Count {
-				start := time.Now()
-				// otherwise, we have a match so call the verb
-				_, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{
-					Verb: c.Verb.ToProto(),
-					Body: []byte(c.Request),
-				}))
-				if err != nil {
-					// Only log error once.
-					if atomic.AddInt64(&errors, 1) == 1 {
-						logger.Errorf(err, "Error calling %s", c.Verb)
-					}
-				} else {
-					atomic.AddInt64(&success, 1)
-				}
-				timings[job] = append(timings[job], time.Since(start))
-			}
-			return nil
-		})
-	}
-	_ = wg.Wait() //nolint: errcheck
+	// Only log the first error of each kind.
+	logged := sync.Map{}
+	logError := func(kind string, err error) {
+		if _, loaded := logged.LoadOrStore(kind, true); !loaded {
+			logger.Errorf(err, "Error calling %s", c.Verb)
+		}
+	}
+	start := time.Now()
+	recorder := bench.NewRecorder(start, c.Interval)
+	call := func(ctx context.Context, seq int64) {
+		body, err := generator.Request(seq)
+		if err != nil {
+			logError("request", err)
+			recorder.Record(time.Now(), 0, "request")
+			return
+		}
+		callStart := time.Now()
+		resp, err := client.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{
+			Verb: c.Verb.ToProto(),
+			Body: body,
+		}))
+		latency := time.Since(callStart)
+		kind := ""
+		if err != nil {
+			kind = bench.ErrorKind(err)
+			logError(kind, err)
+		} else if verbErr := resp.Msg.GetError(); verbErr != nil {
+			kind = bench.ErrorVerb
+			logError(kind, fmt.Errorf("verb error: %s", verbErr.Message))
+		}
+		recorder.Record(callStart, latency, kind)
+	}
 
-	// Display timing percentiles.
-	var allTimings []time.Duration
-	for _, t := range timings {
-		allTimings = append(allTimings, t...)
+	var dropped int64
+	if openLoop {
+		dropped = bench.RunOpenLoop(ctx, profile, c.MaxInFlight, call)
+	} else {
+		bench.RunClosedLoop(ctx, c.Parallelism, c.Count, call)
 	}
-	sort.Slice(allTimings, func(i, j int) bool { return allTimings[i] < allTimings[j] })
-	fmt.Printf("Results:\n")
-	fmt.Printf("  Successes: %d\n", success)
-	fmt.Printf("  Errors: %d\n", errors)
-	fmt.Printf("Timing percentiles:\n")
-	for p, t := range computePercentiles(allTimings) {
-		fmt.Printf("  %d%%: %s\n", p, t)
+	results := recorder.Results(time.Now(), dropped)
+
+	printBenchResults(results, openLoop)
+	if c.Output != "" {
+		if err := c.writeResults(results); err != nil {
+			return err
+		}
+		fmt.Printf("Results written to %s\n", c.Output)
 	}
-	fmt.Printf("Standard deviation: ±%v\n", computeStandardDeviation(allTimings))
 	return nil
 }
 
-func computePercentiles(timings []time.Duration) map[int]time.Duration {
-	percentiles := map[int]time.Duration{}
-	for _, p := range []int{50, 90, 95, 99} {
-		percentiles[p] = percentile(timings, p)
+func (c *benchCmd) generateFromSchema(ctx context.Context, schemaClient ftlv1connect.SchemaServiceClient, generator *bench.Generator) error {
+	resp, err := schemaClient.GetSchema(ctx, connect.NewRequest(&ftlv1.GetSchemaRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to get schema: %w", err)
+	}
+	sch, err := schema.FromProto(resp.Msg.Schema)
+	if err != nil {
+		return fmt.Errorf("invalid schema: %w", err)
 	}
-	return percentiles
+	verb := &schema.Verb{}
+	if err := sch.ResolveToType(&schema.Ref{Module: c.Verb.Module, Name: c.Verb.Name}, verb); err != nil {
+		return fmt.Errorf("failed to find verb %s: %w", c.Verb, err)
+	}
+	if err := generator.GenerateFrom(sch, verb.Request); err != nil {
+		return fmt.Errorf("%s: %w", c.Verb, err)
+	}
+	return nil
 }
 
-func percentile(timings []time.Duration, p int) time.Duration {
-	if len(timings) == 0 {
-		return 0
+func (c *benchCmd) writeResults(results bench.Results) error {
+	format := c.Format
+	if format == "auto" {
+		format = "json"
+		if strings.EqualFold(filepath.Ext(c.Output), ".csv") {
+			format = "csv"
+		}
+	}
+	w, err := os.Create(c.Output)
+	if err != nil {
+		return fmt.Errorf("failed to create results file: %w", err)
+	}
+	defer w.Close()
+	if format == "csv" {
+		err = results.WriteCSV(w)
+	} else {
+		err = results.WriteJSON(w)
+	}
+	if err != nil {
+		return err //nolint:wrapcheck
 	}
-	i := int(float64(len(timings)) * float64(p) / 100)
-	return timings[i]
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to write results file: %w", err)
+	}
+	return nil
 }
 
-func computeStandardDeviation(timings []time.Duration) time.Duration {
-	if len(timings) == 0 {
-		return 0
+func printBenchResults(results bench.Results, openLoop bool) {
+	fmt.Printf("Results:\n")
+	fmt.Printf("  Successes: %d\n", results.Successes)
+	fmt.Printf("  Errors: %d\n", results.Errors)
+	if openLoop {
+		fmt.Printf("  Dropped: %d\n", results.Dropped)
 	}
-
-	var sum time.Duration
-	for _, t := range timings {
-		sum += t
+	fmt.Printf("  Rate: %.1f/s\n", results.Rate)
+	if len(results.ErrorsByKind) > 0 {
+		fmt.Printf("Errors by code:\n")
+		for _, kind := range results.SortedErrorKinds() {
+			fmt.Printf("  %s: %d\n", kind, results.ErrorsByKind[kind])
+		}
 	}
-	mean := float64(sum) / float64(len(timings))
-
-	var varianceSum float64
-	for _, t := range timings {
-		diff := float64(t) - mean
-		varianceSum += diff * diff
+	latency := results.Latency
+	fmt.Printf("Timing percentiles:\n")
+	fmt.Printf("  50%%: %s\n", formatMillis(latency.P50Ms))
+	fmt.Printf("  90%%: %s\n", formatMillis(latency.P90Ms))
+	fmt.Printf("  95%%: %s\n", formatMillis(latency.P95Ms))
+	fmt.Printf("  99%%: %s\n", formatMillis(latency.P99Ms))
+	fmt.Printf("  99.9%%: %s\n", formatMillis(latency.P999Ms))
+	fmt.Printf("  Max: %s\n", formatMillis(latency.MaxMs))
+	fmt.Printf("Standard deviation: ±%s\n", formatMillis(latency.StdDevMs))
+	if openLoop && len(results.Intervals) > 1 {
+		fmt.Printf("Intervals:\n")
+		fmt.Printf("  %8s %8s %8s %10s %10s %10s\n", "start", "rate", "errors", "p50", "p99", "max")
+		for _, interval := range results.Intervals {
+			fmt.Printf("  %7.0fs %7.1f/s %8d %10s %10s %10s\n", interval.StartSeconds, interval.Rate, interval.Errors,
+				formatMillis(interval.Latency.P50Ms), formatMillis(interval.Latency.P99Ms), formatMillis(interval.Latency.MaxMs))
+		}
 	}
-	variance := varianceSum / float64(len(timings))
+}
 
-	return time.Duration(math.Sqrt(variance))
+func formatMillis(ms float64) string {
+	return time.Duration(ms * float64(time.Millisecond)).Round(time.Microsecond).String()
 }
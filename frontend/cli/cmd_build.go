@@ -4,7 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/backend/controller/artefacts"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/internal/buildengine"
 	"github.com/block/ftl/internal/projectconfig"
@@ -12,9 +16,18 @@ import (
 )
 
 type buildCmd struct {
-	Parallelism int      `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
-	Dirs        []string `arg:"" help:"Base directories containing modules (defaults to modules in project config)." type:"existingdir" optional:""`
-	BuildEnv    []string `help:"Environment variables to set for the build."`
+	Parallelism int              `short:"j" help:"Number of modules to build in parallel." default:"${numcpu}"`
+	Dirs        []string         `arg:"" help:"Base directories containing modules (defaults to modules in project config)." type:"existingdir" optional:""`
+	BuildEnv    []string         `help:"Environment variables to set for the build."`
+	NoCache     bool             `help:"Always build modules, rather than restoring unchanged modules from the build cache."`
+	Cache       buildCacheConfig `embed:"" prefix:"cache-"`
+}
+
+type buildCacheConfig struct {
+	Registry      string `help:"OCI container registry to share the build cache through, in the form host[:port]/repository." env:"FTL_BUILD_CACHE_REGISTRY" group:"Build cache:"`
+	Username      string `help:"Build cache registry username." env:"FTL_BUILD_CACHE_REGISTRY_USERNAME" group:"Build cache:"`
+	Password      string `help:"Build cache registry password." env:"FTL_BUILD_CACHE_REGISTRY_PASSWORD" group:"Build cache:"`
+	AllowInsecure bool   `help:"Allows the use of an insecure HTTP based build cache registry." env:"FTL_BUILD_CACHE_REGISTRY_ALLOW_INSECURE" group:"Build cache:"`
 }
 
 func (b *buildCmd) Run(
@@ -30,6 +43,11 @@ func (b *buildCmd) Run(
 		return errors.New("no directories specified")
 	}
 
+	cacheOptions, err := b.cacheOptions(projConfig)
+	if err != nil {
+		return err
+	}
+
 	// Cancel build engine context to ensure all language plugins are killed.
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -39,8 +57,10 @@ func (b *buildCmd) Run(
 		schemaSourceFactory(),
 		projConfig,
 		b.Dirs,
-		buildengine.BuildEnv(b.BuildEnv),
-		buildengine.Parallelism(b.Parallelism),
+		append([]buildengine.Option{
+			buildengine.BuildEnv(b.BuildEnv),
+			buildengine.Parallelism(b.Parallelism),
+		}, cacheOptions...)...,
 	)
 	if err != nil {
 		return err
@@ -50,3 +70,54 @@ func (b *buildCmd) Run(
 	}
 	return nil
 }
+
+// cacheOptions returns the build engine options for the build cache.
+func (b *buildCmd) cacheOptions(projConfig projectconfig.Config) ([]buildengine.Option, error) {
+	if b.NoCache {
+		return nil, nil
+	}
+	remote := optional.None[buildengine.RemoteBuildCache]()
+	if b.Cache.Registry != "" {
+		storage, err := artefacts.NewOCIRegistryStorage(artefacts.RegistryConfig{
+			Registry:      b.Cache.Registry,
+			Username:      b.Cache.Username,
+			Password:      b.Cache.Password,
+			AllowInsecure: b.Cache.AllowInsecure,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create build cache registry client: %w", err)
+		}
+		remote = optional.Some[buildengine.RemoteBuildCache](ociBuildCache{storage: storage})
+	}
+	cache := buildengine.NewBuildCache(buildengine.DefaultBuildCacheDir(projConfig), remote)
+	return []buildengine.Option{buildengine.WithBuildCache(cache)}, nil
+}
+
+// ociBuildCache stores build cache entries as tagged artefacts in an OCI registry.
+type ociBuildCache struct {
+	storage *artefacts.OCIArtefactService
+}
+
+var _ buildengine.RemoteBuildCache = ociBuildCache{}
+
+func (o ociBuildCache) Get(ctx context.Context, key string) (optional.Option[[]byte], error) {
+	reader, ok, err := o.storage.DownloadTagged(ctx, ociBuildCacheTag(key))
+	if err != nil || !ok {
+		return optional.None[[]byte](), err //nolint:wrapcheck
+	}
+	defer reader.Close()
+	entry, err := io.ReadAll(reader)
+	if err != nil {
+		return optional.None[[]byte](), fmt.Errorf("failed to read build cache entry: %w", err)
+	}
+	return optional.Some(entry), nil
+}
+
+func (o ociBuildCache) Put(ctx context.Context, key string, entry []byte) error {
+	_, err := o.storage.UploadTagged(ctx, ociBuildCacheTag(key), artefacts.Artefact{Content: entry})
+	return err //nolint:wrapcheck
+}
+
+func ociBuildCacheTag(key string) string {
+	return "ftl-build-" + key
+}
@@ -3,25 +3,33 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"text/tabwriter"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/either"
 	"github.com/alecthomas/types/optional"
 
 	"github.com/block/ftl/backend/admin"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/profiles"
 )
 
 type configCmd struct {
-	List   configListCmd   `cmd:"" help:"List configuration."`
-	Get    configGetCmd    `cmd:"" help:"Get a configuration value."`
-	Set    configSetCmd    `cmd:"" help:"Set a configuration value."`
-	Unset  configUnsetCmd  `cmd:"" help:"Unset a configuration value."`
-	Import configImportCmd `cmd:"" help:"Import configuration values."`
-	Export configExportCmd `cmd:"" help:"Export configuration values."`
+	List    configListCmd    `cmd:"" help:"List configuration."`
+	Get     configGetCmd     `cmd:"" help:"Get a configuration value."`
+	Set     configSetCmd     `cmd:"" help:"Set a configuration value."`
+	Unset   configUnsetCmd   `cmd:"" help:"Unset a configuration value."`
+	History configHistoryCmd `cmd:"" help:"Show the history of changes to a configuration value."`
+	Check   configCheckCmd   `cmd:"" help:"Check that configuration values and secrets used by modules are set and valid."`
+	Import  configImportCmd  `cmd:"" help:"Import configuration values."`
+	Export  configExportCmd  `cmd:"" help:"Export configuration values."`
 
 	Envar  bool `help:"Print configuration as environment variables." group:"Provider:" xor:"configwriter"`
 	Inline bool `help:"Write values inline in the configuration file." group:"Provider:" xor:"configwriter"`
@@ -160,6 +168,143 @@ func (s *configUnsetCmd) Run(ctx context.Context, scmd *configCmd, adminClient a
 	return nil
 }
 
+type configHistoryCmd struct {
+	Restore *int64            `placeholder:"ID" help:"Restore the value set by the change with this ID."`
+	Ref     configuration.Ref `arg:"" help:"Configuration reference in the form [<module>.]<name>."`
+}
+
+func (s *configHistoryCmd) Help() string {
+	return `
+Lists changes to a configuration value recorded by the FTL cluster, most recent
+first, including who made each change. With --restore, the value set by an
+earlier change is restored.
+`
+}
+
+func (s *configHistoryCmd) Run(ctx context.Context, adminClient admin.Client) error {
+	if s.Restore != nil {
+		_, err := adminClient.ConfigRestore(ctx, connect.NewRequest(&ftlv1.ConfigRestoreRequest{
+			Ref:      configRefFromRef(s.Ref),
+			ChangeId: *s.Restore,
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to restore config: %w", err)
+		}
+		return nil
+	}
+	resp, err := adminClient.ConfigHistory(ctx, connect.NewRequest(&ftlv1.ConfigHistoryRequest{
+		Ref: configRefFromRef(s.Ref),
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get config history: %w", err)
+	}
+	printConfigHistory(resp.Msg.Changes, func(change *ftlv1.ConfigChange) string {
+		return string(change.Value)
+	})
+	return nil
+}
+
+// printConfigHistory prints a table of changes, describing each value with "value".
+func printConfigHistory(changes []*ftlv1.ConfigChange, value func(change *ftlv1.ConfigChange) string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTIME\tACTOR\tPROVIDER\tOPERATION\tVALUE")
+	for _, change := range changes {
+		actor := change.Actor
+		if actor == "" {
+			actor = "unauthenticated"
+		}
+		operation := "set"
+		description := value(change)
+		if change.Operation == ftlv1.ConfigChangeOperation_CONFIG_CHANGE_OPERATION_UNSET {
+			operation = "unset"
+			description = ""
+		} else if change.RestoredFrom != nil {
+			operation = fmt.Sprintf("restore #%d", *change.RestoredFrom)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", change.Id, change.Timestamp.AsTime().Local().Format(time.DateTime), actor, change.Provider, operation, description)
+	}
+	_ = w.Flush()
+}
+
+type configCheckCmd struct {
+	Profile []string `help:"Check the values of these local profiles rather than the active configuration." placeholder:"PROFILE"`
+	Module  string   `optional:"" arg:"" placeholder:"MODULE" help:"Only check values used by this module."`
+}
+
+func (s *configCheckCmd) Help() string {
+	return `
+Checks that every config value and secret declared by each module is set, either
+in the module or globally, and is valid for its declared type. Configs with a
+default value and optional values do not need to be set.
+`
+}
+
+func (s *configCheckCmd) Run(ctx context.Context, adminClient admin.Client, project *profiles.Project) error {
+	req := &ftlv1.ConfigCheckRequest{}
+	if s.Module != "" {
+		req.Module = &s.Module
+	}
+	if len(s.Profile) == 0 {
+		resp, err := adminClient.ConfigCheck(ctx, connect.NewRequest(req))
+		if err != nil {
+			return fmt.Errorf("failed to check config: %w", err)
+		}
+		return reportConfigProblems("", resp.Msg.Problems)
+	}
+	configs, err := project.List()
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+	errs := []error{}
+	for _, name := range s.Profile {
+		config, ok := slices.Find(configs, func(config profiles.ProfileConfig) bool { return config.Name == name })
+		if !ok {
+			return fmt.Errorf("%s: profile does not exist", name)
+		}
+		if _, ok := config.Config.(either.Right[profiles.LocalProfileConfig, profiles.RemoteProfileConfig]); ok {
+			return fmt.Errorf("%s: checking remote profiles is not supported", name)
+		}
+		profile, err := project.Load(ctx, name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		client := admin.NewLocalClient(profile.ConfigurationManager(), profile.SecretsManager())
+		resp, err := client.ConfigCheck(ctx, connect.NewRequest(req))
+		if err != nil {
+			return fmt.Errorf("%s: failed to check config: %w", name, err)
+		}
+		if err := reportConfigProblems(name, resp.Msg.Problems); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// reportConfigProblems prints problems grouped by module and returns an error if there are any.
+func reportConfigProblems(profile string, problems []*ftlv1.ConfigCheckResponse_Problem) error {
+	prefix := ""
+	if profile != "" {
+		prefix = profile + ": "
+	}
+	if len(problems) == 0 {
+		fmt.Printf("%sall configuration is valid\n", prefix)
+		return nil
+	}
+	module := ""
+	for _, problem := range problems {
+		if problem.Ref.GetModule() != module {
+			module = problem.Ref.GetModule()
+			fmt.Printf("%s%s:\n", prefix, module)
+		}
+		kind := "config"
+		if problem.Secret {
+			kind = "secret"
+		}
+		fmt.Printf("  %s %s: %s\n", kind, problem.Ref.Name, problem.Error)
+	}
+	return fmt.Errorf("%s%d configuration problems found", prefix, len(problems))
+}
+
 type configImportCmd struct {
 	Input *os.File `arg:"" placeholder:"JSON" help:"JSON to import as configuration values (read from stdin if omitted). Format: {\"<module>.<name>\": <value>, ... }" optional:"" default:"-"`
 }
@@ -33,10 +33,16 @@ func (d *deployCmd) Run(
 		ctx, cancel = context.WithCancel(ctx)
 	}
 	defer cancel()
+	cacheOptions, err := d.Build.cacheOptions(projConfig)
+	if err != nil {
+		return err
+	}
 	engine, err := buildengine.New(
 		ctx, provisionerClient, schemaSourceFactory(), projConfig, d.Build.Dirs,
-		buildengine.BuildEnv(d.Build.BuildEnv),
-		buildengine.Parallelism(d.Build.Parallelism),
+		append([]buildengine.Option{
+			buildengine.BuildEnv(d.Build.BuildEnv),
+			buildengine.Parallelism(d.Build.Parallelism),
+		}, cacheOptions...)...,
 	)
 	if err != nil {
 		return err
@@ -124,6 +124,11 @@ func (d *devCmd) Run(
 		starting.Close()
 
 		opts := []buildengine.Option{buildengine.Parallelism(d.Build.Parallelism), buildengine.BuildEnv(d.Build.BuildEnv), buildengine.WithDevMode(devModeEndpointUpdates), buildengine.WithStartTime(startTime)}
+		cacheOptions, err := d.Build.cacheOptions(projConfig)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, cacheOptions...)
 		if d.Lsp {
 			d.languageServer = lsp.NewServer(ctx)
 			ctx = log.ContextWithLogger(ctx, log.FromContext(ctx).AddSink(lsp.NewLogSink(d.languageServer)))
@@ -137,7 +142,7 @@ func (d *devCmd) Run(
 			return err
 		}
 		if d.languageServer != nil {
-			d.languageServer.Subscribe(ctx, engine.EngineUpdates)
+			d.languageServer.Subscribe(ctx, engine)
 		}
 		return engine.Dev(ctx, d.Watch)
 	})
@@ -0,0 +1,437 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	osExec "os/exec" //nolint:depguard
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alecthomas/types/either"
+	"github.com/jpillora/backoff"
+
+	provisionerconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/provisioner/v1beta1/provisionerpbconnect"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/backend/provisioner"
+	"github.com/block/ftl/internal/bind"
+	"github.com/block/ftl/internal/buildengine"
+	"github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/configuration/providers"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/profiles"
+	"github.com/block/ftl/internal/projectconfig"
+	"github.com/block/ftl/internal/rpc"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
+)
+
+type previewCmd struct {
+	Up   previewUpCmd   `cmd:"" help:"Start an isolated FTL cluster and deploy the project's modules to it."`
+	Down previewDownCmd `cmd:"" help:"Stop a preview environment and delete its databases, topics and local state."`
+	List previewListCmd `cmd:"" help:"List preview environments."`
+}
+
+// Offsets of each service's port within a preview's port block, mirroring the layout of the default ports.
+const (
+	previewIngressPort = iota
+	previewControllerPort
+	previewProvisionerPort
+	previewTimelinePort
+	previewLeasePort
+	previewAdminPort
+	previewAsyncPort
+	previewConsolePort
+	previewObjectStorePort
+
+	previewPortBlock = 10
+	maxPreviews      = 100
+)
+
+var previewNameRe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// previewEnvironment is the persisted state of a preview environment.
+type previewEnvironment struct {
+	Name      string    `json:"name"`
+	Project   string    `json:"project"`
+	PID       int       `json:"pid"`
+	BasePort  int       `json:"base-port"`
+	Prefix    string    `json:"prefix"`
+	Profile   string    `json:"profile,omitempty"`
+	DBPort    int       `json:"db-port"`
+	MysqlPort int       `json:"mysql-port"`
+	Created   time.Time `json:"created"`
+}
+
+func (p previewEnvironment) endpoint(offset int) *url.URL {
+	return &url.URL{Scheme: "http", Host: "127.0.0.1:" + strconv.Itoa(p.BasePort+offset)}
+}
+
+func (p previewEnvironment) running() bool {
+	if p.PID == 0 {
+		return false
+	}
+	return syscall.Kill(p.PID, 0) == nil
+}
+
+type previewUpCmd struct {
+	Name           string        `arg:"" help:"Name of the preview environment, eg. the branch being previewed."`
+	BasePort       int           `help:"First port of the range that preview environments are allocated ports from." default:"9100"`
+	DBPort         int           `help:"Port of the shared local Postgres database." env:"FTL_DB_PORT" default:"15432"`
+	MysqlPort      int           `help:"Port of the shared local MySQL database." env:"FTL_MYSQL_PORT" default:"13306"`
+	StartupTimeout time.Duration `help:"Timeout for the preview cluster to start up." default:"1m"`
+	Replicas       int32         `short:"n" help:"Number of replicas to deploy." default:"1"`
+	Build          buildCmd      `embed:""`
+}
+
+func (p *previewUpCmd) Run(
+	ctx context.Context,
+	cli *CLI,
+	projConfig projectconfig.Config,
+	secretsRegistry *providers.Registry[configuration.Secrets],
+	configRegistry *providers.Registry[configuration.Configuration],
+) error {
+	logger := log.FromContext(ctx)
+	id, err := previewID(p.Name)
+	if err != nil {
+		return err
+	}
+	if len(p.Build.Dirs) == 0 {
+		p.Build.Dirs = projConfig.AbsModuleDirs()
+	}
+	if len(p.Build.Dirs) == 0 {
+		return errors.New("no module directories configured")
+	}
+	existing, err := loadPreviews()
+	if err != nil {
+		return err
+	}
+	for _, env := range existing {
+		if env.Name == id && env.running() {
+			return fmt.Errorf("preview %q is already running, use 'ftl preview down %s' to stop it", id, id)
+		}
+	}
+	basePort, err := allocatePreviewPorts(p.BasePort, existing)
+	if err != nil {
+		return err
+	}
+	dir, err := previewDir(id)
+	if err != nil {
+		return err
+	}
+	env := previewEnvironment{
+		Name:      id,
+		Project:   projConfig.Path,
+		BasePort:  basePort,
+		Prefix:    "preview_" + id + "_",
+		DBPort:    p.DBPort,
+		MysqlPort: p.MysqlPort,
+		Created:   time.Now(),
+	}
+
+	if err := startPreviewCluster(cli, &env, dir); err != nil {
+		return err
+	}
+	if err := savePreview(dir, env); err != nil {
+		return err
+	}
+	logger.Infof("Preview %s starting with pid %d, logging to %s", id, env.PID, filepath.Join(dir, "serve.log"))
+
+	controllerClient := rpc.Dial(ftlv1connect.NewControllerServiceClient, env.endpoint(previewControllerPort).String(), log.Error)
+	provisionerClient := rpc.Dial(provisionerconnect.NewProvisionerServiceClient, env.endpoint(previewProvisionerPort).String(), log.Error)
+	schemaClient := rpc.Dial(ftlv1connect.NewSchemaServiceClient, env.endpoint(previewControllerPort).String(), log.Error)
+	if err := waitForControllerOnline(ctx, p.StartupTimeout, controllerClient); err != nil {
+		return fmt.Errorf("preview cluster failed to start, see %s: %w", filepath.Join(dir, "serve.log"), err)
+	}
+	if err := rpc.Wait(ctx, backoff.Backoff{Max: p.StartupTimeout}, p.StartupTimeout, provisionerClient); err != nil {
+		return fmt.Errorf("preview provisioner failed to start, see %s: %w", filepath.Join(dir, "serve.log"), err)
+	}
+
+	if project, err := profiles.Open(filepath.Dir(projConfig.Path), secretsRegistry, configRegistry); err == nil {
+		env.Profile = "preview-" + id
+		err = project.New(profiles.ProfileConfig{
+			Name:   env.Profile,
+			Config: either.RightOf[profiles.LocalProfileConfig](profiles.RemoteProfileConfig{Endpoint: env.endpoint(previewControllerPort)}),
+		})
+		if err != nil {
+			logger.Warnf("Could not create profile for preview %s: %s", id, err)
+			env.Profile = ""
+		} else if err := savePreview(dir, env); err != nil {
+			return err
+		}
+	} else {
+		logger.Debugf("Not creating a profile for preview %s: %s", id, err)
+	}
+
+	// Cancel build engine context to ensure all language plugins are killed.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cacheOptions, err := p.Build.cacheOptions(projConfig)
+	if err != nil {
+		return err
+	}
+	engine, err := buildengine.New(
+		ctx, provisionerClient, schemaeventsource.New(ctx, schemaClient), projConfig, p.Build.Dirs,
+		append([]buildengine.Option{
+			buildengine.BuildEnv(p.Build.BuildEnv),
+			buildengine.Parallelism(p.Build.Parallelism),
+		}, cacheOptions...)...,
+	)
+	if err != nil {
+		return err
+	}
+	if err := engine.BuildAndDeploy(ctx, p.Replicas, true); err != nil {
+		return fmt.Errorf("failed to deploy to preview %s: %w", id, err)
+	}
+
+	fmt.Printf("Preview %s is running\n", id)
+	fmt.Printf("  Endpoint: %s\n", env.endpoint(previewControllerPort))
+	fmt.Printf("  Ingress:  %s\n", env.endpoint(previewIngressPort))
+	fmt.Printf("  Console:  %s\n", env.endpoint(previewConsolePort))
+	if env.Profile != "" {
+		fmt.Printf("  Profile:  %s\n", env.Profile)
+	}
+	fmt.Printf("Use FTL_ENDPOINT=%s to point other commands at it.\n", env.endpoint(previewControllerPort))
+	return nil
+}
+
+type previewDownCmd struct {
+	Name string `arg:"" help:"Name of the preview environment."`
+}
+
+func (p *previewDownCmd) Run(
+	ctx context.Context,
+	secretsRegistry *providers.Registry[configuration.Secrets],
+	configRegistry *providers.Registry[configuration.Configuration],
+) error {
+	logger := log.FromContext(ctx)
+	id, err := previewID(p.Name)
+	if err != nil {
+		return err
+	}
+	dir, err := previewDir(id)
+	if err != nil {
+		return err
+	}
+	env, err := loadPreview(filepath.Join(dir, "preview.json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("preview %q does not exist", id)
+	} else if err != nil {
+		return err
+	}
+
+	if env.running() {
+		// The cluster runs in its own process group, along with the runners and plugins it started.
+		if err := syscall.Kill(-env.PID, syscall.SIGTERM); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return fmt.Errorf("failed to stop preview %s: %w", id, err)
+		}
+		for range 50 {
+			if !env.running() {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		logger.Infof("Stopped preview %s (pid: %d)", id, env.PID)
+	}
+	if err := provisioner.DeprovisionDevResources(ctx, env.Prefix, env.DBPort, env.MysqlPort); err != nil {
+		return fmt.Errorf("failed to delete resources of preview %s: %w", id, err)
+	}
+	if env.Profile != "" {
+		project, err := profiles.Open(filepath.Dir(env.Project), secretsRegistry, configRegistry)
+		if err == nil {
+			err = project.Delete(env.Profile)
+		}
+		if err != nil {
+			logger.Warnf("Could not delete profile %s: %s", env.Profile, err)
+		}
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove preview state: %w", err)
+	}
+	fmt.Printf("Preview %s removed\n", id)
+	return nil
+}
+
+type previewListCmd struct{}
+
+func (previewListCmd) Run() error {
+	envs, err := loadPreviews()
+	if err != nil {
+		return err
+	}
+	if len(envs) == 0 {
+		fmt.Println("No preview environments")
+		return nil
+	}
+	format := "%-24s %-8s %-24s %-24s %s\n"
+	fmt.Printf(format, "NAME", "STATUS", "ENDPOINT", "CONSOLE", "PROJECT")
+	for _, env := range envs {
+		status := "stopped"
+		if env.running() {
+			status = "running"
+		}
+		fmt.Printf(format, env.Name, status, env.endpoint(previewControllerPort), env.endpoint(previewConsolePort), env.Project)
+	}
+	return nil
+}
+
+// previewID normalises a preview name, such as a branch name, into an identifier that is safe to use in database,
+// topic and profile names.
+func previewID(name string) (string, error) {
+	id := strings.Trim(previewNameRe.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if id == "" {
+		return "", fmt.Errorf("invalid preview name %q", name)
+	}
+	// Leave room for module and database names within the 63 character limit on Postgres identifiers.
+	if len(id) > 24 {
+		return "", fmt.Errorf("preview name %q is too long, it must be at most 24 characters", name)
+	}
+	return id, nil
+}
+
+// allocatePreviewPorts finds the first block of ports after basePort that is not used by another preview, and whose
+// ports are all free.
+func allocatePreviewPorts(basePort int, existing []previewEnvironment) (int, error) {
+	used := map[int]bool{}
+	for _, env := range existing {
+		used[env.BasePort] = true
+	}
+	for slot := range maxPreviews {
+		port := basePort + slot*previewPortBlock
+		if used[port] {
+			continue
+		}
+		allocator, err := bind.NewBindAllocator(&url.URL{Scheme: "http", Host: "127.0.0.1:" + strconv.Itoa(port)}, previewPortBlock)
+		if err != nil {
+			return 0, fmt.Errorf("could not create bind allocator: %w", err)
+		}
+		free := true
+		for range previewPortBlock {
+			if _, err := allocator.NextPort(); err != nil {
+				free = false
+				break
+			}
+		}
+		if free {
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("no free ports for a preview environment after port %d", basePort)
+}
+
+// startPreviewCluster starts "ftl serve" in the background, bound to the preview's ports and storing its state under
+// dir.
+func startPreviewCluster(cli *CLI, env *previewEnvironment, dir string) error {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return fmt.Errorf("failed to create preview directory: %w", err)
+	}
+	logFile, err := os.Create(filepath.Join(dir, "serve.log"))
+	if err != nil {
+		return fmt.Errorf("failed to create preview log: %w", err)
+	}
+	defer logFile.Close()
+
+	args := []string{
+		"--config=" + env.Project,
+		"--plain",
+		"--log-level=" + cli.LogConfig.Level.String(),
+		"--endpoint=" + env.endpoint(previewControllerPort).String(),
+		"--provisioner-endpoint=" + env.endpoint(previewProvisionerPort).String(),
+		"--timeline-endpoint=" + env.endpoint(previewTimelinePort).String(),
+		"--lease-endpoint=" + env.endpoint(previewLeasePort).String(),
+		"--admin-endpoint=" + env.endpoint(previewAdminPort).String(),
+		"--async-endpoint=" + env.endpoint(previewAsyncPort).String(),
+		"serve",
+		"--bind=" + env.endpoint(previewIngressPort).String(),
+		"--ingress-bind=" + env.endpoint(previewIngressPort).String(),
+		"--timeline-bind=" + env.endpoint(previewTimelinePort).String(),
+		"--lease-bind=" + env.endpoint(previewLeasePort).String(),
+		"--admin-bind=" + env.endpoint(previewAdminPort).String(),
+		"--async-bind=" + env.endpoint(previewAsyncPort).String(),
+		"--console-bind=" + env.endpoint(previewConsolePort).String(),
+		"--object-store-port=" + strconv.Itoa(env.BasePort+previewObjectStorePort),
+		"--object-store-dir=" + filepath.Join(dir, "objectstore"),
+		"--sq-lite-dir=" + filepath.Join(dir, "sqlite"),
+		"--kv-dir=" + filepath.Join(dir, "kv"),
+		"--async-db=" + filepath.Join(dir, "async.db"),
+		"--admin-history=" + filepath.Join(dir, "admin-history.db"),
+		"--db-port=" + strconv.Itoa(env.DBPort),
+		"--mysql-port=" + strconv.Itoa(env.MysqlPort),
+		"--resource-prefix=" + env.Prefix,
+	}
+	cmd := osExec.Command(os.Args[0], args...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = nil, logFile, logFile
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start preview cluster: %w", err)
+	}
+	env.PID = cmd.Process.Pid
+	return nil
+}
+
+func previewsDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".ftl", "previews"), nil
+}
+
+func previewDir(id string) (string, error) {
+	dir, err := previewsDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, id), nil
+}
+
+func loadPreviews() ([]previewEnvironment, error) {
+	dir, err := previewsDir()
+	if err != nil {
+		return nil, err
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*", "preview.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list previews: %w", err)
+	}
+	envs := make([]previewEnvironment, 0, len(paths))
+	for _, path := range paths {
+		env, err := loadPreview(path)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, env)
+	}
+	sort.Slice(envs, func(i, j int) bool { return envs[i].Name < envs[j].Name })
+	return envs, nil
+}
+
+func loadPreview(path string) (previewEnvironment, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return previewEnvironment{}, fmt.Errorf("failed to read preview: %w", err)
+	}
+	env := previewEnvironment{}
+	if err := json.Unmarshal(data, &env); err != nil {
+		return previewEnvironment{}, fmt.Errorf("%s: failed to decode preview: %w", path, err)
+	}
+	return env, nil
+}
+
+func savePreview(dir string, env previewEnvironment) error {
+	data, err := json.MarshalIndent(env, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode preview: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "preview.json"), data, 0600); err != nil {
+		return fmt.Errorf("failed to write preview: %w", err)
+	}
+	return nil
+}
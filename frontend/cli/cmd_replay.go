@@ -2,27 +2,43 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
 	"github.com/jpillora/backoff"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/common/reflection"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/replay"
 	"github.com/block/ftl/internal/rpc"
 	status "github.com/block/ftl/internal/terminal"
 )
 
 type replayCmd struct {
 	Wait time.Duration  `short:"w" help:"Wait up to this elapsed time for the FTL cluster to become available." default:"1m"`
-	Verb reflection.Ref `arg:"" required:"" help:"Full path of Verb to call." predictor:"verbs"`
+	Verb reflection.Ref `arg:"" optional:"" help:"Full path of Verb to call. With --from-timeline, only calls to this verb are exported." predictor:"verbs"`
+
+	FromTimeline bool          `help:"Export calls recorded in the timeline to a fixture file, rather than replaying the last call to a verb." xor:"mode" group:"Export:"`
+	Module       []string      `help:"Only export calls to these modules." group:"Export:"`
+	Since        time.Duration `help:"Only export calls made within this long ago." group:"Export:"`
+	Until        time.Duration `help:"Only export calls made at least this long ago." group:"Export:"`
+	Limit        int           `help:"Maximum number of calls to export." default:"1000" group:"Export:"`
+	Output       string        `short:"o" help:"Fixture file to export calls to." default:"replay.json" type:"path" group:"Export:"`
+
+	Fixture string              `help:"Replay the calls in a fixture file, and compare their responses with those recorded." type:"existingfile" xor:"mode" group:"Replay:"`
+	Ignore  []replay.IgnorePath `help:"JSON paths to ignore when comparing responses, eg. '$.createdAt', or 'echo.echo:$.items[*].id' for a single verb." sep:"none" group:"Replay:"`
+	Report  string              `help:"Write a JSON report of the replay to this file." type:"path" group:"Replay:"`
 }
 
 func (c *replayCmd) Run(
@@ -37,11 +53,21 @@ func (c *replayCmd) Run(
 	if err := rpc.Wait(ctx, backoff.Backoff{Max: time.Second * 2}, c.Wait, verbClient); err != nil {
 		return fmt.Errorf("failed to wait for client: %w", err)
 	}
+	if c.Fixture != "" {
+		return c.replayFixture(ctx, verbClient)
+	}
 
 	if err := rpc.Wait(ctx, backoff.Backoff{Max: time.Second * 2}, c.Wait-time.Since(startTime), timelineClient); err != nil {
 		return fmt.Errorf("failed to wait for console service client: %w", err)
 	}
 
+	if c.FromTimeline {
+		return c.exportTimeline(ctx, timelineClient)
+	}
+	if c.Verb.Module == "" {
+		return errors.New("expected a verb to replay, --from-timeline or --fixture")
+	}
+
 	logger := log.FromContext(ctx)
 
 	// First check the verb is valid
@@ -77,6 +103,7 @@ func (c *replayCmd) Run(
 	}
 
 	events, err := timelineClient.GetTimeline(ctx, connect.NewRequest(&timelinepb.GetTimelineRequest{
+		Limit: 1,
 		Order: timelinepb.GetTimelineRequest_ORDER_DESC,
 		Filters: []*timelinepb.GetTimelineRequest_Filter{
 			{
@@ -109,3 +136,113 @@ func (c *replayCmd) Run(
 	logger.Infof("Response:")
 	return callVerb(ctx, verbClient, schemaClient, c.Verb, []byte(requestJSON))
 }
+
+// exportTimeline writes the calls recorded in the timeline that match the filters to a fixture file.
+func (c *replayCmd) exportTimeline(ctx context.Context, timelineClient timelinepbconnect.TimelineServiceClient) error {
+	callFilters := []*timelinepb.GetTimelineRequest_CallFilter{}
+	if c.Verb.Module != "" {
+		callFilters = append(callFilters, &timelinepb.GetTimelineRequest_CallFilter{DestModule: c.Verb.Module, DestVerb: &c.Verb.Name})
+	}
+	for _, module := range c.Module {
+		callFilters = append(callFilters, &timelinepb.GetTimelineRequest_CallFilter{DestModule: module})
+	}
+	filters := []*timelinepb.GetTimelineRequest_Filter{{
+		Filter: &timelinepb.GetTimelineRequest_Filter_EventTypes{
+			EventTypes: &timelinepb.GetTimelineRequest_EventTypeFilter{
+				EventTypes: []timelinepb.EventType{timelinepb.EventType_EVENT_TYPE_CALL},
+			},
+		},
+	}}
+	for _, filter := range callFilters {
+		filters = append(filters, &timelinepb.GetTimelineRequest_Filter{Filter: &timelinepb.GetTimelineRequest_Filter_Call{Call: filter}})
+	}
+	if c.Since > 0 || c.Until > 0 {
+		timeFilter := &timelinepb.GetTimelineRequest_TimeFilter{}
+		if c.Since > 0 {
+			timeFilter.NewerThan = timestamppb.New(time.Now().Add(-c.Since))
+		}
+		if c.Until > 0 {
+			timeFilter.OlderThan = timestamppb.New(time.Now().Add(-c.Until))
+		}
+		filters = append(filters, &timelinepb.GetTimelineRequest_Filter{Filter: &timelinepb.GetTimelineRequest_Filter_Time{Time: timeFilter}})
+	}
+
+	fixture := replay.Fixture{Calls: []replay.Call{}}
+	// The cursor is the ID of the first event not yet returned, and the ID filter is inclusive.
+	var cursor optional.Option[int64]
+	for len(fixture.Calls) < c.Limit {
+		pageFilters := filters
+		if id, ok := cursor.Get(); ok {
+			pageFilters = append(pageFilters[:len(pageFilters):len(pageFilters)], &timelinepb.GetTimelineRequest_Filter{
+				Filter: &timelinepb.GetTimelineRequest_Filter_Id{Id: &timelinepb.GetTimelineRequest_IDFilter{HigherThan: &id}},
+			})
+		}
+		resp, err := timelineClient.GetTimeline(ctx, connect.NewRequest(&timelinepb.GetTimelineRequest{
+			Filters: pageFilters,
+			Limit:   int32(min(c.Limit-len(fixture.Calls), 100)), //nolint:gosec
+			Order:   timelinepb.GetTimelineRequest_ORDER_ASC,
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to get events: %w", err)
+		}
+		for _, event := range resp.Msg.Events {
+			if call := event.GetCall(); call != nil {
+				fixture.Calls = append(fixture.Calls, replay.CallFromEvent(call))
+			}
+		}
+		if resp.Msg.Cursor == nil || len(resp.Msg.Events) == 0 {
+			break
+		}
+		cursor = optional.Some(*resp.Msg.Cursor)
+	}
+	if err := fixture.Save(c.Output); err != nil {
+		return err //nolint:wrapcheck
+	}
+	fmt.Printf("Exported %d calls to %s\n", len(fixture.Calls), c.Output)
+	return nil
+}
+
+// replayFixture replays the calls in a fixture, and fails if any of their responses differ from those recorded.
+func (c *replayCmd) replayFixture(ctx context.Context, verbClient ftlv1connect.VerbServiceClient) error {
+	fixture, err := replay.Load(c.Fixture)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	call := func(ctx context.Context, verb reflection.Ref, request []byte) ([]byte, string, error) {
+		resp, err := verbClient.Call(ctx, connect.NewRequest(&ftlv1.CallRequest{Verb: verb.ToProto(), Body: request}))
+		if err != nil {
+			return nil, "", err //nolint:wrapcheck
+		}
+		if verbErr := resp.Msg.GetError(); verbErr != nil {
+			return nil, verbErr.Message, nil
+		}
+		return resp.Msg.GetBody(), "", nil
+	}
+	report, err := replay.Run(ctx, fixture, call, c.Ignore)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	for _, result := range report.Results {
+		if result.Passed() {
+			fmt.Printf("ok    %s\n", result.Verb)
+			continue
+		}
+		fmt.Printf("FAIL  %s\t%s\n", result.Verb, result.Request)
+		if result.Error != "" {
+			fmt.Printf("      %s\n", result.Error)
+		}
+		for _, difference := range result.Differences {
+			fmt.Printf("      %s\n", difference)
+		}
+	}
+	fmt.Printf("%d calls replayed, %d passed, %d failed\n", report.Total, report.Passed, report.Failed)
+	if c.Report != "" {
+		if err := report.Save(c.Report); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d replayed calls did not match their recorded responses", report.Failed)
+	}
+	return nil
+}
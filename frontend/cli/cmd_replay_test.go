@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1/timelinepbconnect"
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/replay"
+)
+
+// fakeTimeline serves call events in the same pages as the timeline service.
+type fakeTimeline struct {
+	timelinepbconnect.TimelineServiceClient
+	events []*timelinepb.Event
+}
+
+func (f *fakeTimeline) GetTimeline(ctx context.Context, req *connect.Request[timelinepb.GetTimelineRequest]) (*connect.Response[timelinepb.GetTimelineResponse], error) {
+	results := []*timelinepb.Event{}
+	for _, event := range f.events {
+		inRange := true
+		for _, filter := range req.Msg.Filters {
+			if id := filter.GetId(); id != nil && id.HigherThan != nil && event.Id < *id.HigherThan {
+				inRange = false
+			}
+		}
+		if inRange {
+			results = append(results, event)
+		}
+	}
+	resp := &timelinepb.GetTimelineResponse{Events: results}
+	if len(results) > int(req.Msg.Limit) {
+		resp.Events = results[:req.Msg.Limit]
+		resp.Cursor = &results[req.Msg.Limit].Id
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func TestExportTimelinePages(t *testing.T) {
+	ctx := context.Background()
+	timeline := &fakeTimeline{}
+	for i := range 250 {
+		timeline.events = append(timeline.events, &timelinepb.Event{
+			Id: int64(i + 1),
+			Entry: &timelinepb.Event_Call{Call: &timelinepb.CallEvent{
+				DestinationVerbRef: reflection.Ref{Module: "echo", Name: "echo"}.ToProto(),
+				Request:            fmt.Sprintf(`{"n":%d}`, i),
+				Response:           `{}`,
+			}},
+		})
+	}
+	output := filepath.Join(t.TempDir(), "replay.json")
+	cmd := &replayCmd{Limit: 1000, Output: output}
+	assert.NoError(t, cmd.exportTimeline(ctx, timeline))
+
+	fixture, err := replay.Load(output)
+	assert.NoError(t, err)
+	requests := slices.Map(fixture.Calls, func(c replay.Call) int {
+		var request struct{ N int }
+		assert.NoError(t, json.Unmarshal(c.Request, &request))
+		return request.N
+	})
+	expected := make([]int, 250)
+	for i := range expected {
+		expected[i] = i
+	}
+	assert.Equal(t, expected, requests, "each call should be exported exactly once")
+}
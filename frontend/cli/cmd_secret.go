@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"connectrpc.com/connect"
+	"filippo.io/age"
 	"github.com/alecthomas/types/optional"
 	"github.com/mattn/go-isatty"
 	"golang.org/x/term"
@@ -15,16 +18,21 @@ import (
 	"github.com/block/ftl/backend/admin"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
 	cf "github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/configuration/providers"
+	"github.com/block/ftl/internal/projectconfig"
 	"github.com/block/ftl/internal/terminal"
 )
 
 type secretCmd struct {
-	List   secretListCmd   `cmd:"" help:"List secrets."`
-	Get    secretGetCmd    `cmd:"" help:"Get a secret."`
-	Set    secretSetCmd    `cmd:"" help:"Set a secret."`
-	Unset  secretUnsetCmd  `cmd:"" help:"Unset a secret."`
-	Import secretImportCmd `cmd:"" help:"Import secrets."`
-	Export secretExportCmd `cmd:"" help:"Export secrets."`
+	List    secretListCmd    `cmd:"" help:"List secrets."`
+	Get     secretGetCmd     `cmd:"" help:"Get a secret."`
+	Set     secretSetCmd     `cmd:"" help:"Set a secret."`
+	Unset   secretUnsetCmd   `cmd:"" help:"Unset a secret."`
+	History secretHistoryCmd `cmd:"" help:"Show the history of changes to a secret."`
+	Import  secretImportCmd  `cmd:"" help:"Import secrets."`
+	Export  secretExportCmd  `cmd:"" help:"Export secrets."`
+
+	RotateKey secretRotateKeyCmd `cmd:"" help:"Re-encrypt age secrets to a new set of recipients."`
 
 	Envar    bool `help:"Write configuration as environment variables." group:"Provider:" xor:"secretwriter"`
 	Inline   bool `help:"Write values inline in the configuration file." group:"Provider:" xor:"secretwriter"`
@@ -153,6 +161,31 @@ func (s *secretUnsetCmd) Run(ctx context.Context, adminClient admin.Client) (err
 	return nil
 }
 
+type secretHistoryCmd struct {
+	Ref cf.Ref `arg:"" help:"Secret reference in the form [<module>.]<name>."`
+}
+
+func (s *secretHistoryCmd) Help() string {
+	return `
+Lists changes to a secret recorded by the FTL cluster, most recent first,
+including who made each change. Secret values are not recorded, so a
+fingerprint of each value is shown instead. Equal values have equal fingerprints.
+`
+}
+
+func (s *secretHistoryCmd) Run(ctx context.Context, adminClient admin.Client) error {
+	resp, err := adminClient.SecretHistory(ctx, connect.NewRequest(&ftlv1.SecretHistoryRequest{
+		Ref: configRefFromRef(s.Ref),
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get secret history: %w", err)
+	}
+	printConfigHistory(resp.Msg.Changes, func(change *ftlv1.ConfigChange) string {
+		return "fingerprint:" + change.GetFingerprint()
+	})
+	return nil
+}
+
 type secretImportCmd struct {
 	Input *os.File `arg:"" placeholder:"JSON" help:"JSON to import as secrets (read from stdin if omitted). Format: {\"<module>.<name>\": <secret>, ... }" optional:"" default:"-"`
 }
@@ -228,3 +261,85 @@ func (s *secretExportCmd) Run(ctx context.Context, adminClient admin.Client) (er
 	fmt.Println(string(output))
 	return nil
 }
+
+type secretRotateKeyCmd struct {
+	Profile  string   `help:"Profile to re-encrypt secrets for." default:"default"`
+	Add      []string `help:"Age recipient to add." placeholder:"RECIPIENT"`
+	Remove   []string `help:"Age recipient to remove." placeholder:"RECIPIENT"`
+	Generate bool     `help:"Generate a new identity, replacing the current identity's recipient with its own."`
+}
+
+func (s *secretRotateKeyCmd) Help() string {
+	return `
+Re-encrypts the secrets stored by the age provider in ftl-secrets.age.json,
+after adding and removing recipients for the profile. Removed recipients can
+no longer decrypt the secrets.
+
+Existing secrets are decrypted with the identity in $FTL_AGE_IDENTITY, or the
+identity file at $FTL_AGE_IDENTITY_FILE. With --generate, a new identity is
+written to the identity file and the old one is kept alongside it with a .old
+suffix.
+`
+}
+
+func (s *secretRotateKeyCmd) Run(ctx context.Context, projectConfig projectconfig.Config) error {
+	if projectConfig.Path == "" {
+		return errors.New("no project found")
+	}
+	identities, err := providers.AgeIdentities()
+	if err != nil && !(s.Generate && errors.Is(err, os.ErrNotExist)) {
+		return err
+	}
+	add := s.Add
+	remove := s.Remove
+	var generated *age.X25519Identity
+	if s.Generate {
+		if _, ok := os.LookupEnv("FTL_AGE_IDENTITY"); ok {
+			return errors.New("cannot generate an identity while FTL_AGE_IDENTITY is set")
+		}
+		generated, err = age.GenerateX25519Identity()
+		if err != nil {
+			return fmt.Errorf("failed to generate age identity: %w", err)
+		}
+		add = append(add, generated.Recipient().String())
+		for _, identity := range identities {
+			if x25519, ok := identity.(*age.X25519Identity); ok {
+				remove = append(remove, x25519.Recipient().String())
+			}
+		}
+	}
+	provider := providers.NewAge(filepath.Join(filepath.Dir(projectConfig.Path), providers.AgeSecretsFile), s.Profile)
+	if err := provider.RotateKey(identities, add, remove); err != nil {
+		return fmt.Errorf("failed to rotate age key: %w", err)
+	}
+	if generated == nil {
+		return nil
+	}
+	path, err := providers.AgeIdentityPath()
+	if err != nil {
+		return err
+	}
+	// Secrets are now only readable with the new identity, so print it if it can't be saved.
+	if err := saveAgeIdentity(path, generated); err != nil {
+		fmt.Printf("New identity: %s\n", generated)
+		return err
+	}
+	fmt.Printf("Wrote new identity for recipient %s to %s\n", generated.Recipient(), path)
+	return nil
+}
+
+func saveAgeIdentity(path string, identity *age.X25519Identity) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create age identity directory: %w", err)
+	}
+	if err := os.WriteFile(path+".new", []byte(identity.String()+"\n"), 0600); err != nil {
+		return fmt.Errorf("failed to write age identity: %w", err)
+	}
+	if err := os.Rename(path, path+".old"); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to back up age identity: %w", err)
+	}
+	if err := os.Rename(path+".new", path); err != nil {
+		return fmt.Errorf("failed to write age identity: %w", err)
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/block/ftl"
 	"github.com/block/ftl/backend/admin"
+	"github.com/block/ftl/backend/async"
 	"github.com/block/ftl/backend/console"
 	"github.com/block/ftl/backend/controller"
 	"github.com/block/ftl/backend/controller/artefacts"
@@ -39,6 +40,7 @@ import (
 	"github.com/block/ftl/internal/configuration/manager"
 	"github.com/block/ftl/internal/dev"
 	"github.com/block/ftl/internal/exec"
+	"github.com/block/ftl/internal/identity"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/model"
 	"github.com/block/ftl/internal/observability"
@@ -56,6 +58,10 @@ type serveCommonConfig struct {
 	Bind                *url.URL             `help:"Starting endpoint to bind to and advertise to. Each controller, ingress, runner and language plugin will increment the port by 1" default:"http://127.0.0.1:8891"`
 	DBPort              int                  `help:"Port to use for the database." env:"FTL_DB_PORT" default:"15432"`
 	MysqlPort           int                  `help:"Port to use for the MySQL database, if one is required." env:"FTL_MYSQL_PORT" default:"13306"`
+	SQLiteDir           string               `help:"Directory to store local SQLite databases in." env:"FTL_SQLITE_DIR" type:"path" default:"~/.ftl/sqlite"`
+	KVDir               string               `help:"Directory to store local key-value stores in." env:"FTL_KV_DIR" type:"path" default:"~/.ftl/kv"`
+	ObjectStoreDir      string               `help:"Directory to store local object store buckets in." env:"FTL_OBJECTSTORE_DIR" type:"path" default:"~/.ftl/objectstore"`
+	ObjectStorePort     int                  `help:"Port to serve the local S3-compatible object store on." env:"FTL_OBJECTSTORE_PORT" default:"19000"`
 	RegistryPort        int                  `help:"Port to use for the registry." env:"FTL_OCI_REGISTRY_PORT" default:"15000"`
 	Controllers         int                  `short:"c" help:"Number of controllers to start." default:"1"`
 	Provisioners        int                  `short:"p" help:"Number of provisioners to start." default:"1"`
@@ -72,8 +78,10 @@ type serveCommonConfig struct {
 	Timeline            timeline.Config      `embed:"" prefix:"timeline-"`
 	Console             console.Config       `embed:"" prefix:"console-"`
 	Lease               lease.Config         `embed:"" prefix:"lease-"`
+	Async               async.Config         `embed:"" prefix:"async-"`
 	Admin               admin.Config         `embed:"" prefix:"admin-"`
 	Recreate            bool                 `help:"Recreate any stateful resources if they already exist." default:"false"`
+	ResourcePrefix      string               `help:"Prefix for the names of provisioned databases and topics, to isolate them from other local clusters." hidden:""`
 	controller.CommonConfig
 	provisioner.CommonProvisionerConfig
 }
@@ -191,6 +199,15 @@ func (s *serveCommonConfig) run(
 
 	wg, ctx := errgroup.WithContext(ctx)
 
+	// Controllers sign caller tokens, which the async service verifies, so they must share keys.
+	if len(s.Async.CallerSigningKeys) == 0 {
+		key, err := identity.GenerateKey()
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		s.Async.CallerSigningKeys = []string{key}
+	}
+
 	controllerAddresses := make([]*url.URL, 0, s.Controllers)
 	controllerIngressAddresses := make([]*url.URL, 0, s.Controllers)
 	for range s.Controllers {
@@ -208,8 +225,8 @@ func (s *serveCommonConfig) run(
 
 	// Add console addresses to allow origins for console requests
 	consoleURLs := []string{
-		"http://localhost:8899",
-		"http://127.0.0.1:8899",
+		"http://localhost:" + s.Console.Bind.Port(),
+		"http://127.0.0.1:" + s.Console.Bind.Port(),
 	}
 	for _, urlStr := range consoleURLs {
 		consoleURL, err := url.Parse(urlStr)
@@ -228,14 +245,24 @@ func (s *serveCommonConfig) run(
 		provisionerAddresses = append(provisionerAddresses, bind)
 	}
 
+	// Redact sensitive fields from the payloads published by local services, unless their capture is switched on
+	// through the admin service.
+	captureSensitivePayloads := admin.WatchSensitivePayloadCapture(ctx, adminClient)
+	redactingTimelineClient := timeline.NewClient(ctx, s.Timeline.Bind,
+		timeline.WithSchema(schemaEventSourceFactory().ViewOnly()),
+		timeline.WithSensitivePayloads(captureSensitivePayloads),
+	)
+
 	runnerScaling, err := localscaling.NewLocalScaling(
 		ctx,
 		controllerAddresses,
 		s.Lease.Bind,
+		s.Async.Bind,
 		projConfig.Path,
 		devMode && !projConfig.DisableIDEIntegration,
 		storage,
 		bool(s.ObservabilityConfig.ExportOTEL),
+		captureSensitivePayloads,
 		devModeEndpoints,
 	)
 	if err != nil {
@@ -247,9 +274,10 @@ func (s *serveCommonConfig) run(
 	}
 	for i := range s.Controllers {
 		config := controller.Config{
-			CommonConfig: s.CommonConfig,
-			Bind:         controllerAddresses[i],
-			Key:          model.NewLocalControllerKey(i),
+			CommonConfig:      s.CommonConfig,
+			Bind:              controllerAddresses[i],
+			Key:               model.NewLocalControllerKey(i),
+			CallerSigningKeys: s.Async.CallerSigningKeys,
 		}
 		config.SetDefaults()
 		config.ModuleUpdateFrequency = time.Second * 1
@@ -258,7 +286,7 @@ func (s *serveCommonConfig) run(
 		controllerCtx := log.ContextWithLogger(ctx, logger.Scope(scope))
 
 		wg.Go(func() error {
-			if err := controller.Start(controllerCtx, config, storage, adminClient, timelineClient, true); err != nil {
+			if err := controller.Start(controllerCtx, config, storage, adminClient, redactingTimelineClient, true); err != nil {
 				logger.Errorf(err, "controller%d failed: %v", i, err)
 				return fmt.Errorf("controller%d failed: %w", i, err)
 			}
@@ -277,18 +305,25 @@ func (s *serveCommonConfig) run(
 
 		scope := fmt.Sprintf("provisioner%d", i)
 		provisionerCtx := log.ContextWithLogger(ctx, logger.Scope(scope))
+		provisionerCtx, err = config.ContextWithVault(provisionerCtx)
+		if err != nil {
+			return fmt.Errorf("provisioner%d failed: %w", i, err)
+		}
 
 		// default local dev provisioner
 
 		provisionerRegistry := &provisioner.ProvisionerRegistry{
 			Bindings: []*provisioner.ProvisionerBinding{
 				{
-					Provisioner: provisioner.NewDevProvisioner(s.DBPort, s.MysqlPort, s.Recreate),
+					Provisioner: provisioner.NewDevProvisioner(s.DBPort, s.MysqlPort, s.SQLiteDir, s.KVDir, s.ObjectStoreDir, s.ObjectStorePort, s.ResourcePrefix, s.Recreate),
 					Types: []schema.ResourceType{
 						schema.ResourceTypeMysql,
 						schema.ResourceTypePostgres,
+						schema.ResourceTypeSQLite,
 						schema.ResourceTypeTopic,
 						schema.ResourceTypeSubscription,
+						schema.ResourceTypeKV,
+						schema.ResourceTypeObjectStore,
 					},
 					ID: "dev",
 				},
@@ -331,7 +366,7 @@ func (s *serveCommonConfig) run(
 	if !s.NoConsole {
 		// Start Console
 		wg.Go(func() error {
-			err := console.Start(ctx, s.Console, schemaEventSourceFactory(), controllerClient, timelineClient, adminClient, routing.NewVerbRouter(ctx, schemaEventSourceFactory(), timelineClient))
+			err := console.Start(ctx, s.Console, schemaEventSourceFactory(), controllerClient, timelineClient, adminClient, routing.NewVerbRouter(ctx, schemaEventSourceFactory(), redactingTimelineClient, "console"))
 			if err != nil {
 				return fmt.Errorf("console failed: %w", err)
 			}
@@ -348,15 +383,23 @@ func (s *serveCommonConfig) run(
 	})
 	// Start Cron
 	wg.Go(func() error {
-		err := cron.Start(ctx, schemaEventSourceFactory(), routing.NewVerbRouter(ctx, schemaEventSourceFactory(), timelineClient), timelineClient)
+		err := cron.Start(ctx, schemaEventSourceFactory(), routing.NewVerbRouter(ctx, schemaEventSourceFactory(), redactingTimelineClient, "cron"), redactingTimelineClient)
 		if err != nil {
 			return fmt.Errorf("cron failed: %w", err)
 		}
 		return nil
 	})
+	// Start Async
+	wg.Go(func() error {
+		err := async.Start(ctx, s.Async, schemaEventSourceFactory(), routing.NewVerbRouter(ctx, schemaEventSourceFactory(), redactingTimelineClient, "async"), redactingTimelineClient)
+		if err != nil {
+			return fmt.Errorf("async failed: %w", err)
+		}
+		return nil
+	})
 	// Start Ingress
 	wg.Go(func() error {
-		err := ingress.Start(ctx, s.Ingress, schemaEventSourceFactory(), routing.NewVerbRouter(ctx, schemaEventSourceFactory(), timelineClient), timelineClient)
+		err := ingress.Start(ctx, s.Ingress, schemaEventSourceFactory(), routing.NewVerbRouter(ctx, schemaEventSourceFactory(), redactingTimelineClient, "ingress"), redactingTimelineClient)
 		if err != nil {
 			return fmt.Errorf("ingress failed: %w", err)
 		}
@@ -372,7 +415,7 @@ func (s *serveCommonConfig) run(
 	})
 	// Start Admin
 	wg.Go(func() error {
-		err := admin.Start(ctx, s.Admin, cm, sm, admin.NewSchemaRetreiver(schemaEventSourceFactory()))
+		err := admin.Start(ctx, s.Admin, cm, sm, admin.NewSchemaRetreiver(schemaEventSourceFactory()), timelineClient)
 		if err != nil {
 			return fmt.Errorf("lease failed: %w", err)
 		}
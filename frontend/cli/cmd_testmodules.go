@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/backend/provisioner"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/buildengine"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/projectconfig"
+	"github.com/block/ftl/internal/schema/schemaeventsource"
+)
+
+type testCmd struct {
+	Modules       []string `arg:"" help:"Modules to test (defaults to all modules)." optional:""`
+	Parallelism   int      `short:"j" help:"Number of modules to build and test in parallel." default:"${numcpu}"`
+	BuildEnv      []string `help:"Environment variables to set for the build."`
+	JUnit         string   `help:"Write test results to this file as JUnit XML." placeholder:"FILE"`
+	KeepDatabases bool     `help:"Keep the test databases provisioned for this run rather than dropping them afterwards."`
+}
+
+// testDatabase is a database provisioned for a single test run.
+type testDatabase struct {
+	module string
+	id     string
+	dbType string
+}
+
+func (t *testCmd) Run(
+	ctx context.Context,
+	controllerClient ftlv1connect.ControllerServiceClient,
+	schemaSourceFactory func() schemaeventsource.EventSource,
+	projConfig projectconfig.Config,
+) error {
+	logger := log.FromContext(ctx)
+	dirs := projConfig.AbsModuleDirs()
+	if len(dirs) == 0 {
+		return errors.New("no module directories configured")
+	}
+
+	// Cancel build engine context to ensure all language plugins are killed.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	engine, err := buildengine.New(ctx, controllerClient, schemaSourceFactory(), projConfig, dirs,
+		buildengine.BuildEnv(t.BuildEnv),
+		buildengine.Parallelism(t.Parallelism),
+	)
+	if err != nil {
+		return err
+	}
+	if err := engine.Build(ctx); err != nil {
+		return fmt.Errorf("build failed: %w", err)
+	}
+
+	runID, err := newTestRunID()
+	if err != nil {
+		return err
+	}
+	sch := engine.Schema()
+	var lock sync.Mutex
+	var provisioned []testDatabase
+	defer func() {
+		if t.KeepDatabases {
+			return
+		}
+		for _, db := range provisioned {
+			if err := deprovisionTestDatabase(context.WithoutCancel(ctx), db); err != nil {
+				logger.Warnf("Could not drop test database %s for %s: %s", db.id, db.module, err)
+			}
+		}
+	}()
+
+	env := func(ctx context.Context, module buildengine.Module) ([]string, error) {
+		env := []string{"FTL_CONFIG=" + projConfig.Path}
+		sch, ok := sch.Module(module.Config.Module).Get()
+		if !ok {
+			return env, nil
+		}
+		for _, decl := range sch.Decls {
+			db, ok := decl.(*schema.Database)
+			if !ok {
+				continue
+			}
+			tdb := testDatabase{module: module.Config.Module, id: db.Name + "_" + runID, dbType: db.Type}
+			dsn, err := provisionTestDatabase(ctx, tdb, module.Config.Dir, db.Name)
+			lock.Lock()
+			provisioned = append(provisioned, tdb)
+			lock.Unlock()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", db.Name, err)
+			}
+			// Picked up by ftltest.WithDatabase in place of provisioning its own database.
+			env = append(env, fmt.Sprintf("FTL_TEST_DSN_%s_%s=%s", strings.ToUpper(module.Config.Module), strings.ToUpper(db.Name), dsn))
+		}
+		return env, nil
+	}
+	results, err := engine.Test(ctx, env, t.Modules...)
+	if err != nil {
+		return err
+	}
+
+	failed := printTestResults(results)
+	if t.JUnit != "" {
+		w, err := os.Create(t.JUnit)
+		if err != nil {
+			return fmt.Errorf("could not create JUnit report: %w", err)
+		}
+		defer w.Close()
+		if err := buildengine.WriteJUnitReport(w, results); err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("tests failed in %d module(s)", failed)
+	}
+	return nil
+}
+
+// provisionTestDatabase provisions an isolated database for a test run and applies the module's migrations.
+func provisionTestDatabase(ctx context.Context, db testDatabase, moduleDir, name string) (string, error) {
+	var dsn string
+	var err error
+	switch db.dbType {
+	case schema.PostgresDatabaseType:
+		if dsn, err = provisioner.ProvisionPostgresForTest(ctx, db.module, db.id); err == nil {
+			err = provisioner.RunPostgresMigration(ctx, dsn, moduleDir, name)
+		}
+	case schema.MySQLDatabaseType:
+		if dsn, err = provisioner.ProvisionMySQLForTest(ctx, db.module, db.id); err == nil {
+			err = provisioner.RunMySQLMigration(ctx, dsn, moduleDir, name)
+		}
+	case schema.SQLiteDatabaseType:
+		if dsn, err = provisioner.ProvisionSQLiteForTest(ctx, db.module, db.id); err == nil {
+			err = provisioner.RunSQLiteMigration(ctx, dsn, moduleDir, name)
+		}
+	default:
+		return "", fmt.Errorf("unsupported database type %q", db.dbType)
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not provision test database: %w", err)
+	}
+	return dsn, nil
+}
+
+func deprovisionTestDatabase(ctx context.Context, db testDatabase) error {
+	switch db.dbType {
+	case schema.PostgresDatabaseType:
+		return provisioner.DeprovisionPostgresForTest(ctx, db.module, db.id)
+	case schema.MySQLDatabaseType:
+		return provisioner.DeprovisionMySQLForTest(ctx, db.module, db.id)
+	case schema.SQLiteDatabaseType:
+		return provisioner.DeprovisionSQLiteForTest(ctx, db.module, db.id)
+	default:
+		return nil
+	}
+}
+
+// newTestRunID returns a random suffix that isolates the databases of a test run from other runs.
+func newTestRunID() (string, error) {
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("could not generate test run ID: %w", err)
+	}
+	return "r" + hex.EncodeToString(id), nil
+}
+
+// printTestResults prints a summary of each module's results, and returns the number of modules that failed.
+func printTestResults(results []buildengine.TestResult) int {
+	failed := 0
+	for _, result := range results {
+		switch {
+		case result.Skipped:
+			fmt.Printf("skip  %s (no test command configured)\n", result.Module)
+		case result.Failed():
+			failed++
+			fmt.Printf("FAIL  %s\t%s\t%s\n", result.Module, result.Duration.Round(time.Millisecond), testCaseSummary(result))
+			if output := strings.TrimSpace(result.Output); output != "" {
+				fmt.Println(indent(output, "      "))
+			} else {
+				fmt.Printf("      %s\n", result.Err)
+			}
+		default:
+			fmt.Printf("ok    %s\t%s\t%s\n", result.Module, result.Duration.Round(time.Millisecond), testCaseSummary(result))
+		}
+	}
+	return failed
+}
+
+func testCaseSummary(result buildengine.TestResult) string {
+	if len(result.Cases) == 0 {
+		return ""
+	}
+	var passed, failed, skipped int
+	for _, c := range result.Cases {
+		switch {
+		case c.Failed:
+			failed++
+		case c.Skipped:
+			skipped++
+		default:
+			passed++
+		}
+	}
+	return fmt.Sprintf("%d passed, %d failed, %d skipped", passed, failed, skipped)
+}
+
+func indent(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
@@ -11,6 +11,7 @@ import (
 	"runtime"
 	"runtime/trace"
 	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/alecthomas/kong"
@@ -20,12 +21,14 @@ import (
 
 	"github.com/block/ftl"
 	"github.com/block/ftl/backend/admin"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/lease/v1/leasepbconnect"
 	provisionerconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/provisioner/v1beta1/provisionerpbconnect"
 	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
 	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/internal"
 	_ "github.com/block/ftl/internal/automaxprocs" // Set GOMAXPROCS to match Linux container CPU quota.
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/configuration"
 	"github.com/block/ftl/internal/configuration/manager"
 	"github.com/block/ftl/internal/configuration/providers"
@@ -44,6 +47,7 @@ type InteractiveCLI struct {
 	TimelineEndpoint    *url.URL         `help:"Timeline endpoint." env:"FTL_TIMELINE_ENDPOINT" default:"http://127.0.0.1:8894"`
 	LeaseEndpoint       *url.URL         `help:"Lease endpoint." env:"FTL_LEASE_ENDPOINT" default:"http://127.0.0.1:8895"`
 	AdminEndpoint       *url.URL         `help:"Admin endpoint." env:"FTL_ADMIN_ENDPOINT" default:"http://127.0.0.1:8896"`
+	AsyncEndpoint       *url.URL         `help:"Async call service endpoint." env:"FTL_ASYNC_ENDPOINT" default:"http://127.0.0.1:8897"`
 	Trace               string           `help:"File to write golang runtime/trace output to." hidden:""`
 
 	Ping     pingCmd     `cmd:"" help:"Ping the FTL cluster."`
@@ -54,16 +58,18 @@ type InteractiveCLI struct {
 	PS       psCmd       `cmd:"" help:"List deployments."`
 	Call     callCmd     `cmd:"" help:"Call an FTL function."`
 	Bench    benchCmd    `cmd:"" help:"Benchmark an FTL function."`
-	Replay   replayCmd   `cmd:"" help:"Call an FTL function with the same request body as the last invocation."`
+	Replay   replayCmd   `cmd:"" help:"Call an FTL function with the same request body as the last invocation, or export and replay recorded calls."`
 	Update   updateCmd   `cmd:"" help:"Update a deployment."`
 	Kill     killCmd     `cmd:"" help:"Kill a deployment."`
 	Schema   schemaCmd   `cmd:"" help:"FTL schema commands."`
 	Build    buildCmd    `cmd:"" help:"Build all modules found in the specified directories."`
 	Deploy   deployCmd   `cmd:"" help:"Build and deploy all modules found in the specified directories."`
+	Test     testCmd     `cmd:"" help:"Run the tests of all modules, or the given modules, against freshly provisioned test databases."`
 	Download downloadCmd `cmd:"" help:"Download a deployment."`
 	Secret   secretCmd   `cmd:"" help:"Manage secrets."`
 	Config   configCmd   `cmd:"" help:"Manage configuration."`
 	Pubsub   pubsubCmd   `cmd:"" help:"Manage pub/sub."`
+	Async    asyncCmd    `cmd:"" help:"Manage asynchronous calls."`
 	Release  releaseCmd  `cmd:"" help:"Manage releases."`
 }
 
@@ -74,11 +80,13 @@ type CLI struct {
 
 	Authenticators map[string]string `help:"Authenticators to use for FTL endpoints." mapsep:"," env:"FTL_AUTHENTICATORS" placeholder:"HOST=EXE,…"`
 	Insecure       bool              `help:"Skip TLS certificate verification. Caution: susceptible to machine-in-the-middle attacks."`
+	TLS            certs.Config      `embed:"" prefix:"tls-" group:"TLS:"`
 	Plain          bool              `help:"Use a plain console with no color or status line." env:"FTL_PLAIN"`
 
 	Interactive interactiveCmd            `cmd:"" help:"Interactive mode." default:""`
 	Dev         devCmd                    `cmd:"" help:"Develop FTL modules. Will start the FTL cluster, build and deploy all modules found in the specified directories, and watch for changes."`
 	Serve       serveCmd                  `cmd:"" help:"Start the FTL server."`
+	Preview     previewCmd                `cmd:"" help:"Manage isolated preview environments, eg. for feature branches."`
 	Completion  kongcompletion.Completion `cmd:"" help:"Outputs shell code for initialising tab completions."`
 
 	// Specify the 1Password vault to access secrets from.
@@ -156,6 +164,17 @@ func main() {
 
 	os.Setenv("FTL_CONFIG", configPath)
 
+	// Without a certificate, https:// endpoints use a development CA in the project, which servers create on demand.
+	if cli.TLS.Cert == "" && cli.TLS.DevCA == "" {
+		devCA := filepath.Join(filepath.Dir(configPath), ".ftl", "tls")
+		if _, err := os.Stat(devCA); err == nil || isServerCommand(kctx.Command()) {
+			cli.TLS.DevCA = devCA
+		}
+	}
+	if err := rpc.InitialiseTLS(cli.TLS); err != nil {
+		kctx.Fatalf("%s", err)
+	}
+
 	// Handle signals.
 	sigch := make(chan os.Signal, 1)
 	signal.Notify(sigch, syscall.SIGINT, syscall.SIGTERM)
@@ -174,6 +193,12 @@ func main() {
 	kctx.FatalIfErrorf(err)
 }
 
+// isServerCommand returns true if the command starts FTL servers.
+func isServerCommand(command string) bool {
+	name, _, _ := strings.Cut(command, " ")
+	return name == "serve" || name == "dev"
+}
+
 func createKongApplication(cli any, csm *currentStatusManager) *kong.Kong {
 	gitRoot, _ := internal.GitRoot(".").Get()
 	app := kong.Must(cli,
@@ -255,6 +280,9 @@ func makeBindContext(logger *log.Logger, cancel context.CancelFunc) terminal.Kon
 		ctx = rpc.ContextWithClient(ctx, leaseClient)
 		kctx.BindTo(leaseClient, (*leasepbconnect.LeaseServiceClient)(nil))
 
+		asyncClient := rpc.Dial(asynccallpbconnect.NewAsyncServiceClient, cli.AsyncEndpoint.String(), log.Error)
+		kctx.BindTo(asyncClient, (*asynccallpbconnect.AsyncServiceClient)(nil))
+
 		adminClient := rpc.Dial(ftlv1connect.NewAdminServiceClient, cli.AdminEndpoint.String(), log.Error)
 		ctx = rpc.ContextWithClient(ctx, adminClient)
 		kctx.BindTo(adminClient, (*ftlv1connect.AdminServiceClient)(nil))
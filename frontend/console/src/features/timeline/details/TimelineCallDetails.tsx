@@ -51,6 +51,11 @@ export const TimelineCallDetails = ({ event }: { event: Event }) => {
         <li>
           <AttributeBadge name='duration' value={formatDuration(call.duration)} />
         </li>
+        {call.timedOut && (
+          <li>
+            <AttributeBadge name='timed out' value='true' />
+          </li>
+        )}
         {call.destinationVerbRef && (
           <li>
             <AttributeBadge name='destination' value={refString(call.destinationVerbRef)} />
@@ -40,6 +40,8 @@ export const asyncEventTypeString = (type: AsyncExecuteEventType) => {
       return 'cron'
     case AsyncExecuteEventType.PUBSUB:
       return 'pubsub'
+    case AsyncExecuteEventType.ASYNC_CALL:
+      return 'call'
     default:
       return 'unknown'
   }
@@ -0,0 +1,89 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file xyz/block/ftl/asynccall/v1/asynccall.proto (package xyz.block.ftl.asynccall.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { PingRequest, PingResponse } from "../../v1/ftl_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { CancelCallRequest, CancelCallResponse, EnqueueCallRequest, EnqueueCallResponse, GetCallRequest, GetCallResponse, ListCallsRequest, ListCallsResponse, RetryCallRequest, RetryCallResponse } from "./asynccall_pb.js";
+
+/**
+ * AsyncService durably queues and executes asynchronous verb calls.
+ *
+ * @generated from service xyz.block.ftl.asynccall.v1.AsyncService
+ */
+export const AsyncService = {
+  typeName: "xyz.block.ftl.asynccall.v1.AsyncService",
+  methods: {
+    /**
+     * Ping service for readiness.
+     *
+     * @generated from rpc xyz.block.ftl.asynccall.v1.AsyncService.Ping
+     */
+    ping: {
+      name: "Ping",
+      I: PingRequest,
+      O: PingResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Enqueue a call to a verb, returning its ID.
+     *
+     * @generated from rpc xyz.block.ftl.asynccall.v1.AsyncService.EnqueueCall
+     */
+    enqueueCall: {
+      name: "EnqueueCall",
+      I: EnqueueCallRequest,
+      O: EnqueueCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Get the current state of a call.
+     *
+     * @generated from rpc xyz.block.ftl.asynccall.v1.AsyncService.GetCall
+     */
+    getCall: {
+      name: "GetCall",
+      I: GetCallRequest,
+      O: GetCallResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * List calls, most recent first.
+     *
+     * @generated from rpc xyz.block.ftl.asynccall.v1.AsyncService.ListCalls
+     */
+    listCalls: {
+      name: "ListCalls",
+      I: ListCallsRequest,
+      O: ListCallsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Reschedule a failed or cancelled call for immediate execution.
+     *
+     * @generated from rpc xyz.block.ftl.asynccall.v1.AsyncService.RetryCall
+     */
+    retryCall: {
+      name: "RetryCall",
+      I: RetryCallRequest,
+      O: RetryCallResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Cancel a pending call.
+     *
+     * @generated from rpc xyz.block.ftl.asynccall.v1.AsyncService.CancelCall
+     */
+    cancelCall: {
+      name: "CancelCall",
+      I: CancelCallRequest,
+      O: CancelCallResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,575 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file xyz/block/ftl/asynccall/v1/asynccall.proto (package xyz.block.ftl.asynccall.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { Ref } from "../../schema/v1/schema_pb.js";
+
+/**
+ * @generated from enum xyz.block.ftl.asynccall.v1.AsyncCallState
+ */
+export enum AsyncCallState {
+  /**
+   * @generated from enum value: ASYNC_CALL_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The call is waiting to be executed, either for the first time or as a retry.
+   *
+   * @generated from enum value: ASYNC_CALL_STATE_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * The call is currently executing.
+   *
+   * @generated from enum value: ASYNC_CALL_STATE_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * @generated from enum value: ASYNC_CALL_STATE_SUCCEEDED = 3;
+   */
+  SUCCEEDED = 3,
+
+  /**
+   * The call and its catch verb, if any, failed and all retries are exhausted.
+   *
+   * @generated from enum value: ASYNC_CALL_STATE_FAILED = 4;
+   */
+  FAILED = 4,
+
+  /**
+   * @generated from enum value: ASYNC_CALL_STATE_CANCELLED = 5;
+   */
+  CANCELLED = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AsyncCallState)
+proto3.util.setEnumType(AsyncCallState, "xyz.block.ftl.asynccall.v1.AsyncCallState", [
+  { no: 0, name: "ASYNC_CALL_STATE_UNSPECIFIED" },
+  { no: 1, name: "ASYNC_CALL_STATE_PENDING" },
+  { no: 2, name: "ASYNC_CALL_STATE_RUNNING" },
+  { no: 3, name: "ASYNC_CALL_STATE_SUCCEEDED" },
+  { no: 4, name: "ASYNC_CALL_STATE_FAILED" },
+  { no: 5, name: "ASYNC_CALL_STATE_CANCELLED" },
+]);
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.AsyncCall
+ */
+export class AsyncCall extends Message<AsyncCall> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref verb = 2;
+   */
+  verb?: Ref;
+
+  /**
+   * The module that enqueued the call, as identified by its caller token.
+   *
+   * @generated from field: optional string caller_module = 14;
+   */
+  callerModule?: string;
+
+  /**
+   * JSON encoded request.
+   *
+   * @generated from field: bytes request = 4;
+   */
+  request = new Uint8Array(0);
+
+  /**
+   * @generated from field: xyz.block.ftl.asynccall.v1.AsyncCallState state = 5;
+   */
+  state = AsyncCallState.UNSPECIFIED;
+
+  /**
+   * Number of attempts made so far, including attempts to call the catch verb.
+   *
+   * @generated from field: int64 attempts = 6;
+   */
+  attempts = protoInt64.zero;
+
+  /**
+   * JSON encoded response, present once the call has succeeded.
+   *
+   * @generated from field: optional bytes response = 7;
+   */
+  response?: Uint8Array;
+
+  /**
+   * The most recent error, if any.
+   *
+   * @generated from field: optional string error = 8;
+   */
+  error?: string;
+
+  /**
+   * Set once the callee's retries are exhausted and the catch verb is being called instead.
+   *
+   * @generated from field: optional xyz.block.ftl.schema.v1.Ref catch_verb = 9;
+   */
+  catchVerb?: Ref;
+
+  /**
+   * @generated from field: string request_key = 10;
+   */
+  requestKey = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 12;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * When the next attempt will be made, present only for pending calls.
+   *
+   * @generated from field: optional google.protobuf.Timestamp next_attempt_at = 13;
+   */
+  nextAttemptAt?: Timestamp;
+
+  constructor(data?: PartialMessage<AsyncCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.AsyncCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verb", kind: "message", T: Ref },
+    { no: 14, name: "caller_module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "request", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 5, name: "state", kind: "enum", T: proto3.getEnumType(AsyncCallState) },
+    { no: 6, name: "attempts", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "response", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 8, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "catch_verb", kind: "message", T: Ref, opt: true },
+    { no: 10, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "created_at", kind: "message", T: Timestamp },
+    { no: 12, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 13, name: "next_attempt_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AsyncCall {
+    return new AsyncCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AsyncCall {
+    return new AsyncCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AsyncCall {
+    return new AsyncCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AsyncCall | PlainMessage<AsyncCall> | undefined, b: AsyncCall | PlainMessage<AsyncCall> | undefined): boolean {
+    return proto3.util.equals(AsyncCall, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.EnqueueCallRequest
+ */
+export class EnqueueCallRequest extends Message<EnqueueCallRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref verb = 1;
+   */
+  verb?: Ref;
+
+  /**
+   * JSON encoded request.
+   *
+   * @generated from field: bytes body = 2;
+   */
+  body = new Uint8Array(0);
+
+  /**
+   * The verb making the call. The calling module is identified by the caller token of the request, so this is
+   * only used to restrict what may be called when the token is missing or invalid.
+   *
+   * @generated from field: optional xyz.block.ftl.schema.v1.Ref caller = 3;
+   */
+  caller?: Ref;
+
+  constructor(data?: PartialMessage<EnqueueCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.EnqueueCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "verb", kind: "message", T: Ref },
+    { no: 2, name: "body", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "caller", kind: "message", T: Ref, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnqueueCallRequest {
+    return new EnqueueCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnqueueCallRequest {
+    return new EnqueueCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnqueueCallRequest {
+    return new EnqueueCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EnqueueCallRequest | PlainMessage<EnqueueCallRequest> | undefined, b: EnqueueCallRequest | PlainMessage<EnqueueCallRequest> | undefined): boolean {
+    return proto3.util.equals(EnqueueCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.EnqueueCallResponse
+ */
+export class EnqueueCallResponse extends Message<EnqueueCallResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<EnqueueCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.EnqueueCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnqueueCallResponse {
+    return new EnqueueCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnqueueCallResponse {
+    return new EnqueueCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnqueueCallResponse {
+    return new EnqueueCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EnqueueCallResponse | PlainMessage<EnqueueCallResponse> | undefined, b: EnqueueCallResponse | PlainMessage<EnqueueCallResponse> | undefined): boolean {
+    return proto3.util.equals(EnqueueCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.GetCallRequest
+ */
+export class GetCallRequest extends Message<GetCallRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.GetCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCallRequest {
+    return new GetCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCallRequest {
+    return new GetCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCallRequest {
+    return new GetCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCallRequest | PlainMessage<GetCallRequest> | undefined, b: GetCallRequest | PlainMessage<GetCallRequest> | undefined): boolean {
+    return proto3.util.equals(GetCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.GetCallResponse
+ */
+export class GetCallResponse extends Message<GetCallResponse> {
+  /**
+   * @generated from field: xyz.block.ftl.asynccall.v1.AsyncCall call = 1;
+   */
+  call?: AsyncCall;
+
+  constructor(data?: PartialMessage<GetCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.GetCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "call", kind: "message", T: AsyncCall },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCallResponse {
+    return new GetCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCallResponse {
+    return new GetCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCallResponse {
+    return new GetCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCallResponse | PlainMessage<GetCallResponse> | undefined, b: GetCallResponse | PlainMessage<GetCallResponse> | undefined): boolean {
+    return proto3.util.equals(GetCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.ListCallsRequest
+ */
+export class ListCallsRequest extends Message<ListCallsRequest> {
+  /**
+   * If set, only calls to verbs in this module are returned.
+   *
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  /**
+   * If set, only calls in this state are returned.
+   *
+   * @generated from field: optional xyz.block.ftl.asynccall.v1.AsyncCallState state = 2;
+   */
+  state?: AsyncCallState;
+
+  /**
+   * Maximum number of calls to return, most recent first. Defaults to 100.
+   *
+   * @generated from field: int32 limit = 3;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListCallsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.ListCallsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "state", kind: "enum", T: proto3.getEnumType(AsyncCallState), opt: true },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCallsRequest {
+    return new ListCallsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCallsRequest {
+    return new ListCallsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCallsRequest {
+    return new ListCallsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCallsRequest | PlainMessage<ListCallsRequest> | undefined, b: ListCallsRequest | PlainMessage<ListCallsRequest> | undefined): boolean {
+    return proto3.util.equals(ListCallsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.ListCallsResponse
+ */
+export class ListCallsResponse extends Message<ListCallsResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.asynccall.v1.AsyncCall calls = 1;
+   */
+  calls: AsyncCall[] = [];
+
+  constructor(data?: PartialMessage<ListCallsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.ListCallsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "calls", kind: "message", T: AsyncCall, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCallsResponse {
+    return new ListCallsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCallsResponse {
+    return new ListCallsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCallsResponse {
+    return new ListCallsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListCallsResponse | PlainMessage<ListCallsResponse> | undefined, b: ListCallsResponse | PlainMessage<ListCallsResponse> | undefined): boolean {
+    return proto3.util.equals(ListCallsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.RetryCallRequest
+ */
+export class RetryCallRequest extends Message<RetryCallRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<RetryCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.RetryCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryCallRequest {
+    return new RetryCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryCallRequest {
+    return new RetryCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryCallRequest {
+    return new RetryCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryCallRequest | PlainMessage<RetryCallRequest> | undefined, b: RetryCallRequest | PlainMessage<RetryCallRequest> | undefined): boolean {
+    return proto3.util.equals(RetryCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.RetryCallResponse
+ */
+export class RetryCallResponse extends Message<RetryCallResponse> {
+  constructor(data?: PartialMessage<RetryCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.RetryCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetryCallResponse {
+    return new RetryCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetryCallResponse {
+    return new RetryCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetryCallResponse {
+    return new RetryCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetryCallResponse | PlainMessage<RetryCallResponse> | undefined, b: RetryCallResponse | PlainMessage<RetryCallResponse> | undefined): boolean {
+    return proto3.util.equals(RetryCallResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.CancelCallRequest
+ */
+export class CancelCallRequest extends Message<CancelCallRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<CancelCallRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.CancelCallRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelCallRequest {
+    return new CancelCallRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelCallRequest {
+    return new CancelCallRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelCallRequest {
+    return new CancelCallRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelCallRequest | PlainMessage<CancelCallRequest> | undefined, b: CancelCallRequest | PlainMessage<CancelCallRequest> | undefined): boolean {
+    return proto3.util.equals(CancelCallRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.asynccall.v1.CancelCallResponse
+ */
+export class CancelCallResponse extends Message<CancelCallResponse> {
+  constructor(data?: PartialMessage<CancelCallResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.asynccall.v1.CancelCallResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelCallResponse {
+    return new CancelCallResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelCallResponse {
+    return new CancelCallResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelCallResponse {
+    return new CancelCallResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelCallResponse | PlainMessage<CancelCallResponse> | undefined, b: CancelCallResponse | PlainMessage<CancelCallResponse> | undefined): boolean {
+    return proto3.util.equals(CancelCallResponse, a, b);
+  }
+}
+
@@ -77,6 +77,20 @@ export class GetDeploymentContextResponse extends Message<GetDeploymentContextRe
    */
   routes: GetDeploymentContextResponse_Route[] = [];
 
+  /**
+   * Signed token identifying the deployment, attached by the runner to outbound calls.
+   *
+   * @generated from field: string caller_token = 7;
+   */
+  callerToken = "";
+
+  /**
+   * Keys that caller tokens are verified with.
+   *
+   * @generated from field: repeated xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.CallerKey caller_keys = 8;
+   */
+  callerKeys: GetDeploymentContextResponse_CallerKey[] = [];
+
   constructor(data?: PartialMessage<GetDeploymentContextResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -91,6 +105,8 @@ export class GetDeploymentContextResponse extends Message<GetDeploymentContextRe
     { no: 4, name: "secrets", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
     { no: 5, name: "databases", kind: "message", T: GetDeploymentContextResponse_DSN, repeated: true },
     { no: 6, name: "routes", kind: "message", T: GetDeploymentContextResponse_Route, repeated: true },
+    { no: 7, name: "caller_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "caller_keys", kind: "message", T: GetDeploymentContextResponse_CallerKey, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeploymentContextResponse {
@@ -128,12 +144,18 @@ export enum GetDeploymentContextResponse_DbType {
    * @generated from enum value: DB_TYPE_MYSQL = 2;
    */
   MYSQL = 2,
+
+  /**
+   * @generated from enum value: DB_TYPE_SQLITE = 3;
+   */
+  SQLITE = 3,
 }
 // Retrieve enum metadata with: proto3.getEnumType(GetDeploymentContextResponse_DbType)
 proto3.util.setEnumType(GetDeploymentContextResponse_DbType, "xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbType", [
   { no: 0, name: "DB_TYPE_UNSPECIFIED" },
   { no: 1, name: "DB_TYPE_POSTGRES" },
   { no: 2, name: "DB_TYPE_MYSQL" },
+  { no: 3, name: "DB_TYPE_SQLITE" },
 ]);
 
 /**
@@ -228,3 +250,46 @@ export class GetDeploymentContextResponse_Route extends Message<GetDeploymentCon
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.CallerKey
+ */
+export class GetDeploymentContextResponse_CallerKey extends Message<GetDeploymentContextResponse_CallerKey> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: bytes public_key = 2;
+   */
+  publicKey = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GetDeploymentContextResponse_CallerKey>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.CallerKey";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "public_key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetDeploymentContextResponse_CallerKey {
+    return new GetDeploymentContextResponse_CallerKey().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetDeploymentContextResponse_CallerKey {
+    return new GetDeploymentContextResponse_CallerKey().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetDeploymentContextResponse_CallerKey {
+    return new GetDeploymentContextResponse_CallerKey().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetDeploymentContextResponse_CallerKey | PlainMessage<GetDeploymentContextResponse_CallerKey> | undefined, b: GetDeploymentContextResponse_CallerKey | PlainMessage<GetDeploymentContextResponse_CallerKey> | undefined): boolean {
+    return proto3.util.equals(GetDeploymentContextResponse_CallerKey, a, b);
+  }
+}
+
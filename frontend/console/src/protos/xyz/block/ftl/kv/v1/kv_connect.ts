@@ -0,0 +1,75 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file xyz/block/ftl/kv/v1/kv.proto (package xyz.block.ftl.kv.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { PingRequest, PingResponse } from "../../v1/ftl_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { CompareAndSwapRequest, CompareAndSwapResponse, DeleteRequest, DeleteResponse, GetRequest, GetResponse, PutRequest, PutResponse } from "./kv_pb.js";
+
+/**
+ * @generated from service xyz.block.ftl.kv.v1.KVService
+ */
+export const KVService = {
+  typeName: "xyz.block.ftl.kv.v1.KVService",
+  methods: {
+    /**
+     * Ping service for readiness.
+     *
+     * @generated from rpc xyz.block.ftl.kv.v1.KVService.Ping
+     */
+    ping: {
+      name: "Ping",
+      I: PingRequest,
+      O: PingResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Get a value from a key-value store.
+     *
+     * @generated from rpc xyz.block.ftl.kv.v1.KVService.Get
+     */
+    get: {
+      name: "Get",
+      I: GetRequest,
+      O: GetResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Put a value into a key-value store.
+     *
+     * @generated from rpc xyz.block.ftl.kv.v1.KVService.Put
+     */
+    put: {
+      name: "Put",
+      I: PutRequest,
+      O: PutResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Delete a key from a key-value store.
+     *
+     * @generated from rpc xyz.block.ftl.kv.v1.KVService.Delete
+     */
+    delete: {
+      name: "Delete",
+      I: DeleteRequest,
+      O: DeleteResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Atomically replace a value if it matches the expected value.
+     *
+     * @generated from rpc xyz.block.ftl.kv.v1.KVService.CompareAndSwap
+     */
+    compareAndSwap: {
+      name: "CompareAndSwap",
+      I: CompareAndSwapRequest,
+      O: CompareAndSwapResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
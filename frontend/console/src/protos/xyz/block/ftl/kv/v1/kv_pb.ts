@@ -0,0 +1,375 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file xyz/block/ftl/kv/v1/kv.proto (package xyz.block.ftl.kv.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Duration, Message, proto3 } from "@bufbuild/protobuf";
+import { Ref } from "../../schema/v1/schema_pb.js";
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.GetRequest
+ */
+export class GetRequest extends Message<GetRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref kv = 1;
+   */
+  kv?: Ref;
+
+  /**
+   * JSON encoded key.
+   *
+   * @generated from field: bytes key = 2;
+   */
+  key = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<GetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.GetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kv", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRequest {
+    return new GetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRequest {
+    return new GetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRequest {
+    return new GetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRequest | PlainMessage<GetRequest> | undefined, b: GetRequest | PlainMessage<GetRequest> | undefined): boolean {
+    return proto3.util.equals(GetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.GetResponse
+ */
+export class GetResponse extends Message<GetResponse> {
+  /**
+   * JSON encoded value, absent if the key does not exist or has expired.
+   *
+   * @generated from field: optional bytes value = 1;
+   */
+  value?: Uint8Array;
+
+  constructor(data?: PartialMessage<GetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.GetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResponse {
+    return new GetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResponse {
+    return new GetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResponse {
+    return new GetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetResponse | PlainMessage<GetResponse> | undefined, b: GetResponse | PlainMessage<GetResponse> | undefined): boolean {
+    return proto3.util.equals(GetResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.PutRequest
+ */
+export class PutRequest extends Message<PutRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref kv = 1;
+   */
+  kv?: Ref;
+
+  /**
+   * @generated from field: bytes key = 2;
+   */
+  key = new Uint8Array(0);
+
+  /**
+   * @generated from field: bytes value = 3;
+   */
+  value = new Uint8Array(0);
+
+  /**
+   * If set, the entry expires after this duration.
+   *
+   * @generated from field: optional google.protobuf.Duration ttl = 4;
+   */
+  ttl?: Duration;
+
+  /**
+   * Only verb name is included because this verb will be in the same module as the store
+   *
+   * @generated from field: string caller = 5;
+   */
+  caller = "";
+
+  constructor(data?: PartialMessage<PutRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.PutRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kv", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "ttl", kind: "message", T: Duration, opt: true },
+    { no: 5, name: "caller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutRequest {
+    return new PutRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutRequest {
+    return new PutRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutRequest {
+    return new PutRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutRequest | PlainMessage<PutRequest> | undefined, b: PutRequest | PlainMessage<PutRequest> | undefined): boolean {
+    return proto3.util.equals(PutRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.PutResponse
+ */
+export class PutResponse extends Message<PutResponse> {
+  constructor(data?: PartialMessage<PutResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.PutResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PutResponse {
+    return new PutResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PutResponse {
+    return new PutResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PutResponse {
+    return new PutResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PutResponse | PlainMessage<PutResponse> | undefined, b: PutResponse | PlainMessage<PutResponse> | undefined): boolean {
+    return proto3.util.equals(PutResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.DeleteRequest
+ */
+export class DeleteRequest extends Message<DeleteRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref kv = 1;
+   */
+  kv?: Ref;
+
+  /**
+   * @generated from field: bytes key = 2;
+   */
+  key = new Uint8Array(0);
+
+  /**
+   * @generated from field: string caller = 3;
+   */
+  caller = "";
+
+  constructor(data?: PartialMessage<DeleteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.DeleteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kv", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "caller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRequest {
+    return new DeleteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRequest | PlainMessage<DeleteRequest> | undefined, b: DeleteRequest | PlainMessage<DeleteRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.DeleteResponse
+ */
+export class DeleteResponse extends Message<DeleteResponse> {
+  constructor(data?: PartialMessage<DeleteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.DeleteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteResponse {
+    return new DeleteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteResponse | PlainMessage<DeleteResponse> | undefined, b: DeleteResponse | PlainMessage<DeleteResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.CompareAndSwapRequest
+ */
+export class CompareAndSwapRequest extends Message<CompareAndSwapRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref kv = 1;
+   */
+  kv?: Ref;
+
+  /**
+   * @generated from field: bytes key = 2;
+   */
+  key = new Uint8Array(0);
+
+  /**
+   * The expected current value. If absent, the swap only succeeds if the key does not exist.
+   *
+   * @generated from field: optional bytes old = 3;
+   */
+  old?: Uint8Array;
+
+  /**
+   * @generated from field: bytes new = 4;
+   */
+  new = new Uint8Array(0);
+
+  /**
+   * @generated from field: optional google.protobuf.Duration ttl = 5;
+   */
+  ttl?: Duration;
+
+  /**
+   * @generated from field: string caller = 6;
+   */
+  caller = "";
+
+  constructor(data?: PartialMessage<CompareAndSwapRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.CompareAndSwapRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kv", kind: "message", T: Ref },
+    { no: 2, name: "key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "old", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 4, name: "new", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 5, name: "ttl", kind: "message", T: Duration, opt: true },
+    { no: 6, name: "caller", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompareAndSwapRequest {
+    return new CompareAndSwapRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompareAndSwapRequest {
+    return new CompareAndSwapRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompareAndSwapRequest {
+    return new CompareAndSwapRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompareAndSwapRequest | PlainMessage<CompareAndSwapRequest> | undefined, b: CompareAndSwapRequest | PlainMessage<CompareAndSwapRequest> | undefined): boolean {
+    return proto3.util.equals(CompareAndSwapRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.kv.v1.CompareAndSwapResponse
+ */
+export class CompareAndSwapResponse extends Message<CompareAndSwapResponse> {
+  /**
+   * @generated from field: bool swapped = 1;
+   */
+  swapped = false;
+
+  constructor(data?: PartialMessage<CompareAndSwapResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.kv.v1.CompareAndSwapResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "swapped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CompareAndSwapResponse {
+    return new CompareAndSwapResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CompareAndSwapResponse {
+    return new CompareAndSwapResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CompareAndSwapResponse {
+    return new CompareAndSwapResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CompareAndSwapResponse | PlainMessage<CompareAndSwapResponse> | undefined, b: CompareAndSwapResponse | PlainMessage<CompareAndSwapResponse> | undefined): boolean {
+    return proto3.util.equals(CompareAndSwapResponse, a, b);
+  }
+}
+
@@ -84,6 +84,13 @@ export class ModuleConfig extends Message<ModuleConfig> {
    */
   sqlMigrationDir = "";
 
+  /**
+   * Test is the command to run the module's tests.
+   *
+   * @generated from field: optional string test = 12;
+   */
+  test?: string;
+
   constructor(data?: PartialMessage<ModuleConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -102,6 +109,7 @@ export class ModuleConfig extends Message<ModuleConfig> {
     { no: 9, name: "watch", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 10, name: "language_config", kind: "message", T: Struct },
     { no: 11, name: "sql_migration_dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "test", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModuleConfig {
@@ -524,6 +532,13 @@ export class ModuleConfigDefaultsResponse extends Message<ModuleConfigDefaultsRe
    */
   sqlMigrationDir = "";
 
+  /**
+   * Default command to run the module's tests
+   *
+   * @generated from field: optional string test = 9;
+   */
+  test?: string;
+
   constructor(data?: PartialMessage<ModuleConfigDefaultsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -540,6 +555,7 @@ export class ModuleConfigDefaultsResponse extends Message<ModuleConfigDefaultsRe
     { no: 6, name: "watch", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 7, name: "language_config", kind: "message", T: Struct },
     { no: 8, name: "sql_migration_dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "test", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModuleConfigDefaultsResponse {
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import { DatabaseRuntimeEvent, Module, ModuleRuntimeEvent, TopicRuntimeEvent, VerbRuntimeEvent } from "../../schema/v1/schema_pb.js";
+import { DatabaseRuntimeEvent, KVRuntimeEvent, Module, ModuleRuntimeEvent, ObjectStoreRuntimeEvent, TopicRuntimeEvent, VerbRuntimeEvent } from "../../schema/v1/schema_pb.js";
 
 /**
  * @generated from message xyz.block.ftl.provisioner.v1beta1.ProvisionRequest
@@ -201,6 +201,18 @@ export class ProvisioningEvent extends Message<ProvisioningEvent> {
      */
     value: VerbRuntimeEvent;
     case: "verbRuntimeEvent";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.KVRuntimeEvent kv_runtime_event = 5;
+     */
+    value: KVRuntimeEvent;
+    case: "kvRuntimeEvent";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.ObjectStoreRuntimeEvent object_store_runtime_event = 6;
+     */
+    value: ObjectStoreRuntimeEvent;
+    case: "objectStoreRuntimeEvent";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ProvisioningEvent>) {
@@ -215,6 +227,8 @@ export class ProvisioningEvent extends Message<ProvisioningEvent> {
     { no: 2, name: "database_runtime_event", kind: "message", T: DatabaseRuntimeEvent, oneof: "value" },
     { no: 3, name: "topic_runtime_event", kind: "message", T: TopicRuntimeEvent, oneof: "value" },
     { no: 4, name: "verb_runtime_event", kind: "message", T: VerbRuntimeEvent, oneof: "value" },
+    { no: 5, name: "kv_runtime_event", kind: "message", T: KVRuntimeEvent, oneof: "value" },
+    { no: 6, name: "object_store_runtime_event", kind: "message", T: ObjectStoreRuntimeEvent, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProvisioningEvent {
@@ -5,7 +5,7 @@
 
 import { PingRequest, PingResponse } from "../../v1/ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
-import { CreateDeploymentRequest, CreateDeploymentResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, ReplaceDeployRequest, ReplaceDeployResponse, StatusRequest, StatusResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "../../v1/controller_pb.js";
+import { CreateDeploymentRequest, CreateDeploymentResponse, DeployChangesetRequest, DeployChangesetResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, ReplaceDeployRequest, ReplaceDeployResponse, StatusRequest, StatusResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "../../v1/controller_pb.js";
 
 /**
  * @generated from service xyz.block.ftl.provisioner.v1beta1.ProvisionerService
@@ -77,6 +77,15 @@ export const ProvisionerService = {
       O: ReplaceDeployResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc xyz.block.ftl.provisioner.v1beta1.ProvisionerService.DeployChangeset
+     */
+    deployChangeset: {
+      name: "DeployChangeset",
+      I: DeployChangesetRequest,
+      O: DeployChangesetResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
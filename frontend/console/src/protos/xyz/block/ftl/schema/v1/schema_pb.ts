@@ -289,6 +289,11 @@ export class Config extends Message<Config> {
    */
   type?: Type;
 
+  /**
+   * @generated from field: optional string default = 5;
+   */
+  default?: string;
+
   constructor(data?: PartialMessage<Config>) {
     super();
     proto3.util.initPartial(data, this);
@@ -301,6 +306,7 @@ export class Config extends Message<Config> {
     { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "type", kind: "message", T: Type },
+    { no: 5, name: "default", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Config {
@@ -524,6 +530,12 @@ export class DatabaseConnector extends Message<DatabaseConnector> {
      */
     value: DSNDatabaseConnector;
     case: "dsnDatabaseConnector";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.VaultDatabaseConnector vault_database_connector = 3;
+     */
+    value: VaultDatabaseConnector;
+    case: "vaultDatabaseConnector";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<DatabaseConnector>) {
@@ -536,6 +548,7 @@ export class DatabaseConnector extends Message<DatabaseConnector> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "awsiam_auth_database_connector", kind: "message", T: AWSIAMAuthDatabaseConnector, oneof: "value" },
     { no: 1, name: "dsn_database_connector", kind: "message", T: DSNDatabaseConnector, oneof: "value" },
+    { no: 3, name: "vault_database_connector", kind: "message", T: VaultDatabaseConnector, oneof: "value" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DatabaseConnector {
@@ -791,6 +804,18 @@ export class Decl extends Message<Decl> {
      */
     value: Enum;
     case: "enum";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.KV kv = 10;
+     */
+    value: KV;
+    case: "kv";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.ObjectStore object_store = 11;
+     */
+    value: ObjectStore;
+    case: "objectStore";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.Secret secret = 7;
@@ -829,6 +854,8 @@ export class Decl extends Message<Decl> {
     { no: 1, name: "data", kind: "message", T: Data, oneof: "value" },
     { no: 3, name: "database", kind: "message", T: Database, oneof: "value" },
     { no: 4, name: "enum", kind: "message", T: Enum, oneof: "value" },
+    { no: 10, name: "kv", kind: "message", T: KV, oneof: "value" },
+    { no: 11, name: "object_store", kind: "message", T: ObjectStore, oneof: "value" },
     { no: 7, name: "secret", kind: "message", T: Secret, oneof: "value" },
     { no: 9, name: "topic", kind: "message", T: Topic, oneof: "value" },
     { no: 5, name: "type_alias", kind: "message", T: TypeAlias, oneof: "value" },
@@ -1288,6 +1315,157 @@ export class IntValue extends Message<IntValue> {
   }
 }
 
+/**
+ * KV is a provisioned key-value store.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.KV
+ */
+export class KV extends Message<KV> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.KVRuntime runtime = 31634;
+   */
+  runtime?: KVRuntime;
+
+  /**
+   * @generated from field: repeated string comments = 2;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Type key = 4;
+   */
+  key?: Type;
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Type value = 5;
+   */
+  value?: Type;
+
+  constructor(data?: PartialMessage<KV>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.KV";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 31634, name: "runtime", kind: "message", T: KVRuntime, opt: true },
+    { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "key", kind: "message", T: Type },
+    { no: 5, name: "value", kind: "message", T: Type },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KV {
+    return new KV().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KV {
+    return new KV().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KV {
+    return new KV().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KV | PlainMessage<KV> | undefined, b: KV | PlainMessage<KV> | undefined): boolean {
+    return proto3.util.equals(KV, a, b);
+  }
+}
+
+/**
+ * KVRuntime holds the runtime information for a provisioned key-value store.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.KVRuntime
+ */
+export class KVRuntime extends Message<KVRuntime> {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  constructor(data?: PartialMessage<KVRuntime>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.KVRuntime";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KVRuntime {
+    return new KVRuntime().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KVRuntime {
+    return new KVRuntime().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KVRuntime {
+    return new KVRuntime().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KVRuntime | PlainMessage<KVRuntime> | undefined, b: KVRuntime | PlainMessage<KVRuntime> | undefined): boolean {
+    return proto3.util.equals(KVRuntime, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.schema.v1.KVRuntimeEvent
+ */
+export class KVRuntimeEvent extends Message<KVRuntimeEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.KVRuntime payload = 2;
+   */
+  payload?: KVRuntime;
+
+  constructor(data?: PartialMessage<KVRuntimeEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.KVRuntimeEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "payload", kind: "message", T: KVRuntime },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KVRuntimeEvent {
+    return new KVRuntimeEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KVRuntimeEvent {
+    return new KVRuntimeEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KVRuntimeEvent {
+    return new KVRuntimeEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KVRuntimeEvent | PlainMessage<KVRuntimeEvent> | undefined, b: KVRuntimeEvent | PlainMessage<KVRuntimeEvent> | undefined): boolean {
+    return proto3.util.equals(KVRuntimeEvent, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.schema.v1.Map
  */
@@ -1364,6 +1542,12 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataCalls;
     case: "calls";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.MetadataCircuitBreaker circuit_breaker = 19;
+     */
+    value: MetadataCircuitBreaker;
+    case: "circuitBreaker";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.MetadataConfig config = 10;
@@ -1388,12 +1572,30 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataEncoding;
     case: "encoding";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.MetadataIdempotent idempotent = 17;
+     */
+    value: MetadataIdempotent;
+    case: "idempotent";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.MetadataIngress ingress = 2;
      */
     value: MetadataIngress;
     case: "ingress";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.MetadataKV kv = 15;
+     */
+    value: MetadataKV;
+    case: "kv";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.MetadataObjectStore object_store = 16;
+     */
+    value: MetadataObjectStore;
+    case: "objectStore";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.MetadataPublisher publisher = 12;
@@ -1418,12 +1620,24 @@ export class Metadata extends Message<Metadata> {
      */
     value: MetadataSecrets;
     case: "secrets";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.MetadataSensitive sensitive = 20;
+     */
+    value: MetadataSensitive;
+    case: "sensitive";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.MetadataSubscriber subscriber = 7;
      */
     value: MetadataSubscriber;
     case: "subscriber";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.MetadataTimeout timeout = 18;
+     */
+    value: MetadataTimeout;
+    case: "timeout";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.MetadataTypeMap type_map = 8;
@@ -1443,16 +1657,22 @@ export class Metadata extends Message<Metadata> {
     { no: 5, name: "alias", kind: "message", T: MetadataAlias, oneof: "value" },
     { no: 14, name: "artefact", kind: "message", T: MetadataArtefact, oneof: "value" },
     { no: 1, name: "calls", kind: "message", T: MetadataCalls, oneof: "value" },
+    { no: 19, name: "circuit_breaker", kind: "message", T: MetadataCircuitBreaker, oneof: "value" },
     { no: 10, name: "config", kind: "message", T: MetadataConfig, oneof: "value" },
     { no: 3, name: "cron_job", kind: "message", T: MetadataCronJob, oneof: "value" },
     { no: 4, name: "databases", kind: "message", T: MetadataDatabases, oneof: "value" },
     { no: 9, name: "encoding", kind: "message", T: MetadataEncoding, oneof: "value" },
+    { no: 17, name: "idempotent", kind: "message", T: MetadataIdempotent, oneof: "value" },
     { no: 2, name: "ingress", kind: "message", T: MetadataIngress, oneof: "value" },
+    { no: 15, name: "kv", kind: "message", T: MetadataKV, oneof: "value" },
+    { no: 16, name: "object_store", kind: "message", T: MetadataObjectStore, oneof: "value" },
     { no: 12, name: "publisher", kind: "message", T: MetadataPublisher, oneof: "value" },
     { no: 6, name: "retry", kind: "message", T: MetadataRetry, oneof: "value" },
     { no: 13, name: "sql_migration", kind: "message", T: MetadataSQLMigration, oneof: "value" },
     { no: 11, name: "secrets", kind: "message", T: MetadataSecrets, oneof: "value" },
+    { no: 20, name: "sensitive", kind: "message", T: MetadataSensitive, oneof: "value" },
     { no: 7, name: "subscriber", kind: "message", T: MetadataSubscriber, oneof: "value" },
+    { no: 18, name: "timeout", kind: "message", T: MetadataTimeout, oneof: "value" },
     { no: 8, name: "type_map", kind: "message", T: MetadataTypeMap, oneof: "value" },
   ]);
 
@@ -1622,6 +1842,69 @@ export class MetadataCalls extends Message<MetadataCalls> {
   }
 }
 
+/**
+ * MetadataCircuitBreaker stops calls being sent to a verb in a deployment
+ * while too many of them are failing.
+ *
+ * 	+circuitbreaker [errors=<percent>] [latency=<duration>] [cooldown=<duration>]
+ *
+ * Calls that take longer than the latency threshold, if one is set, count as
+ * failures.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.MetadataCircuitBreaker
+ */
+export class MetadataCircuitBreaker extends Message<MetadataCircuitBreaker> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: optional int64 error_percent = 2;
+   */
+  errorPercent?: bigint;
+
+  /**
+   * @generated from field: string latency = 3;
+   */
+  latency = "";
+
+  /**
+   * @generated from field: string cooldown = 4;
+   */
+  cooldown = "";
+
+  constructor(data?: PartialMessage<MetadataCircuitBreaker>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.MetadataCircuitBreaker";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "error_percent", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 3, name: "latency", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "cooldown", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataCircuitBreaker {
+    return new MetadataCircuitBreaker().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataCircuitBreaker {
+    return new MetadataCircuitBreaker().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataCircuitBreaker {
+    return new MetadataCircuitBreaker().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataCircuitBreaker | PlainMessage<MetadataCircuitBreaker> | undefined, b: MetadataCircuitBreaker | PlainMessage<MetadataCircuitBreaker> | undefined): boolean {
+    return proto3.util.equals(MetadataCircuitBreaker, a, b);
+  }
+}
+
 /**
  * MetadataConfig represents a metadata block with a list of config items that are used.
  *
@@ -1802,6 +2085,55 @@ export class MetadataEncoding extends Message<MetadataEncoding> {
   }
 }
 
+/**
+ * MetadataIdempotent marks a verb as idempotent.
+ *
+ * The first successful response to a call carrying an idempotency key is
+ * retained for the window, and returned for any subsequent call with the same
+ * key instead of executing the verb again.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.MetadataIdempotent
+ */
+export class MetadataIdempotent extends Message<MetadataIdempotent> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string window = 2;
+   */
+  window = "";
+
+  constructor(data?: PartialMessage<MetadataIdempotent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.MetadataIdempotent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "window", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataIdempotent {
+    return new MetadataIdempotent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataIdempotent {
+    return new MetadataIdempotent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataIdempotent {
+    return new MetadataIdempotent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataIdempotent | PlainMessage<MetadataIdempotent> | undefined, b: MetadataIdempotent | PlainMessage<MetadataIdempotent> | undefined): boolean {
+    return proto3.util.equals(MetadataIdempotent, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.schema.v1.MetadataIngress
  */
@@ -1857,6 +2189,92 @@ export class MetadataIngress extends Message<MetadataIngress> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.schema.v1.MetadataKV
+ */
+export class MetadataKV extends Message<MetadataKV> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.schema.v1.Ref calls = 2;
+   */
+  calls: Ref[] = [];
+
+  constructor(data?: PartialMessage<MetadataKV>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.MetadataKV";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "calls", kind: "message", T: Ref, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataKV {
+    return new MetadataKV().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataKV {
+    return new MetadataKV().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataKV {
+    return new MetadataKV().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataKV | PlainMessage<MetadataKV> | undefined, b: MetadataKV | PlainMessage<MetadataKV> | undefined): boolean {
+    return proto3.util.equals(MetadataKV, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.schema.v1.MetadataObjectStore
+ */
+export class MetadataObjectStore extends Message<MetadataObjectStore> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: repeated xyz.block.ftl.schema.v1.Ref calls = 2;
+   */
+  calls: Ref[] = [];
+
+  constructor(data?: PartialMessage<MetadataObjectStore>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.MetadataObjectStore";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "calls", kind: "message", T: Ref, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataObjectStore {
+    return new MetadataObjectStore().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataObjectStore {
+    return new MetadataObjectStore().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataObjectStore {
+    return new MetadataObjectStore().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataObjectStore | PlainMessage<MetadataObjectStore> | undefined, b: MetadataObjectStore | PlainMessage<MetadataObjectStore> | undefined): boolean {
+    return proto3.util.equals(MetadataObjectStore, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.schema.v1.MetadataPublisher
  */
@@ -2049,6 +2467,47 @@ export class MetadataSecrets extends Message<MetadataSecrets> {
   }
 }
 
+/**
+ * MetadataSensitive marks a data field as containing sensitive information.
+ *
+ * Sensitive fields are redacted from payloads recorded in the timeline.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.MetadataSensitive
+ */
+export class MetadataSensitive extends Message<MetadataSensitive> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  constructor(data?: PartialMessage<MetadataSensitive>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.MetadataSensitive";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataSensitive {
+    return new MetadataSensitive().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataSensitive {
+    return new MetadataSensitive().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataSensitive {
+    return new MetadataSensitive().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataSensitive | PlainMessage<MetadataSensitive> | undefined, b: MetadataSensitive | PlainMessage<MetadataSensitive> | undefined): boolean {
+    return proto3.util.equals(MetadataSensitive, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.schema.v1.MetadataSubscriber
  */
@@ -2104,6 +2563,54 @@ export class MetadataSubscriber extends Message<MetadataSubscriber> {
   }
 }
 
+/**
+ * MetadataTimeout limits how long a call to a verb may take.
+ *
+ * The timeout also bounds any calls the verb makes, which inherit whatever
+ * remains of the caller's deadline.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.MetadataTimeout
+ */
+export class MetadataTimeout extends Message<MetadataTimeout> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string duration = 2;
+   */
+  duration = "";
+
+  constructor(data?: PartialMessage<MetadataTimeout>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.MetadataTimeout";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetadataTimeout {
+    return new MetadataTimeout().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetadataTimeout | PlainMessage<MetadataTimeout> | undefined, b: MetadataTimeout | PlainMessage<MetadataTimeout> | undefined): boolean {
+    return proto3.util.equals(MetadataTimeout, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.schema.v1.MetadataTypeMap
  */
@@ -2475,6 +2982,157 @@ export class ModuleRuntimeScaling extends Message<ModuleRuntimeScaling> {
   }
 }
 
+/**
+ * ObjectStore is a provisioned blob/object store.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.ObjectStore
+ */
+export class ObjectStore extends Message<ObjectStore> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.ObjectStoreRuntime runtime = 31634;
+   */
+  runtime?: ObjectStoreRuntime;
+
+  /**
+   * @generated from field: repeated string comments = 2;
+   */
+  comments: string[] = [];
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ObjectStore>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.ObjectStore";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 31634, name: "runtime", kind: "message", T: ObjectStoreRuntime, opt: true },
+    { no: 2, name: "comments", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObjectStore {
+    return new ObjectStore().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObjectStore {
+    return new ObjectStore().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObjectStore {
+    return new ObjectStore().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ObjectStore | PlainMessage<ObjectStore> | undefined, b: ObjectStore | PlainMessage<ObjectStore> | undefined): boolean {
+    return proto3.util.equals(ObjectStore, a, b);
+  }
+}
+
+/**
+ * ObjectStoreRuntime holds the runtime information for a provisioned object store.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.ObjectStoreRuntime
+ */
+export class ObjectStoreRuntime extends Message<ObjectStoreRuntime> {
+  /**
+   * @generated from field: string endpoint = 1;
+   */
+  endpoint = "";
+
+  /**
+   * @generated from field: string bucket = 2;
+   */
+  bucket = "";
+
+  /**
+   * @generated from field: string region = 3;
+   */
+  region = "";
+
+  constructor(data?: PartialMessage<ObjectStoreRuntime>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.ObjectStoreRuntime";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bucket", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObjectStoreRuntime {
+    return new ObjectStoreRuntime().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObjectStoreRuntime {
+    return new ObjectStoreRuntime().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObjectStoreRuntime {
+    return new ObjectStoreRuntime().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ObjectStoreRuntime | PlainMessage<ObjectStoreRuntime> | undefined, b: ObjectStoreRuntime | PlainMessage<ObjectStoreRuntime> | undefined): boolean {
+    return proto3.util.equals(ObjectStoreRuntime, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.schema.v1.ObjectStoreRuntimeEvent
+ */
+export class ObjectStoreRuntimeEvent extends Message<ObjectStoreRuntimeEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.ObjectStoreRuntime payload = 2;
+   */
+  payload?: ObjectStoreRuntime;
+
+  constructor(data?: PartialMessage<ObjectStoreRuntimeEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.ObjectStoreRuntimeEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "payload", kind: "message", T: ObjectStoreRuntime },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObjectStoreRuntimeEvent {
+    return new ObjectStoreRuntimeEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObjectStoreRuntimeEvent {
+    return new ObjectStoreRuntimeEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObjectStoreRuntimeEvent {
+    return new ObjectStoreRuntimeEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ObjectStoreRuntimeEvent | PlainMessage<ObjectStoreRuntimeEvent> | undefined, b: ObjectStoreRuntimeEvent | PlainMessage<ObjectStoreRuntimeEvent> | undefined): boolean {
+    return proto3.util.equals(ObjectStoreRuntimeEvent, a, b);
+  }
+}
+
 /**
  * Optional represents a Type whose value may be optional.
  *
@@ -2641,6 +3299,12 @@ export class RuntimeEvent extends Message<RuntimeEvent> {
      */
     value: DatabaseRuntimeEvent;
     case: "databaseRuntimeEvent";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.KVRuntimeEvent kv_runtime_event = 7;
+     */
+    value: KVRuntimeEvent;
+    case: "kvRuntimeEvent";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.ModuleRuntimeBase module_runtime_base = 1;
@@ -2659,6 +3323,12 @@ export class RuntimeEvent extends Message<RuntimeEvent> {
      */
     value: ModuleRuntimeScaling;
     case: "moduleRuntimeScaling";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.schema.v1.ObjectStoreRuntimeEvent object_store_runtime_event = 8;
+     */
+    value: ObjectStoreRuntimeEvent;
+    case: "objectStoreRuntimeEvent";
   } | {
     /**
      * @generated from field: xyz.block.ftl.schema.v1.TopicRuntimeEvent topic_runtime_event = 6;
@@ -2682,9 +3352,11 @@ export class RuntimeEvent extends Message<RuntimeEvent> {
   static readonly typeName = "xyz.block.ftl.schema.v1.RuntimeEvent";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 5, name: "database_runtime_event", kind: "message", T: DatabaseRuntimeEvent, oneof: "value" },
+    { no: 7, name: "kv_runtime_event", kind: "message", T: KVRuntimeEvent, oneof: "value" },
     { no: 1, name: "module_runtime_base", kind: "message", T: ModuleRuntimeBase, oneof: "value" },
     { no: 3, name: "module_runtime_deployment", kind: "message", T: ModuleRuntimeDeployment, oneof: "value" },
     { no: 2, name: "module_runtime_scaling", kind: "message", T: ModuleRuntimeScaling, oneof: "value" },
+    { no: 8, name: "object_store_runtime_event", kind: "message", T: ObjectStoreRuntimeEvent, oneof: "value" },
     { no: 6, name: "topic_runtime_event", kind: "message", T: TopicRuntimeEvent, oneof: "value" },
     { no: 4, name: "verb_runtime_event", kind: "message", T: VerbRuntimeEvent, oneof: "value" },
   ]);
@@ -3445,6 +4117,70 @@ export class Value extends Message<Value> {
   }
 }
 
+/**
+ * VaultDatabaseConnector connects with short-lived credentials leased from a
+ * Vault database secrets engine.
+ *
+ * @generated from message xyz.block.ftl.schema.v1.VaultDatabaseConnector
+ */
+export class VaultDatabaseConnector extends Message<VaultDatabaseConnector> {
+  /**
+   * @generated from field: optional xyz.block.ftl.schema.v1.Position pos = 1;
+   */
+  pos?: Position;
+
+  /**
+   * @generated from field: string endpoint = 2;
+   */
+  endpoint = "";
+
+  /**
+   * @generated from field: string database = 3;
+   */
+  database = "";
+
+  /**
+   * @generated from field: string mount = 4;
+   */
+  mount = "";
+
+  /**
+   * @generated from field: string role = 5;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<VaultDatabaseConnector>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.schema.v1.VaultDatabaseConnector";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pos", kind: "message", T: Position, opt: true },
+    { no: 2, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "database", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "mount", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VaultDatabaseConnector {
+    return new VaultDatabaseConnector().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VaultDatabaseConnector {
+    return new VaultDatabaseConnector().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VaultDatabaseConnector {
+    return new VaultDatabaseConnector().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VaultDatabaseConnector | PlainMessage<VaultDatabaseConnector> | undefined, b: VaultDatabaseConnector | PlainMessage<VaultDatabaseConnector> | undefined): boolean {
+    return proto3.util.equals(VaultDatabaseConnector, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.schema.v1.Verb
  */
@@ -3712,6 +4448,16 @@ export class VerbRuntimeSubscription extends Message<VerbRuntimeSubscription> {
    */
   kafkaBrokers: string[] = [];
 
+  /**
+   * @generated from field: string topic_id = 2;
+   */
+  topicId = "";
+
+  /**
+   * @generated from field: string consumer_group_id = 3;
+   */
+  consumerGroupId = "";
+
   constructor(data?: PartialMessage<VerbRuntimeSubscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3721,6 +4467,8 @@ export class VerbRuntimeSubscription extends Message<VerbRuntimeSubscription> {
   static readonly typeName = "xyz.block.ftl.schema.v1.VerbRuntimeSubscription";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "kafka_brokers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "topic_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "consumer_group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerbRuntimeSubscription {
@@ -6,6 +6,8 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Duration, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { Ref } from "../../schema/v1/schema_pb.js";
+import { CircuitBreakerState } from "../../v1/circuitbreaker_pb.js";
+import { ConfigChangeOperation } from "../../v1/confighistory_pb.js";
 
 /**
  * @generated from enum xyz.block.ftl.timeline.v1.EventType
@@ -60,6 +62,26 @@ export enum EventType {
    * @generated from enum value: EVENT_TYPE_PUBSUB_CONSUME = 9;
    */
   PUBSUB_CONSUME = 9,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_KV_WRITE = 10;
+   */
+  KV_WRITE = 10,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_CIRCUIT_BREAKER = 11;
+   */
+  CIRCUIT_BREAKER = 11,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_CONFIG_CHANGE = 12;
+   */
+  CONFIG_CHANGE = 12,
+
+  /**
+   * @generated from enum value: EVENT_TYPE_ACCESS_DENIED = 13;
+   */
+  ACCESS_DENIED = 13,
 }
 // Retrieve enum metadata with: proto3.getEnumType(EventType)
 proto3.util.setEnumType(EventType, "xyz.block.ftl.timeline.v1.EventType", [
@@ -73,6 +95,10 @@ proto3.util.setEnumType(EventType, "xyz.block.ftl.timeline.v1.EventType", [
   { no: 7, name: "EVENT_TYPE_ASYNC_EXECUTE" },
   { no: 8, name: "EVENT_TYPE_PUBSUB_PUBLISH" },
   { no: 9, name: "EVENT_TYPE_PUBSUB_CONSUME" },
+  { no: 10, name: "EVENT_TYPE_KV_WRITE" },
+  { no: 11, name: "EVENT_TYPE_CIRCUIT_BREAKER" },
+  { no: 12, name: "EVENT_TYPE_CONFIG_CHANGE" },
+  { no: 13, name: "EVENT_TYPE_ACCESS_DENIED" },
 ]);
 
 /**
@@ -93,12 +119,18 @@ export enum AsyncExecuteEventType {
    * @generated from enum value: ASYNC_EXECUTE_EVENT_TYPE_PUBSUB = 2;
    */
   PUBSUB = 2,
+
+  /**
+   * @generated from enum value: ASYNC_EXECUTE_EVENT_TYPE_ASYNC_CALL = 3;
+   */
+  ASYNC_CALL = 3,
 }
 // Retrieve enum metadata with: proto3.getEnumType(AsyncExecuteEventType)
 proto3.util.setEnumType(AsyncExecuteEventType, "xyz.block.ftl.timeline.v1.AsyncExecuteEventType", [
   { no: 0, name: "ASYNC_EXECUTE_EVENT_TYPE_UNSPECIFIED" },
   { no: 1, name: "ASYNC_EXECUTE_EVENT_TYPE_CRON" },
   { no: 2, name: "ASYNC_EXECUTE_EVENT_TYPE_PUBSUB" },
+  { no: 3, name: "ASYNC_EXECUTE_EVENT_TYPE_ASYNC_CALL" },
 ]);
 
 /**
@@ -145,6 +177,38 @@ proto3.util.setEnumType(LogLevel, "xyz.block.ftl.timeline.v1.LogLevel", [
   { no: 17, name: "LOG_LEVEL_ERROR" },
 ]);
 
+/**
+ * @generated from enum xyz.block.ftl.timeline.v1.KVWriteOperation
+ */
+export enum KVWriteOperation {
+  /**
+   * @generated from enum value: KV_WRITE_OPERATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: KV_WRITE_OPERATION_PUT = 1;
+   */
+  PUT = 1,
+
+  /**
+   * @generated from enum value: KV_WRITE_OPERATION_DELETE = 2;
+   */
+  DELETE = 2,
+
+  /**
+   * @generated from enum value: KV_WRITE_OPERATION_COMPARE_AND_SWAP = 3;
+   */
+  COMPARE_AND_SWAP = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(KVWriteOperation)
+proto3.util.setEnumType(KVWriteOperation, "xyz.block.ftl.timeline.v1.KVWriteOperation", [
+  { no: 0, name: "KV_WRITE_OPERATION_UNSPECIFIED" },
+  { no: 1, name: "KV_WRITE_OPERATION_PUT" },
+  { no: 2, name: "KV_WRITE_OPERATION_DELETE" },
+  { no: 3, name: "KV_WRITE_OPERATION_COMPARE_AND_SWAP" },
+]);
+
 /**
  * @generated from message xyz.block.ftl.timeline.v1.LogEvent
  */
@@ -278,6 +342,13 @@ export class CallEvent extends Message<CallEvent> {
    */
   stack?: string;
 
+  /**
+   * True if the call failed because it exceeded its deadline.
+   *
+   * @generated from field: bool timed_out = 13;
+   */
+  timedOut = false;
+
   constructor(data?: PartialMessage<CallEvent>) {
     super();
     proto3.util.initPartial(data, this);
@@ -296,6 +367,7 @@ export class CallEvent extends Message<CallEvent> {
     { no: 8, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 9, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 10, name: "stack", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 13, name: "timed_out", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CallEvent {
@@ -862,6 +934,380 @@ export class PubSubConsumeEvent extends Message<PubSubConsumeEvent> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.timeline.v1.KVWriteEvent
+ */
+export class KVWriteEvent extends Message<KVWriteEvent> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: optional string request_key = 2;
+   */
+  requestKey?: string;
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref verb_ref = 3;
+   */
+  verbRef?: Ref;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 4;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 5;
+   */
+  duration?: Duration;
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref kv = 6;
+   */
+  kv?: Ref;
+
+  /**
+   * @generated from field: xyz.block.ftl.timeline.v1.KVWriteOperation operation = 7;
+   */
+  operation = KVWriteOperation.UNSPECIFIED;
+
+  /**
+   * @generated from field: string key = 8;
+   */
+  key = "";
+
+  /**
+   * For compare-and-swap, whether the swap succeeded.
+   *
+   * @generated from field: bool applied = 9;
+   */
+  applied = false;
+
+  /**
+   * @generated from field: optional string error = 10;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<KVWriteEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.timeline.v1.KVWriteEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "request_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "verb_ref", kind: "message", T: Ref },
+    { no: 4, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 5, name: "duration", kind: "message", T: Duration },
+    { no: 6, name: "kv", kind: "message", T: Ref },
+    { no: 7, name: "operation", kind: "enum", T: proto3.getEnumType(KVWriteOperation) },
+    { no: 8, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "applied", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KVWriteEvent {
+    return new KVWriteEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KVWriteEvent {
+    return new KVWriteEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KVWriteEvent {
+    return new KVWriteEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KVWriteEvent | PlainMessage<KVWriteEvent> | undefined, b: KVWriteEvent | PlainMessage<KVWriteEvent> | undefined): boolean {
+    return proto3.util.equals(KVWriteEvent, a, b);
+  }
+}
+
+/**
+ * A circuit breaker guarding calls to a verb changed state.
+ *
+ * @generated from message xyz.block.ftl.timeline.v1.CircuitBreakerEvent
+ */
+export class CircuitBreakerEvent extends Message<CircuitBreakerEvent> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref verb_ref = 2;
+   */
+  verbRef?: Ref;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.CircuitBreakerState previous_state = 4;
+   */
+  previousState = CircuitBreakerState.UNSPECIFIED;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.CircuitBreakerState state = 5;
+   */
+  state = CircuitBreakerState.UNSPECIFIED;
+
+  /**
+   * The calls and failures counted when the transition occurred.
+   *
+   * @generated from field: int64 requests = 6;
+   */
+  requests = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 failures = 7;
+   */
+  failures = protoInt64.zero;
+
+  /**
+   * The service holding the breaker, such as "controller" or "ingress".
+   *
+   * @generated from field: string service = 8;
+   */
+  service = "";
+
+  /**
+   * How long the breaker stays open before it allows a call through.
+   *
+   * @generated from field: google.protobuf.Duration cooldown = 9;
+   */
+  cooldown?: Duration;
+
+  /**
+   * The instance of the service holding the breaker, as each replica has its own breakers.
+   *
+   * @generated from field: string instance = 10;
+   */
+  instance = "";
+
+  constructor(data?: PartialMessage<CircuitBreakerEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.timeline.v1.CircuitBreakerEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verb_ref", kind: "message", T: Ref },
+    { no: 3, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 4, name: "previous_state", kind: "enum", T: proto3.getEnumType(CircuitBreakerState) },
+    { no: 5, name: "state", kind: "enum", T: proto3.getEnumType(CircuitBreakerState) },
+    { no: 6, name: "requests", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "failures", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "service", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "cooldown", kind: "message", T: Duration },
+    { no: 10, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CircuitBreakerEvent {
+    return new CircuitBreakerEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CircuitBreakerEvent {
+    return new CircuitBreakerEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CircuitBreakerEvent {
+    return new CircuitBreakerEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CircuitBreakerEvent | PlainMessage<CircuitBreakerEvent> | undefined, b: CircuitBreakerEvent | PlainMessage<CircuitBreakerEvent> | undefined): boolean {
+    return proto3.util.equals(CircuitBreakerEvent, a, b);
+  }
+}
+
+/**
+ * A configuration value or secret was set or unset.
+ *
+ * @generated from message xyz.block.ftl.timeline.v1.ConfigChangeEvent
+ */
+export class ConfigChangeEvent extends Message<ConfigChangeEvent> {
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref ref = 1;
+   */
+  ref?: Ref;
+
+  /**
+   * Whether the value is a secret rather than configuration.
+   *
+   * @generated from field: bool secret = 2;
+   */
+  secret = false;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: string actor = 4;
+   */
+  actor = "";
+
+  /**
+   * @generated from field: string provider = 5;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigChangeOperation operation = 6;
+   */
+  operation = ConfigChangeOperation.UNSPECIFIED;
+
+  /**
+   * The JSON encoded value that was set. Only recorded for configuration.
+   *
+   * @generated from field: optional bytes value = 7;
+   */
+  value?: Uint8Array;
+
+  /**
+   * The hex encoded HMAC-SHA256 of the value that was set, keyed with a key held by the admin service.
+   *
+   * @generated from field: optional string fingerprint = 8;
+   */
+  fingerprint?: string;
+
+  /**
+   * The ID of the change in the audit history whose value was restored, if any.
+   *
+   * @generated from field: optional int64 restored_from = 9;
+   */
+  restoredFrom?: bigint;
+
+  /**
+   * The ID of the change in the audit history.
+   *
+   * @generated from field: int64 change_id = 10;
+   */
+  changeId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ConfigChangeEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.timeline.v1.ConfigChangeEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ref", kind: "message", T: Ref },
+    { no: 2, name: "secret", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 4, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "operation", kind: "enum", T: proto3.getEnumType(ConfigChangeOperation) },
+    { no: 7, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 8, name: "fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "restored_from", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 10, name: "change_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigChangeEvent {
+    return new ConfigChangeEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigChangeEvent {
+    return new ConfigChangeEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigChangeEvent {
+    return new ConfigChangeEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigChangeEvent | PlainMessage<ConfigChangeEvent> | undefined, b: ConfigChangeEvent | PlainMessage<ConfigChangeEvent> | undefined): boolean {
+    return proto3.util.equals(ConfigChangeEvent, a, b);
+  }
+}
+
+/**
+ * A request to the cluster was denied by its access control policy.
+ *
+ * @generated from message xyz.block.ftl.timeline.v1.AccessDeniedEvent
+ */
+export class AccessDeniedEvent extends Message<AccessDeniedEvent> {
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 1;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * The procedure that was called, eg. "/xyz.block.ftl.v1.AdminService/SecretGet".
+   *
+   * @generated from field: string procedure = 2;
+   */
+  procedure = "";
+
+  /**
+   * The subject of the request.
+   *
+   * @generated from field: string identity = 3;
+   */
+  identity = "";
+
+  /**
+   * The permission that was required.
+   *
+   * @generated from field: string permission = 4;
+   */
+  permission = "";
+
+  /**
+   * The modules the request applied to. If empty, the request applied to all modules.
+   *
+   * @generated from field: repeated string modules = 5;
+   */
+  modules: string[] = [];
+
+  /**
+   * @generated from field: string reason = 6;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<AccessDeniedEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.timeline.v1.AccessDeniedEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 2, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "identity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "permission", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "modules", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccessDeniedEvent {
+    return new AccessDeniedEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccessDeniedEvent {
+    return new AccessDeniedEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccessDeniedEvent {
+    return new AccessDeniedEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccessDeniedEvent | PlainMessage<AccessDeniedEvent> | undefined, b: AccessDeniedEvent | PlainMessage<AccessDeniedEvent> | undefined): boolean {
+    return proto3.util.equals(AccessDeniedEvent, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.timeline.v1.Event
  */
@@ -935,6 +1381,30 @@ export class Event extends Message<Event> {
      */
     value: PubSubConsumeEvent;
     case: "pubsubConsume";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.KVWriteEvent kv_write = 12;
+     */
+    value: KVWriteEvent;
+    case: "kvWrite";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.CircuitBreakerEvent circuit_breaker = 13;
+     */
+    value: CircuitBreakerEvent;
+    case: "circuitBreaker";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.ConfigChangeEvent config_change = 14;
+     */
+    value: ConfigChangeEvent;
+    case: "configChange";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.AccessDeniedEvent access_denied = 15;
+     */
+    value: AccessDeniedEvent;
+    case: "accessDenied";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Event>) {
@@ -956,6 +1426,10 @@ export class Event extends Message<Event> {
     { no: 9, name: "async_execute", kind: "message", T: AsyncExecuteEvent, oneof: "entry" },
     { no: 10, name: "pubsub_publish", kind: "message", T: PubSubPublishEvent, oneof: "entry" },
     { no: 11, name: "pubsub_consume", kind: "message", T: PubSubConsumeEvent, oneof: "entry" },
+    { no: 12, name: "kv_write", kind: "message", T: KVWriteEvent, oneof: "entry" },
+    { no: 13, name: "circuit_breaker", kind: "message", T: CircuitBreakerEvent, oneof: "entry" },
+    { no: 14, name: "config_change", kind: "message", T: ConfigChangeEvent, oneof: "entry" },
+    { no: 15, name: "access_denied", kind: "message", T: AccessDeniedEvent, oneof: "entry" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Event {
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Duration, Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { AsyncExecuteEvent, CallEvent, CronScheduledEvent, DeploymentCreatedEvent, DeploymentUpdatedEvent, Event, EventType, IngressEvent, LogEvent, LogLevel, PubSubConsumeEvent, PubSubPublishEvent } from "./event_pb.js";
+import { AccessDeniedEvent, AsyncExecuteEvent, CallEvent, CircuitBreakerEvent, ConfigChangeEvent, CronScheduledEvent, DeploymentCreatedEvent, DeploymentUpdatedEvent, Event, EventType, IngressEvent, KVWriteEvent, LogEvent, LogLevel, PubSubConsumeEvent, PubSubPublishEvent } from "./event_pb.js";
 
 /**
  * @generated from message xyz.block.ftl.timeline.v1.GetTimelineRequest
@@ -751,6 +751,30 @@ export class CreateEventsRequest_EventEntry extends Message<CreateEventsRequest_
      */
     value: PubSubConsumeEvent;
     case: "pubsubConsume";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.KVWriteEvent kv_write = 11;
+     */
+    value: KVWriteEvent;
+    case: "kvWrite";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.CircuitBreakerEvent circuit_breaker = 12;
+     */
+    value: CircuitBreakerEvent;
+    case: "circuitBreaker";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.ConfigChangeEvent config_change = 13;
+     */
+    value: ConfigChangeEvent;
+    case: "configChange";
+  } | {
+    /**
+     * @generated from field: xyz.block.ftl.timeline.v1.AccessDeniedEvent access_denied = 14;
+     */
+    value: AccessDeniedEvent;
+    case: "accessDenied";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<CreateEventsRequest_EventEntry>) {
@@ -771,6 +795,10 @@ export class CreateEventsRequest_EventEntry extends Message<CreateEventsRequest_
     { no: 8, name: "async_execute", kind: "message", T: AsyncExecuteEvent, oneof: "entry" },
     { no: 9, name: "pubsub_publish", kind: "message", T: PubSubPublishEvent, oneof: "entry" },
     { no: 10, name: "pubsub_consume", kind: "message", T: PubSubConsumeEvent, oneof: "entry" },
+    { no: 11, name: "kv_write", kind: "message", T: KVWriteEvent, oneof: "entry" },
+    { no: 12, name: "circuit_breaker", kind: "message", T: CircuitBreakerEvent, oneof: "entry" },
+    { no: 13, name: "config_change", kind: "message", T: ConfigChangeEvent, oneof: "entry" },
+    { no: 14, name: "access_denied", kind: "message", T: AccessDeniedEvent, oneof: "entry" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateEventsRequest_EventEntry {
@@ -5,7 +5,7 @@
 
 import { PingRequest, PingResponse } from "./ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
-import { ConfigGetRequest, ConfigGetResponse, ConfigListRequest, ConfigListResponse, ConfigSetRequest, ConfigSetResponse, ConfigUnsetRequest, ConfigUnsetResponse, MapConfigsForModuleRequest, MapConfigsForModuleResponse, MapSecretsForModuleRequest, MapSecretsForModuleResponse, SecretGetRequest, SecretGetResponse, SecretSetRequest, SecretSetResponse, SecretsListRequest, SecretsListResponse, SecretUnsetRequest, SecretUnsetResponse } from "./admin_pb.js";
+import { ConfigCheckRequest, ConfigCheckResponse, ConfigGetRequest, ConfigGetResponse, ConfigHistoryRequest, ConfigHistoryResponse, ConfigListRequest, ConfigListResponse, ConfigRestoreRequest, ConfigRestoreResponse, ConfigSetRequest, ConfigSetResponse, ConfigUnsetRequest, ConfigUnsetResponse, GetCircuitBreakersRequest, GetCircuitBreakersResponse, MapConfigsForModuleRequest, MapConfigsForModuleResponse, MapSecretsForModuleRequest, MapSecretsForModuleResponse, SecretGetRequest, SecretGetResponse, SecretHistoryRequest, SecretHistoryResponse, SecretSetRequest, SecretSetResponse, SecretsListRequest, SecretsListResponse, SecretUnsetRequest, SecretUnsetResponse } from "./admin_pb.js";
 
 /**
  * AdminService is the service that provides and updates admin data. For example,
@@ -70,6 +70,41 @@ export const AdminService = {
       O: ConfigUnsetResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Get the history of changes to a config value.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.ConfigHistory
+     */
+    configHistory: {
+      name: "ConfigHistory",
+      I: ConfigHistoryRequest,
+      O: ConfigHistoryResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Restore a config value to the value set by a previous change.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.ConfigRestore
+     */
+    configRestore: {
+      name: "ConfigRestore",
+      I: ConfigRestoreRequest,
+      O: ConfigRestoreResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Check that every config value and secret used by modules is set and valid.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.ConfigCheck
+     */
+    configCheck: {
+      name: "ConfigCheck",
+      I: ConfigCheckRequest,
+      O: ConfigCheckResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
     /**
      * List secrets.
      *
@@ -114,6 +149,18 @@ export const AdminService = {
       O: SecretUnsetResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Get the history of changes to a secret.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.SecretHistory
+     */
+    secretHistory: {
+      name: "SecretHistory",
+      I: SecretHistoryRequest,
+      O: SecretHistoryResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
     /**
      * MapForModule combines all configuration values visible to the module.
      * Local values take precedence.
@@ -138,6 +185,18 @@ export const AdminService = {
       O: MapSecretsForModuleResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetCircuitBreakers returns the state of the circuit breakers for verbs in active deployments.
+     *
+     * @generated from rpc xyz.block.ftl.v1.AdminService.GetCircuitBreakers
+     */
+    getCircuitBreakers: {
+      name: "GetCircuitBreakers",
+      I: GetCircuitBreakersRequest,
+      O: GetCircuitBreakersResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
   }
 } as const;
 
@@ -4,7 +4,9 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { ConfigChange } from "./confighistory_pb.js";
+import { CircuitBreakerStatus } from "./circuitbreaker_pb.js";
 
 /**
  * @generated from enum xyz.block.ftl.v1.ConfigProvider
@@ -995,3 +997,424 @@ export class MapSecretsForModuleResponse extends Message<MapSecretsForModuleResp
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigHistoryRequest
+ */
+export class ConfigHistoryRequest extends Message<ConfigHistoryRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   */
+  ref?: ConfigRef;
+
+  constructor(data?: PartialMessage<ConfigHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ref", kind: "message", T: ConfigRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigHistoryRequest {
+    return new ConfigHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigHistoryRequest {
+    return new ConfigHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigHistoryRequest {
+    return new ConfigHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigHistoryRequest | PlainMessage<ConfigHistoryRequest> | undefined, b: ConfigHistoryRequest | PlainMessage<ConfigHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ConfigHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigHistoryResponse
+ */
+export class ConfigHistoryResponse extends Message<ConfigHistoryResponse> {
+  /**
+   * Changes, most recent first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ConfigChange changes = 1;
+   */
+  changes: ConfigChange[] = [];
+
+  constructor(data?: PartialMessage<ConfigHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: ConfigChange, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigHistoryResponse {
+    return new ConfigHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigHistoryResponse {
+    return new ConfigHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigHistoryResponse {
+    return new ConfigHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigHistoryResponse | PlainMessage<ConfigHistoryResponse> | undefined, b: ConfigHistoryResponse | PlainMessage<ConfigHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(ConfigHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigRestoreRequest
+ */
+export class ConfigRestoreRequest extends Message<ConfigRestoreRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   */
+  ref?: ConfigRef;
+
+  /**
+   * The ID of the change to restore the value of.
+   *
+   * @generated from field: int64 change_id = 2;
+   */
+  changeId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ConfigRestoreRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigRestoreRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ref", kind: "message", T: ConfigRef },
+    { no: 2, name: "change_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigRestoreRequest {
+    return new ConfigRestoreRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigRestoreRequest {
+    return new ConfigRestoreRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigRestoreRequest {
+    return new ConfigRestoreRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigRestoreRequest | PlainMessage<ConfigRestoreRequest> | undefined, b: ConfigRestoreRequest | PlainMessage<ConfigRestoreRequest> | undefined): boolean {
+    return proto3.util.equals(ConfigRestoreRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigRestoreResponse
+ */
+export class ConfigRestoreResponse extends Message<ConfigRestoreResponse> {
+  constructor(data?: PartialMessage<ConfigRestoreResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigRestoreResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigRestoreResponse {
+    return new ConfigRestoreResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigRestoreResponse {
+    return new ConfigRestoreResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigRestoreResponse {
+    return new ConfigRestoreResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigRestoreResponse | PlainMessage<ConfigRestoreResponse> | undefined, b: ConfigRestoreResponse | PlainMessage<ConfigRestoreResponse> | undefined): boolean {
+    return proto3.util.equals(ConfigRestoreResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigCheckRequest
+ */
+export class ConfigCheckRequest extends Message<ConfigCheckRequest> {
+  /**
+   * Only check values used by this module.
+   *
+   * @generated from field: optional string module = 1;
+   */
+  module?: string;
+
+  constructor(data?: PartialMessage<ConfigCheckRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigCheckRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigCheckRequest {
+    return new ConfigCheckRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigCheckRequest {
+    return new ConfigCheckRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigCheckRequest {
+    return new ConfigCheckRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigCheckRequest | PlainMessage<ConfigCheckRequest> | undefined, b: ConfigCheckRequest | PlainMessage<ConfigCheckRequest> | undefined): boolean {
+    return proto3.util.equals(ConfigCheckRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigCheckResponse
+ */
+export class ConfigCheckResponse extends Message<ConfigCheckResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.ConfigCheckResponse.Problem problems = 1;
+   */
+  problems: ConfigCheckResponse_Problem[] = [];
+
+  constructor(data?: PartialMessage<ConfigCheckResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigCheckResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "problems", kind: "message", T: ConfigCheckResponse_Problem, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigCheckResponse {
+    return new ConfigCheckResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigCheckResponse {
+    return new ConfigCheckResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigCheckResponse {
+    return new ConfigCheckResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigCheckResponse | PlainMessage<ConfigCheckResponse> | undefined, b: ConfigCheckResponse | PlainMessage<ConfigCheckResponse> | undefined): boolean {
+    return proto3.util.equals(ConfigCheckResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.ConfigCheckResponse.Problem
+ */
+export class ConfigCheckResponse_Problem extends Message<ConfigCheckResponse_Problem> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   */
+  ref?: ConfigRef;
+
+  /**
+   * @generated from field: bool secret = 2;
+   */
+  secret = false;
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ConfigCheckResponse_Problem>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigCheckResponse.Problem";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ref", kind: "message", T: ConfigRef },
+    { no: 2, name: "secret", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigCheckResponse_Problem {
+    return new ConfigCheckResponse_Problem().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigCheckResponse_Problem {
+    return new ConfigCheckResponse_Problem().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigCheckResponse_Problem {
+    return new ConfigCheckResponse_Problem().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigCheckResponse_Problem | PlainMessage<ConfigCheckResponse_Problem> | undefined, b: ConfigCheckResponse_Problem | PlainMessage<ConfigCheckResponse_Problem> | undefined): boolean {
+    return proto3.util.equals(ConfigCheckResponse_Problem, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SecretHistoryRequest
+ */
+export class SecretHistoryRequest extends Message<SecretHistoryRequest> {
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigRef ref = 1;
+   */
+  ref?: ConfigRef;
+
+  constructor(data?: PartialMessage<SecretHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SecretHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ref", kind: "message", T: ConfigRef },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecretHistoryRequest {
+    return new SecretHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecretHistoryRequest {
+    return new SecretHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecretHistoryRequest {
+    return new SecretHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecretHistoryRequest | PlainMessage<SecretHistoryRequest> | undefined, b: SecretHistoryRequest | PlainMessage<SecretHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(SecretHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.SecretHistoryResponse
+ */
+export class SecretHistoryResponse extends Message<SecretHistoryResponse> {
+  /**
+   * Changes, most recent first.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.ConfigChange changes = 1;
+   */
+  changes: ConfigChange[] = [];
+
+  constructor(data?: PartialMessage<SecretHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.SecretHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changes", kind: "message", T: ConfigChange, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecretHistoryResponse {
+    return new SecretHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecretHistoryResponse {
+    return new SecretHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecretHistoryResponse {
+    return new SecretHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecretHistoryResponse | PlainMessage<SecretHistoryResponse> | undefined, b: SecretHistoryResponse | PlainMessage<SecretHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(SecretHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetCircuitBreakersRequest
+ */
+export class GetCircuitBreakersRequest extends Message<GetCircuitBreakersRequest> {
+  constructor(data?: PartialMessage<GetCircuitBreakersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetCircuitBreakersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCircuitBreakersRequest {
+    return new GetCircuitBreakersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCircuitBreakersRequest {
+    return new GetCircuitBreakersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCircuitBreakersRequest {
+    return new GetCircuitBreakersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCircuitBreakersRequest | PlainMessage<GetCircuitBreakersRequest> | undefined, b: GetCircuitBreakersRequest | PlainMessage<GetCircuitBreakersRequest> | undefined): boolean {
+    return proto3.util.equals(GetCircuitBreakersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.GetCircuitBreakersResponse
+ */
+export class GetCircuitBreakersResponse extends Message<GetCircuitBreakersResponse> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.CircuitBreakerStatus circuit_breakers = 1;
+   */
+  circuitBreakers: CircuitBreakerStatus[] = [];
+
+  constructor(data?: PartialMessage<GetCircuitBreakersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.GetCircuitBreakersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "circuit_breakers", kind: "message", T: CircuitBreakerStatus, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCircuitBreakersResponse {
+    return new GetCircuitBreakersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCircuitBreakersResponse {
+    return new GetCircuitBreakersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCircuitBreakersResponse {
+    return new GetCircuitBreakersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCircuitBreakersResponse | PlainMessage<GetCircuitBreakersResponse> | undefined, b: GetCircuitBreakersResponse | PlainMessage<GetCircuitBreakersResponse> | undefined): boolean {
+    return proto3.util.equals(GetCircuitBreakersResponse, a, b);
+  }
+}
+
@@ -0,0 +1,139 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file xyz/block/ftl/v1/circuitbreaker.proto (package xyz.block.ftl.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { Ref } from "../schema/v1/schema_pb.js";
+
+/**
+ * @generated from enum xyz.block.ftl.v1.CircuitBreakerState
+ */
+export enum CircuitBreakerState {
+  /**
+   * @generated from enum value: CIRCUIT_BREAKER_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Calls are allowed.
+   *
+   * @generated from enum value: CIRCUIT_BREAKER_STATE_CLOSED = 1;
+   */
+  CLOSED = 1,
+
+  /**
+   * Calls fail fast without being sent to the deployment.
+   *
+   * @generated from enum value: CIRCUIT_BREAKER_STATE_OPEN = 2;
+   */
+  OPEN = 2,
+
+  /**
+   * A single call is allowed to test whether the deployment has recovered.
+   *
+   * @generated from enum value: CIRCUIT_BREAKER_STATE_HALF_OPEN = 3;
+   */
+  HALF_OPEN = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CircuitBreakerState)
+proto3.util.setEnumType(CircuitBreakerState, "xyz.block.ftl.v1.CircuitBreakerState", [
+  { no: 0, name: "CIRCUIT_BREAKER_STATE_UNSPECIFIED" },
+  { no: 1, name: "CIRCUIT_BREAKER_STATE_CLOSED" },
+  { no: 2, name: "CIRCUIT_BREAKER_STATE_OPEN" },
+  { no: 3, name: "CIRCUIT_BREAKER_STATE_HALF_OPEN" },
+]);
+
+/**
+ * The state of the circuit breaker held by an instance of a service for a verb
+ * in a deployment, as of its most recent transition. An open breaker whose
+ * cooldown has elapsed is reported as half-open, because it allows the next
+ * call through.
+ *
+ * @generated from message xyz.block.ftl.v1.CircuitBreakerStatus
+ */
+export class CircuitBreakerStatus extends Message<CircuitBreakerStatus> {
+  /**
+   * @generated from field: string deployment_key = 1;
+   */
+  deploymentKey = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.schema.v1.Ref verb_ref = 2;
+   */
+  verbRef?: Ref;
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.CircuitBreakerState state = 3;
+   */
+  state = CircuitBreakerState.UNSPECIFIED;
+
+  /**
+   * When the breaker entered its current state.
+   *
+   * @generated from field: google.protobuf.Timestamp since = 4;
+   */
+  since?: Timestamp;
+
+  /**
+   * The calls and failures counted when the transition occurred.
+   *
+   * @generated from field: int64 requests = 5;
+   */
+  requests = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 failures = 6;
+   */
+  failures = protoInt64.zero;
+
+  /**
+   * The service holding the breaker, such as "controller" or "ingress".
+   *
+   * @generated from field: string service = 7;
+   */
+  service = "";
+
+  /**
+   * The instance of the service holding the breaker, as each replica has its own breakers.
+   *
+   * @generated from field: string instance = 8;
+   */
+  instance = "";
+
+  constructor(data?: PartialMessage<CircuitBreakerStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CircuitBreakerStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployment_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verb_ref", kind: "message", T: Ref },
+    { no: 3, name: "state", kind: "enum", T: proto3.getEnumType(CircuitBreakerState) },
+    { no: 4, name: "since", kind: "message", T: Timestamp },
+    { no: 5, name: "requests", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "failures", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "service", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "instance", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CircuitBreakerStatus {
+    return new CircuitBreakerStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CircuitBreakerStatus {
+    return new CircuitBreakerStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CircuitBreakerStatus {
+    return new CircuitBreakerStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CircuitBreakerStatus | PlainMessage<CircuitBreakerStatus> | undefined, b: CircuitBreakerStatus | PlainMessage<CircuitBreakerStatus> | undefined): boolean {
+    return proto3.util.equals(CircuitBreakerStatus, a, b);
+  }
+}
+
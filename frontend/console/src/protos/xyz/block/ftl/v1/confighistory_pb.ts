@@ -0,0 +1,128 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts"
+// @generated from file xyz/block/ftl/v1/confighistory.proto (package xyz.block.ftl.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum xyz.block.ftl.v1.ConfigChangeOperation
+ */
+export enum ConfigChangeOperation {
+  /**
+   * @generated from enum value: CONFIG_CHANGE_OPERATION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CONFIG_CHANGE_OPERATION_SET = 1;
+   */
+  SET = 1,
+
+  /**
+   * @generated from enum value: CONFIG_CHANGE_OPERATION_UNSET = 2;
+   */
+  UNSET = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ConfigChangeOperation)
+proto3.util.setEnumType(ConfigChangeOperation, "xyz.block.ftl.v1.ConfigChangeOperation", [
+  { no: 0, name: "CONFIG_CHANGE_OPERATION_UNSPECIFIED" },
+  { no: 1, name: "CONFIG_CHANGE_OPERATION_SET" },
+  { no: 2, name: "CONFIG_CHANGE_OPERATION_UNSET" },
+]);
+
+/**
+ * A change to a configuration value or secret, as recorded in the audit log.
+ *
+ * @generated from message xyz.block.ftl.v1.ConfigChange
+ */
+export class ConfigChange extends Message<ConfigChange> {
+  /**
+   * The ID of the change in the audit history.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * The authenticated identity of the client that made the change, if known.
+   *
+   * @generated from field: string actor = 3;
+   */
+  actor = "";
+
+  /**
+   * The key of the provider the value was stored in.
+   *
+   * @generated from field: string provider = 4;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: xyz.block.ftl.v1.ConfigChangeOperation operation = 5;
+   */
+  operation = ConfigChangeOperation.UNSPECIFIED;
+
+  /**
+   * The JSON encoded value that was set. Only recorded for configuration.
+   *
+   * @generated from field: optional bytes value = 6;
+   */
+  value?: Uint8Array;
+
+  /**
+   * The hex encoded HMAC-SHA256 of the value that was set, keyed with a key held by the admin service.
+   * Equal values have equal fingerprints, but a value can't be guessed from its fingerprint.
+   *
+   * @generated from field: optional string fingerprint = 7;
+   */
+  fingerprint?: string;
+
+  /**
+   * The ID of the change whose value was restored, if any.
+   *
+   * @generated from field: optional int64 restored_from = 8;
+   */
+  restoredFrom?: bigint;
+
+  constructor(data?: PartialMessage<ConfigChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.ConfigChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 3, name: "actor", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "operation", kind: "enum", T: proto3.getEnumType(ConfigChangeOperation) },
+    { no: 6, name: "value", kind: "scalar", T: 12 /* ScalarType.BYTES */, opt: true },
+    { no: 7, name: "fingerprint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "restored_from", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigChange {
+    return new ConfigChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigChange {
+    return new ConfigChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigChange {
+    return new ConfigChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigChange | PlainMessage<ConfigChange> | undefined, b: ConfigChange | PlainMessage<ConfigChange> | undefined): boolean {
+    return proto3.util.equals(ConfigChange, a, b);
+  }
+}
+
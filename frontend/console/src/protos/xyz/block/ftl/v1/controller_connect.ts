@@ -5,7 +5,7 @@
 
 import { PingRequest, PingResponse } from "./ftl_pb.js";
 import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
-import { CreateDeploymentRequest, CreateDeploymentResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, ProcessListRequest, ProcessListResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./controller_pb.js";
+import { CommitChangesetRequest, CommitChangesetResponse, CreateChangesetRequest, CreateChangesetResponse, CreateDeploymentRequest, CreateDeploymentResponse, DeployChangesetRequest, DeployChangesetResponse, GetArtefactDiffsRequest, GetArtefactDiffsResponse, GetDeploymentArtefactsRequest, GetDeploymentArtefactsResponse, GetDeploymentRequest, GetDeploymentResponse, ProcessListRequest, ProcessListResponse, RegisterRunnerRequest, RegisterRunnerResponse, ReplaceDeployRequest, ReplaceDeployResponse, RollbackChangesetRequest, RollbackChangesetResponse, StatusRequest, StatusResponse, StreamDeploymentLogsRequest, StreamDeploymentLogsResponse, UpdateDeployRequest, UpdateDeployResponse, UploadArtefactRequest, UploadArtefactResponse } from "./controller_pb.js";
 
 /**
  * @generated from service xyz.block.ftl.v1.ControllerService
@@ -142,6 +142,56 @@ export const ControllerService = {
       O: ReplaceDeployResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Create a changeset for deploying several modules atomically.
+     *
+     * The schemas of the modules are validated together against the schemas of
+     * the other active modules.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.CreateChangeset
+     */
+    createChangeset: {
+      name: "CreateChangeset",
+      I: CreateChangesetRequest,
+      O: CreateChangesetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Activate all deployments in a changeset, replacing any existing
+     * deployments of the same modules.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.CommitChangeset
+     */
+    commitChangeset: {
+      name: "CommitChangeset",
+      I: CommitChangesetRequest,
+      O: CommitChangesetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Discard all deployments in a changeset.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.RollbackChangeset
+     */
+    rollbackChangeset: {
+      name: "RollbackChangeset",
+      I: RollbackChangesetRequest,
+      O: RollbackChangesetResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Create and activate deployments of several modules atomically.
+     *
+     * If any deployment fails, none of them are activated.
+     *
+     * @generated from rpc xyz.block.ftl.v1.ControllerService.DeployChangeset
+     */
+    deployChangeset: {
+      name: "DeployChangeset",
+      I: DeployChangesetRequest,
+      O: DeployChangesetResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Stream logs from a deployment
      *
@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
 import { Module } from "../schema/v1/schema_pb.js";
+import { CircuitBreakerStatus } from "./circuitbreaker_pb.js";
 
 /**
  * @generated from message xyz.block.ftl.v1.GetArtefactDiffsRequest
@@ -226,6 +227,16 @@ export class CreateDeploymentRequest extends Message<CreateDeploymentRequest> {
    */
   artefacts: DeploymentArtefact[] = [];
 
+  /**
+   * Changeset the deployment belongs to, if any.
+   *
+   * Deployments in a changeset are validated against the other modules in
+   * the changeset, and are not activated until the changeset is committed.
+   *
+   * @generated from field: optional string changeset = 3;
+   */
+  changeset?: string;
+
   constructor(data?: PartialMessage<CreateDeploymentRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -236,6 +247,7 @@ export class CreateDeploymentRequest extends Message<CreateDeploymentRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "schema", kind: "message", T: Module },
     { no: 2, name: "artefacts", kind: "message", T: DeploymentArtefact, repeated: true },
+    { no: 3, name: "changeset", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateDeploymentRequest {
@@ -700,6 +712,312 @@ export class ReplaceDeployResponse extends Message<ReplaceDeployResponse> {
   }
 }
 
+/**
+ * @generated from message xyz.block.ftl.v1.CreateChangesetRequest
+ */
+export class CreateChangesetRequest extends Message<CreateChangesetRequest> {
+  /**
+   * Schemas of the modules to be deployed in the changeset.
+   *
+   * @generated from field: repeated xyz.block.ftl.schema.v1.Module modules = 1;
+   */
+  modules: Module[] = [];
+
+  constructor(data?: PartialMessage<CreateChangesetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CreateChangesetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "modules", kind: "message", T: Module, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChangesetRequest {
+    return new CreateChangesetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChangesetRequest {
+    return new CreateChangesetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChangesetRequest {
+    return new CreateChangesetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateChangesetRequest | PlainMessage<CreateChangesetRequest> | undefined, b: CreateChangesetRequest | PlainMessage<CreateChangesetRequest> | undefined): boolean {
+    return proto3.util.equals(CreateChangesetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CreateChangesetResponse
+ */
+export class CreateChangesetResponse extends Message<CreateChangesetResponse> {
+  /**
+   * @generated from field: string changeset = 1;
+   */
+  changeset = "";
+
+  constructor(data?: PartialMessage<CreateChangesetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CreateChangesetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changeset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateChangesetResponse {
+    return new CreateChangesetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateChangesetResponse {
+    return new CreateChangesetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateChangesetResponse {
+    return new CreateChangesetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateChangesetResponse | PlainMessage<CreateChangesetResponse> | undefined, b: CreateChangesetResponse | PlainMessage<CreateChangesetResponse> | undefined): boolean {
+    return proto3.util.equals(CreateChangesetResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CommitChangesetRequest
+ */
+export class CommitChangesetRequest extends Message<CommitChangesetRequest> {
+  /**
+   * @generated from field: string changeset = 1;
+   */
+  changeset = "";
+
+  /**
+   * @generated from field: int32 min_replicas = 2;
+   */
+  minReplicas = 0;
+
+  constructor(data?: PartialMessage<CommitChangesetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CommitChangesetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changeset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CommitChangesetRequest {
+    return new CommitChangesetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CommitChangesetRequest {
+    return new CommitChangesetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CommitChangesetRequest {
+    return new CommitChangesetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CommitChangesetRequest | PlainMessage<CommitChangesetRequest> | undefined, b: CommitChangesetRequest | PlainMessage<CommitChangesetRequest> | undefined): boolean {
+    return proto3.util.equals(CommitChangesetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.CommitChangesetResponse
+ */
+export class CommitChangesetResponse extends Message<CommitChangesetResponse> {
+  constructor(data?: PartialMessage<CommitChangesetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.CommitChangesetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CommitChangesetResponse {
+    return new CommitChangesetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CommitChangesetResponse {
+    return new CommitChangesetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CommitChangesetResponse {
+    return new CommitChangesetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CommitChangesetResponse | PlainMessage<CommitChangesetResponse> | undefined, b: CommitChangesetResponse | PlainMessage<CommitChangesetResponse> | undefined): boolean {
+    return proto3.util.equals(CommitChangesetResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.RollbackChangesetRequest
+ */
+export class RollbackChangesetRequest extends Message<RollbackChangesetRequest> {
+  /**
+   * @generated from field: string changeset = 1;
+   */
+  changeset = "";
+
+  constructor(data?: PartialMessage<RollbackChangesetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.RollbackChangesetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changeset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackChangesetRequest {
+    return new RollbackChangesetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackChangesetRequest {
+    return new RollbackChangesetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackChangesetRequest {
+    return new RollbackChangesetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackChangesetRequest | PlainMessage<RollbackChangesetRequest> | undefined, b: RollbackChangesetRequest | PlainMessage<RollbackChangesetRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackChangesetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.RollbackChangesetResponse
+ */
+export class RollbackChangesetResponse extends Message<RollbackChangesetResponse> {
+  constructor(data?: PartialMessage<RollbackChangesetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.RollbackChangesetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackChangesetResponse {
+    return new RollbackChangesetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackChangesetResponse {
+    return new RollbackChangesetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackChangesetResponse {
+    return new RollbackChangesetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackChangesetResponse | PlainMessage<RollbackChangesetResponse> | undefined, b: RollbackChangesetResponse | PlainMessage<RollbackChangesetResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackChangesetResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DeployChangesetRequest
+ */
+export class DeployChangesetRequest extends Message<DeployChangesetRequest> {
+  /**
+   * @generated from field: repeated xyz.block.ftl.v1.CreateDeploymentRequest deployments = 1;
+   */
+  deployments: CreateDeploymentRequest[] = [];
+
+  /**
+   * @generated from field: int32 min_replicas = 2;
+   */
+  minReplicas = 0;
+
+  constructor(data?: PartialMessage<DeployChangesetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeployChangesetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deployments", kind: "message", T: CreateDeploymentRequest, repeated: true },
+    { no: 2, name: "min_replicas", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployChangesetRequest {
+    return new DeployChangesetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployChangesetRequest {
+    return new DeployChangesetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployChangesetRequest {
+    return new DeployChangesetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeployChangesetRequest | PlainMessage<DeployChangesetRequest> | undefined, b: DeployChangesetRequest | PlainMessage<DeployChangesetRequest> | undefined): boolean {
+    return proto3.util.equals(DeployChangesetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message xyz.block.ftl.v1.DeployChangesetResponse
+ */
+export class DeployChangesetResponse extends Message<DeployChangesetResponse> {
+  /**
+   * @generated from field: string changeset = 1;
+   */
+  changeset = "";
+
+  /**
+   * Keys of the created deployments, in the order they were requested.
+   *
+   * @generated from field: repeated string deployment_keys = 2;
+   */
+  deploymentKeys: string[] = [];
+
+  constructor(data?: PartialMessage<DeployChangesetResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "xyz.block.ftl.v1.DeployChangesetResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "changeset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "deployment_keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeployChangesetResponse {
+    return new DeployChangesetResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeployChangesetResponse {
+    return new DeployChangesetResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeployChangesetResponse {
+    return new DeployChangesetResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeployChangesetResponse | PlainMessage<DeployChangesetResponse> | undefined, b: DeployChangesetResponse | PlainMessage<DeployChangesetResponse> | undefined): boolean {
+    return proto3.util.equals(DeployChangesetResponse, a, b);
+  }
+}
+
 /**
  * @generated from message xyz.block.ftl.v1.StreamDeploymentLogsRequest
  */
@@ -859,6 +1177,13 @@ export class StatusResponse extends Message<StatusResponse> {
    */
   routes: StatusResponse_Route[] = [];
 
+  /**
+   * Circuit breakers that are not closed.
+   *
+   * @generated from field: repeated xyz.block.ftl.v1.CircuitBreakerStatus circuit_breakers = 6;
+   */
+  circuitBreakers: CircuitBreakerStatus[] = [];
+
   constructor(data?: PartialMessage<StatusResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -871,6 +1196,7 @@ export class StatusResponse extends Message<StatusResponse> {
     { no: 2, name: "runners", kind: "message", T: StatusResponse_Runner, repeated: true },
     { no: 3, name: "deployments", kind: "message", T: StatusResponse_Deployment, repeated: true },
     { no: 5, name: "routes", kind: "message", T: StatusResponse_Route, repeated: true },
+    { no: 6, name: "circuit_breakers", kind: "message", T: CircuitBreakerStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusResponse {
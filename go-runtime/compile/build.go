@@ -301,6 +301,28 @@ func (d goTopicHandle) MapperTypeName(trimModuleName string) string {
 	return name
 }
 
+type goKVHandle struct {
+	Name      string
+	Module    string
+	KeyType   goSchemaType
+	ValueType goSchemaType
+
+	nativeType
+}
+
+func (k goKVHandle) resource() {}
+
+func (k goKVHandle) getNativeType() nativeType {
+	return k.nativeType
+}
+
+type goObjectStoreHandle struct {
+	Name   string
+	Module string
+}
+
+func (o goObjectStoreHandle) resource() {}
+
 type goConfigHandle struct {
 	Name   string
 	Module string
@@ -924,6 +946,20 @@ func (b *mainDeploymentContextBuilder) getVerbResource(verb *schema.Verb, param
 				b.mainModule.Name, verb.Name, ref, ref)
 		}
 		return b.processTopic(ref.Module, ref, topic)
+	case *schema.MetadataKV:
+		kv, ok := resolved.(*schema.KV)
+		if !ok {
+			return goKVHandle{}, fmt.Errorf("%s.%s uses %s kv handle, but %s is not a kv",
+				b.mainModule.Name, verb.Name, ref, ref)
+		}
+		return b.processKV(ref.Module, ref, kv)
+	case *schema.MetadataObjectStore:
+		store, ok := resolved.(*schema.ObjectStore)
+		if !ok {
+			return goObjectStoreHandle{}, fmt.Errorf("%s.%s uses %s object store handle, but %s is not an object store",
+				b.mainModule.Name, verb.Name, ref, ref)
+		}
+		return goObjectStoreHandle{Name: store.Name, Module: ref.Module}, nil
 	case *schema.MetadataConfig:
 		cfg, ok := resolved.(*schema.Config)
 		if !ok {
@@ -1050,6 +1086,34 @@ func (b *mainDeploymentContextBuilder) processTopic(moduleName string, ref *sche
 	}, nil
 }
 
+func (b *mainDeploymentContextBuilder) processKV(moduleName string, ref *schema.Ref, kv *schema.KV) (goKVHandle, error) {
+	nn, ok := b.nativeNames[ref]
+	if !ok {
+		return goKVHandle{}, fmt.Errorf("missing native name for kv %s.%s", moduleName, kv.Name)
+	}
+
+	nt, err := b.getNativeType(nn)
+	if err != nil {
+		return goKVHandle{}, err
+	}
+
+	kt, err := b.getGoSchemaType(kv.Key)
+	if err != nil {
+		return goKVHandle{}, fmt.Errorf("failed to get key type for kv %s.%s: %w", moduleName, kv.Name, err)
+	}
+	vt, err := b.getGoSchemaType(kv.Value)
+	if err != nil {
+		return goKVHandle{}, fmt.Errorf("failed to get value type for kv %s.%s: %w", moduleName, kv.Name, err)
+	}
+	return goKVHandle{
+		Name:       kv.Name,
+		Module:     moduleName,
+		KeyType:    kt,
+		ValueType:  vt,
+		nativeType: nt,
+	}, nil
+}
+
 func (b *mainDeploymentContextBuilder) getGoVerb(nativeName string, verb *schema.Verb, resources ...verbResource) (goVerb, error) {
 	nt, err := b.getNativeType(nativeName)
 	if err != nil {
@@ -1242,6 +1306,18 @@ var scaffoldFuncs = scaffolder.FuncMap{
 		}
 		return nil
 	},
+	"getKVHandle": func(resource verbResource) *goKVHandle {
+		if c, ok := resource.(goKVHandle); ok {
+			return &c
+		}
+		return nil
+	},
+	"getObjectStoreHandle": func(resource verbResource) *goObjectStoreHandle {
+		if c, ok := resource.(goObjectStoreHandle); ok {
+			return &c
+		}
+		return nil
+	},
 	"getConfigHandle": func(resource verbResource) *goConfigHandle {
 		if c, ok := resource.(goConfigHandle); ok {
 			return &c
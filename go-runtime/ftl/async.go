@@ -0,0 +1,141 @@
+package ftl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/internal"
+)
+
+// AsyncCallState is the state of an asynchronous call.
+type AsyncCallState = internal.AsyncCallState
+
+const (
+	// AsyncCallPending calls are waiting to be executed, either for the first time or as a retry.
+	AsyncCallPending = internal.AsyncCallPending
+	// AsyncCallRunning calls are currently executing.
+	AsyncCallRunning = internal.AsyncCallRunning
+	// AsyncCallSucceeded calls completed successfully.
+	AsyncCallSucceeded = internal.AsyncCallSucceeded
+	// AsyncCallFailed calls exhausted their retries, and those of their catch verb if any.
+	AsyncCallFailed = internal.AsyncCallFailed
+	// AsyncCallCancelled calls were cancelled before they were executed.
+	AsyncCallCancelled = internal.AsyncCallCancelled
+)
+
+// ErrAsyncCallFailed is returned by [AsyncCallHandle.Wait] when a call has failed.
+var ErrAsyncCallFailed = errors.New("async call failed")
+
+// ErrAsyncCallCancelled is returned by [AsyncCallHandle.Wait] when a call has been cancelled.
+var ErrAsyncCallCancelled = errors.New("async call cancelled")
+
+// AsyncCallStatus describes the progress of an asynchronous call.
+type AsyncCallStatus[Resp any] struct {
+	State    AsyncCallState
+	Attempts int
+	// Response is set once the call has succeeded.
+	Response Option[Resp]
+	// Error is the error from the most recent failed attempt, if any.
+	Error Option[string]
+}
+
+// AsyncCallHandle refers to an asynchronous call enqueued with [AsyncCall] or [AsyncSink].
+type AsyncCallHandle[Resp any] struct {
+	ID string
+}
+
+// AsyncCallHandleFromID returns a handle for a previously enqueued call, for
+// example one whose ID was stored by another verb.
+func AsyncCallHandleFromID[Resp any](id string) AsyncCallHandle[Resp] {
+	return AsyncCallHandle[Resp]{ID: id}
+}
+
+// AsyncCall durably enqueues a call to a verb and returns immediately.
+//
+// The call is executed at least once, surviving restarts of both the caller
+// and the callee, using the callee's retry policy and catch verb.
+func AsyncCall[Client ~func(context.Context, Req) (Resp, error), Req, Resp any](ctx context.Context, client Client, req Req) (AsyncCallHandle[Resp], error) {
+	id, err := asyncCall(ctx, clientRef[Client](), req, func(ctx context.Context) (any, error) {
+		return client(ctx, req)
+	})
+	return AsyncCallHandle[Resp]{ID: id}, err
+}
+
+// AsyncSink durably enqueues a call to a sink verb and returns immediately.
+//
+// See [AsyncCall] for details.
+func AsyncSink[Client ~func(context.Context, Req) error, Req any](ctx context.Context, client Client, req Req) (AsyncCallHandle[Unit], error) {
+	id, err := asyncCall(ctx, clientRef[Client](), req, func(ctx context.Context) (any, error) {
+		return Unit{}, client(ctx, req)
+	})
+	return AsyncCallHandle[Unit]{ID: id}, err
+}
+
+func clientRef[Client any]() *schema.Ref {
+	ref := reflection.ClientRef[Client]()
+	return &schema.Ref{Module: ref.Module, Name: ref.Name}
+}
+
+func asyncCall(ctx context.Context, verb *schema.Ref, req any, call func(context.Context) (any, error)) (string, error) {
+	body, err := encoding.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return internal.FromContext(ctx).AsyncCall(ctx, verb, body, call) //nolint:wrapcheck
+}
+
+// Status returns the current status of the call.
+func (h AsyncCallHandle[Resp]) Status(ctx context.Context) (AsyncCallStatus[Resp], error) {
+	status, err := internal.FromContext(ctx).AsyncCallStatus(ctx, h.ID)
+	if err != nil {
+		return AsyncCallStatus[Resp]{}, err //nolint:wrapcheck
+	}
+	out := AsyncCallStatus[Resp]{State: status.State, Attempts: status.Attempts}
+	if status.Error != "" {
+		out.Error = Some(status.Error)
+	}
+	if status.State == AsyncCallSucceeded {
+		var resp Resp
+		if err := encoding.Unmarshal(status.Response, &resp); err != nil {
+			return AsyncCallStatus[Resp]{}, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		out.Response = Some(resp)
+	}
+	return out, nil
+}
+
+// Wait blocks until the call has completed, returning its response.
+//
+// If the call fails or is cancelled, an error wrapping [ErrAsyncCallFailed]
+// or [ErrAsyncCallCancelled] is returned. Use a context deadline to bound
+// how long to wait.
+func (h AsyncCallHandle[Resp]) Wait(ctx context.Context) (Resp, error) {
+	var zero Resp
+	delay := 50 * time.Millisecond
+	for {
+		status, err := h.Status(ctx)
+		if err != nil {
+			return zero, err
+		}
+		switch status.State {
+		case AsyncCallSucceeded:
+			return status.Response.MustGet(), nil
+		case AsyncCallFailed:
+			return zero, fmt.Errorf("%s: %w: %s", h.ID, ErrAsyncCallFailed, status.Error.Default("unknown error"))
+		case AsyncCallCancelled:
+			return zero, fmt.Errorf("%s: %w", h.ID, ErrAsyncCallCancelled)
+		case AsyncCallPending, AsyncCallRunning:
+		}
+		select {
+		case <-ctx.Done():
+			return zero, fmt.Errorf("%s: %w", h.ID, context.Cause(ctx))
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, 2*time.Second)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/alecthomas/types/once"
 	_ "github.com/go-sql-driver/mysql" // Register MySQL driver
 	_ "github.com/jackc/pgx/v5/stdlib" // Register Postgres driver
+	_ "modernc.org/sqlite"             // Register SQLite driver
 )
 
 type DatabaseConfig interface {
@@ -50,11 +51,24 @@ type DefaultMySQLDatabaseConfig struct{}
 func (DefaultMySQLDatabaseConfig) db()    {} //nolint:unused
 func (DefaultMySQLDatabaseConfig) mysql() {} //nolint:unused
 
+type SQLiteDatabaseConfig interface {
+	DatabaseConfig
+	sqlite()
+}
+
+// DefaultSQLiteDatabaseConfig is a default implementation of SQLiteDatabaseConfig. It does not provide
+// an implementation for the Name method and should be embedded in a struct that does.
+type DefaultSQLiteDatabaseConfig struct{}
+
+func (DefaultSQLiteDatabaseConfig) db()     {} //nolint:unused
+func (DefaultSQLiteDatabaseConfig) sqlite() {} //nolint:unused
+
 type DatabaseType string
 
 const (
 	DatabaseTypePostgres DatabaseType = "postgres"
 	DatabaseTypeMysql    DatabaseType = "mysql"
+	DatabaseTypeSQLite   DatabaseType = "sqlite"
 )
 
 type DatabaseHandle[T DatabaseConfig] struct {
@@ -0,0 +1,53 @@
+package ftltest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/internal"
+)
+
+// fakeAsync executes asynchronous calls immediately, without retries.
+type fakeAsync struct {
+	lock  sync.Mutex
+	next  int
+	calls map[string]internal.AsyncCallStatus
+}
+
+func newFakeAsync() *fakeAsync {
+	return &fakeAsync{calls: map[string]internal.AsyncCallStatus{}}
+}
+
+func (f *fakeFTL) AsyncCall(ctx context.Context, verb *schema.Ref, request []byte, call func(context.Context) (any, error)) (string, error) {
+	f.async.lock.Lock()
+	f.async.next++
+	id := fmt.Sprintf("acl-%s-%s-%d", verb.Module, verb.Name, f.async.next)
+	f.async.lock.Unlock()
+
+	status := internal.AsyncCallStatus{State: internal.AsyncCallSucceeded, Attempts: 1}
+	resp, err := call(ContextWithIdempotencyKey(ctx, id))
+	if err == nil {
+		status.Response, err = encoding.Marshal(resp)
+	}
+	if err != nil {
+		status = internal.AsyncCallStatus{State: internal.AsyncCallFailed, Attempts: 1, Error: err.Error()}
+	}
+
+	f.async.lock.Lock()
+	defer f.async.lock.Unlock()
+	f.async.calls[id] = status
+	return id, nil
+}
+
+func (f *fakeFTL) AsyncCallStatus(ctx context.Context, id string) (internal.AsyncCallStatus, error) {
+	f.async.lock.Lock()
+	defer f.async.lock.Unlock()
+	status, ok := f.async.calls[id]
+	if !ok {
+		return internal.AsyncCallStatus{}, fmt.Errorf("async call %s not found", id)
+	}
+	return status, nil
+}
@@ -0,0 +1,51 @@
+package ftltest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/go-runtime/ftl"
+)
+
+type EchoClient func(context.Context, string) (string, error)
+
+type ChargeClient func(context.Context, int) error
+
+func TestFakeAsyncCall(t *testing.T) {
+	reflection.AllowAnyPackageForTesting = true
+	defer func() { reflection.AllowAnyPackageForTesting = false }()
+	ctx := contextWithFakeFTL(context.Background())
+
+	var echo EchoClient = func(ctx context.Context, req string) (string, error) { return "echo: " + req, nil }
+	handle, err := ftl.AsyncCall(ctx, echo, "hello")
+	assert.NoError(t, err)
+	resp, err := handle.Wait(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo: hello", resp)
+
+	status, err := ftl.AsyncCallHandleFromID[string](handle.ID).Status(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.AsyncCallSucceeded, status.State)
+	assert.Equal(t, ftl.Some("echo: hello"), status.Response)
+
+	var keyed EchoClient = func(ctx context.Context, req string) (string, error) {
+		return ftl.IdempotencyKey(ctx).Default("none"), nil
+	}
+	assert.Equal(t, ftl.None[string](), ftl.IdempotencyKey(ctx))
+	handle, err = ftl.AsyncCall(ctx, keyed, "")
+	assert.NoError(t, err)
+	resp, err = handle.Wait(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, handle.ID, resp, "async calls use the call ID as the idempotency key")
+
+	var charge ChargeClient = func(ctx context.Context, req int) error { return errors.New("declined") }
+	sinkHandle, err := ftl.AsyncSink(ctx, charge, 42)
+	assert.NoError(t, err)
+	_, err = sinkHandle.Wait(ctx)
+	assert.IsError(t, err, ftl.ErrAsyncCallFailed)
+	assert.Contains(t, err.Error(), "declined")
+}
@@ -61,6 +61,9 @@ type fakeFTL struct {
 	configValues  map[string][]byte
 	secretValues  map[string][]byte
 	pubSub        *fakePubSub
+	kv            *fakeKV
+	objectStore   *fakeObjectStore
+	async         *fakeAsync
 }
 
 // mapImpl is a function that takes an object and returns an object of a potentially different
@@ -74,6 +77,9 @@ func contextWithFakeFTL(ctx context.Context, options ...Option) context.Context
 		configValues:  map[string][]byte{},
 		secretValues:  map[string][]byte{},
 		options:       options,
+		kv:            newFakeKV(),
+		objectStore:   newFakeObjectStore(),
+		async:         newFakeAsync(),
 	}
 	ctx = internal.WithContext(ctx, fake)
 
@@ -0,0 +1,106 @@
+package ftltest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/deploymentcontext"
+	"github.com/block/ftl/internal/replay"
+)
+
+// WithFixtures fakes verbs in other modules by replaying calls recorded in a fixture file, such as one exported from
+// the timeline with `ftl replay --from-timeline`.
+//
+// A call to a verb in the fixture returns the response, or error, of a recorded call to that verb with the same
+// request. If several recorded calls match, each is returned in turn, and the last is repeated once they are
+// exhausted. Requests are compared as JSON, and ignore lists paths to values that should not be compared, in the
+// same form as `ftl replay --ignore`, eg. "$.createdAt".
+//
+// Mocks provided with WhenVerb take precedence over fixtures.
+//
+// To be used when setting up a context for a test:
+//
+//	ctx := ftltest.Context(
+//		ftltest.WithFixtures("testdata/payments.json", "$.idempotencyKey"),
+//		// ... other options
+//	)
+func WithFixtures(path string, ignore ...string) Option {
+	return Option{
+		rank: fixtures,
+		apply: func(ctx context.Context, state *OptionsState) error {
+			fixture, err := replay.Load(path)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+			ignorePaths := make([]replay.IgnorePath, 0, len(ignore))
+			for _, path := range ignore {
+				ignorePath, err := replay.ParseIgnorePath(path)
+				if err != nil {
+					return err //nolint:wrapcheck
+				}
+				ignorePaths = append(ignorePaths, ignorePath)
+			}
+			calls := map[schema.RefKey][]replay.Call{}
+			for _, call := range fixture.Calls {
+				ref, err := reflection.ParseRef(call.Verb)
+				if err != nil {
+					return fmt.Errorf("%s: %w", path, err)
+				}
+				if ref.Module == moduleGetter() {
+					continue
+				}
+				calls[schema.RefKey(ref)] = append(calls[schema.RefKey(ref)], call)
+			}
+			for ref, calls := range calls {
+				state.mockVerbs[ref] = fixtureVerb(reflection.Ref(ref), calls, ignorePaths)
+			}
+			return nil
+		},
+	}
+}
+
+// fixtureVerb returns a mock verb that replays the recorded calls to a verb with a matching request.
+func fixtureVerb(ref reflection.Ref, calls []replay.Call, ignore []replay.IgnorePath) deploymentcontext.Verb {
+	lock := sync.Mutex{}
+	used := make([]bool, len(calls))
+	return func(ctx context.Context, req any) (any, error) {
+		request, err := encoding.Marshal(req)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to encode request: %w", ref, err)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		match := -1
+		for i, call := range calls {
+			recorded := []byte(call.Request)
+			if len(recorded) == 0 {
+				recorded = []byte("{}")
+			}
+			differences, err := replay.Diff(ref.String(), recorded, request, ignore)
+			if err != nil || len(differences) > 0 {
+				continue
+			}
+			match = i
+			if !used[i] {
+				break
+			}
+		}
+		if match == -1 {
+			return nil, fmt.Errorf("%s: no recorded call matches request %s", ref, request)
+		}
+		used[match] = true
+		call := calls[match]
+		if call.Error != "" {
+			return nil, errors.New(call.Error)
+		}
+		if len(call.Response) == 0 {
+			return deploymentcontext.EncodedResponse("{}"), nil
+		}
+		return deploymentcontext.EncodedResponse(call.Response), nil
+	}
+}
@@ -0,0 +1,73 @@
+package ftltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/deploymentcontext"
+)
+
+type fixtureTimeRequest struct {
+	Zone        string
+	RequestedAt time.Time
+}
+
+type fixtureTimeResponse struct {
+	Time time.Time
+}
+
+func TestWithFixtures(t *testing.T) {
+	reflection.AllowAnyPackageForTesting = true
+	defer func() { reflection.AllowAnyPackageForTesting = false }()
+	withFakeModule(t, "wrapped")
+
+	ctx := Context(
+		WithFixtures("testdata/fixtures/time.json", "$.requestedAt"),
+		WhenVerb[EchoClient](func(ctx context.Context, req string) (string, error) { return "mocked", nil }),
+	)
+	callTime := func(zone string) (fixtureTimeResponse, error) {
+		var resp fixtureTimeResponse
+		encoded, err := callBehavior(ctx, schema.Ref{Module: "time", Name: "time"}, fixtureTimeRequest{Zone: zone, RequestedAt: time.Now()})
+		if err != nil {
+			return resp, err
+		}
+		assert.NoError(t, encoding.Unmarshal(encoded.(deploymentcontext.EncodedResponse), &resp)) //nolint:forcetypeassert
+		return resp, nil
+	}
+
+	// Matching calls are replayed in order, repeating the last.
+	for _, expected := range []int{0, 1, 1} {
+		resp, err := callTime("UTC")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 0, expected, 0, time.UTC), resp.Time.UTC())
+	}
+
+	_, err := callTime("Mars")
+	assert.EqualError(t, err, "unknown zone")
+
+	_, err = callTime("Venus")
+	assert.Contains(t, err.Error(), "time.time: no recorded call matches request")
+
+	// Calls to verbs in the current module are not faked.
+	_, err = callBehavior(ctx, schema.Ref{Module: "wrapped", Name: "inner"}, struct{}{})
+	assert.Contains(t, err.Error(), "no mock found")
+
+	// Mocks take precedence over fixtures.
+	resp, err := callBehavior(ctx, schema.Ref{Module: "github", Name: "echo"}, "hello")
+	assert.NoError(t, err)
+	assert.Equal(t, any("mocked"), resp)
+}
+
+func callBehavior(ctx context.Context, ref schema.Ref, req any) (any, error) {
+	behavior, err := deploymentcontext.FromContext(ctx).CurrentContext().BehaviorForVerb(ref)
+	if err != nil {
+		return nil, err
+	}
+	return behavior.MustGet().Call(ctx, nil, req)
+}
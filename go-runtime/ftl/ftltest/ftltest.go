@@ -13,6 +13,7 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib" // SQL driver
 
 	"github.com/block/ftl/backend/provisioner"
+	"github.com/block/ftl/common/encoding"
 	"github.com/block/ftl/common/reflection"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/go-runtime/ftl"
@@ -39,6 +40,8 @@ type optionRank int
 
 const (
 	profile optionRank = iota
+	// fixtures are applied before other options so that mocks from WhenVerb take precedence.
+	fixtures
 	other
 )
 
@@ -224,17 +227,9 @@ func WithDatabase[T ftl.DatabaseConfig]() Option {
 			name := cfg.Name()
 			switch any(cfg).(type) {
 			case ftl.PostgresDatabaseConfig:
-				dsn, err := provisioner.ProvisionPostgresForTest(ctx, moduleGetter(), name)
+				dsn, err := testDatabaseDSN(ctx, name, provisioner.ProvisionPostgresForTest, provisioner.RunPostgresMigration)
 				if err != nil {
-					return fmt.Errorf("could not provision database %q: %w", name, err)
-				}
-				dir, err := os.Getwd()
-				if err != nil {
-					return fmt.Errorf("could not get working dir")
-				}
-				err = provisioner.RunPostgresMigration(ctx, dsn, dir, name)
-				if err != nil {
-					return fmt.Errorf("could not migrate database %q: %w", name, err)
+					return err
 				}
 				// replace original database with test database
 				replacementDB, err := deploymentcontext.NewTestDatabase(deploymentcontext.DBTypePostgres, dsn)
@@ -243,20 +238,23 @@ func WithDatabase[T ftl.DatabaseConfig]() Option {
 				}
 				state.databases[name] = replacementDB
 			case ftl.MySQLDatabaseConfig:
-				dsn, err := provisioner.ProvisionMySQLForTest(ctx, moduleGetter(), name)
+				dsn, err := testDatabaseDSN(ctx, name, provisioner.ProvisionMySQLForTest, provisioner.RunMySQLMigration)
 				if err != nil {
-					return fmt.Errorf("could not provision database %q: %w", name, err)
+					return err
 				}
-				dir, err := os.Getwd()
+				// replace original database with test database
+				replacementDB, err := deploymentcontext.NewTestDatabase(deploymentcontext.DBTypeMySQL, dsn)
 				if err != nil {
-					return fmt.Errorf("could not get working dir")
+					return fmt.Errorf("could not create database %q with DSN %q: %w", name, dsn, err)
 				}
-				err = provisioner.RunMySQLMigration(ctx, dsn, dir, name)
+				state.databases[name] = replacementDB
+			case ftl.SQLiteDatabaseConfig:
+				dsn, err := testDatabaseDSN(ctx, name, provisioner.ProvisionSQLiteForTest, provisioner.RunSQLiteMigration)
 				if err != nil {
-					return fmt.Errorf("could not migrate database %q: %w", name, err)
+					return err
 				}
 				// replace original database with test database
-				replacementDB, err := deploymentcontext.NewTestDatabase(deploymentcontext.DBTypeMySQL, dsn)
+				replacementDB, err := deploymentcontext.NewTestDatabase(deploymentcontext.DBTypeSQLite, dsn)
 				if err != nil {
 					return fmt.Errorf("could not create database %q with DSN %q: %w", name, dsn, err)
 				}
@@ -416,6 +414,36 @@ func WithMapsAllowed() Option {
 	}
 }
 
+// testDatabaseDSN returns the DSN of a test database, provisioning and migrating it unless `ftl test` has already
+// done so and provided its DSN in the environment.
+func testDatabaseDSN(ctx context.Context, name string,
+	provision func(ctx context.Context, module, id string) (string, error),
+	migrate func(ctx context.Context, dsn, moduleDir, name string) error,
+) (string, error) {
+	if dsn, ok := os.LookupEnv(testDSNEnvKey(moduleGetter(), name)); ok {
+		return dsn, nil
+	}
+	dsn, err := provision(ctx, moduleGetter(), name)
+	if err != nil {
+		return "", fmt.Errorf("could not provision database %q: %w", name, err)
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("could not get working dir")
+	}
+	if err := migrate(ctx, dsn, dir, name); err != nil {
+		return "", fmt.Errorf("could not migrate database %q: %w", name, err)
+	}
+	return dsn, nil
+}
+
+// testDSNEnvKey returns the environment variable `ftl test` uses to pass the DSN of a provisioned test database.
+//
+// The format is FTL_TEST_DSN_<MODULE>_<DBNAME>
+func testDSNEnvKey(module, name string) string {
+	return fmt.Sprintf("FTL_TEST_DSN_%s_%s", strings.ToUpper(module), strings.ToUpper(name))
+}
+
 // dsnSecretKey returns the key for the secret that is expected to hold the DSN for a database.
 //
 // The format is FTL_DSN_<MODULE>_<DBNAME>
@@ -468,6 +496,8 @@ func GetDatabaseHandle[T ftl.DatabaseConfig]() (ftl.DatabaseHandle[T], error) {
 		dbType = ftl.DatabaseTypePostgres
 	case "mysql":
 		dbType = ftl.DatabaseTypeMysql
+	case "sqlite":
+		dbType = ftl.DatabaseTypeSQLite
 	default:
 		return ftl.DatabaseHandle[T]{}, fmt.Errorf("unsupported database type %v", reflectedDB.DBType)
 	}
@@ -495,6 +525,12 @@ func call[VerbClient, Req, Resp any](ctx context.Context, req Req) (resp Resp, e
 		if r, ok := uncheckedResp.(Resp); ok {
 			return r, nil
 		}
+		if encoded, ok := uncheckedResp.(deploymentcontext.EncodedResponse); ok {
+			if err := encoding.Unmarshal(encoded, &resp); err != nil {
+				return resp, fmt.Errorf("%s: failed to decode response: %w", ref, err)
+			}
+			return resp, nil
+		}
 		return resp, fmt.Errorf("%s: overridden verb had invalid response type %T, expected %v", ref, uncheckedResp, reflect.TypeFor[Resp]())
 	}
 	return inline(ctx, req)
@@ -520,3 +556,9 @@ func defaultDatabaseConfig[T ftl.DatabaseConfig]() T {
 	}
 	return cfg
 }
+
+// ContextWithIdempotencyKey returns a context in which [ftl.IdempotencyKey]
+// returns key, as if the verb were called with an idempotency key.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return internal.ContextWithCallMetadata(ctx, map[internal.MetadataKey]string{internal.IdempotencyKeyMetadata: key})
+}
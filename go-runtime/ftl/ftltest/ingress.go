@@ -0,0 +1,106 @@
+package ftltest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+
+	"github.com/block/ftl/backend/ingress"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	pc "github.com/block/ftl/internal/projectconfig"
+)
+
+// Allows tests to provide the schema used to route ingress requests.
+var ingressSchema = loadProjectSchema
+
+// CallIngress sends an HTTP request to an ingress verb, returning the HTTP response.
+//
+// The request is mapped to the verb's request, and the verb's response back to HTTP, in the same way as FTL's HTTP
+// ingress, so path parameters, query parameters, headers and bodies are handled as they would be in a deployment.
+// Routes are taken from the schemas written by `ftl build`, so the module must have been built. The verb is called
+// inline, applying resources and test behavior, as with Call.
+//
+// To be used in a test:
+//
+//	req := httptest.NewRequest("GET", "/users/alice", nil)
+//	resp, err := ftltest.CallIngress[GetUserClient, builtin.HttpRequest[ftl.Unit, GetUserPath, ftl.Unit], builtin.HttpResponse[User, string]](ctx, req)
+func CallIngress[VerbClient, Req, Resp any](ctx context.Context, r *http.Request) (*http.Response, error) {
+	sch, err := ingressSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ref := reflection.ClientRef[VerbClient]()
+	client := ingressVerbClient(func(ctx context.Context, verb reflection.Ref, body []byte) ([]byte, error) {
+		if verb != ref {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("request was routed to %s, not %s", verb, ref))
+		}
+		var req Req
+		if err := encoding.Unmarshal(body, &req); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: failed to decode request: %w", ref, err))
+		}
+		resp, err := call[VerbClient, Req, Resp](ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return encoding.Marshal(resp) //nolint:wrapcheck
+	})
+	rec := httptest.NewRecorder()
+	ingress.NewHandler(sch, client).ServeHTTP(rec, r.WithContext(ctx))
+	return rec.Result(), nil
+}
+
+// ingressVerbClient calls verbs on behalf of the ingress handler.
+//
+// Errors returned as connect errors fail the call, while other errors are returned to ingress as verb errors.
+type ingressVerbClient func(ctx context.Context, verb reflection.Ref, body []byte) ([]byte, error)
+
+func (c ingressVerbClient) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
+	body, err := c(ctx, reflection.RefFromProto(req.Msg.Verb), req.Msg.Body)
+	if cerr := new(connect.Error); err != nil && errors.As(err, &cerr) {
+		return nil, cerr
+	} else if err != nil {
+		return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Error_{Error: &ftlv1.CallResponse_Error{Message: err.Error()}}}), nil
+	}
+	return connect.NewResponse(&ftlv1.CallResponse{Response: &ftlv1.CallResponse_Body{Body: body}}), nil
+}
+
+// loadProjectSchema loads the schemas of all modules in the project, as written by `ftl build`.
+func loadProjectSchema(ctx context.Context) (*schema.Schema, error) {
+	path, ok := pc.DefaultConfigPath().Get()
+	if !ok {
+		return nil, fmt.Errorf("could not find default project file in $FTL_CONFIG or git")
+	}
+	projectConfig, err := pc.Load(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("project: %w", err)
+	}
+	schemaPath := projectConfig.SchemaPath(moduleGetter())
+	if _, err := os.Stat(schemaPath); err != nil {
+		return nil, fmt.Errorf("could not find schema for module %q, build it with `ftl build`: %w", moduleGetter(), err)
+	}
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(schemaPath), "*.pb"))
+	if err != nil {
+		return nil, fmt.Errorf("could not list module schemas: %w", err)
+	}
+	sch := &schema.Schema{Modules: []*schema.Module{schema.Builtins()}}
+	for _, file := range files {
+		module, err := schema.ModuleFromProtoFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("could not load module schema %s: %w", file, err)
+		}
+		if module.Builtin {
+			continue
+		}
+		sch.Modules = append(sch.Modules, module)
+	}
+	return sch, nil
+}
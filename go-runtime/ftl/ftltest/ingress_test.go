@@ -0,0 +1,89 @@
+package ftltest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/ftl"
+)
+
+// Mirrors builtin.HttpRequest and builtin.HttpResponse.
+type testHTTPRequest[Body, Path, Query any] struct {
+	Method         string
+	Path           string
+	PathParameters Path
+	Query          Query
+	Headers        map[string][]string
+	Body           Body
+}
+
+type testHTTPResponse[Body, Error any] struct {
+	Status  int
+	Headers map[string][]string
+	Body    ftl.Option[Body]
+	Error   ftl.Option[Error]
+}
+
+type GetUserPath struct {
+	Username string
+}
+
+type GetUserClient func(context.Context, testHTTPRequest[ftl.Unit, GetUserPath, ftl.Unit]) (testHTTPResponse[string, string], error)
+
+func TestCallIngress(t *testing.T) {
+	reflection.AllowAnyPackageForTesting = true
+	defer func() { reflection.AllowAnyPackageForTesting = false }()
+	withFakeModule(t, "github")
+	previousIngressSchema := ingressSchema
+	ingressSchema = func(ctx context.Context) (*schema.Schema, error) {
+		return schema.ParseString("", `
+			module github {
+				data GetUserPath {
+					username String
+				}
+
+				export verb getUser(HttpRequest<Unit, github.GetUserPath, Unit>) HttpResponse<String, String>
+					+ingress http GET /users/{username}
+			}
+		`)
+	}
+	t.Cleanup(func() { ingressSchema = previousIngressSchema })
+
+	ctx := Context(WhenVerb[GetUserClient](func(ctx context.Context, req testHTTPRequest[ftl.Unit, GetUserPath, ftl.Unit]) (testHTTPResponse[string, string], error) {
+		if req.PathParameters.Username == "nobody" {
+			return testHTTPResponse[string, string]{Status: http.StatusNotFound, Error: ftl.Some("no such user")}, nil
+		}
+		return testHTTPResponse[string, string]{
+			Headers: map[string][]string{"X-Method": {req.Method}},
+			Body:    ftl.Some("hello " + req.PathParameters.Username),
+		}, nil
+	}))
+	callIngress := func(path string) (*http.Response, string) {
+		resp, err := CallIngress[GetUserClient, testHTTPRequest[ftl.Unit, GetUserPath, ftl.Unit], testHTTPResponse[string, string]](ctx, httptest.NewRequest("GET", path, nil))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		return resp, string(body)
+	}
+
+	resp, body := callIngress("/users/alice")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "hello alice", body)
+	assert.Equal(t, "GET", resp.Header.Get("X-Method"))
+	assert.Equal(t, "text/plain; charset=utf-8", resp.Header.Get("Content-Type"))
+
+	resp, body = callIngress("/users/nobody")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, "no such user", body)
+
+	resp, _ = callIngress("/accounts/alice")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
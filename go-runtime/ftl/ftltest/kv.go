@@ -0,0 +1,84 @@
+package ftltest
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/block/ftl/common/schema"
+)
+
+type kvEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func (e kvEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// fakeKV is an in-memory implementation of FTL key-value stores.
+type fakeKV struct {
+	lock   sync.Mutex
+	stores map[schema.RefKey]map[string]kvEntry
+}
+
+func newFakeKV() *fakeKV {
+	return &fakeKV{stores: map[schema.RefKey]map[string]kvEntry{}}
+}
+
+// get must be called with the lock held.
+func (f *fakeKV) get(kv *schema.Ref, key []byte) ([]byte, bool) {
+	entry, ok := f.stores[kv.ToRefKey()][string(key)]
+	if !ok || entry.expired(time.Now()) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// put must be called with the lock held.
+func (f *fakeKV) put(kv *schema.Ref, key, value []byte, ttl time.Duration) {
+	store, ok := f.stores[kv.ToRefKey()]
+	if !ok {
+		store = map[string]kvEntry{}
+		f.stores[kv.ToRefKey()] = store
+	}
+	entry := kvEntry{value: bytes.Clone(value)}
+	if ttl != 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	store[string(key)] = entry
+}
+
+func (f *fakeFTL) KVGet(ctx context.Context, kv *schema.Ref, key []byte) ([]byte, error) {
+	f.kv.lock.Lock()
+	defer f.kv.lock.Unlock()
+	value, _ := f.kv.get(kv, key)
+	return bytes.Clone(value), nil
+}
+
+func (f *fakeFTL) KVPut(ctx context.Context, kv *schema.Ref, key, value []byte, ttl time.Duration) error {
+	f.kv.lock.Lock()
+	defer f.kv.lock.Unlock()
+	f.kv.put(kv, key, value, ttl)
+	return nil
+}
+
+func (f *fakeFTL) KVDelete(ctx context.Context, kv *schema.Ref, key []byte) error {
+	f.kv.lock.Lock()
+	defer f.kv.lock.Unlock()
+	delete(f.kv.stores[kv.ToRefKey()], string(key))
+	return nil
+}
+
+func (f *fakeFTL) KVCompareAndSwap(ctx context.Context, kv *schema.Ref, key, old, value []byte, ttl time.Duration) (bool, error) {
+	f.kv.lock.Lock()
+	defer f.kv.lock.Unlock()
+	current, ok := f.kv.get(kv, key)
+	if ok != (old != nil) || !bytes.Equal(current, old) {
+		return false, nil
+	}
+	f.kv.put(kv, key, value, ttl)
+	return true, nil
+}
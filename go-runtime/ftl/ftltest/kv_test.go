@@ -0,0 +1,62 @@
+package ftltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/ftl"
+)
+
+type kvValue struct {
+	Count int
+}
+
+func TestFakeKV(t *testing.T) {
+	ctx := contextWithFakeFTL(context.Background())
+	kv := ftl.KVHandle[string, kvValue]{Ref: &schema.Ref{Module: module, Name: "counts"}}
+
+	value, err := kv.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.None[kvValue](), value)
+
+	swapped, err := kv.CompareAndSwap(ctx, "a", ftl.None[kvValue](), kvValue{Count: 1})
+	assert.NoError(t, err)
+	assert.True(t, swapped)
+
+	swapped, err = kv.CompareAndSwap(ctx, "a", ftl.None[kvValue](), kvValue{Count: 2})
+	assert.NoError(t, err)
+	assert.False(t, swapped, "expected swap to fail as the key exists")
+
+	swapped, err = kv.CompareAndSwap(ctx, "a", ftl.Some(kvValue{Count: 1}), kvValue{Count: 2})
+	assert.NoError(t, err)
+	assert.True(t, swapped)
+
+	value, err = kv.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.Some(kvValue{Count: 2}), value)
+
+	assert.NoError(t, kv.Delete(ctx, "a"))
+	value, err = kv.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.None[kvValue](), value)
+}
+
+func TestFakeKVTTL(t *testing.T) {
+	ctx := contextWithFakeFTL(context.Background())
+	kv := ftl.KVHandle[int, string]{Ref: &schema.Ref{Module: module, Name: "sessions"}}
+
+	assert.NoError(t, kv.Put(ctx, 1, "short", ftl.WithTTL(time.Millisecond)))
+	assert.NoError(t, kv.Put(ctx, 2, "long"))
+	time.Sleep(5 * time.Millisecond)
+
+	value, err := kv.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.None[string](), value)
+	value, err = kv.Get(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.Some("long"), value)
+}
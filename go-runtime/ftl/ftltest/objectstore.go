@@ -0,0 +1,88 @@
+package ftltest
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/internal"
+)
+
+type fakeObject struct {
+	data         []byte
+	lastModified time.Time
+}
+
+// fakeObjectStore is an in-memory implementation of FTL object stores.
+type fakeObjectStore struct {
+	lock   sync.Mutex
+	stores map[schema.RefKey]map[string]fakeObject
+}
+
+func newFakeObjectStore() *fakeObjectStore {
+	return &fakeObjectStore{stores: map[schema.RefKey]map[string]fakeObject{}}
+}
+
+func (f *fakeFTL) ObjectStorePut(ctx context.Context, store *schema.Ref, key string, data []byte, contentType string) error {
+	f.objectStore.lock.Lock()
+	defer f.objectStore.lock.Unlock()
+	objects, ok := f.objectStore.stores[store.ToRefKey()]
+	if !ok {
+		objects = map[string]fakeObject{}
+		f.objectStore.stores[store.ToRefKey()] = objects
+	}
+	objects[key] = fakeObject{data: bytes.Clone(data), lastModified: time.Now()}
+	return nil
+}
+
+func (f *fakeFTL) ObjectStoreGet(ctx context.Context, store *schema.Ref, key string) ([]byte, bool, error) {
+	f.objectStore.lock.Lock()
+	defer f.objectStore.lock.Unlock()
+	object, ok := f.objectStore.stores[store.ToRefKey()][key]
+	if !ok {
+		return nil, false, nil
+	}
+	return bytes.Clone(object.data), true, nil
+}
+
+func (f *fakeFTL) ObjectStoreDelete(ctx context.Context, store *schema.Ref, key string) error {
+	f.objectStore.lock.Lock()
+	defer f.objectStore.lock.Unlock()
+	delete(f.objectStore.stores[store.ToRefKey()], key)
+	return nil
+}
+
+func (f *fakeFTL) ObjectStoreList(ctx context.Context, store *schema.Ref, prefix string) ([]internal.ObjectInfo, error) {
+	f.objectStore.lock.Lock()
+	defer f.objectStore.lock.Unlock()
+	out := []internal.ObjectInfo{}
+	for key, object := range f.objectStore.stores[store.ToRefKey()] {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		out = append(out, internal.ObjectInfo{
+			Key:          key,
+			Size:         int64(len(object.data)),
+			LastModified: object.lastModified,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out, nil
+}
+
+// ObjectStorePresign returns a "memory://" URL identifying the object. It can
+// be compared in tests but can not be fetched.
+func (f *fakeFTL) ObjectStorePresign(ctx context.Context, store *schema.Ref, method string, key string, expires time.Duration) (string, error) {
+	u := url.URL{
+		Scheme:   "memory",
+		Host:     store.Module,
+		Path:     "/" + store.Name + "/" + key,
+		RawQuery: url.Values{"method": {method}, "expires": {expires.String()}}.Encode(),
+	}
+	return u.String(), nil
+}
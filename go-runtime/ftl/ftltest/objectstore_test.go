@@ -0,0 +1,47 @@
+package ftltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/ftl"
+)
+
+func TestFakeObjectStore(t *testing.T) {
+	ctx := contextWithFakeFTL(context.Background())
+	store := ftl.ObjectStoreHandle{Ref: &schema.Ref{Module: module, Name: "uploads"}}
+
+	data, err := store.Get(ctx, "a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.None[[]byte](), data)
+
+	assert.NoError(t, store.Put(ctx, "images/b.png", []byte("b"), ftl.WithContentType("image/png")))
+	assert.NoError(t, store.Put(ctx, "a.txt", []byte("a")))
+	assert.NoError(t, store.Put(ctx, "images/a.png", []byte("aa")))
+
+	data, err = store.Get(ctx, "a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.Some([]byte("a")), data)
+
+	objects, err := store.List(ctx, "images/")
+	assert.NoError(t, err)
+	keys := []string{}
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+	}
+	assert.Equal(t, []string{"images/a.png", "images/b.png"}, keys)
+	assert.Equal(t, 2, objects[0].Size)
+
+	assert.NoError(t, store.Delete(ctx, "a.txt"))
+	data, err = store.Get(ctx, "a.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, ftl.None[[]byte](), data)
+
+	url, err := store.PresignGet(ctx, "images/a.png", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "memory://"+module+"/uploads/images/a.png?expires=1m0s&method=GET", url)
+}
@@ -0,0 +1,22 @@
+package ftl
+
+import (
+	"context"
+
+	"github.com/block/ftl/go-runtime/internal"
+)
+
+// IdempotencyKey returns the idempotency key of the current call, if any.
+//
+// Calls through ingress carry the key from the Idempotency-Key HTTP header,
+// async calls carry the call ID, and pubsub deliveries carry a key derived
+// from the topic, partition and offset of the event. Verbs annotated with
+// //ftl:idempotent are deduplicated by FTL, but other verbs can use the key
+// to deduplicate their own side effects.
+func IdempotencyKey(ctx context.Context) Option[string] {
+	key, ok := internal.LookupCallMetadata(ctx, internal.IdempotencyKeyMetadata)
+	if !ok || key == "" {
+		return None[string]()
+	}
+	return Some(key)
+}
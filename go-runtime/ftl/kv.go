@@ -0,0 +1,103 @@
+package ftl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/internal"
+)
+
+// KVHandle accesses a key-value store.
+//
+// Keys and values are encoded with the FTL JSON encoding.
+type KVHandle[K, V any] struct {
+	Ref *schema.Ref
+}
+
+// KVWriteOption configures a write to a key-value store.
+type KVWriteOption func(*kvWriteOptions)
+
+type kvWriteOptions struct {
+	ttl time.Duration
+}
+
+// WithTTL expires the written entry after the given duration.
+func WithTTL(ttl time.Duration) KVWriteOption {
+	return func(o *kvWriteOptions) { o.ttl = ttl }
+}
+
+// Get returns the value for key, or None if the key does not exist or has expired.
+func (k KVHandle[K, V]) Get(ctx context.Context, key K) (Option[V], error) {
+	encodedKey, err := encoding.Marshal(key)
+	if err != nil {
+		return None[V](), fmt.Errorf("failed to marshal key: %w", err)
+	}
+	data, err := internal.FromContext(ctx).KVGet(ctx, k.Ref, encodedKey)
+	if err != nil {
+		return None[V](), err //nolint:wrapcheck
+	}
+	if data == nil {
+		return None[V](), nil
+	}
+	var value V
+	if err := encoding.Unmarshal(data, &value); err != nil {
+		return None[V](), fmt.Errorf("failed to unmarshal value: %w", err)
+	}
+	return Some(value), nil
+}
+
+// Put sets key to value.
+func (k KVHandle[K, V]) Put(ctx context.Context, key K, value V, options ...KVWriteOption) error {
+	encodedKey, err := encoding.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal key: %w", err)
+	}
+	encodedValue, err := encoding.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+	return internal.FromContext(ctx).KVPut(ctx, k.Ref, encodedKey, encodedValue, kvWriteOptionsFrom(options).ttl) //nolint:wrapcheck
+}
+
+// Delete removes key from the store. Deleting a key that does not exist is not an error.
+func (k KVHandle[K, V]) Delete(ctx context.Context, key K) error {
+	encodedKey, err := encoding.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal key: %w", err)
+	}
+	return internal.FromContext(ctx).KVDelete(ctx, k.Ref, encodedKey) //nolint:wrapcheck
+}
+
+// CompareAndSwap atomically sets key to value if its current value is equal
+// to old, returning true if the swap occurred.
+//
+// If old is None the swap only occurs if the key does not exist.
+func (k KVHandle[K, V]) CompareAndSwap(ctx context.Context, key K, old Option[V], value V, options ...KVWriteOption) (bool, error) {
+	encodedKey, err := encoding.Marshal(key)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal key: %w", err)
+	}
+	var encodedOld []byte
+	if o, ok := old.Get(); ok {
+		encodedOld, err = encoding.Marshal(o)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal old value: %w", err)
+		}
+	}
+	encodedValue, err := encoding.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	return internal.FromContext(ctx).KVCompareAndSwap(ctx, k.Ref, encodedKey, encodedOld, encodedValue, kvWriteOptionsFrom(options).ttl) //nolint:wrapcheck
+}
+
+func kvWriteOptionsFrom(options []KVWriteOption) kvWriteOptions {
+	o := kvWriteOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
@@ -0,0 +1,85 @@
+package ftl
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/internal"
+)
+
+// ObjectStoreHandle accesses a blob/object store.
+type ObjectStoreHandle struct {
+	Ref *schema.Ref
+}
+
+// ObjectInfo describes an object in an object store.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// ObjectPutOption configures a write to an object store.
+type ObjectPutOption func(*objectPutOptions)
+
+type objectPutOptions struct {
+	contentType string
+}
+
+// WithContentType sets the Content-Type of the written object.
+func WithContentType(contentType string) ObjectPutOption {
+	return func(o *objectPutOptions) { o.contentType = contentType }
+}
+
+// Put writes data to the object at key, replacing any existing object.
+func (o ObjectStoreHandle) Put(ctx context.Context, key string, data []byte, options ...ObjectPutOption) error {
+	opts := objectPutOptions{}
+	for _, option := range options {
+		option(&opts)
+	}
+	return internal.FromContext(ctx).ObjectStorePut(ctx, o.Ref, key, data, opts.contentType) //nolint:wrapcheck
+}
+
+// Get returns the contents of the object at key, or None if it does not exist.
+func (o ObjectStoreHandle) Get(ctx context.Context, key string) (Option[[]byte], error) {
+	data, ok, err := internal.FromContext(ctx).ObjectStoreGet(ctx, o.Ref, key)
+	if err != nil {
+		return None[[]byte](), err //nolint:wrapcheck
+	}
+	if !ok {
+		return None[[]byte](), nil
+	}
+	return Some(data), nil
+}
+
+// Delete removes the object at key. Deleting an object that does not exist is not an error.
+func (o ObjectStoreHandle) Delete(ctx context.Context, key string) error {
+	return internal.FromContext(ctx).ObjectStoreDelete(ctx, o.Ref, key) //nolint:wrapcheck
+}
+
+// List returns the objects whose keys start with prefix, ordered by key.
+func (o ObjectStoreHandle) List(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	objects, err := internal.FromContext(ctx).ObjectStoreList(ctx, o.Ref, prefix)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	out := make([]ObjectInfo, 0, len(objects))
+	for _, object := range objects {
+		out = append(out, ObjectInfo(object))
+	}
+	return out, nil
+}
+
+// PresignGet returns a URL that can be used to download the object at key
+// without credentials, until it expires.
+func (o ObjectStoreHandle) PresignGet(ctx context.Context, key string, expires time.Duration) (string, error) {
+	return internal.FromContext(ctx).ObjectStorePresign(ctx, o.Ref, http.MethodGet, key, expires) //nolint:wrapcheck
+}
+
+// PresignPut returns a URL that can be used to upload the object at key
+// without credentials, until it expires.
+func (o ObjectStoreHandle) PresignPut(ctx context.Context, key string, expires time.Duration) (string, error) {
+	return internal.FromContext(ctx).ObjectStorePresign(ctx, o.Ref, http.MethodPut, key, expires) //nolint:wrapcheck
+}
@@ -168,6 +168,7 @@ func (s *Service) ModuleConfigDefaults(ctx context.Context, req *connect.Request
 		Watch:           watch,
 		DeployDir:       deployDir,
 		SqlMigrationDir: "db",
+		Test:            optional.Some("go test -json ./...").Ptr(),
 	}), nil
 }
 
@@ -73,6 +73,7 @@ func TestGoConfigDefaults(t *testing.T) {
 					"../../../../go-runtime/ftl/**/*.go",
 				},
 				SQLMigrationDir: "db",
+				Test:            optional.Some("go test -json ./..."),
 			},
 		},
 		{
@@ -87,6 +88,7 @@ func TestGoConfigDefaults(t *testing.T) {
 					"../../../../go-runtime/schema/testdata/**/*.go",
 				},
 				SQLMigrationDir: "db",
+				Test:            optional.Some("go test -json ./..."),
 			},
 		},
 	} {
@@ -121,5 +123,6 @@ func defaultsFromProto(proto *langpb.ModuleConfigDefaultsResponse) moduleconfig.
 		GeneratedSchemaDir: optional.Ptr(proto.GeneratedSchemaDir),
 		LanguageConfig:     proto.LanguageConfig.AsMap(),
 		SQLMigrationDir:    proto.SqlMigrationDir,
+		Test:               optional.Ptr(proto.Test),
 	}
 }
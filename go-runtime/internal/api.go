@@ -2,12 +2,18 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/rpc/headers"
 )
 
 type MetadataKey string
 
+// IdempotencyKeyMetadata is the call metadata key holding the idempotency key
+// of a call, if any.
+const IdempotencyKeyMetadata MetadataKey = headers.IdempotencyKeyHeader
+
 // FTL is the interface that the FTL runtime provides to user code.
 //
 // In production, the FTL runtime will provide an implementation of this
@@ -31,6 +37,79 @@ type FTL interface {
 
 	// GetSecret unmarshals a secret value into dest.
 	GetSecret(ctx context.Context, name string, dest any) error
+
+	// KVGet returns the encoded value for an encoded key in a key-value store,
+	// or nil if the key does not exist.
+	KVGet(ctx context.Context, kv *schema.Ref, key []byte) ([]byte, error)
+
+	// KVPut sets an encoded key to an encoded value in a key-value store.
+	//
+	// If ttl is non-zero the entry expires after that duration.
+	KVPut(ctx context.Context, kv *schema.Ref, key, value []byte, ttl time.Duration) error
+
+	// KVDelete removes an encoded key from a key-value store.
+	KVDelete(ctx context.Context, kv *schema.Ref, key []byte) error
+
+	// KVCompareAndSwap sets an encoded key to value if its current value is
+	// old, or if old is nil and the key does not exist.
+	KVCompareAndSwap(ctx context.Context, kv *schema.Ref, key, old, value []byte, ttl time.Duration) (bool, error)
+
+	// ObjectStorePut writes an object to an object store, replacing any
+	// existing object with the same key.
+	ObjectStorePut(ctx context.Context, store *schema.Ref, key string, data []byte, contentType string) error
+
+	// ObjectStoreGet returns the contents of an object in an object store, or
+	// false if the object does not exist.
+	ObjectStoreGet(ctx context.Context, store *schema.Ref, key string) ([]byte, bool, error)
+
+	// ObjectStoreDelete removes an object from an object store.
+	ObjectStoreDelete(ctx context.Context, store *schema.Ref, key string) error
+
+	// ObjectStoreList lists the objects in an object store whose keys start
+	// with prefix, ordered by key.
+	ObjectStoreList(ctx context.Context, store *schema.Ref, prefix string) ([]ObjectInfo, error)
+
+	// ObjectStorePresign returns a URL that can be used without credentials
+	// to perform an HTTP GET or PUT of an object until it expires.
+	ObjectStorePresign(ctx context.Context, store *schema.Ref, method string, key string, expires time.Duration) (string, error)
+
+	// AsyncCall durably enqueues a call to a verb with an encoded request,
+	// returning the ID of the call.
+	//
+	// "call" invokes the verb in-process, and is only used by test
+	// implementations.
+	AsyncCall(ctx context.Context, verb *schema.Ref, request []byte, call func(context.Context) (any, error)) (string, error)
+
+	// AsyncCallStatus returns the status of an asynchronous call.
+	AsyncCallStatus(ctx context.Context, id string) (AsyncCallStatus, error)
+}
+
+// AsyncCallState is the state of an asynchronous call.
+type AsyncCallState string
+
+const (
+	AsyncCallPending   AsyncCallState = "pending"
+	AsyncCallRunning   AsyncCallState = "running"
+	AsyncCallSucceeded AsyncCallState = "succeeded"
+	AsyncCallFailed    AsyncCallState = "failed"
+	AsyncCallCancelled AsyncCallState = "cancelled"
+)
+
+// AsyncCallStatus describes the progress of an asynchronous call.
+type AsyncCallStatus struct {
+	State    AsyncCallState
+	Attempts int
+	// Response is the encoded response of a successful call.
+	Response []byte
+	// Error is the error from the last failed attempt, if any.
+	Error string
+}
+
+// ObjectInfo describes an object in an object store.
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
 }
 
 type ftlContextKey struct{}
@@ -64,3 +143,14 @@ func CallMetadataFromContext(ctx context.Context) map[MetadataKey]string {
 	}
 	return metadata
 }
+
+// LookupCallMetadata returns a call metadata value, or false if the value or
+// the call metadata itself is not present.
+func LookupCallMetadata(ctx context.Context, key MetadataKey) (string, bool) {
+	metadata, ok := ctx.Value(callMetadataKey{}).(map[MetadataKey]string)
+	if !ok {
+		return "", false
+	}
+	value, ok := metadata[key]
+	return value, ok
+}
@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	asyncpb "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1"
+	asyncconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/rpc"
+)
+
+func (r *RealFTL) AsyncCall(ctx context.Context, verb *schema.Ref, request []byte, _ func(context.Context) (any, error)) (string, error) {
+	caller := reflection.CallingVerb()
+	client := rpc.ClientFromContext[asyncconnect.AsyncServiceClient](ctx)
+	resp, err := client.EnqueueCall(ctx, connect.NewRequest(&asyncpb.EnqueueCallRequest{
+		Verb:   verb.ToProto(),
+		Body:   request,
+		Caller: caller.ToProto(),
+	}))
+	if err != nil {
+		return "", fmt.Errorf("failed to enqueue async call to %s: %w", verb, err)
+	}
+	return resp.Msg.Id, nil
+}
+
+func (r *RealFTL) AsyncCallStatus(ctx context.Context, id string) (AsyncCallStatus, error) {
+	client := rpc.ClientFromContext[asyncconnect.AsyncServiceClient](ctx)
+	resp, err := client.GetCall(ctx, connect.NewRequest(&asyncpb.GetCallRequest{Id: id}))
+	if err != nil {
+		return AsyncCallStatus{}, fmt.Errorf("failed to get async call %s: %w", id, err)
+	}
+	call := resp.Msg.Call
+	var state AsyncCallState
+	switch call.State {
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_PENDING:
+		state = AsyncCallPending
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_RUNNING:
+		state = AsyncCallRunning
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_SUCCEEDED:
+		state = AsyncCallSucceeded
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_FAILED:
+		state = AsyncCallFailed
+	case asyncpb.AsyncCallState_ASYNC_CALL_STATE_CANCELLED:
+		state = AsyncCallCancelled
+	default:
+		return AsyncCallStatus{}, fmt.Errorf("async call %s has unknown state %s", id, call.State)
+	}
+	return AsyncCallStatus{
+		State:    state,
+		Attempts: int(call.Attempts),
+		Response: call.Response,
+		Error:    call.GetError(),
+	}, nil
+}
@@ -6,10 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/puzpuzpuz/xsync/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	kvpb "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1"
+	kvconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1/kvpbconnect"
 	pubpb "github.com/block/ftl/backend/protos/xyz/block/ftl/publish/v1"
 	pubconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/publish/v1/publishpbconnect"
 	"github.com/block/ftl/common/encoding"
@@ -29,13 +33,16 @@ type RealFTL struct {
 	dmctx *deploymentcontext.DynamicDeploymentContext
 	// Cache for Map() calls
 	mapped *xsync.MapOf[uintptr, mapCacheEntry]
+	// Object store clients by name
+	objectStores *xsync.MapOf[string, *objectStore]
 }
 
 // New creates a new [RealFTL]
 func New(dmctx *deploymentcontext.DynamicDeploymentContext) *RealFTL {
 	return &RealFTL{
-		dmctx:  dmctx,
-		mapped: xsync.NewMapOf[uintptr, mapCacheEntry](),
+		dmctx:        dmctx,
+		mapped:       xsync.NewMapOf[uintptr, mapCacheEntry](),
+		objectStores: xsync.NewMapOf[string, *objectStore](),
 	}
 }
 
@@ -71,6 +78,90 @@ func (r *RealFTL) PublishEvent(ctx context.Context, topic *schema.Ref, event any
 	return nil
 }
 
+func (r *RealFTL) KVGet(ctx context.Context, kv *schema.Ref, key []byte) ([]byte, error) {
+	client := rpc.ClientFromContext[kvconnect.KVServiceClient](ctx)
+	resp, err := client.Get(ctx, connect.NewRequest(&kvpb.GetRequest{
+		Kv:  kv.ToProto(),
+		Key: key,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from kv %s: %w", kv, err)
+	}
+	return resp.Msg.Value, nil
+}
+
+func (r *RealFTL) KVPut(ctx context.Context, kv *schema.Ref, key, value []byte, ttl time.Duration) error {
+	caller, err := kvCaller(kv)
+	if err != nil {
+		return err
+	}
+	client := rpc.ClientFromContext[kvconnect.KVServiceClient](ctx)
+	_, err = client.Put(ctx, connect.NewRequest(&kvpb.PutRequest{
+		Kv:     kv.ToProto(),
+		Key:    key,
+		Value:  value,
+		Ttl:    ttlToProto(ttl),
+		Caller: caller,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to put to kv %s: %w", kv, err)
+	}
+	return nil
+}
+
+func (r *RealFTL) KVDelete(ctx context.Context, kv *schema.Ref, key []byte) error {
+	caller, err := kvCaller(kv)
+	if err != nil {
+		return err
+	}
+	client := rpc.ClientFromContext[kvconnect.KVServiceClient](ctx)
+	_, err = client.Delete(ctx, connect.NewRequest(&kvpb.DeleteRequest{
+		Kv:     kv.ToProto(),
+		Key:    key,
+		Caller: caller,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to delete from kv %s: %w", kv, err)
+	}
+	return nil
+}
+
+func (r *RealFTL) KVCompareAndSwap(ctx context.Context, kv *schema.Ref, key, old, value []byte, ttl time.Duration) (bool, error) {
+	caller, err := kvCaller(kv)
+	if err != nil {
+		return false, err
+	}
+	client := rpc.ClientFromContext[kvconnect.KVServiceClient](ctx)
+	resp, err := client.CompareAndSwap(ctx, connect.NewRequest(&kvpb.CompareAndSwapRequest{
+		Kv:     kv.ToProto(),
+		Key:    key,
+		Old:    old,
+		New:    value,
+		Ttl:    ttlToProto(ttl),
+		Caller: caller,
+	}))
+	if err != nil {
+		return false, fmt.Errorf("failed to compare and swap in kv %s: %w", kv, err)
+	}
+	return resp.Msg.Swapped, nil
+}
+
+// kvCaller returns the name of the calling verb, ensuring it is in the same module as the store.
+func kvCaller(kv *schema.Ref) (string, error) {
+	caller := reflection.CallingVerb()
+	if kv.Module != caller.Module {
+		return "", fmt.Errorf("can not write to another module's kv: %s", kv)
+	}
+	return caller.Name, nil
+}
+
+func ttlToProto(ttl time.Duration) *durationpb.Duration {
+	if ttl == 0 {
+		return nil
+	}
+	return durationpb.New(ttl)
+}
+
 func (r *RealFTL) CallMap(ctx context.Context, mapper any, value any, mapImpl func(context.Context) (any, error)) any {
 	// Compute checksum of the input.
 	inputData, err := json.Marshal(value)
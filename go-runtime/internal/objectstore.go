@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/block/ftl/common/schema"
+)
+
+type objectStore struct {
+	client *s3.Client
+	bucket string
+}
+
+// objectStore returns a client for an object store, using the location
+// exposed to the module by the runner.
+func (r *RealFTL) objectStore(ctx context.Context, store *schema.Ref) (*objectStore, error) {
+	if cached, ok := r.objectStores.Load(store.Name); ok {
+		return cached, nil
+	}
+	name := strings.ToUpper(store.Name)
+	bucket := os.Getenv("FTL_OBJECTSTORE_BUCKET_" + name)
+	if bucket == "" {
+		return nil, fmt.Errorf("object store %s has not been provisioned", store)
+	}
+	endpoint := os.Getenv("FTL_OBJECTSTORE_ENDPOINT_" + name)
+	region := os.Getenv("FTL_OBJECTSTORE_REGION_" + name)
+
+	var client *s3.Client
+	if endpoint == "" {
+		cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load default aws config: %w", err)
+		}
+		client = s3.NewFromConfig(cfg)
+	} else {
+		// A local S3-compatible store, which does not check credentials.
+		client = s3.New(s3.Options{
+			BaseEndpoint: aws.String(endpoint),
+			Region:       region,
+			Credentials:  credentials.NewStaticCredentialsProvider("ftl", "ftl", ""),
+			UsePathStyle: true,
+		})
+	}
+	out, _ := r.objectStores.LoadOrStore(store.Name, &objectStore{client: client, bucket: bucket})
+	return out, nil
+}
+
+func (r *RealFTL) ObjectStorePut(ctx context.Context, store *schema.Ref, key string, data []byte, contentType string) error {
+	s, err := r.objectStore(ctx, store)
+	if err != nil {
+		return err
+	}
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if _, err := s.client.PutObject(ctx, input); err != nil {
+		return fmt.Errorf("failed to put object %q in %s: %w", key, store, err)
+	}
+	return nil
+}
+
+func (r *RealFTL) ObjectStoreGet(ctx context.Context, store *schema.Ref, key string) ([]byte, bool, error) {
+	s, err := r.objectStore(ctx, store)
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get object %q from %s: %w", key, store, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read object %q from %s: %w", key, store, err)
+	}
+	return data, true, nil
+}
+
+func (r *RealFTL) ObjectStoreDelete(ctx context.Context, store *schema.Ref, key string) error {
+	s, err := r.objectStore(ctx, store)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object %q from %s: %w", key, store, err)
+	}
+	return nil
+}
+
+func (r *RealFTL) ObjectStoreList(ctx context.Context, store *schema.Ref, prefix string) ([]ObjectInfo, error) {
+	s, err := r.objectStore(ctx, store)
+	if err != nil {
+		return nil, err
+	}
+	out := []ObjectInfo{}
+	pages := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects in %s: %w", store, err)
+		}
+		for _, object := range page.Contents {
+			out = append(out, ObjectInfo{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+	return out, nil
+}
+
+func (r *RealFTL) ObjectStorePresign(ctx context.Context, store *schema.Ref, method string, key string, expires time.Duration) (string, error) {
+	s, err := r.objectStore(ctx, store)
+	if err != nil {
+		return "", err
+	}
+	presigner := s3.NewPresignClient(s.client, s3.WithPresignExpires(expires))
+	var url string
+	switch method {
+	case http.MethodGet:
+		req, err := presigner.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to presign GET of %q in %s: %w", key, store, err)
+		}
+		url = req.URL
+	case http.MethodPut:
+		req, err := presigner.PresignPutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to presign PUT of %q in %s: %w", key, store, err)
+		}
+		url = req.URL
+	default:
+		return "", fmt.Errorf("unsupported presign method %q", method)
+	}
+	return url, nil
+}
@@ -28,6 +28,10 @@ var (
 	FtlDatabaseHandlePath = "github.com/block/ftl/go-runtime/ftl.DatabaseHandle"
 	// FtlTopicHandlePath is the path to the FTL topic handle type.
 	FtlTopicHandlePath = "github.com/block/ftl/go-runtime/ftl.TopicHandle"
+	// FtlKVHandlePath is the path to the FTL key-value store handle type.
+	FtlKVHandlePath = "github.com/block/ftl/go-runtime/ftl.KVHandle"
+	// FtlObjectStoreHandlePath is the path to the FTL object store handle type.
+	FtlObjectStoreHandlePath = "github.com/block/ftl/go-runtime/ftl.ObjectStoreHandle"
 	// FtlConfigTypePath is the path to the FTL config handle type.
 	FtlConfigTypePath = "github.com/block/ftl/go-runtime/ftl.Config"
 	// FtlSecretTypePath is the path to the FTL secret handle type.
@@ -787,6 +791,11 @@ func IsMysqlDatabaseConfigType(pass *analysis.Pass, typ types.Type) bool {
 	return implementsType(pass, typ, "github.com/block/ftl/go-runtime/ftl", "MySQLDatabaseConfig")
 }
 
+// IsSQLiteDatabaseConfigType will return true if the provided type implements the `SQLiteDatabaseConfig` type.
+func IsSQLiteDatabaseConfigType(pass *analysis.Pass, typ types.Type) bool {
+	return implementsType(pass, typ, "github.com/block/ftl/go-runtime/ftl", "SQLiteDatabaseConfig")
+}
+
 type VerbResourceType int
 
 const (
@@ -796,6 +805,8 @@ const (
 	VerbResourceTypeSecret
 	VerbResourceTypeTopicHandle
 	VerbResourceTypeVerbClient
+	VerbResourceTypeKVHandle
+	VerbResourceTypeObjectStoreHandle
 )
 
 func GetVerbResourceType(pass *analysis.Pass, obj types.Object) VerbResourceType {
@@ -816,6 +827,10 @@ func GetVerbResourceType(pass *analysis.Pass, obj types.Object) VerbResourceType
 			return VerbResourceTypeNone
 		case FtlTopicHandlePath:
 			return VerbResourceTypeTopicHandle
+		case FtlKVHandlePath:
+			return VerbResourceTypeKVHandle
+		case FtlObjectStoreHandlePath:
+			return VerbResourceTypeObjectStoreHandle
 		case FtlConfigTypePath:
 			return VerbResourceTypeConfig
 		case FtlSecretTypePath:
@@ -42,16 +42,21 @@ type Exportable interface {
 type DirectiveVerb struct {
 	Pos token.Pos
 
-	Verb   bool `parser:"@'verb'"`
-	Export bool `parser:"@'export'?"`
+	Verb    bool   `parser:"@'verb'"`
+	Export  bool   `parser:"@'export'?"`
+	Timeout string `parser:"('timeout' '=' @(Number (?! Whitespace) Ident))?"`
 }
 
 func (*DirectiveVerb) directive() {}
 func (d *DirectiveVerb) String() string {
+	components := []string{"ftl:verb"}
 	if d.Export {
-		return "ftl:verb export"
+		components = append(components, "export")
+	}
+	if d.Timeout != "" {
+		components = append(components, "timeout="+d.Timeout)
 	}
-	return "ftl:verb"
+	return strings.Join(components, " ")
 }
 func (d *DirectiveVerb) IsExported() bool {
 	return d.Export
@@ -252,6 +257,69 @@ func (d *DirectiveRetry) Catch() optional.Option[schema.Ref] {
 	})
 }
 
+// DirectiveIdempotent marks a verb as idempotent, optionally with a window
+// for which responses are retained.
+type DirectiveIdempotent struct {
+	Pos token.Pos
+
+	Window string `parser:"'idempotent' @(Number (?! Whitespace) Ident)?"`
+}
+
+func (*DirectiveIdempotent) directive() {}
+
+func (d *DirectiveIdempotent) String() string {
+	if d.Window == "" {
+		return "idempotent"
+	}
+	return "idempotent " + d.Window
+}
+func (*DirectiveIdempotent) GetTypeName() string { return "idempotent" }
+func (d *DirectiveIdempotent) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveIdempotent) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveIdempotent) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
+// DirectiveCircuitBreaker stops calls being sent to a verb while too many of
+// them are failing.
+type DirectiveCircuitBreaker struct {
+	Pos token.Pos
+
+	ErrorPercent *int   `parser:"'circuitbreaker' ('errors' '=' @Number)?"`
+	Latency      string `parser:"('latency' '=' @(Number (?! Whitespace) Ident))?"`
+	Cooldown     string `parser:"('cooldown' '=' @(Number (?! Whitespace) Ident))?"`
+}
+
+func (*DirectiveCircuitBreaker) directive() {}
+
+func (d *DirectiveCircuitBreaker) String() string {
+	components := []string{"circuitbreaker"}
+	if d.ErrorPercent != nil {
+		components = append(components, "errors="+strconv.Itoa(*d.ErrorPercent))
+	}
+	if d.Latency != "" {
+		components = append(components, "latency="+d.Latency)
+	}
+	if d.Cooldown != "" {
+		components = append(components, "cooldown="+d.Cooldown)
+	}
+	return strings.Join(components, " ")
+}
+func (*DirectiveCircuitBreaker) GetTypeName() string { return "circuitbreaker" }
+func (d *DirectiveCircuitBreaker) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveCircuitBreaker) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveCircuitBreaker) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.FuncDecl{}}
+}
+
 // DirectiveSubscriber is used to subscribe a sink to a subscription
 type DirectiveSubscriber struct {
 	Pos token.Pos
@@ -367,6 +435,29 @@ func (*DirectiveEncoding) MustAnnotate() []ast.Node {
 	return []ast.Node{&ast.FuncDecl{}}
 }
 
+// DirectiveDefault declares the JSON encoded default value of a config.
+type DirectiveDefault struct {
+	Pos token.Pos
+
+	Value string `parser:"'default' @String"`
+}
+
+func (*DirectiveDefault) directive() {}
+
+func (d *DirectiveDefault) String() string {
+	return "default " + strconv.Quote(d.Value)
+}
+func (*DirectiveDefault) GetTypeName() string { return "default" }
+func (d *DirectiveDefault) SetPosition(pos token.Pos) {
+	d.Pos = pos
+}
+func (d *DirectiveDefault) GetPosition() token.Pos {
+	return d.Pos
+}
+func (*DirectiveDefault) MustAnnotate() []ast.Node {
+	return []ast.Node{&ast.GenDecl{}}
+}
+
 var DirectiveParser = participle.MustBuild[directiveWrapper](
 	participle.Lexer(schema.Lexer),
 	participle.Elide("Whitespace"),
@@ -374,7 +465,8 @@ var DirectiveParser = participle.MustBuild[directiveWrapper](
 	participle.UseLookahead(2),
 	participle.Union[Directive](&DirectiveVerb{}, &DirectiveData{}, &DirectiveEnum{}, &DirectiveTypeAlias{},
 		&DirectiveIngress{}, &DirectiveCronJob{}, &DirectiveRetry{}, &DirectiveSubscriber{}, &DirectiveExport{},
-		&DirectiveTypeMap{}, &DirectiveEncoding{}),
+		&DirectiveTypeMap{}, &DirectiveEncoding{}, &DirectiveIdempotent{}, &DirectiveCircuitBreaker{},
+		&DirectiveDefault{}),
 	participle.Union[schema.IngressPathComponent](&schema.IngressPathLiteral{}, &schema.IngressPathParameter{}),
 )
 
@@ -83,6 +83,8 @@ type ExtractedMetadata struct {
 	IsExported bool
 	Metadata   []schema.Metadata
 	Comments   []string
+	// Default is the JSON encoded default value declared with "ftl:default".
+	Default optional.Option[string]
 }
 
 func (*ExtractedMetadata) schemaFactValue() {}
@@ -151,6 +153,7 @@ type DatabaseType string
 const (
 	DatabaseTypePostgres DatabaseType = "postgres"
 	DatabaseTypeMySQL    DatabaseType = "mysql"
+	DatabaseTypeSQLite   DatabaseType = "sqlite"
 )
 
 // DatabaseConfig marks a database node with an extracted configuration value.
@@ -41,6 +41,7 @@ func Extract(pass *analysis.Pass, obj types.Object, node *ast.TypeSpec) optional
 	}
 	if md, ok := common.GetFactForObject[*common.ExtractedMetadata](pass, obj).Get(); ok {
 		cfg.Comments = md.Comments
+		cfg.Default = md.Default.Ptr()
 	}
 	return optional.Some(cfg)
 }
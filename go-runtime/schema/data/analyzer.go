@@ -22,6 +22,7 @@ var (
 	Extractor = common.NewDeclExtractor[*schema.Data, *ast.TypeSpec]("data", Extract)
 
 	aliasFieldTag = "json"
+	ftlFieldTag   = "ftl"
 )
 
 func Extract(pass *analysis.Pass, node *ast.TypeSpec, obj types.Object) optional.Option[*schema.Data] {
@@ -98,6 +99,24 @@ func extractData(pass *analysis.Pass, node *ast.TypeSpec, named *types.Named) op
 						Alias: jsonFieldName,
 					})
 				}
+
+				ftlOption, err := parseTag(pass, f, tag, ftlFieldTag)
+				if err != nil {
+					fieldErrors = true
+					continue
+				}
+				switch ftlOption {
+				case "", "nonsensitive":
+					// Fields of secrets are redacted from logs unless they are marked nonsensitive, which has no schema representation.
+				case "sensitive":
+					metadata = append(metadata, &schema.MetadataSensitive{
+						Pos:       common.GoPosToSchemaPos(pass.Fset, node.Pos()),
+						Sensitive: true,
+					})
+				default:
+					common.TokenErrorf(pass, f.Pos(), name, "unsupported ftl tag %q on field %q, expected \"sensitive\" or \"nonsensitive\"", ftlOption, name)
+					fieldErrors = true
+				}
 			}
 			out.Fields = append(out.Fields, &schema.Field{
 				Pos:      common.GoPosToSchemaPos(pass.Fset, node.Pos()),
@@ -24,6 +24,8 @@ func Extract(pass *analysis.Pass, obj types.Object, node *ast.TypeSpec) optional
 		return extractDatabase(pass, obj, node, schema.PostgresDatabaseType, comments)
 	case mysql:
 		return extractDatabase(pass, obj, node, schema.MySQLDatabaseType, comments)
+	case sqlite:
+		return extractDatabase(pass, obj, node, schema.SQLiteDatabaseType, comments)
 	default:
 		return optional.None[*schema.Database]()
 	}
@@ -78,6 +80,7 @@ const (
 	none dbType = iota
 	postgres
 	mysql
+	sqlite
 )
 
 func getDBType(pass *analysis.Pass, node ast.Node) dbType {
@@ -92,5 +95,8 @@ func getDBType(pass *analysis.Pass, node ast.Node) dbType {
 	if common.IsMysqlDatabaseConfigType(pass, typ) {
 		return mysql
 	}
+	if common.IsSQLiteDatabaseConfigType(pass, typ) {
+		return sqlite
+	}
 	return none
 }
@@ -24,7 +24,9 @@ import (
 	"github.com/block/ftl/go-runtime/schema/enum"
 	"github.com/block/ftl/go-runtime/schema/finalize"
 	"github.com/block/ftl/go-runtime/schema/initialize"
+	"github.com/block/ftl/go-runtime/schema/kv"
 	"github.com/block/ftl/go-runtime/schema/metadata"
+	"github.com/block/ftl/go-runtime/schema/objectstore"
 	"github.com/block/ftl/go-runtime/schema/resourceconfig"
 	"github.com/block/ftl/go-runtime/schema/secret"
 	"github.com/block/ftl/go-runtime/schema/topic"
@@ -60,6 +62,8 @@ var extractors = [][]*analysis.Analyzer{
 		data.Extractor,
 		database.Extractor,
 		topic.Extractor,
+		kv.Extractor,
+		objectstore.Extractor,
 		typealias.Extractor,
 		typeenumvariant.Extractor,
 		valueenumvariant.Extractor,
@@ -398,7 +402,7 @@ func updateTransitiveVisibility(d schema.Decl, module *schema.Module) {
 				t.Export = true
 			case *schema.Verb:
 				t.Export = true
-			case *schema.Database, *schema.Config, *schema.Secret:
+			case *schema.Database, *schema.Config, *schema.Secret, *schema.KV, *schema.ObjectStore:
 			}
 			updateTransitiveVisibility(decl, module)
 		}
@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl-golang-tools/go/analysis"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/strcase"
+	"github.com/block/ftl/go-runtime/schema/common"
+)
+
+// Extractor extracts key-value stores.
+var Extractor = common.NewResourceDeclExtractor[*schema.KV]("kv", Extract, matchFunc)
+
+func Extract(pass *analysis.Pass, obj types.Object, node *ast.TypeSpec) optional.Option[*schema.KV] {
+	idxListExpr, ok := node.Type.(*ast.IndexListExpr)
+	if !ok || len(idxListExpr.Indices) != 2 {
+		common.Errorf(pass, node, "unsupported kv type; expected ftl.KVHandle[Key, Value]")
+		return optional.None[*schema.KV]()
+	}
+
+	key, ok := common.ExtractType(pass, idxListExpr.Indices[0]).Get()
+	if !ok {
+		common.Errorf(pass, idxListExpr.Indices[0], "unsupported kv key type")
+		return optional.None[*schema.KV]()
+	}
+	value, ok := common.ExtractType(pass, idxListExpr.Indices[1]).Get()
+	if !ok {
+		common.Errorf(pass, idxListExpr.Indices[1], "unsupported kv value type")
+		return optional.None[*schema.KV]()
+	}
+
+	name := strcase.ToLowerCamel(node.Name.Name)
+	if !schema.ValidateName(name) {
+		common.Errorf(pass, node, "kv names must be valid identifiers")
+		return optional.None[*schema.KV]()
+	}
+
+	kv := &schema.KV{
+		Pos:   common.GoPosToSchemaPos(pass.Fset, node.Pos()),
+		Name:  name,
+		Key:   key,
+		Value: value,
+	}
+	if md, ok := common.GetFactForObject[*common.ExtractedMetadata](pass, obj).Get(); ok {
+		kv.Comments = md.Comments
+	}
+	return optional.Some(kv)
+}
+
+func matchFunc(pass *analysis.Pass, node ast.Node, obj types.Object) bool {
+	return common.GetVerbResourceType(pass, obj) == common.VerbResourceTypeKVHandle
+}
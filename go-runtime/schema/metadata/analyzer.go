@@ -1,6 +1,7 @@
 package metadata
 
 import (
+	"encoding/json"
 	"go/ast"
 	"go/token"
 	"reflect"
@@ -74,6 +75,7 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 	exported := isExported(directives)
 	var declType schema.Decl
 	var metadata []schema.Metadata
+	var defaultValue optional.Option[string]
 	for _, dir := range directives {
 		var newSchType schema.Decl
 		if found.Contains(dir.GetTypeName()) && !canRepeatDirective(dir) {
@@ -126,6 +128,20 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 				MaxBackoff: dt.MaxBackoff,
 				Catch:      dt.Catch().Ptr(),
 			})
+		case *common.DirectiveIdempotent:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataIdempotent{
+				Pos:    common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				Window: dt.Window,
+			})
+		case *common.DirectiveCircuitBreaker:
+			newSchType = &schema.Verb{}
+			metadata = append(metadata, &schema.MetadataCircuitBreaker{
+				Pos:          common.GoPosToSchemaPos(pass.Fset, dt.Pos),
+				ErrorPercent: dt.ErrorPercent,
+				Latency:      dt.Latency,
+				Cooldown:     dt.Cooldown,
+			})
 		case *common.DirectiveSubscriber:
 			newSchType = &schema.Verb{}
 			metadata = append(metadata, &schema.MetadataSubscriber{
@@ -151,6 +167,12 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 			})
 		case *common.DirectiveVerb:
 			newSchType = &schema.Verb{}
+			if dt.Timeout != "" {
+				metadata = append(metadata, &schema.MetadataTimeout{
+					Pos:      common.GoPosToSchemaPos(pass.Fset, dt.GetPosition()),
+					Duration: dt.Timeout,
+				})
+			}
 		case *common.DirectiveData:
 			newSchType = &schema.Data{}
 		case *common.DirectiveEnum:
@@ -160,6 +182,12 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 			requireOnlyDirective(pass, node, directives, dt.GetTypeName())
 		case *common.DirectiveTypeAlias:
 			newSchType = &schema.TypeAlias{}
+		case *common.DirectiveDefault:
+			if !json.Valid([]byte(dt.Value)) {
+				common.NoEndColumnErrorf(pass, dt.GetPosition(), "ftl:default value %q is not valid JSON", dt.Value)
+				continue
+			}
+			defaultValue = optional.Some(dt.Value)
 		}
 		declType = updateDeclType(pass, node.Pos(), declType, newSchType)
 	}
@@ -169,6 +197,7 @@ func extractMetadata(pass *analysis.Pass, node ast.Node, doc *ast.CommentGroup)
 		Metadata:   metadata,
 		IsExported: exported,
 		Comments:   common.ExtractComments(doc),
+		Default:    defaultValue,
 	}
 	validateMetadata(pass, node, md)
 	return optional.Some(md)
@@ -0,0 +1,37 @@
+package objectstore
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl-golang-tools/go/analysis"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/strcase"
+	"github.com/block/ftl/go-runtime/schema/common"
+)
+
+// Extractor extracts object stores.
+var Extractor = common.NewResourceDeclExtractor[*schema.ObjectStore]("objectstore", Extract, matchFunc)
+
+func Extract(pass *analysis.Pass, obj types.Object, node *ast.TypeSpec) optional.Option[*schema.ObjectStore] {
+	name := strcase.ToLowerCamel(node.Name.Name)
+	if !schema.ValidateName(name) {
+		common.Errorf(pass, node, "object store names must be valid identifiers")
+		return optional.None[*schema.ObjectStore]()
+	}
+
+	store := &schema.ObjectStore{
+		Pos:  common.GoPosToSchemaPos(pass.Fset, node.Pos()),
+		Name: name,
+	}
+	if md, ok := common.GetFactForObject[*common.ExtractedMetadata](pass, obj).Get(); ok {
+		store.Comments = md.Comments
+	}
+	return optional.Some(store)
+}
+
+func matchFunc(pass *analysis.Pass, node ast.Node, obj types.Object) bool {
+	return common.GetVerbResourceType(pass, obj) == common.VerbResourceTypeObjectStoreHandle
+}
@@ -111,6 +111,9 @@ func getDBType(pass *analysis.Pass, receiver *ast.Ident, receiverType types.Type
 	if common.IsMysqlDatabaseConfigType(pass, receiverType) {
 		return common.DatabaseTypeMySQL
 	}
+	if common.IsSQLiteDatabaseConfigType(pass, receiverType) {
+		return common.DatabaseTypeSQLite
+	}
 	common.Errorf(pass, receiver, "unsupported database type %s", receiverType.String())
 	return ""
 }
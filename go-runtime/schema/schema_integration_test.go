@@ -67,7 +67,7 @@ func testExtractModuleSchema(t *testing.T) {
 	assert.NoError(t, err)
 	actual := schema.Normalise(r.Module)
 	expected := `module one {
-  config configValue one.Config
+  config configValue one.Config = "{\"field\": \"default\"}"
   secret secretValue String
 
   database postgres testDb
@@ -158,6 +158,7 @@ func testExtractModuleSchema(t *testing.T) {
     localTypeEnumRef one.BlobOrList
     externalValueEnumRef two.TwoEnum
     externalTypeEnumRef two.TypeEnum
+    token String +sensitive
   }
 
   export data Resp {
@@ -459,6 +460,8 @@ func testParsedirectives(t *testing.T) {
 	}{
 		{name: "Verb", input: "ftl:verb", expected: &common.DirectiveVerb{Verb: true}},
 		{name: "Verb export", input: "ftl:verb export", expected: &common.DirectiveVerb{Verb: true, Export: true}},
+		{name: "Verb timeout", input: "ftl:verb export timeout=5s", expected: &common.DirectiveVerb{Verb: true, Export: true, Timeout: "5s"}},
+		{name: "CircuitBreaker", input: "ftl:circuitbreaker latency=2s", expected: &common.DirectiveCircuitBreaker{Latency: "2s"}},
 		{name: "Data", input: "ftl:data", expected: &common.DirectiveData{Data: true}},
 		{name: "Data export", input: "ftl:data export", expected: &common.DirectiveData{Data: true, Export: true}},
 		{name: "Enum", input: "ftl:enum", expected: &common.DirectiveEnum{Enum: true}},
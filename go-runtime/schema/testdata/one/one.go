@@ -120,6 +120,7 @@ type Req struct {
 	LocalTypeEnumRef     BlobOrList
 	ExternalValueEnumRef two.TwoEnum
 	ExternalTypeEnumRef  two.TypeEnum
+	Token                string `ftl:"sensitive"`
 }
 type Resp struct{}
 
@@ -132,6 +133,7 @@ type ExportedData struct {
 	Field string
 }
 
+//ftl:default "{\"field\": \"default\"}"
 type ConfigValue = ftl.Config[Config]
 type SecretValue = ftl.Secret[string]
 
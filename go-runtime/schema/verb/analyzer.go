@@ -29,6 +29,10 @@ func (r resource) toMetadataType() (schema.Metadata, error) {
 		return &schema.MetadataDatabases{}, nil
 	case common.VerbResourceTypeTopicHandle:
 		return &schema.MetadataPublisher{}, nil
+	case common.VerbResourceTypeKVHandle:
+		return &schema.MetadataKV{}, nil
+	case common.VerbResourceTypeObjectStoreHandle:
+		return &schema.MetadataObjectStore{}, nil
 	case common.VerbResourceTypeConfig:
 		return &schema.MetadataConfig{}, nil
 	case common.VerbResourceTypeSecret:
@@ -89,6 +93,10 @@ func Extract(pass *analysis.Pass, node *ast.FuncDecl, obj types.Object) optional
 				verb.AddDatabase(r.ref)
 			case common.VerbResourceTypeTopicHandle:
 				verb.AddTopicPublish(r.ref)
+			case common.VerbResourceTypeKVHandle:
+				verb.AddKV(r.ref)
+			case common.VerbResourceTypeObjectStoreHandle:
+				verb.AddObjectStore(r.ref)
 			case common.VerbResourceTypeConfig:
 				verb.AddConfig(r.ref)
 			case common.VerbResourceTypeSecret:
@@ -209,6 +217,16 @@ func resolveResource(pass *analysis.Pass, typ ast.Expr) (*resource, error) {
 		if ref, ok = common.ExtractSimpleRefWithCasing(pass, typ, strcase.ToLowerCamel).Get(); !ok {
 			return nil, fmt.Errorf("unsupported verb parameter type; expected ftl.TopicHandle[Event, PartitionMapper]")
 		}
+	case common.VerbResourceTypeKVHandle:
+		var ok bool
+		if ref, ok = common.ExtractSimpleRefWithCasing(pass, typ, strcase.ToLowerCamel).Get(); !ok {
+			return nil, fmt.Errorf("unsupported verb parameter type; expected ftl.KVHandle[Key, Value]")
+		}
+	case common.VerbResourceTypeObjectStoreHandle:
+		var ok bool
+		if ref, ok = common.ExtractSimpleRefWithCasing(pass, typ, strcase.ToLowerCamel).Get(); !ok {
+			return nil, fmt.Errorf("unsupported verb parameter type; expected an alias of ftl.ObjectStoreHandle")
+		}
 	}
 	if ref == nil {
 		return nil, fmt.Errorf("unsupported verb parameter type")
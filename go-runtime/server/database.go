@@ -40,6 +40,9 @@ func InitPostgres(ref reflection.Ref) *reflection.ReflectedDatabaseHandle {
 func InitMySQL(ref reflection.Ref) *reflection.ReflectedDatabaseHandle {
 	return InitDatabase(ref, "mysql", deploymentcontext.DBTypeMySQL, "mysql")
 }
+func InitSQLite(ref reflection.Ref) *reflection.ReflectedDatabaseHandle {
+	return InitDatabase(ref, "sqlite", deploymentcontext.DBTypeSQLite, "sqlite")
+}
 
 func InitDatabase(ref reflection.Ref, dbtype string, protoDBtype deploymentcontext.DBType, driver string) *reflection.ReflectedDatabaseHandle {
 	return &reflection.ReflectedDatabaseHandle{
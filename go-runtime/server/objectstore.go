@@ -0,0 +1,19 @@
+package server
+
+import (
+	"reflect"
+
+	"github.com/block/ftl/common/reflection"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/go-runtime/ftl"
+)
+
+func ObjectStoreHandle(module, name string) reflection.VerbResource {
+	handle := ftl.ObjectStoreHandle{Ref: &schema.Ref{
+		Name:   name,
+		Module: module,
+	}}
+	return func() reflect.Value {
+		return reflect.ValueOf(handle)
+	}
+}
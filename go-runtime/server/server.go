@@ -12,7 +12,9 @@ import (
 	"connectrpc.com/connect"
 	"github.com/alecthomas/types/optional"
 
+	asyncconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1/asynccallpbconnect"
 	deploymentconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1/deploymentpbconnect"
+	kvconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1/kvpbconnect"
 	leaseconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/lease/v1/leasepbconnect"
 	pubconnect "github.com/block/ftl/backend/protos/xyz/block/ftl/publish/v1/publishpbconnect"
 	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
@@ -47,8 +49,12 @@ func NewUserVerbServer(projectName string, moduleName string, handlers ...Handle
 		ctx = rpc.ContextWithClient(ctx, verbServiceClient)
 		pubClient := rpc.Dial(pubconnect.NewPublishServiceClient, uc.FTLEndpoint.String(), log.Error)
 		ctx = rpc.ContextWithClient(ctx, pubClient)
+		kvClient := rpc.Dial(kvconnect.NewKVServiceClient, uc.FTLEndpoint.String(), log.Error)
+		ctx = rpc.ContextWithClient(ctx, kvClient)
 		leaseClient := rpc.Dial(leaseconnect.NewLeaseServiceClient, uc.FTLEndpoint.String(), log.Error)
 		ctx = rpc.ContextWithClient(ctx, leaseClient)
+		asyncClient := rpc.Dial(asyncconnect.NewAsyncServiceClient, uc.FTLEndpoint.String(), log.Error)
+		ctx = rpc.ContextWithClient(ctx, asyncClient)
 
 		moduleContextSupplier := deploymentcontext.NewDeploymentContextSupplier(moduleServiceClient)
 		// FTL_DEPLOYMENT is set by the FTL runtime.
@@ -205,6 +211,12 @@ func call[Verb, Req, Resp any]() func(ctx context.Context, req Req) (resp Resp,
 			if r, ok := uncheckedResp.(Resp); ok {
 				return r, nil
 			}
+			if encoded, ok := uncheckedResp.(deploymentcontext.EncodedResponse); ok {
+				if err := encoding.Unmarshal(encoded, &resp); err != nil {
+					return resp, fmt.Errorf("%s: failed to decode response: %w", ref, err)
+				}
+				return resp, nil
+			}
 			return resp, fmt.Errorf("%s: overridden verb had invalid response type %T, expected %v", ref,
 				uncheckedResp, reflect.TypeFor[Resp]())
 		}
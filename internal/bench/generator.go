@@ -0,0 +1,352 @@
+package bench
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/titanous/json5"
+
+	"github.com/block/ftl/common/schema"
+)
+
+// maxGenerateDepth limits how deeply nested generated values can be, to avoid unbounded recursion through
+// self-referential types.
+const maxGenerateDepth = 5
+
+// Generator produces the request payload for each call of a load test.
+//
+// Payloads are JSON5 objects which may contain text/template actions, eg. `{id: {{.Seq}}, email: "{{fake "email"}}"}`.
+// If a request type is set with GenerateFrom, a value is also generated for every field of the type, and the
+// fields of the payload override the generated ones.
+type Generator struct {
+	template *template.Template
+	// request is the payload if it is not a template.
+	request map[string]any
+
+	schema      *schema.Schema
+	requestType schema.Type
+}
+
+// NewGenerator creates a Generator for a JSON5 payload.
+func NewGenerator(request string) (*Generator, error) {
+	g := &Generator{}
+	if !strings.Contains(request, "{{") {
+		if err := json5.Unmarshal([]byte(request), &g.request); err != nil {
+			return nil, fmt.Errorf("invalid request: %w", err)
+		}
+		return g, nil
+	}
+	tmpl, err := template.New("request").Funcs(templateFuncs).Parse(request)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request template: %w", err)
+	}
+	g.template = tmpl
+	// Render once so that errors are reported before the load test starts.
+	if _, err := g.render(0); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// GenerateFrom sets the type of the request, so that a value is generated for each of its fields.
+func (g *Generator) GenerateFrom(sch *schema.Schema, requestType schema.Type) error {
+	g.schema = sch
+	g.requestType = requestType
+	_, err := g.Request(0)
+	return err
+}
+
+// TemplateData is the data available to request templates.
+type TemplateData struct {
+	// Seq is the sequence number of the request, starting at 0.
+	Seq int64
+}
+
+// Request returns the JSON encoded payload for the request with the given sequence number.
+func (g *Generator) Request(seq int64) ([]byte, error) {
+	request := g.request
+	if g.template != nil {
+		rendered, err := g.render(seq)
+		if err != nil {
+			return nil, err
+		}
+		request = rendered
+	}
+	if g.requestType != nil {
+		generated, err := g.generate(g.requestType, "", 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate request: %w", err)
+		}
+		if generated, ok := generated.(map[string]any); ok {
+			request = merge(generated, request)
+		}
+	}
+	if request == nil {
+		request = map[string]any{}
+	}
+	out, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	return out, nil
+}
+
+func (g *Generator) render(seq int64) (map[string]any, error) {
+	buf := &bytes.Buffer{}
+	if err := g.template.Execute(buf, TemplateData{Seq: seq}); err != nil {
+		return nil, fmt.Errorf("failed to render request template: %w", err)
+	}
+	out := map[string]any{}
+	if err := json5.Unmarshal(buf.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("request template rendered invalid JSON5 %q: %w", buf.String(), err)
+	}
+	return out, nil
+}
+
+// merge overrides the values in dst with those in src, merging nested objects.
+func merge(dst, src map[string]any) map[string]any {
+	for key, value := range src {
+		srcObject, srcOK := value.(map[string]any)
+		dstObject, dstOK := dst[key].(map[string]any)
+		if srcOK && dstOK {
+			dst[key] = merge(dstObject, srcObject)
+		} else {
+			dst[key] = value
+		}
+	}
+	return dst
+}
+
+// generate a random value of the given type, using the field name to pick realistic strings.
+func (g *Generator) generate(t schema.Type, field string, depth int) (any, error) {
+	switch t := t.(type) {
+	case *schema.Int:
+		if strings.EqualFold(field, "age") || strings.HasSuffix(field, "Age") || strings.HasSuffix(field, "_age") {
+			return 18 + rand.Intn(72), nil //nolint:gosec
+		}
+		return rand.Intn(1000), nil //nolint:gosec
+	case *schema.Float:
+		return rand.Float64() * 1000, nil //nolint:gosec
+	case *schema.String:
+		return fakeForField(field), nil
+	case *schema.Bool:
+		return rand.Intn(2) == 1, nil //nolint:gosec
+	case *schema.Bytes:
+		data := make([]byte, 16)
+		_, _ = rand.Read(data) //nolint:gosec
+		return base64.StdEncoding.EncodeToString(data), nil
+	case *schema.Time:
+		return time.Now().UTC().Format(time.RFC3339Nano), nil
+	case *schema.Unit:
+		return map[string]any{}, nil
+	case *schema.Any:
+		return fakeForField(field), nil
+	case *schema.Optional:
+		if depth >= maxGenerateDepth {
+			return nil, nil
+		}
+		return g.generate(t.Type, field, depth+1)
+	case *schema.Array:
+		out := []any{}
+		if depth >= maxGenerateDepth {
+			return out, nil
+		}
+		for range 1 + rand.Intn(3) { //nolint:gosec
+			value, err := g.generate(t.Element, field, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, value)
+		}
+		return out, nil
+	case *schema.Map:
+		out := map[string]any{}
+		if depth >= maxGenerateDepth {
+			return out, nil
+		}
+		for range 1 + rand.Intn(2) { //nolint:gosec
+			value, err := g.generate(t.Value, field, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			out[fake("word")] = value
+		}
+		return out, nil
+	case *schema.Ref:
+		return g.generateRef(t, field, depth)
+	default:
+		return nil, fmt.Errorf("cannot generate a value for %s", t)
+	}
+}
+
+func (g *Generator) generateRef(ref *schema.Ref, field string, depth int) (any, error) {
+	if g.schema == nil {
+		return nil, fmt.Errorf("cannot resolve %s without a schema", ref)
+	}
+	decl, ok := g.schema.Resolve(ref).Get()
+	if !ok {
+		return nil, fmt.Errorf("unknown ref %s", ref)
+	}
+	switch decl := decl.(type) {
+	case *schema.Data:
+		data, err := decl.Monomorphise(ref)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ref, err)
+		}
+		out := map[string]any{}
+		for _, f := range data.Fields {
+			value, err := g.generate(f.Type, f.Name, depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", ref, f.Name, err)
+			}
+			out[f.Alias(schema.AliasKindJSON).Default(f.Name)] = value
+		}
+		return out, nil
+	case *schema.Enum:
+		if len(decl.Variants) == 0 {
+			return nil, fmt.Errorf("enum %s has no variants", ref)
+		}
+		variant := decl.Variants[rand.Intn(len(decl.Variants))] //nolint:gosec
+		if decl.IsValueEnum() {
+			return variant.Value.GetValue(), nil
+		}
+		typeValue, ok := variant.Value.(*schema.TypeValue)
+		if !ok {
+			return nil, fmt.Errorf("enum %s variant %s has no type", ref, variant.Name)
+		}
+		value, err := g.generate(typeValue.Value, field, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"name": variant.Name, "value": value}, nil
+	case *schema.TypeAlias:
+		return g.generate(decl.Type, field, depth)
+	default:
+		return nil, fmt.Errorf("cannot generate a value for %s", ref)
+	}
+}
+
+// fakeForField returns a realistic string for a field, based on its name.
+func fakeForField(field string) string {
+	name := strings.ToLower(strings.ReplaceAll(field, "_", ""))
+	switch {
+	case strings.Contains(name, "email"):
+		return fake("email")
+	case strings.Contains(name, "firstname"):
+		return fake("firstName")
+	case strings.Contains(name, "lastname"), strings.Contains(name, "surname"):
+		return fake("lastName")
+	case strings.Contains(name, "phone"):
+		return fake("phone")
+	case strings.Contains(name, "city"):
+		return fake("city")
+	case strings.Contains(name, "country"):
+		return fake("country")
+	case strings.Contains(name, "company"):
+		return fake("company")
+	case strings.Contains(name, "url"), strings.Contains(name, "website"):
+		return fake("url")
+	case strings.Contains(name, "name"):
+		return fake("name")
+	case name == "id", strings.HasSuffix(field, "Id"), strings.HasSuffix(field, "ID"), strings.HasSuffix(field, "_id"), strings.Contains(name, "uuid"):
+		return uuid.NewString()
+	default:
+		return fake("word")
+	}
+}
+
+var (
+	firstNames = []string{"Alice", "Bob", "Carol", "Dave", "Erin", "Frank", "Grace", "Heidi", "Ivan", "Judy"}
+	lastNames  = []string{"Smith", "Jones", "Taylor", "Brown", "Williams", "Wilson", "Johnson", "Davies", "Patel", "Wright"}
+	cities     = []string{"Sydney", "London", "New York", "Toronto", "Berlin", "Tokyo", "Paris", "Melbourne", "Dublin", "Austin"}
+	countries  = []string{"Australia", "United Kingdom", "United States", "Canada", "Germany", "Japan", "France", "Ireland"}
+	companies  = []string{"Acme", "Globex", "Initech", "Umbrella", "Hooli", "Soylent", "Stark", "Wayne"}
+	words      = []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel", "india", "juliet"}
+)
+
+// fakeKinds are the kinds of values supported by fake.
+var fakeKinds = []string{"name", "firstName", "lastName", "email", "phone", "city", "country", "company", "url", "word", "sentence", "uuid"}
+
+// fake returns a realistic random value of the given kind, or an empty string if the kind is unknown.
+func fake(kind string) string {
+	switch kind {
+	case "name":
+		return pick(firstNames) + " " + pick(lastNames)
+	case "firstName":
+		return pick(firstNames)
+	case "lastName":
+		return pick(lastNames)
+	case "email":
+		return fmt.Sprintf("%s.%s%d@example.com", strings.ToLower(pick(firstNames)), strings.ToLower(pick(lastNames)), rand.Intn(1000)) //nolint:gosec
+	case "phone":
+		return fmt.Sprintf("+1-555-%03d-%04d", rand.Intn(1000), rand.Intn(10000)) //nolint:gosec
+	case "city":
+		return pick(cities)
+	case "country":
+		return pick(countries)
+	case "company":
+		return pick(companies) + " " + pick([]string{"Inc", "LLC", "Ltd", "Corp"})
+	case "url":
+		return fmt.Sprintf("https://%s.example.com/%s", pick(words), pick(words))
+	case "word":
+		return pick(words)
+	case "sentence":
+		out := make([]string, 4+rand.Intn(6)) //nolint:gosec
+		for i := range out {
+			out[i] = pick(words)
+		}
+		return strings.ToUpper(out[0][:1]) + strings.Join(out, " ")[1:] + "."
+	case "uuid":
+		return uuid.NewString()
+	default:
+		return ""
+	}
+}
+
+func pick[T any](values []T) T {
+	return values[rand.Intn(len(values))] //nolint:gosec
+}
+
+const randomStringChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+var templateFuncs = template.FuncMap{
+	"randInt": func(minValue, maxValue int) int {
+		if maxValue <= minValue {
+			return minValue
+		}
+		return minValue + rand.Intn(maxValue-minValue+1) //nolint:gosec
+	},
+	"randFloat": func(minValue, maxValue float64) float64 {
+		return minValue + rand.Float64()*(maxValue-minValue) //nolint:gosec
+	},
+	"randString": func(n int) string {
+		out := make([]byte, n)
+		for i := range out {
+			out[i] = randomStringChars[rand.Intn(len(randomStringChars))] //nolint:gosec
+		}
+		return string(out)
+	},
+	"randBool": func() bool { return rand.Intn(2) == 1 }, //nolint:gosec
+	"uuid":     uuid.NewString,
+	"now":      func() string { return time.Now().UTC().Format(time.RFC3339Nano) },
+	"fake": func(kind string) (string, error) {
+		value := fake(kind)
+		if value == "" {
+			return "", fmt.Errorf("unknown fake value %q, expected one of %s", kind, strings.Join(fakeKinds, ", "))
+		}
+		return value, nil
+	},
+	"pick": func(values ...any) (any, error) {
+		if len(values) == 0 {
+			return nil, fmt.Errorf("pick requires at least one value")
+		}
+		return pick(values), nil
+	},
+}
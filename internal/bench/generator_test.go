@@ -0,0 +1,110 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+)
+
+func TestGeneratorTemplate(t *testing.T) {
+	g, err := NewGenerator(`{seq: {{.Seq}}, user: "{{fake "email"}}", id: "{{uuid}}", n: {{randInt 5 5}}, kind: "{{pick "a" "a"}}"}`)
+	assert.NoError(t, err)
+	request := decodeRequest(t, g, 7)
+	assert.Equal(t, 7.0, request["seq"])
+	assert.Equal(t, 5.0, request["n"])
+	assert.Equal(t, "a", request["kind"])
+	assert.Contains(t, request["user"].(string), "@example.com")
+	assert.Equal(t, 36, len(request["id"].(string)))
+
+	_, err = NewGenerator(`{user: "{{fake "nope"}}"}`)
+	assert.EqualError(t, err, `failed to render request template: template: request:1:10: executing "request" at <fake "nope">: error calling fake: unknown fake value "nope", expected one of name, firstName, lastName, email, phone, city, country, company, url, word, sentence, uuid`)
+
+	_, err = NewGenerator(`{seq: {{.Seq}}`)
+	assert.Error(t, err)
+
+	g, err = NewGenerator(`{name: "static"}`)
+	assert.NoError(t, err)
+	data, err := g.Request(1)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"static"}`, string(data))
+}
+
+func TestGeneratorFromSchema(t *testing.T) {
+	sch, err := schema.ParseString("test", `
+		module test {
+			enum Colour: String {
+				Red = "red"
+				Blue = "blue"
+			}
+			enum Shape {
+				Circle Float
+				Label String
+			}
+			data Address {
+				city String
+				country String
+			}
+			data Pair<T> {
+				left T
+				right T
+			}
+			data Request {
+				email String +alias json "emailAddress"
+				userId String
+				age Int
+				active Bool
+				createdAt Time
+				tags [String]
+				attributes {String: Int}
+				address test.Address?
+				colour test.Colour
+				shape test.Shape
+				pair test.Pair<Int>
+				avatar Bytes
+				note String
+			}
+			verb handle(test.Request) Unit
+		}
+	`)
+	assert.NoError(t, err)
+	ref := &schema.Ref{Module: "test", Name: "Request"}
+
+	g, err := NewGenerator(`{note: "fixed-{{.Seq}}", address: {city: "Sydney"}}`)
+	assert.NoError(t, err)
+	assert.NoError(t, g.GenerateFrom(sch, ref))
+	for seq := range int64(10) {
+		request := decodeRequest(t, g, seq)
+		assert.Contains(t, request["emailAddress"].(string), "@example.com")
+		assert.Equal(t, 36, len(request["userId"].(string)))
+		age := request["age"].(float64)
+		assert.True(t, age >= 18 && age < 90, "age %v", age)
+		assert.True(t, request["colour"] == "red" || request["colour"] == "blue", "colour %v", request["colour"])
+		assert.Equal(t, any(fmt.Sprintf("fixed-%d", seq)), request["note"])
+		address := request["address"].(map[string]any)
+		assert.Equal(t, "Sydney", address["city"])
+		assert.NotEqual(t, "", address["country"])
+
+		// The generated request must be valid for the verb.
+		fields, err := schema.TransformFromAliasedFields(ref, sch, request)
+		assert.NoError(t, err)
+		assert.NoError(t, schema.ValidateRequestMap(ref, nil, fields, sch))
+	}
+
+	g, err = NewGenerator(`{}`)
+	assert.NoError(t, err)
+	err = g.GenerateFrom(sch, &schema.Ref{Module: "test", Name: "Missing"})
+	assert.EqualError(t, err, "failed to generate request: unknown ref test.Missing")
+}
+
+func decodeRequest(t *testing.T, g *Generator, seq int64) map[string]any {
+	t.Helper()
+	data, err := g.Request(seq)
+	assert.NoError(t, err)
+	out := map[string]any{}
+	assert.NoError(t, json.Unmarshal(data, &out))
+	return out
+}
@@ -0,0 +1,148 @@
+// Package bench implements load generation for benchmarking verbs.
+package bench
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stage is a period of a load test over which the request rate changes linearly to Rate.
+type Stage struct {
+	Duration time.Duration
+	// Rate is the target number of requests per second at the end of the stage.
+	Rate float64
+}
+
+// ParseStage parses a stage of the form "DURATION:RATE", eg. "30s:100".
+func ParseStage(s string) (Stage, error) {
+	durationStr, rateStr, ok := strings.Cut(s, ":")
+	if !ok {
+		return Stage{}, fmt.Errorf("invalid stage %q: expected DURATION:RATE", s)
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil || duration <= 0 {
+		return Stage{}, fmt.Errorf("invalid stage %q: invalid duration %q", s, durationStr)
+	}
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || rate < 0 {
+		return Stage{}, fmt.Errorf("invalid stage %q: invalid rate %q", s, rateStr)
+	}
+	return Stage{Duration: duration, Rate: rate}, nil
+}
+
+// UnmarshalText allows Stage to be used as a flag.
+func (s *Stage) UnmarshalText(text []byte) error {
+	stage, err := ParseStage(string(text))
+	if err != nil {
+		return err
+	}
+	*s = stage
+	return nil
+}
+
+// Profile describes how the request rate of a load test changes over time.
+type Profile struct {
+	// InitialRate is the rate at the start of the first stage.
+	InitialRate float64
+	Stages      []Stage
+}
+
+// Duration returns the total duration of the load test.
+func (p Profile) Duration() time.Duration {
+	var total time.Duration
+	for _, stage := range p.Stages {
+		total += stage.Duration
+	}
+	return total
+}
+
+// RateAt returns the target number of requests per second at the given time since the start of the load test.
+func (p Profile) RateAt(elapsed time.Duration) float64 {
+	from := p.InitialRate
+	for _, stage := range p.Stages {
+		if elapsed < stage.Duration {
+			return from + (stage.Rate-from)*float64(elapsed)/float64(stage.Duration)
+		}
+		elapsed -= stage.Duration
+		from = stage.Rate
+	}
+	return from
+}
+
+// tickInterval is how often the open-loop scheduler issues requests.
+const tickInterval = time.Millisecond
+
+// RunOpenLoop issues requests at the rate given by the profile, regardless of how long each request takes.
+//
+// call is invoked in its own goroutine with the sequence number of each request. If maxInFlight requests are
+// already in flight, the request is dropped rather than delayed, so that a slow server cannot reduce the offered
+// load. Returns the number of dropped requests once all in-flight requests have completed.
+func RunOpenLoop(ctx context.Context, profile Profile, maxInFlight int, call func(ctx context.Context, seq int64)) int64 {
+	var dropped int64
+	var seq int64
+	inFlight := make(chan struct{}, maxInFlight)
+	wg := sync.WaitGroup{}
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	last := start
+	credit := 0.0
+	total := profile.Duration()
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case now := <-ticker.C:
+			elapsed := now.Sub(start)
+			if elapsed >= total {
+				break loop
+			}
+			credit += profile.RateAt(elapsed) * now.Sub(last).Seconds()
+			last = now
+			for ; credit >= 1; credit-- {
+				select {
+				case inFlight <- struct{}{}:
+				default:
+					atomic.AddInt64(&dropped, 1)
+					continue
+				}
+				wg.Add(1)
+				go func(seq int64) {
+					defer wg.Done()
+					defer func() { <-inFlight }()
+					call(ctx, seq)
+				}(seq)
+				seq++
+			}
+		}
+	}
+	wg.Wait()
+	return dropped
+}
+
+// RunClosedLoop runs parallelism workers that each make count calls in sequence, so that each request is only issued
+// once the previous request from the same worker has completed.
+func RunClosedLoop(ctx context.Context, parallelism, count int, call func(ctx context.Context, seq int64)) {
+	var seq int64
+	wg := sync.WaitGroup{}
+	for range parallelism {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range count {
+				if ctx.Err() != nil {
+					return
+				}
+				call(ctx, atomic.AddInt64(&seq, 1)-1)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,67 @@
+package bench
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestParseStage(t *testing.T) {
+	stage, err := ParseStage("30s:100")
+	assert.NoError(t, err)
+	assert.Equal(t, Stage{Duration: 30 * time.Second, Rate: 100}, stage)
+
+	for _, invalid := range []string{"30s", "30:100", "0s:100", "30s:-1", "30s:fast"} {
+		_, err := ParseStage(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestProfileRateAt(t *testing.T) {
+	profile := Profile{Stages: []Stage{
+		{Duration: 10 * time.Second, Rate: 100},
+		{Duration: 20 * time.Second, Rate: 100},
+		{Duration: 10 * time.Second, Rate: 0},
+	}}
+	assert.Equal(t, 40*time.Second, profile.Duration())
+	assert.Equal(t, 0.0, profile.RateAt(0))
+	assert.Equal(t, 50.0, profile.RateAt(5*time.Second))
+	assert.Equal(t, 100.0, profile.RateAt(20*time.Second))
+	assert.Equal(t, 50.0, profile.RateAt(35*time.Second))
+	assert.Equal(t, 0.0, profile.RateAt(time.Minute))
+
+	constant := Profile{InitialRate: 10, Stages: []Stage{{Duration: time.Second, Rate: 10}}}
+	assert.Equal(t, 10.0, constant.RateAt(500*time.Millisecond))
+}
+
+func TestRunOpenLoop(t *testing.T) {
+	var calls int64
+	profile := Profile{InitialRate: 200, Stages: []Stage{{Duration: 500 * time.Millisecond, Rate: 200}}}
+	dropped := RunOpenLoop(context.Background(), profile, 1000, func(ctx context.Context, seq int64) {
+		atomic.AddInt64(&calls, 1)
+	})
+	assert.Equal(t, int64(0), dropped)
+	assert.True(t, calls >= 80 && calls <= 100, "expected about 100 calls but got %d", calls)
+
+	// Calls that never complete in time cause subsequent requests to be dropped rather than delayed.
+	calls = 0
+	dropped = RunOpenLoop(context.Background(), profile, 5, func(ctx context.Context, seq int64) {
+		atomic.AddInt64(&calls, 1)
+		time.Sleep(time.Second)
+	})
+	assert.Equal(t, int64(5), calls)
+	assert.True(t, dropped >= 75, "expected most requests to be dropped but got %d", dropped)
+}
+
+func TestRunClosedLoop(t *testing.T) {
+	seen := make([]int64, 40)
+	RunClosedLoop(context.Background(), 4, 10, func(ctx context.Context, seq int64) {
+		atomic.AddInt64(&seen[seq], 1)
+	})
+	for seq, count := range seen {
+		assert.Equal(t, int64(1), count, "seq %d", seq)
+	}
+}
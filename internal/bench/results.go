@@ -0,0 +1,305 @@
+package bench
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/HdrHistogram/hdrhistogram-go"
+)
+
+const (
+	// Latencies are recorded in microseconds, from 1µs up to an hour.
+	minLatency        = 1
+	maxLatency        = int64(time.Hour / time.Microsecond)
+	significantDigits = 3
+)
+
+// lateCallWindow is how long after the end of an interval the latencies of calls that started in it are still recorded
+// against it. An interval is summarised once the window has passed and its histogram is reused, so that the memory
+// used does not grow with the length of the load test.
+const lateCallWindow = time.Minute
+
+// ErrorVerb is the error kind recorded for calls that reached the verb, but the verb returned an error.
+const ErrorVerb = "verb"
+
+// ErrorKind returns the kind of error to record for a failed call, which is its Connect code.
+func ErrorKind(err error) string {
+	return connect.CodeOf(err).String()
+}
+
+// Recorder records the outcome of each call made during a load test.
+//
+// It is safe for concurrent use.
+type Recorder struct {
+	lock     sync.Mutex
+	start    time.Time
+	interval time.Duration
+	total    *bucket
+	// intervals are indexed by the number of intervals since start.
+	intervals []*bucket
+	// summarised is the number of intervals from the start that have been summarised.
+	summarised int
+	// histograms are released by summarised intervals, for reuse.
+	histograms []*hdrhistogram.Histogram
+}
+
+type bucket struct {
+	// latencies is created on the first successful call, and released once the bucket is summarised.
+	latencies *hdrhistogram.Histogram
+	// latency is the summary of latencies, once the bucket is summarised.
+	latency    Latency
+	summarised bool
+	requests   int64
+	errors     map[string]int64
+}
+
+func newBucket() *bucket {
+	return &bucket{errors: map[string]int64{}}
+}
+
+func (b *bucket) record(latency time.Duration, errorKind string, newHistogram func() *hdrhistogram.Histogram) {
+	b.requests++
+	if errorKind != "" {
+		b.errors[errorKind]++
+		return
+	}
+	if b.summarised {
+		return
+	}
+	if b.latencies == nil {
+		b.latencies = newHistogram()
+	}
+	_ = b.latencies.RecordValue(min(max(latency.Microseconds(), minLatency), maxLatency)) //nolint:errcheck
+}
+
+func (b *bucket) latencySummary() Latency {
+	if b.summarised || b.latencies == nil {
+		return b.latency
+	}
+	return latencyFromHistogram(b.latencies)
+}
+
+func newHistogram() *hdrhistogram.Histogram {
+	return hdrhistogram.New(minLatency, maxLatency, significantDigits)
+}
+
+// NewRecorder creates a Recorder for a load test starting at start, which also reports results for each interval.
+func NewRecorder(start time.Time, interval time.Duration) *Recorder {
+	return &Recorder{start: start, interval: interval, total: newBucket()}
+}
+
+// Record a call that was made at the given time.
+//
+// errorKind is empty if the call succeeded. Latencies are only recorded for successful calls, so that fast failures
+// do not skew them. The latencies of calls that complete more than lateCallWindow after the end of the interval they
+// started in are only included in the total.
+func (r *Recorder) Record(at time.Time, latency time.Duration, errorKind string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.total.record(latency, errorKind, newHistogram)
+	index := max(int(at.Sub(r.start)/r.interval), 0)
+	for len(r.intervals) <= index {
+		r.intervals = append(r.intervals, newBucket())
+	}
+	r.intervals[index].record(latency, errorKind, r.intervalHistogram)
+	r.summariseBefore(at.Add(latency).Add(-lateCallWindow))
+}
+
+// intervalHistogram returns a histogram released by a summarised interval, or a new one.
+func (r *Recorder) intervalHistogram() *hdrhistogram.Histogram {
+	if len(r.histograms) == 0 {
+		return newHistogram()
+	}
+	h := r.histograms[len(r.histograms)-1]
+	r.histograms = r.histograms[:len(r.histograms)-1]
+	return h
+}
+
+// summariseBefore summarises the intervals that ended before t, releasing their histograms.
+func (r *Recorder) summariseBefore(t time.Time) {
+	for ; r.summarised < len(r.intervals); r.summarised++ {
+		if r.start.Add(time.Duration(r.summarised+1) * r.interval).After(t) {
+			return
+		}
+		b := r.intervals[r.summarised]
+		b.latency = b.latencySummary()
+		b.summarised = true
+		if b.latencies != nil {
+			b.latencies.Reset()
+			r.histograms = append(r.histograms, b.latencies)
+			b.latencies = nil
+		}
+	}
+}
+
+// Latency percentiles, in milliseconds.
+type Latency struct {
+	MinMs    float64 `json:"minMs"`
+	MeanMs   float64 `json:"meanMs"`
+	P50Ms    float64 `json:"p50Ms"`
+	P90Ms    float64 `json:"p90Ms"`
+	P95Ms    float64 `json:"p95Ms"`
+	P99Ms    float64 `json:"p99Ms"`
+	P999Ms   float64 `json:"p999Ms"`
+	MaxMs    float64 `json:"maxMs"`
+	StdDevMs float64 `json:"stdDevMs"`
+}
+
+func latencyFromHistogram(h *hdrhistogram.Histogram) Latency {
+	if h.TotalCount() == 0 {
+		return Latency{}
+	}
+	ms := func(us int64) float64 { return float64(us) / 1000 }
+	return Latency{
+		MinMs:    ms(h.Min()),
+		MeanMs:   h.Mean() / 1000,
+		P50Ms:    ms(h.ValueAtQuantile(50)),
+		P90Ms:    ms(h.ValueAtQuantile(90)),
+		P95Ms:    ms(h.ValueAtQuantile(95)),
+		P99Ms:    ms(h.ValueAtQuantile(99)),
+		P999Ms:   ms(h.ValueAtQuantile(99.9)),
+		MaxMs:    ms(h.Max()),
+		StdDevMs: h.StdDev() / 1000,
+	}
+}
+
+// Interval summarises the calls made during one interval of a load test.
+type Interval struct {
+	// StartSeconds is the start of the interval, relative to the start of the load test.
+	StartSeconds float64 `json:"startSeconds"`
+	Requests     int64   `json:"requests"`
+	Errors       int64   `json:"errors"`
+	// Rate is the achieved number of requests per second.
+	Rate    float64 `json:"rate"`
+	Latency Latency `json:"latency"`
+}
+
+// Results of a load test.
+type Results struct {
+	DurationSeconds float64 `json:"durationSeconds"`
+	Requests        int64   `json:"requests"`
+	Successes       int64   `json:"successes"`
+	Errors          int64   `json:"errors"`
+	// Dropped is the number of requests that were not made because too many were already in flight.
+	Dropped int64 `json:"dropped"`
+	// Rate is the achieved number of requests per second.
+	Rate    float64 `json:"rate"`
+	Latency Latency `json:"latency"`
+	// ErrorsByKind counts errors by their Connect code, or "verb" for errors returned by the verb.
+	ErrorsByKind map[string]int64 `json:"errorsByKind"`
+	Intervals    []Interval       `json:"intervals"`
+}
+
+// Results summarises the calls recorded by a load test that ended at end.
+func (r *Recorder) Results(end time.Time, dropped int64) Results {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	elapsed := end.Sub(r.start)
+	results := Results{
+		DurationSeconds: elapsed.Seconds(),
+		Requests:        r.total.requests,
+		Errors:          sumErrors(r.total.errors),
+		Dropped:         dropped,
+		Latency:         r.total.latencySummary(),
+		ErrorsByKind:    map[string]int64{},
+		Intervals:       make([]Interval, 0, len(r.intervals)),
+	}
+	results.Successes = results.Requests - results.Errors
+	if elapsed > 0 {
+		results.Rate = float64(results.Requests) / elapsed.Seconds()
+	}
+	for kind, count := range r.total.errors {
+		results.ErrorsByKind[kind] = count
+	}
+	for i, b := range r.intervals {
+		start := time.Duration(i) * r.interval
+		length := min(r.interval, elapsed-start)
+		interval := Interval{
+			StartSeconds: start.Seconds(),
+			Requests:     b.requests,
+			Errors:       sumErrors(b.errors),
+			Latency:      b.latencySummary(),
+		}
+		if length > 0 {
+			interval.Rate = float64(b.requests) / length.Seconds()
+		}
+		results.Intervals = append(results.Intervals, interval)
+	}
+	return results
+}
+
+func sumErrors(errors map[string]int64) int64 {
+	var total int64
+	for _, count := range errors {
+		total += count
+	}
+	return total
+}
+
+// SortedErrorKinds returns the kinds of errors that occurred, most frequent first.
+func (r Results) SortedErrorKinds() []string {
+	kinds := make([]string, 0, len(r.ErrorsByKind))
+	for kind := range r.ErrorsByKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if r.ErrorsByKind[kinds[i]] != r.ErrorsByKind[kinds[j]] {
+			return r.ErrorsByKind[kinds[i]] > r.ErrorsByKind[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	return kinds
+}
+
+// WriteJSON writes the results as JSON.
+func (r Results) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("failed to encode results: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes a row for each interval, followed by a row for the whole load test with a start of "total".
+func (r Results) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	rows := [][]string{{"start_seconds", "requests", "errors", "rate", "min_ms", "mean_ms", "p50_ms", "p90_ms", "p95_ms", "p99_ms", "p999_ms", "max_ms", "stddev_ms"}}
+	for _, interval := range r.Intervals {
+		rows = append(rows, csvRow(formatFloat(interval.StartSeconds), interval.Requests, interval.Errors, interval.Rate, interval.Latency))
+	}
+	rows = append(rows, csvRow("total", r.Requests, r.Errors, r.Rate, r.Latency))
+	if err := out.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	return nil
+}
+
+func csvRow(start string, requests, errors int64, rate float64, latency Latency) []string {
+	return []string{
+		start,
+		strconv.FormatInt(requests, 10),
+		strconv.FormatInt(errors, 10),
+		formatFloat(rate),
+		formatFloat(latency.MinMs),
+		formatFloat(latency.MeanMs),
+		formatFloat(latency.P50Ms),
+		formatFloat(latency.P90Ms),
+		formatFloat(latency.P95Ms),
+		formatFloat(latency.P99Ms),
+		formatFloat(latency.P999Ms),
+		formatFloat(latency.MaxMs),
+		formatFloat(latency.StdDevMs),
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 3, 64)
+}
@@ -0,0 +1,79 @@
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestRecorder(t *testing.T) {
+	start := time.Now()
+	recorder := NewRecorder(start, time.Second)
+	for i := range 100 {
+		recorder.Record(start.Add(time.Duration(i)*10*time.Millisecond), time.Duration(i+1)*time.Millisecond, "")
+	}
+	recorder.Record(start.Add(1500*time.Millisecond), 5*time.Millisecond, ErrorVerb)
+	recorder.Record(start.Add(1500*time.Millisecond), time.Millisecond, ErrorKind(connect.NewError(connect.CodeUnavailable, errors.New("down"))))
+	recorder.Record(start.Add(1600*time.Millisecond), time.Millisecond, ErrorKind(connect.NewError(connect.CodeUnavailable, errors.New("down"))))
+	recorder.Record(start.Add(1700*time.Millisecond), 200*time.Millisecond, "")
+
+	results := recorder.Results(start.Add(2*time.Second), 3)
+	assert.Equal(t, int64(104), results.Requests)
+	assert.Equal(t, int64(101), results.Successes)
+	assert.Equal(t, int64(3), results.Errors)
+	assert.Equal(t, int64(3), results.Dropped)
+	assert.Equal(t, 52.0, results.Rate)
+	assert.Equal(t, map[string]int64{"unavailable": 2, "verb": 1}, results.ErrorsByKind)
+	assert.Equal(t, []string{"unavailable", "verb"}, results.SortedErrorKinds())
+	assert.Equal(t, 1.0, results.Latency.MinMs)
+	assert.True(t, results.Latency.MaxMs >= 200 && results.Latency.MaxMs < 200.5, "max %v", results.Latency.MaxMs)
+	assert.True(t, results.Latency.P50Ms >= 50 && results.Latency.P50Ms <= 52, "p50 %v", results.Latency.P50Ms)
+
+	assert.Equal(t, 2, len(results.Intervals))
+	assert.Equal(t, Interval{StartSeconds: 0, Requests: 100, Errors: 0, Rate: 100, Latency: results.Intervals[0].Latency}, results.Intervals[0])
+	assert.Equal(t, int64(4), results.Intervals[1].Requests)
+	assert.Equal(t, int64(3), results.Intervals[1].Errors)
+	assert.True(t, results.Intervals[1].Latency.P50Ms >= 200 && results.Intervals[1].Latency.P50Ms < 200.5)
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, results.WriteCSV(buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, 4, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "start_seconds,requests,errors,rate,"))
+	assert.True(t, strings.HasPrefix(lines[1], "0.000,100,0,100.000,1.000,"), lines[1])
+	assert.True(t, strings.HasPrefix(lines[3], "total,104,3,52.000,"), lines[3])
+
+	buf.Reset()
+	assert.NoError(t, results.WriteJSON(buf))
+	assert.Contains(t, buf.String(), `"errorsByKind": {`)
+}
+
+func TestRecorderSummarisesIntervals(t *testing.T) {
+	start := time.Now()
+	recorder := NewRecorder(start, time.Second)
+	for i := range 600 {
+		recorder.Record(start.Add(time.Duration(i)*time.Second), time.Millisecond, "")
+	}
+	// A call that completes long after its interval ends only contributes to the total latency.
+	recorder.Record(start, 2*lateCallWindow, "")
+	live := 0
+	for _, b := range recorder.intervals {
+		if b.latencies != nil {
+			live++
+		}
+	}
+	assert.True(t, live <= int(lateCallWindow/time.Second)+1, "%d intervals have histograms", live)
+	assert.True(t, len(recorder.histograms)+live <= int(lateCallWindow/time.Second)+2, "histograms should be reused")
+
+	results := recorder.Results(start.Add(600*time.Second), 0)
+	assert.Equal(t, 600, len(results.Intervals))
+	assert.Equal(t, int64(2), results.Intervals[0].Requests)
+	assert.Equal(t, 1.0, results.Intervals[0].Latency.MaxMs)
+	assert.Equal(t, 1.0, results.Intervals[599].Latency.MaxMs)
+	assert.True(t, results.Latency.MaxMs >= 120000, "max %v", results.Latency.MaxMs)
+}
@@ -0,0 +1,432 @@
+package buildengine
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/types/optional"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/block/ftl"
+	"github.com/block/ftl/common/errors"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/exec"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/moduleconfig"
+	"github.com/block/ftl/internal/projectconfig"
+	"github.com/block/ftl/internal/watch"
+)
+
+// maxCacheEntriesPerModule is the number of entries retained in the local
+// build cache for each module. Older entries are removed when a new one is stored.
+const maxCacheEntriesPerModule = 4
+
+const (
+	cacheManifestFile = "manifest.json"
+	cacheSchemaFile   = "schema.pb"
+	cacheDeployDir    = "deploy"
+)
+
+// RemoteBuildCache shares build cache entries between machines, for example
+// via an OCI registry.
+type RemoteBuildCache interface {
+	// Get returns the entry for key, if present.
+	Get(ctx context.Context, key string) (optional.Option[[]byte], error)
+	// Put stores the entry for key.
+	Put(ctx context.Context, key string, entry []byte) error
+}
+
+// BuildCache is a content-addressed cache of module build results.
+//
+// Entries are keyed by a hash of everything that can influence the output of
+// a build: the module's source files, its configuration, the schemas of its
+// direct dependencies, the build environment and the toolchain versions. If
+// none of those have changed the schema and deploy artefacts are restored
+// from the cache instead of invoking the language plugin.
+type BuildCache struct {
+	dir    string
+	remote optional.Option[RemoteBuildCache]
+
+	toolchainLock     sync.Mutex
+	toolchainVersions map[string]string
+}
+
+// NewBuildCache creates a build cache that stores entries locally in dir,
+// and optionally in a remote cache.
+func NewBuildCache(dir string, remote optional.Option[RemoteBuildCache]) *BuildCache {
+	return &BuildCache{
+		dir:               dir,
+		remote:            remote,
+		toolchainVersions: map[string]string{},
+	}
+}
+
+// DefaultBuildCacheDir returns the default location of the local build cache for a project.
+func DefaultBuildCacheDir(projectConfig projectconfig.Config) string {
+	return filepath.Join(projectConfig.Root(), ".ftl", "build-cache")
+}
+
+type cacheManifest struct {
+	Module string   `json:"module"`
+	Deploy []string `json:"deploy"`
+}
+
+// Key computes the cache key for a build of a module.
+func (c *BuildCache) Key(ctx context.Context, config moduleconfig.ModuleConfig, dependencies []*schema.Module, buildEnv []string) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "ftl:%s:%d\n", ftl.Version, ftl.Timestamp.Unix())
+	fmt.Fprintf(h, "platform:%s/%s\n", runtime.GOOS, runtime.GOARCH)
+	fmt.Fprintf(h, "toolchain:%s\n", c.toolchainVersion(ctx, config))
+
+	// The module directory is excluded so that entries can be shared between checkouts.
+	portable := config
+	portable.Dir = ""
+	configJSON, err := json.Marshal(portable)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal module config: %w", err)
+	}
+	fmt.Fprintf(h, "config:%s\n", configJSON)
+
+	for _, env := range buildEnv {
+		fmt.Fprintf(h, "env:%s\n", env)
+	}
+
+	deps := slices.Clone(dependencies)
+	sort.Slice(deps, func(i, j int) bool { return deps[i].Name < deps[j].Name })
+	for _, dep := range deps {
+		fmt.Fprintf(h, "dependency:%s\n%s\n", dep.Name, dep.String())
+	}
+
+	patterns := slices.Clone(config.Watch)
+	if config.SQLMigrationDirectory != "" {
+		patterns = append(patterns, filepath.ToSlash(filepath.Join(config.SQLMigrationDirectory, "**", "*")))
+	}
+	hashes, err := watch.ComputeFileHashes(config.Dir, true, patterns)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash source files: %w", err)
+	}
+	files := make([]string, 0, len(hashes))
+	for path, hash := range hashes {
+		rel, err := filepath.Rel(config.Dir, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to hash source files: %w", err)
+		}
+		files = append(files, fmt.Sprintf("file:%s:%x\n", filepath.ToSlash(rel), hash))
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		h.Write([]byte(file))
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// toolchainVersion returns the version reported by the language toolchain
+// used to build a module, or an empty string if it can not be determined.
+func (c *BuildCache) toolchainVersion(ctx context.Context, config moduleconfig.ModuleConfig) string {
+	var args []string
+	switch config.Language {
+	case "go":
+		args = []string{"go", "env", "GOVERSION"}
+	case "java", "kotlin":
+		args = []string{"java", "-version"}
+	case "python":
+		args = []string{"uv", "--version"}
+	default:
+		return ""
+	}
+	c.toolchainLock.Lock()
+	defer c.toolchainLock.Unlock()
+	if version, ok := c.toolchainVersions[config.Language]; ok {
+		return version
+	}
+	out, err := exec.Capture(ctx, config.Dir, args[0], args[1:]...)
+	if err != nil {
+		log.FromContext(ctx).Debugf("Could not determine %s toolchain version: %s", config.Language, err)
+	}
+	version := strings.TrimSpace(string(out))
+	c.toolchainVersions[config.Language] = version
+	return version
+}
+
+// Restore the build result for key into the module's deploy directory and
+// the project schema directory.
+//
+// Returns false if there is no entry for key.
+func (c *BuildCache) Restore(ctx context.Context, projectConfig projectconfig.Config, config moduleconfig.ModuleConfig, key string) (moduleSchema *schema.Module, deploy []string, ok bool, err error) {
+	logger := log.FromContext(ctx)
+	entry, err := os.ReadFile(c.entryPath(config.Module, key))
+	if errors.Is(err, os.ErrNotExist) {
+		remote, hasRemote := c.remote.Get()
+		if !hasRemote {
+			return nil, nil, false, nil
+		}
+		remoteEntry, err := remote.Get(ctx, key)
+		if err != nil {
+			logger.Warnf("Failed to fetch build cache entry from remote cache: %s", err)
+			return nil, nil, false, nil
+		}
+		if entry, ok = remoteEntry.Get(); !ok {
+			return nil, nil, false, nil
+		}
+		if err := c.writeLocal(config.Module, key, entry); err != nil {
+			return nil, nil, false, err
+		}
+	} else if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to read build cache entry: %w", err)
+	}
+
+	moduleSchema, deploy, err = unpackCacheEntry(projectConfig, config.Abs(), entry)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to restore build cache entry %s: %w", key, err)
+	}
+	return moduleSchema, deploy, true, nil
+}
+
+// Store the build result for key, reading the deploy artefacts from the
+// module's deploy directory.
+func (c *BuildCache) Store(ctx context.Context, config moduleconfig.ModuleConfig, key string, moduleSchema *schema.Module, deploy []string) error {
+	entry, err := packCacheEntry(config.Abs(), moduleSchema, deploy)
+	if err != nil {
+		return fmt.Errorf("failed to create build cache entry: %w", err)
+	}
+	if err := c.writeLocal(config.Module, key, entry); err != nil {
+		return err
+	}
+	if remote, ok := c.remote.Get(); ok {
+		if err := remote.Put(ctx, key, entry); err != nil {
+			log.FromContext(ctx).Warnf("Failed to upload build cache entry to remote cache: %s", err)
+		}
+	}
+	return nil
+}
+
+func (c *BuildCache) entryPath(module, key string) string {
+	return filepath.Join(c.dir, module+"-"+key+".tar.gz")
+}
+
+func (c *BuildCache) writeLocal(module, key string, entry []byte) error {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create build cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write build cache entry: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	if _, err := tmp.Write(entry); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write build cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write build cache entry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.entryPath(module, key)); err != nil {
+		return fmt.Errorf("failed to write build cache entry: %w", err)
+	}
+	return c.prune(module)
+}
+
+// prune removes all but the most recently written entries for a module.
+func (c *BuildCache) prune(module string) error {
+	matches, err := filepath.Glob(filepath.Join(c.dir, module+"-*.tar.gz"))
+	if err != nil {
+		return fmt.Errorf("failed to prune build cache: %w", err)
+	}
+	type entry struct {
+		path    string
+		modTime int64
+	}
+	entries := make([]entry, 0, len(matches))
+	for _, match := range matches {
+		// Module names can not contain "-", but guard against prefix collisions regardless.
+		if len(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(match), module+"-"), ".tar.gz")) != sha256.Size*2 {
+			continue
+		}
+		info, err := os.Stat(match)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{path: match, modTime: info.ModTime().UnixNano()})
+	}
+	if len(entries) <= maxCacheEntriesPerModule {
+		return nil
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].modTime > entries[j].modTime })
+	for _, e := range entries[maxCacheEntriesPerModule:] {
+		if err := os.Remove(e.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to prune build cache: %w", err)
+		}
+	}
+	return nil
+}
+
+func packCacheEntry(config moduleconfig.AbsModuleConfig, moduleSchema *schema.Module, deploy []string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+
+	manifest, err := json.Marshal(cacheManifest{Module: config.Module, Deploy: deploy})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := writeTarFile(tw, cacheManifestFile, 0600, manifest); err != nil {
+		return nil, err
+	}
+	schemaBytes, err := proto.Marshal(moduleSchema.ToProto())
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	if err := writeTarFile(tw, cacheSchemaFile, 0600, schemaBytes); err != nil {
+		return nil, err
+	}
+
+	for _, path := range deploy {
+		root := filepath.Join(config.DeployDir, path)
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return fmt.Errorf("%s: only regular files can be cached", path)
+			}
+			rel, err := filepath.Rel(config.DeployDir, path)
+			if err != nil {
+				return err
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return writeTarFile(tw, filepath.ToSlash(filepath.Join(cacheDeployDir, rel)), int64(info.Mode().Perm()), content)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to build cache entry: %w", path, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close build cache entry: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close build cache entry: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, mode int64, content []byte) error {
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: mode, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func unpackCacheEntry(projectConfig projectconfig.Config, config moduleconfig.AbsModuleConfig, entry []byte) (*schema.Module, []string, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(entry))
+	if err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+	tr := tar.NewReader(gz)
+
+	var manifest optional.Option[cacheManifest]
+	var schemaBytes []byte
+	files := map[string]*tar.Header{}
+	contents := map[string][]byte{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+		switch {
+		case hdr.Name == cacheManifestFile:
+			var m cacheManifest
+			if err := json.Unmarshal(content, &m); err != nil {
+				return nil, nil, fmt.Errorf("invalid manifest: %w", err)
+			}
+			manifest = optional.Some(m)
+		case hdr.Name == cacheSchemaFile:
+			schemaBytes = content
+		case strings.HasPrefix(hdr.Name, cacheDeployDir+"/"):
+			rel := filepath.FromSlash(strings.TrimPrefix(hdr.Name, cacheDeployDir+"/"))
+			if !filepath.IsLocal(rel) {
+				return nil, nil, fmt.Errorf("invalid path %q", hdr.Name)
+			}
+			files[rel] = hdr
+			contents[rel] = content
+		}
+	}
+	m, ok := manifest.Get()
+	if !ok || schemaBytes == nil {
+		return nil, nil, errors.New("incomplete entry")
+	}
+	if m.Module != config.Module {
+		return nil, nil, fmt.Errorf("entry is for module %q", m.Module)
+	}
+	pb := &schemapb.Module{}
+	if err := proto.Unmarshal(schemaBytes, pb); err != nil {
+		return nil, nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	moduleSchema, err := schema.ModuleFromProto(pb)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schema: %w", err)
+	}
+
+	// Replace the previous deploy artefacts entirely so no stale files remain.
+	for _, path := range m.Deploy {
+		if !filepath.IsLocal(path) {
+			return nil, nil, fmt.Errorf("invalid deploy path %q", path)
+		}
+		if err := os.RemoveAll(filepath.Join(config.DeployDir, path)); err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+	}
+	for rel, hdr := range files {
+		dest := filepath.Join(config.DeployDir, rel)
+		if err := os.MkdirAll(filepath.Dir(dest), 0700); err != nil {
+			return nil, nil, err //nolint:wrapcheck
+		}
+		if err := os.WriteFile(dest, contents[rel], fs.FileMode(hdr.Mode).Perm()); err != nil { //nolint:gosec
+			return nil, nil, err //nolint:wrapcheck
+		}
+	}
+
+	schemaPath := projectConfig.SchemaPath(config.Module)
+	if err := os.MkdirAll(filepath.Dir(schemaPath), 0700); err != nil {
+		return nil, nil, fmt.Errorf("failed to create schema directory: %w", err)
+	}
+	if err := os.WriteFile(schemaPath, schemaBytes, 0600); err != nil {
+		return nil, nil, fmt.Errorf("failed to write schema: %w", err)
+	}
+	return moduleSchema, m.Deploy, nil
+}
@@ -0,0 +1,130 @@
+package buildengine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/moduleconfig"
+	"github.com/block/ftl/internal/projectconfig"
+)
+
+type memoryRemoteCache map[string][]byte
+
+func (m memoryRemoteCache) Get(ctx context.Context, key string) (optional.Option[[]byte], error) {
+	entry, ok := m[key]
+	if !ok {
+		return optional.None[[]byte](), nil
+	}
+	return optional.Some(entry), nil
+}
+
+func (m memoryRemoteCache) Put(ctx context.Context, key string, entry []byte) error {
+	m[key] = entry
+	return nil
+}
+
+func newCacheTestModule(t *testing.T) (projectconfig.Config, moduleconfig.ModuleConfig) {
+	t.Helper()
+	root := t.TempDir()
+	projConfig := projectconfig.Config{Path: filepath.Join(root, "ftl-project.toml"), Name: "test"}
+	dir := filepath.Join(root, "echo")
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "dist", "bin"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "main.src"), []byte("v1"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "dist", "main"), []byte("binary"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "dist", "bin", "launch"), []byte("launcher"), 0700))
+	return projConfig, moduleconfig.ModuleConfig{
+		Dir:       dir,
+		Language:  "test",
+		Module:    "echo",
+		DeployDir: "dist",
+		Watch:     []string{"*.src"},
+	}
+}
+
+func TestBuildCacheKey(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	_, config := newCacheTestModule(t)
+	cache := NewBuildCache(t.TempDir(), optional.None[RemoteBuildCache]())
+	dep := &schema.Module{Name: "other", Decls: []schema.Decl{&schema.Data{Name: "Req"}}}
+
+	key, err := cache.Key(ctx, config, []*schema.Module{dep}, nil)
+	assert.NoError(t, err)
+	again, err := cache.Key(ctx, config, []*schema.Module{dep}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	// Moving the module does not change the key.
+	moved := config
+	moved.Dir = filepath.Join(t.TempDir(), "echo")
+	assert.NoError(t, os.CopyFS(moved.Dir, os.DirFS(config.Dir)))
+	movedKey, err := cache.Key(ctx, moved, []*schema.Module{dep}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, key, movedKey)
+
+	withEnv, err := cache.Key(ctx, config, []*schema.Module{dep}, []string{"FOO=bar"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, withEnv)
+
+	changedDep := &schema.Module{Name: "other", Decls: []schema.Decl{&schema.Data{Name: "Request"}}}
+	withChangedDep, err := cache.Key(ctx, config, []*schema.Module{changedDep}, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, withChangedDep)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(config.Dir, "main.src"), []byte("v2"), 0600))
+	withChangedSource, err := cache.Key(ctx, config, []*schema.Module{dep}, nil)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, withChangedSource)
+}
+
+func TestBuildCacheStoreAndRestore(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	projConfig, config := newCacheTestModule(t)
+	remote := memoryRemoteCache{}
+	cache := NewBuildCache(t.TempDir(), optional.Some[RemoteBuildCache](remote))
+	key, err := cache.Key(ctx, config, nil, nil)
+	assert.NoError(t, err)
+
+	_, _, ok, err := cache.Restore(ctx, projConfig, config, key)
+	assert.NoError(t, err)
+	assert.False(t, ok, "expected a cache miss")
+
+	moduleSchema := &schema.Module{Name: "echo", Decls: []schema.Decl{&schema.Verb{Name: "echo", Request: &schema.Unit{}, Response: &schema.Unit{}}}}
+	err = cache.Store(ctx, config, key, moduleSchema, []string{"main", "bin"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(remote))
+
+	// Restore replaces the deploy artefacts and writes the schema.
+	deployDir := filepath.Join(config.Dir, "dist")
+	assert.NoError(t, os.RemoveAll(deployDir))
+	assert.NoError(t, os.MkdirAll(filepath.Join(deployDir, "bin"), 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(deployDir, "bin", "stale"), []byte("stale"), 0600))
+
+	restoredSchema, deploy, ok, err := cache.Restore(ctx, projConfig, config, key)
+	assert.NoError(t, err)
+	assert.True(t, ok, "expected a cache hit")
+	assert.Equal(t, []string{"main", "bin"}, deploy)
+	assert.Equal(t, moduleSchema.String(), restoredSchema.String())
+	content, err := os.ReadFile(filepath.Join(deployDir, "bin", "launch"))
+	assert.NoError(t, err)
+	assert.Equal(t, "launcher", string(content))
+	info, err := os.Stat(filepath.Join(deployDir, "main"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+	_, err = os.Stat(filepath.Join(deployDir, "bin", "stale"))
+	assert.True(t, os.IsNotExist(err), "expected stale deploy files to be removed")
+	_, err = os.Stat(projConfig.SchemaPath("echo"))
+	assert.NoError(t, err)
+
+	// A fresh local cache is populated from the remote cache.
+	fresh := NewBuildCache(t.TempDir(), optional.Some[RemoteBuildCache](remote))
+	_, _, ok, err = fresh.Restore(ctx, projConfig, config, key)
+	assert.NoError(t, err)
+	assert.True(t, ok, "expected a remote cache hit")
+}
@@ -33,6 +33,7 @@ type DeployClient interface {
 	UploadArtefact(ctx context.Context, req *connect.Request[ftlv1.UploadArtefactRequest]) (*connect.Response[ftlv1.UploadArtefactResponse], error)
 	CreateDeployment(ctx context.Context, req *connect.Request[ftlv1.CreateDeploymentRequest]) (*connect.Response[ftlv1.CreateDeploymentResponse], error)
 	ReplaceDeploy(ctx context.Context, req *connect.Request[ftlv1.ReplaceDeployRequest]) (*connect.Response[ftlv1.ReplaceDeployResponse], error)
+	DeployChangeset(ctx context.Context, req *connect.Request[ftlv1.DeployChangesetRequest]) (*connect.Response[ftlv1.DeployChangesetResponse], error)
 	Status(ctx context.Context, req *connect.Request[ftlv1.StatusRequest]) (*connect.Response[ftlv1.StatusResponse], error)
 	UpdateDeploy(ctx context.Context, req *connect.Request[ftlv1.UpdateDeployRequest]) (*connect.Response[ftlv1.UpdateDeployResponse], error)
 	Ping(ctx context.Context, req *connect.Request[ftlv1.PingRequest]) (*connect.Response[ftlv1.PingResponse], error)
@@ -49,26 +50,99 @@ func Deploy(ctx context.Context, projectConfig projectconfig.Config, module Modu
 	ctx = log.ContextWithLogger(ctx, logger)
 	logger.Infof("Deploying module")
 
+	req, err := prepareDeployment(ctx, projectConfig, module, deploy, client)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CreateDeployment(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentKey: resp.Msg.GetDeploymentKey(), MinReplicas: replicas}))
+	if err != nil {
+		return err
+	}
+
+	if waitForDeployOnline {
+		logger.Debugf("Waiting for deployment %s to become ready", resp.Msg.DeploymentKey)
+		err = checkReadiness(ctx, client, resp.Msg.DeploymentKey, replicas, req.Schema)
+		if err != nil {
+			return err
+		}
+		logger.Debugf("Deployment %s became ready", resp.Msg.DeploymentKey)
+	}
+
+	return nil
+}
+
+// DeployChangeset deploys several modules to the FTL controller atomically, such that either all of the new
+// deployments are activated together or none of them are.
+//
+// Each module is deployed with its current deploy files. Optionally wait for the deployments to become ready.
+func DeployChangeset(ctx context.Context, projectConfig projectconfig.Config, modules []Module, replicas int32, waitForDeployOnline bool, client DeployClient) error {
+	moduleNames := slices.Map(modules, func(m Module) string { return m.Config.Module })
+	logger := log.FromContext(ctx).Scope("deploy")
+	logger.Infof("Deploying modules %s together", strings.Join(moduleNames, ", "))
+
+	reqs := make([]*ftlv1.CreateDeploymentRequest, len(modules))
+	for i, module := range modules {
+		ctx := log.ContextWithLogger(ctx, log.FromContext(ctx).Module(module.Config.Module).Scope("deploy"))
+		req, err := prepareDeployment(ctx, projectConfig, module, module.Deploy, client)
+		if err != nil {
+			return fmt.Errorf("%s: %w", module.Config.Module, err)
+		}
+		reqs[i] = req
+	}
+
+	resp, err := client.DeployChangeset(ctx, connect.NewRequest(&ftlv1.DeployChangesetRequest{
+		Deployments: reqs,
+		MinReplicas: replicas,
+	}))
+	if err != nil {
+		return err
+	}
+	if len(resp.Msg.DeploymentKeys) != len(reqs) {
+		return fmt.Errorf("expected %d deployments in changeset %s but got %d", len(reqs), resp.Msg.Changeset, len(resp.Msg.DeploymentKeys))
+	}
+	logger.Debugf("Deployed changeset %s", resp.Msg.Changeset)
+
+	if waitForDeployOnline {
+		for i, key := range resp.Msg.DeploymentKeys {
+			logger.Debugf("Waiting for deployment %s to become ready", key)
+			if err := checkReadiness(ctx, client, key, replicas, reqs[i].Schema); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// prepareDeployment uploads any deploy files missing from the cluster and returns the request to create the
+// module's deployment.
+func prepareDeployment(ctx context.Context, projectConfig projectconfig.Config, module Module, deploy []string, client DeployClient) (*ftlv1.CreateDeploymentRequest, error) {
+	logger := log.FromContext(ctx)
 	moduleConfig := module.Config.Abs()
 	files, err := FindFilesToDeploy(moduleConfig, deploy)
 	if err != nil {
 		logger.Errorf(err, "failed to find files in %s", moduleConfig)
-		return err
+		return nil, err
 	}
 
 	filesByHash, err := hashFiles(moduleConfig.DeployDir, files)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	gadResp, err := client.GetArtefactDiffs(ctx, connect.NewRequest(&ftlv1.GetArtefactDiffsRequest{ClientDigests: maps.Keys(filesByHash)}))
 	if err != nil {
-		return fmt.Errorf("failed to get artefact diffs: %w", err)
+		return nil, fmt.Errorf("failed to get artefact diffs: %w", err)
 	}
 
 	moduleSchema, err := loadProtoSchema(projectConfig, moduleConfig)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	logger.Debugf("Uploading %d/%d files", len(gadResp.Msg.MissingDigests), len(files))
@@ -76,43 +150,24 @@ func Deploy(ctx context.Context, projectConfig projectconfig.Config, module Modu
 		file := filesByHash[missing]
 		content, err := os.ReadFile(file.localPath)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		logger.Tracef("Uploading %s", relToCWD(file.localPath))
 		resp, err := client.UploadArtefact(ctx, connect.NewRequest(&ftlv1.UploadArtefactRequest{
 			Content: content,
 		}))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		logger.Debugf("Uploaded %s as %s:%s", relToCWD(file.localPath), sha256.FromBytes(resp.Msg.Digest), file.Path)
 	}
 
-	resp, err := client.CreateDeployment(ctx, connect.NewRequest(&ftlv1.CreateDeploymentRequest{
+	return &ftlv1.CreateDeploymentRequest{
 		Schema: moduleSchema,
 		Artefacts: slices.Map(maps.Values(filesByHash), func(a deploymentArtefact) *ftlv1.DeploymentArtefact {
 			return a.DeploymentArtefact
 		}),
-	}))
-	if err != nil {
-		return err
-	}
-
-	_, err = client.ReplaceDeploy(ctx, connect.NewRequest(&ftlv1.ReplaceDeployRequest{DeploymentKey: resp.Msg.GetDeploymentKey(), MinReplicas: replicas}))
-	if err != nil {
-		return err
-	}
-
-	if waitForDeployOnline {
-		logger.Debugf("Waiting for deployment %s to become ready", resp.Msg.DeploymentKey)
-		err = checkReadiness(ctx, client, resp.Msg.DeploymentKey, replicas, moduleSchema)
-		if err != nil {
-			return err
-		}
-		logger.Debugf("Deployment %s became ready", resp.Msg.DeploymentKey)
-	}
-
-	return nil
+	}, nil
 }
 
 func terminateModuleDeployment(ctx context.Context, client DeployClient, module string) error {
@@ -7,11 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/alecthomas/types/optional"
 	"github.com/alecthomas/types/pubsub"
+	"github.com/alecthomas/types/result"
 	"github.com/puzpuzpuz/xsync/v3"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
@@ -35,6 +37,9 @@ type moduleMeta struct {
 	plugin         *languageplugin.LanguagePlugin
 	events         chan languageplugin.PluginEvent
 	configDefaults moduleconfig.CustomDefaults
+	// watching is true once the plugin has been asked to build the module in dev mode,
+	// after which it rebuilds the module as it changes.
+	watching bool
 }
 
 // copyMetaWithUpdatedDependencies finds the dependencies for a module and returns a
@@ -131,6 +136,8 @@ func (ModuleBuildFailed) rawBuildEvent() {}
 type ModuleBuildSuccess struct {
 	Config        moduleconfig.ModuleConfig
 	IsAutoRebuild bool
+	// Cached is true if the build result was restored from the build cache.
+	Cached bool
 }
 
 func (ModuleBuildSuccess) buildEvent()    {}
@@ -176,12 +183,15 @@ type Engine struct {
 	moduleDirs       []string
 	watcher          *watch.Watcher // only watches for module toml changes
 	controllerSchema *xsync.MapOf[string, *schema.Module]
-	schemaChanges    *pubsub.Topic[schemaeventsource.Event]
-	cancel           func()
-	parallelism      int
-	modulesToBuild   *xsync.MapOf[string, bool]
-	buildEnv         []string
-	startTime        optional.Option[time.Time]
+	// schemas of modules built locally, which may not have been deployed yet
+	localSchema    *xsync.MapOf[string, *schema.Module]
+	schemaChanges  *pubsub.Topic[schemaeventsource.Event]
+	cancel         func()
+	parallelism    int
+	modulesToBuild *xsync.MapOf[string, bool]
+	buildEnv       []string
+	startTime      optional.Option[time.Time]
+	buildCache     optional.Option[*BuildCache]
 
 	// events coming in from plugins
 	pluginEvents chan languageplugin.PluginEvent
@@ -221,6 +231,17 @@ func WithDevMode(updates chan dev.LocalEndpoint) Option {
 	}
 }
 
+// WithBuildCache restores unchanged modules from the given build cache
+// instead of rebuilding them.
+//
+// In dev mode a restored module is deployed immediately, and its language
+// plugin then builds it in the background in order to watch it for changes.
+func WithBuildCache(cache *BuildCache) Option {
+	return func(o *Engine) {
+		o.buildCache = optional.Some(cache)
+	}
+}
+
 // WithStartTime sets the start time to report total startup time
 func WithStartTime(startTime time.Time) Option {
 	return func(o *Engine) {
@@ -252,6 +273,7 @@ func New(
 		moduleMetas:      xsync.NewMapOf[string, moduleMeta](),
 		watcher:          watch.NewWatcher("ftl.toml"),
 		controllerSchema: xsync.NewMapOf[string, *schema.Module](),
+		localSchema:      xsync.NewMapOf[string, *schema.Module](),
 		schemaChanges:    pubsub.New[schemaeventsource.Event](),
 		pluginEvents:     make(chan languageplugin.PluginEvent, 128),
 		parallelism:      runtime.NumCPU(),
@@ -437,36 +459,68 @@ func (e *Engine) Deploy(ctx context.Context, replicas int32, waitForDeployOnline
 	}
 
 	for _, group := range groups {
-		deployGroup, ctx := errgroup.WithContext(ctx)
+		modules := []Module{}
 		for _, moduleName := range group {
 			if moduleName == "builtin" {
 				continue
 			}
-			deployGroup.Go(func() error {
-				meta, ok := e.moduleMetas.Load(moduleName)
-				if !ok {
-					return fmt.Errorf("module %q not found", moduleName)
-				}
-				if len(meta.module.Deploy) == 0 {
-					return fmt.Errorf("no files found to deploy for %q", moduleName)
-				}
-				e.rawEngineUpdates <- ModuleDeployStarted{Module: moduleName}
-				err := Deploy(ctx, e.projectConfig, meta.module, meta.module.Deploy, replicas, waitForDeployOnline, e.client)
-				if err != nil {
-					e.rawEngineUpdates <- ModuleDeployFailed{Module: moduleName, Error: err}
-					return err
-				}
-				e.rawEngineUpdates <- ModuleDeploySuccess{Module: moduleName}
-				return nil
-			})
+			meta, ok := e.moduleMetas.Load(moduleName)
+			if !ok {
+				return fmt.Errorf("module %q not found", moduleName)
+			}
+			if len(meta.module.Deploy) == 0 {
+				return fmt.Errorf("no files found to deploy for %q", moduleName)
+			}
+			modules = append(modules, meta.module)
+		}
+		if len(modules) == 0 {
+			continue
 		}
-		if err := deployGroup.Wait(); err != nil {
+		if err := e.deployBatch(ctx, modules, replicas, waitForDeployOnline); err != nil {
 			return fmt.Errorf("deploy failed: %w", err)
 		}
 	}
 	return nil
 }
 
+// deployBatch deploys modules from the same topological group, atomically if there is more than one.
+func (e *Engine) deployBatch(ctx context.Context, modules []Module, replicas int32, waitForDeployOnline bool) error {
+	for _, module := range modules {
+		e.rawEngineUpdates <- ModuleDeployStarted{Module: module.Config.Module}
+	}
+	var err error
+	if len(modules) == 1 {
+		err = Deploy(ctx, e.projectConfig, modules[0], modules[0].Deploy, replicas, waitForDeployOnline, e.client)
+	} else {
+		err = DeployChangeset(ctx, e.projectConfig, modules, replicas, waitForDeployOnline, e.client)
+	}
+	for _, module := range modules {
+		if err != nil {
+			e.rawEngineUpdates <- ModuleDeployFailed{Module: module.Config.Module, Error: err}
+		} else {
+			e.rawEngineUpdates <- ModuleDeploySuccess{Module: module.Config.Module}
+		}
+	}
+	return err
+}
+
+// Schema returns the combined schema of all known modules, preferring the most recent local build of each
+// module over the schema deployed to the cluster.
+func (e *Engine) Schema() *schema.Schema {
+	modules := map[string]*schema.Module{}
+	e.controllerSchema.Range(func(name string, sch *schema.Module) bool {
+		modules[name] = sch
+		return true
+	})
+	e.localSchema.Range(func(name string, sch *schema.Module) bool {
+		if _, ok := e.moduleMetas.Load(name); ok {
+			modules[name] = sch
+		}
+		return true
+	})
+	return &schema.Schema{Modules: maps.Values(modules)}
+}
+
 // Modules returns the names of all modules.
 func (e *Engine) Modules() []string {
 	var moduleNames []string
@@ -765,16 +819,11 @@ func (e *Engine) BuildAndDeploy(ctx context.Context, replicas int32, waitForDepl
 	buildGroup := errgroup.Group{}
 
 	buildGroup.Go(func() error {
-		return e.buildWithCallback(ctx, func(buildCtx context.Context, module Module) error {
-			e.modulesToBuild.Store(module.Config.Module, false)
-			e.rawEngineUpdates <- ModuleDeployStarted{Module: module.Config.Module}
-			err := Deploy(buildCtx, e.projectConfig, module, module.Deploy, replicas, true, e.client)
-			if err != nil {
-				e.rawEngineUpdates <- ModuleDeployFailed{Module: module.Config.Module, Error: err}
-				return err
+		return e.buildWithCallback(ctx, func(buildCtx context.Context, modules []Module) error {
+			for _, module := range modules {
+				e.modulesToBuild.Store(module.Config.Module, false)
 			}
-			e.rawEngineUpdates <- ModuleDeploySuccess{Module: module.Config.Module}
-			return nil
+			return e.deployBatch(buildCtx, modules, replicas, true)
 		}, moduleNames...)
 	})
 
@@ -797,7 +846,8 @@ func (e *Engine) BuildAndDeploy(ctx context.Context, replicas int32, waitForDepl
 	return buildErr
 }
 
-type buildCallback func(ctx context.Context, module Module) error
+// buildCallback is called with the modules built from each group of the topological sort.
+type buildCallback func(ctx context.Context, modules []Module) error
 
 func (e *Engine) buildWithCallback(ctx context.Context, callback buildCallback, moduleNames ...string) error {
 	logger := log.FromContext(ctx)
@@ -869,6 +919,7 @@ func (e *Engine) buildWithCallback(ctx context.Context, callback buildCallback,
 
 		// Collect schemas to be inserted into "built" map for subsequent groups.
 		schemas := make(chan *schema.Module, len(group))
+		built := make(chan Module, len(group))
 
 		wg := errgroup.Group{}
 		wg.SetLimit(e.parallelism)
@@ -878,7 +929,7 @@ func (e *Engine) buildWithCallback(ctx context.Context, callback buildCallback,
 			wg.Go(func() error {
 				logger := log.FromContext(ctx).Module(moduleName).Scope("build")
 				ctx := log.ContextWithLogger(ctx, logger)
-				err := e.tryBuild(ctx, mustBuild, moduleName, builtModules, schemas, callback)
+				err := e.tryBuild(ctx, mustBuild, moduleName, builtModules, schemas, built)
 				if err != nil {
 					errCh <- err
 				}
@@ -899,6 +950,18 @@ func (e *Engine) buildWithCallback(ctx context.Context, callback buildCallback,
 			newSchemas = append(newSchemas, sch)
 		}
 
+		close(built)
+		builtGroup := []Module{}
+		for module := range built {
+			builtGroup = append(builtGroup, module)
+		}
+		if callback != nil && len(builtGroup) > 0 {
+			sort.Slice(builtGroup, func(i, j int) bool { return builtGroup[i].Config.Module < builtGroup[j].Config.Module })
+			if err := callback(ctx, builtGroup); err != nil {
+				errCh <- err
+			}
+		}
+
 		// Generate stubs for all but the last group
 		if idx < (len(topology) - 1) {
 			err = GenerateStubs(ctx, e.projectConfig.Root(), newSchemas, metasMap)
@@ -932,7 +995,8 @@ func (e *Engine) buildWithCallback(ctx context.Context, callback buildCallback,
 	return nil
 }
 
-func (e *Engine) tryBuild(ctx context.Context, mustBuild map[string]bool, moduleName string, builtModules map[string]*schema.Module, schemas chan *schema.Module, callback buildCallback) error {
+// tryBuild builds a module if required, sending it to built if it was built successfully.
+func (e *Engine) tryBuild(ctx context.Context, mustBuild map[string]bool, moduleName string, builtModules map[string]*schema.Module, schemas chan *schema.Module, built chan<- Module) error {
 	logger := log.FromContext(ctx)
 
 	if !mustBuild[moduleName] {
@@ -952,19 +1016,19 @@ func (e *Engine) tryBuild(ctx context.Context, mustBuild map[string]bool, module
 	}
 
 	e.rawEngineUpdates <- ModuleBuildStarted{Config: meta.module.Config}
-	err := e.build(ctx, moduleName, builtModules, schemas)
+	cached, err := e.build(ctx, moduleName, builtModules, schemas)
 	if err != nil {
 		e.rawEngineUpdates <- ModuleBuildFailed{Config: meta.module.Config, Error: err}
 	} else {
-		e.rawEngineUpdates <- ModuleBuildSuccess{Config: meta.module.Config}
+		e.rawEngineUpdates <- ModuleBuildSuccess{Config: meta.module.Config, Cached: cached}
 	}
-	if err == nil && callback != nil {
+	if err == nil {
 		// load latest meta as it may have been updated
 		meta, ok = e.moduleMetas.Load(moduleName)
 		if !ok {
 			return fmt.Errorf("module %q not found", moduleName)
 		}
-		return callback(ctx, meta.module)
+		built <- meta.module
 	}
 
 	return err
@@ -976,33 +1040,77 @@ func (e *Engine) mustSchema(ctx context.Context, moduleName string, builtModules
 		schemas <- sch
 		return nil
 	}
-	return e.build(ctx, moduleName, builtModules, schemas)
+	_, err := e.build(ctx, moduleName, builtModules, schemas)
+	return err
 }
 
 // Build a module and publish its schema.
 //
 // Assumes that all dependencies have been built and are available in "built".
-func (e *Engine) build(ctx context.Context, moduleName string, builtModules map[string]*schema.Module, schemas chan<- *schema.Module) error {
+//
+// Returns true if the build result was restored from the build cache.
+func (e *Engine) build(ctx context.Context, moduleName string, builtModules map[string]*schema.Module, schemas chan<- *schema.Module) (cached bool, err error) {
 	meta, ok := e.moduleMetas.Load(moduleName)
 	if !ok {
-		return fmt.Errorf("module %q not found", moduleName)
+		return false, fmt.Errorf("module %q not found", moduleName)
 	}
 
-	sch := &schema.Schema{Modules: maps.Values(builtModules)}
+	cache, useCache := e.buildCache.Get()
+	// Only the initial dev mode build can be restored, as the plugin must then watch the module.
+	useCache = useCache && !meta.watching
+	var cacheKey string
+	var dependencies []*schema.Module
+	for _, dep := range meta.module.Dependencies(Raw) {
+		depSchema, ok := builtModules[dep]
+		if !ok {
+			useCache = false
+			break
+		}
+		dependencies = append(dependencies, depSchema)
+	}
+	if useCache {
+		cacheKey, err = cache.Key(ctx, meta.module.Config, dependencies, e.buildEnv)
+		if err != nil {
+			log.FromContext(ctx).Module(moduleName).Warnf("Build cache disabled: %s", err)
+			useCache = false
+		}
+	}
 
-	moduleSchema, deploy, err := build(ctx, meta.plugin, e.projectConfig, languageplugin.BuildContext{
+	var moduleSchema *schema.Module
+	var deploy []string
+	if useCache {
+		moduleSchema, deploy, cached, err = cache.Restore(ctx, e.projectConfig, meta.module.Config, cacheKey)
+		if err != nil {
+			log.FromContext(ctx).Module(moduleName).Warnf("Could not restore from build cache: %s", err)
+		} else if cached {
+			log.FromContext(ctx).Module(moduleName).Scope("build").Infof("Module restored from build cache")
+		}
+	}
+
+	bctx := languageplugin.BuildContext{
 		Config:       meta.module.Config,
-		Schema:       sch,
+		Schema:       &schema.Schema{Modules: maps.Values(builtModules)},
 		Dependencies: meta.module.Dependencies(Raw),
 		BuildEnv:     e.buildEnv,
-	}, e.devMode, e.devModeEndpointUpdates)
-	if err != nil {
-		if errors.Is(err, errInvalidateDependencies) {
-			// Do not start a build directly as we are already building out a graph of modules.
-			// Instead we send to a chan so that it can be processed after.
-			e.rebuildRequests <- rebuildRequest{module: moduleName}
+	}
+	if cached && e.devMode {
+		go e.watchRestoredModule(ctx, meta.plugin, bctx)
+	}
+	if !cached {
+		moduleSchema, deploy, err = build(ctx, meta.plugin, e.projectConfig, bctx, e.devMode, e.devModeEndpointUpdates)
+		if err != nil {
+			if errors.Is(err, errInvalidateDependencies) {
+				// Do not start a build directly as we are already building out a graph of modules.
+				// Instead we send to a chan so that it can be processed after.
+				e.rebuildRequests <- rebuildRequest{module: moduleName}
+			}
+			return false, err
+		}
+		if useCache {
+			if err := cache.Store(ctx, meta.module.Config, cacheKey, moduleSchema, deploy); err != nil {
+				log.FromContext(ctx).Module(moduleName).Warnf("Could not store build in build cache: %s", err)
+			}
 		}
-		return err
 	}
 	// update files to deploy
 	e.moduleMetas.Compute(moduleName, func(meta moduleMeta, exists bool) (out moduleMeta, shouldDelete bool) {
@@ -1010,10 +1118,26 @@ func (e *Engine) build(ctx context.Context, moduleName string, builtModules map[
 			return moduleMeta{}, true
 		}
 		meta.module = meta.module.CopyWithDeploy(deploy)
+		meta.watching = meta.watching || e.devMode
 		return meta, false
 	})
+	e.localSchema.Store(moduleName, moduleSchema)
 	schemas <- moduleSchema
-	return nil
+	return cached, nil
+}
+
+// watchRestoredModule builds a module that was restored from the build cache in
+// dev mode, so that its language plugin watches it for changes.
+//
+// The result is handled as an automatic rebuild, which redeploys the module with
+// any dev mode endpoint the plugin provides.
+func (e *Engine) watchRestoredModule(ctx context.Context, plugin *languageplugin.LanguagePlugin, bctx languageplugin.BuildContext) {
+	stubsRoot := stubsLanguageDir(e.projectConfig.Root(), bctx.Config.Language)
+	buildResult := result.From(plugin.Build(ctx, e.projectConfig.Root(), stubsRoot, bctx, true))
+	select {
+	case e.pluginEvents <- languageplugin.AutoRebuildEndedEvent{Module: bctx.Config.Module, Result: buildResult}:
+	case <-ctx.Done():
+	}
 }
 
 // Construct a combined schema for a module and its transitive dependencies.
@@ -1102,7 +1226,7 @@ func (e *Engine) watchForPluginEvents(originalCtx context.Context) {
 					e.rawEngineUpdates <- ModuleBuildStarted{Config: meta.module.Config, IsAutoRebuild: true}
 
 				case languageplugin.AutoRebuildEndedEvent:
-					_, deploy, err := handleBuildResult(ctx, e.projectConfig, meta.module.Config, event.Result, e.devModeEndpointUpdates)
+					moduleSchema, deploy, err := handleBuildResult(ctx, e.projectConfig, meta.module.Config, event.Result, e.devModeEndpointUpdates)
 					if err != nil {
 						e.rawEngineUpdates <- ModuleBuildFailed{Config: meta.module.Config, IsAutoRebuild: true, Error: err}
 						if errors.Is(err, errInvalidateDependencies) {
@@ -1112,6 +1236,7 @@ func (e *Engine) watchForPluginEvents(originalCtx context.Context) {
 						}
 						continue
 					}
+					e.localSchema.Store(meta.module.Config.Module, moduleSchema)
 					e.rawEngineUpdates <- ModuleBuildSuccess{Config: meta.module.Config, IsAutoRebuild: true}
 
 					e.rawEngineUpdates <- ModuleDeployStarted{Module: event.Module}
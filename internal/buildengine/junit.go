@@ -0,0 +1,112 @@
+package buildengine
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message  string `xml:"message,attr,omitempty"`
+	Contents string `xml:",chardata"`
+}
+
+// WriteJUnitReport writes test results as JUnit XML, with a test suite for each module.
+//
+// Modules whose test output could not be parsed into individual tests are reported as a single test case.
+func WriteJUnitReport(w io.Writer, results []TestResult) error {
+	report := junitTestSuites{}
+	var total float64
+	for _, result := range results {
+		suite := junitTestSuite{Name: result.Module, Time: seconds(result.Duration.Seconds())}
+		switch {
+		case result.Skipped:
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name:      result.Module,
+				ClassName: result.Module,
+				Time:      seconds(0),
+				Skipped:   &junitMessage{Message: "no test command configured"},
+			})
+		case len(result.Cases) == 0:
+			tc := junitTestCase{Name: result.Module, ClassName: result.Module, Time: suite.Time, SystemOut: result.Output}
+			if result.Err != nil {
+				tc.Failure = &junitMessage{Message: result.Err.Error(), Contents: result.Output}
+				tc.SystemOut = ""
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+		default:
+			for _, c := range result.Cases {
+				tc := junitTestCase{Name: c.Name, ClassName: c.Package, Time: seconds(c.Duration.Seconds())}
+				if tc.ClassName == "" {
+					tc.ClassName = result.Module
+				}
+				switch {
+				case c.Failed:
+					tc.Failure = &junitMessage{Message: "failed", Contents: c.Output}
+				case c.Skipped:
+					tc.Skipped = &junitMessage{Contents: c.Output}
+				}
+				suite.TestCases = append(suite.TestCases, tc)
+			}
+		}
+		for _, tc := range suite.TestCases {
+			suite.Tests++
+			if tc.Failure != nil {
+				suite.Failures++
+			}
+			if tc.Skipped != nil {
+				suite.Skipped++
+			}
+		}
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		total += result.Duration.Seconds()
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("failed to write JUnit report: %w", err)
+	}
+	return nil
+}
+
+func seconds(s float64) string {
+	return fmt.Sprintf("%.3f", s)
+}
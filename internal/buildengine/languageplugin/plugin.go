@@ -249,6 +249,7 @@ func customDefaultsFromProto(proto *langpb.ModuleConfigDefaultsResponse) modulec
 		GeneratedSchemaDir: optional.Ptr(proto.GeneratedSchemaDir),
 		LanguageConfig:     proto.LanguageConfig.AsMap(),
 		SQLMigrationDir:    proto.SqlMigrationDir,
+		Test:               optional.Ptr(proto.Test),
 	}
 }
 
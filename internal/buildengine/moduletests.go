@@ -0,0 +1,223 @@
+package buildengine
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/block/ftl/internal/exec"
+	"github.com/block/ftl/internal/log"
+)
+
+// TestResult is the outcome of running the tests of a single module.
+type TestResult struct {
+	Module   string
+	Language string
+	// Skipped is true if the module has no test command configured.
+	Skipped  bool
+	Duration time.Duration
+	// Cases are the individual tests, if the test output could be parsed.
+	Cases  []TestCase
+	Output string
+	// Err is set if the tests failed, or could not be run.
+	Err error
+}
+
+// Failed returns true if the module's tests failed.
+func (r TestResult) Failed() bool { return r.Err != nil }
+
+// TestCase is the outcome of a single test.
+type TestCase struct {
+	Name    string
+	Package string
+	Skipped bool
+	Failed  bool
+	// Duration of the test, if reported.
+	Duration time.Duration
+	Output   string
+}
+
+// TestEnvironment returns additional environment variables to set when running a module's tests.
+type TestEnvironment func(ctx context.Context, module Module) ([]string, error)
+
+// Test runs the tests of the given local modules, or all local modules if none are given.
+//
+// Modules are tested in dependency order, with modules that do not depend on each other tested in parallel. The
+// modules should already have been built so that stubs for their dependencies are available. A failure in one
+// module does not prevent the remaining modules from being tested.
+func (e *Engine) Test(ctx context.Context, env TestEnvironment, moduleNames ...string) ([]TestResult, error) {
+	if len(moduleNames) == 0 {
+		moduleNames = e.Modules()
+	}
+	selected := map[string]bool{}
+	for _, name := range moduleNames {
+		if _, ok := e.moduleMetas.Load(name); !ok {
+			return nil, fmt.Errorf("module %q not found", name)
+		}
+		selected[name] = true
+	}
+	graph, err := e.Graph(moduleNames...)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := TopologicalSort(graph)
+	if err != nil {
+		return nil, fmt.Errorf("could not order modules: %w", err)
+	}
+
+	var lock sync.Mutex
+	results := []TestResult{}
+	for _, group := range groups {
+		wg := errgroup.Group{}
+		wg.SetLimit(e.parallelism)
+		for _, name := range group {
+			meta, ok := e.moduleMetas.Load(name)
+			if !ok || !selected[name] {
+				continue
+			}
+			wg.Go(func() error {
+				result := runModuleTests(ctx, meta.module, env)
+				lock.Lock()
+				defer lock.Unlock()
+				results = append(results, result)
+				return nil
+			})
+		}
+		_ = wg.Wait() //nolint:errcheck
+		if ctx.Err() != nil {
+			return results, ctx.Err() //nolint:wrapcheck
+		}
+	}
+	return results, nil
+}
+
+func runModuleTests(ctx context.Context, module Module, env TestEnvironment) TestResult {
+	config := module.Config
+	result := TestResult{Module: config.Module, Language: config.Language}
+	if config.Test == "" {
+		result.Skipped = true
+		return result
+	}
+	logger := log.FromContext(ctx).Module(config.Module).Scope("test")
+	ctx = log.ContextWithLogger(ctx, logger)
+
+	extraEnv, err := env(ctx, module)
+	if err != nil {
+		result.Err = fmt.Errorf("could not set up test environment: %w", err)
+		return result
+	}
+	logger.Infof("Testing")
+	start := time.Now()
+	output := &bytes.Buffer{}
+	cmd := exec.CommandWithEnv(ctx, log.Debug, config.Dir, extraEnv, "bash", "-c", config.Test)
+	cmd.Stdout = output
+	cmd.Stderr = output
+	if err := cmd.Run(); err != nil {
+		result.Err = fmt.Errorf("tests failed: %w", err)
+	}
+	result.Duration = time.Since(start)
+	result.Output = output.String()
+	if cases, ok := parseGoTestEvents(output.Bytes()); ok {
+		result.Cases = cases
+		if failures := goTestOutput(cases); failures != "" {
+			result.Output = failures
+		}
+	}
+	return result
+}
+
+// goTestEvent is a single event emitted by "go test -json".
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// parseGoTestEvents parses the output of "go test -json" into individual test cases.
+//
+// Packages that fail without any failing test, such as when they do not compile, are reported as a failed case
+// named after the package. Returns false if the output is not in this format.
+func parseGoTestEvents(output []byte) ([]TestCase, bool) {
+	type key struct{ pkg, test string }
+	cases := map[key]*TestCase{}
+	order := []key{}
+	packageFailed := map[string]bool{}
+	packageHasFailure := map[string]bool{}
+	parsed := false
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+		var event goTestEvent
+		if err := json.Unmarshal(line, &event); err != nil || event.Action == "" {
+			continue
+		}
+		parsed = true
+		k := key{event.Package, event.Test}
+		c, ok := cases[k]
+		if !ok {
+			c = &TestCase{Name: event.Test, Package: event.Package}
+			if event.Test == "" {
+				c.Name = event.Package
+			}
+			cases[k] = c
+			order = append(order, k)
+		}
+		switch event.Action {
+		case "output":
+			c.Output += event.Output
+		case "pass", "fail", "skip":
+			c.Duration = time.Duration(event.Elapsed * float64(time.Second))
+			c.Skipped = event.Action == "skip"
+			c.Failed = event.Action == "fail"
+			if event.Test == "" {
+				packageFailed[event.Package] = c.Failed
+			} else if c.Failed {
+				packageHasFailure[event.Package] = true
+			}
+		}
+	}
+	if !parsed {
+		return nil, false
+	}
+	out := []TestCase{}
+	for _, k := range order {
+		c := cases[k]
+		// Package level results are only reported if they are not explained by a failing test.
+		if k.test == "" && (!packageFailed[k.pkg] || packageHasFailure[k.pkg]) {
+			continue
+		}
+		out = append(out, *c)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Package != out[j].Package {
+			return out[i].Package < out[j].Package
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out, true
+}
+
+// goTestOutput returns the output of the failed test cases.
+func goTestOutput(cases []TestCase) string {
+	out := &strings.Builder{}
+	for _, c := range cases {
+		if c.Failed {
+			out.WriteString(c.Output)
+		}
+	}
+	return out.String()
+}
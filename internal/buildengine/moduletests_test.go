@@ -0,0 +1,62 @@
+package buildengine
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+const goTestJSON = `{"Action":"start","Package":"ftl/echo"}
+{"Action":"run","Package":"ftl/echo","Test":"TestEcho"}
+{"Action":"output","Package":"ftl/echo","Test":"TestEcho","Output":"=== RUN   TestEcho\n"}
+{"Action":"output","Package":"ftl/echo","Test":"TestEcho","Output":"    echo_test.go:12: expected hello\n"}
+{"Action":"fail","Package":"ftl/echo","Test":"TestEcho","Elapsed":0.5}
+{"Action":"run","Package":"ftl/echo","Test":"TestSkipped"}
+{"Action":"skip","Package":"ftl/echo","Test":"TestSkipped","Elapsed":0}
+{"Action":"run","Package":"ftl/echo","Test":"TestPass"}
+{"Action":"pass","Package":"ftl/echo","Test":"TestPass","Elapsed":0.25}
+{"Action":"fail","Package":"ftl/echo","Elapsed":1}
+{"Action":"output","Package":"ftl/echo/broken","Output":"FAIL\tftl/echo/broken [build failed]\n"}
+{"Action":"fail","Package":"ftl/echo/broken","Elapsed":0}
+`
+
+func TestParseGoTestEvents(t *testing.T) {
+	cases, ok := parseGoTestEvents([]byte(goTestJSON))
+	assert.True(t, ok)
+	assert.Equal(t, []TestCase{
+		{Name: "TestEcho", Package: "ftl/echo", Failed: true, Duration: 500 * time.Millisecond, Output: "=== RUN   TestEcho\n    echo_test.go:12: expected hello\n"},
+		{Name: "TestPass", Package: "ftl/echo", Duration: 250 * time.Millisecond},
+		{Name: "TestSkipped", Package: "ftl/echo", Skipped: true},
+		{Name: "ftl/echo/broken", Package: "ftl/echo/broken", Failed: true, Output: "FAIL\tftl/echo/broken [build failed]\n"},
+	}, cases)
+
+	_, ok = parseGoTestEvents([]byte("Tests run: 3, Failures: 0\nBUILD SUCCESS\n"))
+	assert.False(t, ok)
+}
+
+func TestWriteJUnitReport(t *testing.T) {
+	cases, _ := parseGoTestEvents([]byte(goTestJSON))
+	results := []TestResult{
+		{Module: "echo", Language: "go", Duration: time.Second, Cases: cases, Err: errors.New("tests failed")},
+		{Module: "time", Language: "java", Duration: 2 * time.Second, Output: "BUILD SUCCESS"},
+		{Module: "py", Language: "python", Skipped: true},
+	}
+	w := &bytes.Buffer{}
+	assert.NoError(t, WriteJUnitReport(w, results))
+	report := w.String()
+	for _, expected := range []string{
+		`<testsuites tests="6" failures="2" skipped="2" time="3.000">`,
+		`<testsuite name="echo" tests="4" failures="2" skipped="1" time="1.000">`,
+		`<testcase name="TestEcho" classname="ftl/echo" time="0.500">`,
+		`<failure message="failed">=== RUN   TestEcho&#xA;    echo_test.go:12: expected hello&#xA;</failure>`,
+		`<testcase name="time" classname="time" time="2.000">`,
+		`<system-out>BUILD SUCCESS</system-out>`,
+		`<skipped message="no test command configured"></skipped>`,
+	} {
+		assert.True(t, strings.Contains(report, expected), "missing %q in:\n%s", expected, report)
+	}
+}
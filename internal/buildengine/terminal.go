@@ -30,7 +30,11 @@ func updateTerminalWithEngineEvents(ctx context.Context, topic *pubsub.Topic[Eng
 			case ModuleBuildStarted:
 				terminal.UpdateModuleState(ctx, event.Config.Module, terminal.BuildStateBuilding)
 			case ModuleBuildSuccess:
-				terminal.UpdateModuleState(ctx, event.Config.Module, terminal.BuildStateBuilt)
+				if event.Cached {
+					terminal.UpdateModuleState(ctx, event.Config.Module, terminal.BuildStateCached)
+				} else {
+					terminal.UpdateModuleState(ctx, event.Config.Module, terminal.BuildStateBuilt)
+				}
 			case ModuleBuildFailed:
 				terminal.UpdateModuleState(ctx, event.Config.Module, terminal.BuildStateFailed)
 
@@ -0,0 +1,136 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Config for TLS, shared by FTL servers and clients.
+type Config struct {
+	Cert          string   `help:"PEM encoded certificate to serve https:// endpoints with, also presented to servers that verify clients. Reloaded when changed." env:"FTL_TLS_CERT" placeholder:"FILE"`
+	Key           string   `help:"PEM encoded private key for the certificate." env:"FTL_TLS_KEY" placeholder:"FILE"`
+	CA            []string `help:"PEM encoded CA certificates to trust in addition to the system roots, and to verify client certificates with." env:"FTL_TLS_CA" placeholder:"FILE"`
+	VerifyClients bool     `help:"Require clients of https:// endpoints to present a certificate signed by a trusted CA." env:"FTL_TLS_VERIFY_CLIENTS"`
+	DevCA         string   `help:"Directory of a development CA to issue certificates from when no certificate is provided. Created if it does not exist." env:"FTL_TLS_DEV_CA" placeholder:"DIR"`
+}
+
+type state struct {
+	keyPair       *KeyPair
+	devCA         *DevCA
+	devClientCert *issuedCertificate
+	// Trusted roots for clients, or nil for the system roots.
+	roots         *x509.CertPool
+	clientCAs     *x509.CertPool
+	verifyClients bool
+}
+
+var (
+	lock    sync.RWMutex
+	current = &state{}
+)
+
+// Configure TLS for servers and clients created after this call.
+func Configure(config Config) error {
+	next := &state{verifyClients: config.VerifyClients}
+	switch {
+	case config.Cert != "" && config.Key != "":
+		keyPair, err := LoadKeyPair(config.Cert, config.Key)
+		if err != nil {
+			return err
+		}
+		next.keyPair = keyPair
+	case config.Cert != "" || config.Key != "":
+		return errors.New("both a TLS certificate and key must be provided")
+	case config.DevCA != "":
+		devCA, err := LoadOrCreateDevCA(config.DevCA)
+		if err != nil {
+			return err
+		}
+		clientCert := devCA.issuer("ftl-client")
+		if _, err := clientCert.Get(); err != nil {
+			return err
+		}
+		next.devCA = devCA
+		next.devClientCert = clientCert
+	}
+
+	if len(config.CA) > 0 || next.devCA != nil {
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		clientCAs := x509.NewCertPool()
+		for _, file := range config.CA {
+			pem, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read CA certificates: %w", err)
+			}
+			if !roots.AppendCertsFromPEM(pem) || !clientCAs.AppendCertsFromPEM(pem) {
+				return fmt.Errorf("%s: no CA certificates found", file)
+			}
+		}
+		if next.devCA != nil {
+			roots.AddCert(next.devCA.cert)
+			clientCAs.AddCert(next.devCA.cert)
+		}
+		next.roots = roots
+		next.clientCAs = clientCAs
+	}
+	if next.verifyClients && next.clientCAs == nil {
+		return errors.New("a CA must be provided to verify client certificates with")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	current = next
+	return nil
+}
+
+// ServerConfig returns the TLS configuration for a server bound to the given host.
+func ServerConfig(host string) (*tls.Config, error) {
+	lock.RLock()
+	s := current
+	lock.RUnlock()
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	switch {
+	case s.keyPair != nil:
+		config.GetCertificate = s.keyPair.GetCertificate
+	case s.devCA != nil:
+		cert := s.devCA.issuer(host, host, "localhost", "127.0.0.1", "::1")
+		if _, err := cert.Get(); err != nil {
+			return nil, err
+		}
+		config.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) { return cert.Get() }
+	default:
+		return nil, fmt.Errorf("no TLS certificate for %s, provide one with --tls-cert and --tls-key or use --tls-dev-ca", host)
+	}
+	if s.verifyClients {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = s.clientCAs
+	}
+	return config, nil
+}
+
+// ClientConfig returns the TLS configuration for clients.
+//
+// "allowInsecure" skips certificate verification, making TLS susceptible to machine-in-the-middle attacks.
+func ClientConfig(allowInsecure bool) *tls.Config {
+	lock.RLock()
+	s := current
+	lock.RUnlock()
+	config := &tls.Config{
+		RootCAs:            s.roots,
+		InsecureSkipVerify: allowInsecure, // #nosec G402
+	}
+	switch {
+	case s.keyPair != nil:
+		config.GetClientCertificate = s.keyPair.GetClientCertificate
+	case s.devClientCert != nil:
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) { return s.devClientCert.Get() }
+	}
+	return config
+}
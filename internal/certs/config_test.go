@@ -0,0 +1,66 @@
+package certs
+
+import (
+	"crypto/tls"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestServerRequiresCertificate(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, Configure(Config{})) })
+	assert.NoError(t, Configure(Config{}))
+	_, err := ServerConfig("localhost")
+	assert.Error(t, err)
+
+	err = Configure(Config{Cert: "cert.pem"})
+	assert.EqualError(t, err, "both a TLS certificate and key must be provided")
+
+	err = Configure(Config{DevCA: filepath.Join(t.TempDir(), "ca"), VerifyClients: true})
+	assert.NoError(t, err)
+	err = Configure(Config{VerifyClients: true})
+	assert.EqualError(t, err, "a CA must be provided to verify client certificates with")
+}
+
+func TestDevCAHandshake(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, Configure(Config{})) })
+	assert.NoError(t, Configure(Config{DevCA: filepath.Join(t.TempDir(), "ca"), VerifyClients: true}))
+
+	serverConfig, err := ServerConfig("localhost")
+	assert.NoError(t, err)
+	clientConfig := ClientConfig(false)
+	clientConfig.ServerName = "localhost"
+	assert.NoError(t, handshake(serverConfig, clientConfig))
+
+	// Clients without a certificate are rejected.
+	clientConfig = ClientConfig(false)
+	clientConfig.ServerName = "localhost"
+	clientConfig.GetClientCertificate = nil
+	assert.Error(t, handshake(serverConfig, clientConfig))
+}
+
+// handshake connects a client to a server, returning an error if either rejects the other.
+func handshake(serverConfig, clientConfig *tls.Config) error {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = conn.Write([]byte("ok")) //nolint:errcheck
+	}()
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = io.ReadFull(conn, make([]byte, 2))
+	return err
+}
@@ -0,0 +1,176 @@
+// Package certs provides TLS certificates for FTL servers and clients, either from files that are reloaded when they
+// change, or issued by a development CA that is created on demand.
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	caCertFile = "ca.pem"
+	caKeyFile  = "ca-key.pem"
+
+	caValidity   = time.Hour * 24 * 365 * 10
+	leafValidity = time.Hour * 24 * 30
+	// leafRenewal is how long before it expires an issued certificate is reissued.
+	leafRenewal = leafValidity / 3
+)
+
+// DevCA is a self-signed CA for local development, that issues certificates for servers and clients.
+type DevCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// LoadOrCreateDevCA loads the CA in dir, creating it if it does not exist.
+//
+// The CA certificate is written to dir/ca.pem, which clients outside FTL can be configured to trust.
+func LoadOrCreateDevCA(dir string) (*DevCA, error) {
+	certPath := filepath.Join(dir, caCertFile)
+	keyPath := filepath.Join(dir, caKeyFile)
+	pair, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err == nil {
+		key, ok := pair.PrivateKey.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected an ECDSA key", keyPath)
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", certPath, err)
+		}
+		return &DevCA{cert: cert, key: key}, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to load development CA: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate development CA key: %w", err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber(),
+		Subject:               pkix.Name{Organization: []string{"FTL"}, CommonName: "FTL Development CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create development CA: %w", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create development CA: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode development CA key: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create development CA directory: %w", err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write development CA key: %w", err)
+	}
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write development CA: %w", err)
+	}
+	return &DevCA{cert: cert, key: key}, nil
+}
+
+// Pool returns a pool containing the CA certificate.
+func (c *DevCA) Pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(c.cert)
+	return pool
+}
+
+// Issue a certificate for the given host names and IP addresses, usable by both servers and clients.
+func (c *DevCA) Issue(commonName string, hosts ...string) (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber(),
+		Subject:      pkix.Name{Organization: []string{"FTL"}, CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(leafValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, &key.PublicKey, c.key)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to issue certificate for %s: %w", commonName, err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to issue certificate for %s: %w", commonName, err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der, c.cert.Raw}, PrivateKey: key, Leaf: leaf}, nil
+}
+
+// issuedCertificate is a certificate issued by a DevCA, that is reissued when it is close to expiry so that
+// long-running servers and clients keep working.
+type issuedCertificate struct {
+	ca         *DevCA
+	commonName string
+	hosts      []string
+	now        func() time.Time
+
+	lock sync.Mutex
+	cert *tls.Certificate
+}
+
+func (c *DevCA) issuer(commonName string, hosts ...string) *issuedCertificate {
+	return &issuedCertificate{ca: c, commonName: commonName, hosts: hosts, now: time.Now}
+}
+
+// Get the certificate, issuing a new one if there is none or it expires soon.
+func (i *issuedCertificate) Get() (*tls.Certificate, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.cert != nil && i.now().Before(i.cert.Leaf.NotAfter.Add(-leafRenewal)) {
+		return i.cert, nil
+	}
+	cert, err := i.ca.Issue(i.commonName, i.hosts...)
+	if err != nil {
+		return nil, err
+	}
+	i.cert = &cert
+	return i.cert, nil
+}
+
+func serialNumber() *big.Int {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		panic(err)
+	}
+	return serial
+}
@@ -0,0 +1,52 @@
+package certs
+
+import (
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestDevCA(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tls")
+	ca, err := LoadOrCreateDevCA(dir)
+	assert.NoError(t, err)
+	info, err := os.Stat(filepath.Join(dir, caKeyFile))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadOrCreateDevCA(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, ca.cert.Raw, loaded.cert.Raw, "existing CA should be loaded")
+
+	cert, err := loaded.Issue("controller", "ftl.internal", "10.0.0.1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ftl.internal"}, cert.Leaf.DNSNames)
+	assert.Equal(t, "10.0.0.1", cert.Leaf.IPAddresses[0].String())
+	for _, usage := range []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth} {
+		_, err = cert.Leaf.Verify(x509.VerifyOptions{DNSName: "ftl.internal", Roots: ca.Pool(), KeyUsages: []x509.ExtKeyUsage{usage}})
+		assert.NoError(t, err)
+	}
+}
+
+func TestIssuedCertificateIsRenewed(t *testing.T) {
+	ca, err := LoadOrCreateDevCA(filepath.Join(t.TempDir(), "tls"))
+	assert.NoError(t, err)
+	now := time.Now()
+	issued := ca.issuer("controller", "localhost")
+	issued.now = func() time.Time { return now }
+
+	first, err := issued.Get()
+	assert.NoError(t, err)
+	again, err := issued.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, first.Leaf.SerialNumber, again.Leaf.SerialNumber, "certificate should be reused until it is close to expiry")
+
+	now = first.Leaf.NotAfter.Add(-leafRenewal + time.Minute)
+	renewed, err := issued.Get()
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Leaf.SerialNumber, renewed.Leaf.SerialNumber, "certificate should be reissued when close to expiry")
+}
@@ -0,0 +1,91 @@
+package certs
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// How often certificate files are checked for changes.
+const reloadInterval = time.Second * 10
+
+// KeyPair is a certificate and key loaded from files, which are reloaded when they change so that certificates can
+// be rotated without restarting.
+type KeyPair struct {
+	certFile string
+	keyFile  string
+
+	lock      sync.Mutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	checkedAt time.Time
+	now       func() time.Time
+}
+
+// LoadKeyPair loads a PEM encoded certificate and key.
+func LoadKeyPair(certFile, keyFile string) (*KeyPair, error) {
+	k := &KeyPair{certFile: certFile, keyFile: keyFile, now: time.Now}
+	if _, err := k.Certificate(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Certificate returns the current certificate, reloading it if the files have changed.
+//
+// If reloading fails, the previous certificate continues to be used.
+func (k *KeyPair) Certificate() (*tls.Certificate, error) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	now := k.now()
+	if k.cert != nil && now.Sub(k.checkedAt) < reloadInterval {
+		return k.cert, nil
+	}
+	k.checkedAt = now
+	modTime, err := k.latestModTime()
+	if err != nil {
+		if k.cert != nil {
+			return k.cert, nil
+		}
+		return nil, err
+	}
+	if k.cert != nil && !modTime.After(k.modTime) {
+		return k.cert, nil
+	}
+	cert, err := tls.LoadX509KeyPair(k.certFile, k.keyFile)
+	if err != nil {
+		if k.cert != nil {
+			return k.cert, nil
+		}
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	k.cert = &cert
+	k.modTime = modTime
+	return k.cert, nil
+}
+
+func (k *KeyPair) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{k.certFile, k.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// GetCertificate implements [tls.Config.GetCertificate].
+func (k *KeyPair) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return k.Certificate()
+}
+
+// GetClientCertificate implements [tls.Config.GetClientCertificate].
+func (k *KeyPair) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return k.Certificate()
+}
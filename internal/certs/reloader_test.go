@@ -0,0 +1,62 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+)
+
+func TestKeyPairReloadsWhenChanged(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := LoadOrCreateDevCA(filepath.Join(dir, "ca"))
+	assert.NoError(t, err)
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	writeKeyPair(t, ca, "first", certFile, keyFile)
+
+	keyPair, err := LoadKeyPair(certFile, keyFile)
+	assert.NoError(t, err)
+	now := time.Now()
+	keyPair.now = func() time.Time { return now }
+	assertCommonName(t, keyPair, "first")
+
+	writeKeyPair(t, ca, "second", certFile, keyFile)
+	later := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(certFile, later, later))
+	assertCommonName(t, keyPair, "first")
+
+	now = now.Add(reloadInterval)
+	assertCommonName(t, keyPair, "second")
+
+	// The previous certificate is kept if the new one can't be loaded.
+	assert.NoError(t, os.WriteFile(keyFile, []byte("invalid"), 0600))
+	later = later.Add(time.Minute)
+	assert.NoError(t, os.Chtimes(keyFile, later, later))
+	now = now.Add(reloadInterval)
+	assertCommonName(t, keyPair, "second")
+}
+
+func writeKeyPair(t *testing.T, ca *DevCA, commonName, certFile, keyFile string) {
+	t.Helper()
+	cert, err := ca.Issue(commonName, "localhost")
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600))
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600))
+}
+
+func assertCommonName(t *testing.T, keyPair *KeyPair, expected string) {
+	t.Helper()
+	cert, err := keyPair.GetCertificate(&tls.ClientHelloInfo{})
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.NoError(t, err)
+	assert.Equal(t, expected, leaf.Subject.CommonName)
+}
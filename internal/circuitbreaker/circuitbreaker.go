@@ -0,0 +1,253 @@
+// Package circuitbreaker stops calls being sent to verbs in deployments that
+// are failing, so that callers fail fast rather than each waiting for a call
+// to fail.
+//
+// Each breaker starts closed, allowing calls. Once enough calls in a window
+// fail, or take longer than the verb's latency threshold, the breaker opens
+// and calls are rejected until the cooldown has elapsed. The breaker is then
+// half-open, and allows a single call through: if it succeeds the breaker
+// closes, otherwise it opens again.
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/model"
+)
+
+const (
+	// Window is the period over which calls are counted to determine the error rate.
+	Window = 30 * time.Second
+	// MinRequests is the number of calls that must be made in a window before the breaker can open.
+	MinRequests = 10
+)
+
+// ErrOpen is returned when a call is rejected by an open circuit breaker.
+var ErrOpen = errors.New("circuit breaker is open")
+
+type State int
+
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Key identifies the breaker for a verb in a deployment.
+type Key struct {
+	Deployment model.DeploymentKey
+	Verb       schema.RefKey
+}
+
+func (k Key) String() string { return fmt.Sprintf("%s in %s", k.Verb, k.Deployment) }
+
+// Transition is a change in the state of a breaker.
+type Transition struct {
+	Key  Key
+	From State
+	To   State
+	Time time.Time
+	// Requests and Failures are the counts in the window that caused the transition.
+	Requests int
+	Failures int
+	// Cooldown of the breaker, after which an open breaker allows a call through.
+	Cooldown time.Duration
+}
+
+// Breakers holds the circuit breakers for calls made by a single client.
+type Breakers struct {
+	lock         sync.Mutex
+	breakers     map[string]*breaker
+	onTransition func(Transition)
+	lastPruned   time.Time
+	now          func() time.Time
+}
+
+// New creates a new set of breakers, calling onTransition whenever a breaker changes state.
+func New(onTransition func(Transition)) *Breakers {
+	return &Breakers{
+		breakers:     map[string]*breaker{},
+		onTransition: onTransition,
+		now:          time.Now,
+	}
+}
+
+// Call calls fn unless the breaker for the verb is open, in which case an
+// error with [connect.CodeUnavailable] wrapping [ErrOpen] is returned.
+//
+// Calls to verbs without circuit breaker metadata are always made.
+func (b *Breakers) Call(key Key, verb *schema.Verb, fn func() error) error {
+	md, ok := verb.GetMetadataCircuitBreaker().Get()
+	if !ok {
+		return fn()
+	}
+	params, err := md.Params()
+	if err != nil {
+		return fn()
+	}
+	start := b.now()
+	if err := b.allow(key, params, start); err != nil {
+		return err
+	}
+	err = fn()
+	b.record(key, isFailure(err) || (params.Latency > 0 && b.now().Sub(start) > params.Latency))
+	return err
+}
+
+func (b *Breakers) allow(key Key, params schema.CircuitBreakerParams, now time.Time) error {
+	var transition optional.Option[Transition]
+	defer func() {
+		if t, ok := transition.Get(); ok && b.onTransition != nil {
+			b.onTransition(t)
+		}
+	}()
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.prune(now)
+	br, ok := b.breakers[key.String()]
+	if !ok {
+		br = &breaker{windowStart: now}
+		b.breakers[key.String()] = br
+	}
+	br.params = params
+	br.lastUsed = now
+	switch br.state {
+	case Closed:
+		return nil
+	case Open:
+		if now.Sub(br.changed) < params.Cooldown {
+			return rejected(key)
+		}
+		transition = optional.Some(br.transition(key, HalfOpen, now))
+		br.probing = true
+		return nil
+	case HalfOpen:
+		if br.probing {
+			return rejected(key)
+		}
+		br.probing = true
+		return nil
+	default:
+		panic(fmt.Sprintf("unknown circuit breaker state %s", br.state))
+	}
+}
+
+func (b *Breakers) record(key Key, failed bool) {
+	var transition optional.Option[Transition]
+	defer func() {
+		if t, ok := transition.Get(); ok && b.onTransition != nil {
+			b.onTransition(t)
+		}
+	}()
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	br, ok := b.breakers[key.String()]
+	if !ok {
+		return
+	}
+	now := b.now()
+	switch br.state {
+	case Closed:
+		if now.Sub(br.windowStart) >= Window {
+			br.windowStart = now
+			br.requests = 0
+			br.failures = 0
+		}
+		br.requests++
+		if failed {
+			br.failures++
+		}
+		if br.requests >= MinRequests && float64(br.failures)/float64(br.requests) >= br.params.ErrorRate {
+			transition = optional.Some(br.transition(key, Open, now))
+		}
+	case HalfOpen:
+		br.probing = false
+		br.requests = 1
+		br.failures = 0
+		if failed {
+			br.failures = 1
+			transition = optional.Some(br.transition(key, Open, now))
+		} else {
+			transition = optional.Some(br.transition(key, Closed, now))
+		}
+	case Open:
+		// A call that was allowed before the breaker opened.
+	}
+}
+
+// prune removes closed breakers that have not been used for a while, such as
+// those for deployments that have been replaced.
+func (b *Breakers) prune(now time.Time) {
+	if now.Sub(b.lastPruned) < Window {
+		return
+	}
+	b.lastPruned = now
+	for key, br := range b.breakers {
+		if br.state == Closed && now.Sub(br.lastUsed) > Window*2 {
+			delete(b.breakers, key)
+		}
+	}
+}
+
+type breaker struct {
+	params      schema.CircuitBreakerParams
+	state       State
+	changed     time.Time
+	windowStart time.Time
+	requests    int
+	failures    int
+	probing     bool
+	lastUsed    time.Time
+}
+
+func (br *breaker) transition(key Key, to State, now time.Time) Transition {
+	t := Transition{Key: key, From: br.state, To: to, Time: now, Requests: br.requests, Failures: br.failures, Cooldown: br.params.Cooldown}
+	br.state = to
+	br.changed = now
+	if to == Closed {
+		br.windowStart = now
+		br.requests = 0
+		br.failures = 0
+	}
+	return t
+}
+
+func rejected(key Key) error {
+	return connect.NewError(connect.CodeUnavailable, fmt.Errorf("call to %s rejected: %w", key, ErrOpen))
+}
+
+// isFailure returns true if the error indicates that the deployment is
+// unhealthy, rather than that the call itself was invalid.
+func isFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	switch connect.CodeOf(err) {
+	case connect.CodeCanceled, connect.CodeInvalidArgument, connect.CodeNotFound, connect.CodePermissionDenied,
+		connect.CodeUnauthenticated, connect.CodeAlreadyExists:
+		return false
+	default:
+		return true
+	}
+}
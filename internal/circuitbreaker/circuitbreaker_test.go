@@ -0,0 +1,103 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/model"
+)
+
+var errCall error = connect.NewError(connect.CodeUnavailable, errors.New("connection refused"))
+
+func TestBreakerOpensAndRecovers(t *testing.T) {
+	breakers, now, transitions := newTestBreakers()
+	verb := &schema.Verb{Name: "charge", Metadata: []schema.Metadata{&schema.MetadataCircuitBreaker{Cooldown: "10s"}}}
+	key := Key{Deployment: model.NewDeploymentKey("payments"), Verb: schema.RefKey{Module: "payments", Name: "charge"}}
+
+	for range MinRequests / 2 {
+		assert.NoError(t, breakers.Call(key, verb, func() error { return nil }))
+	}
+	for range MinRequests/2 - 1 {
+		assert.Equal(t, errCall, breakers.Call(key, verb, func() error { return errCall }))
+	}
+	assert.Equal(t, 0, len(*transitions), "breaker should not open until the minimum number of requests is reached")
+	assert.Equal(t, errCall, breakers.Call(key, verb, func() error { return errCall }))
+	assert.Equal(t, []State{Open}, states(*transitions))
+	assert.Equal(t, MinRequests, (*transitions)[0].Requests)
+	assert.Equal(t, MinRequests/2, (*transitions)[0].Failures)
+
+	called := false
+	err := breakers.Call(key, verb, func() error { called = true; return nil })
+	assert.False(t, called, "calls should fail fast while the breaker is open")
+	assert.IsError(t, err, ErrOpen)
+	assert.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+
+	// After the cooldown a single probe is allowed, and failing it re-opens the breaker.
+	*now = now.Add(10 * time.Second)
+	assert.Equal(t, errCall, breakers.Call(key, verb, func() error { return errCall }))
+	assert.Equal(t, []State{Open, HalfOpen, Open}, states(*transitions))
+	assert.IsError(t, breakers.Call(key, verb, func() error { return nil }), ErrOpen)
+
+	// Further calls are rejected while the probe is in flight.
+	*now = now.Add(10 * time.Second)
+	assert.NoError(t, breakers.Call(key, verb, func() error {
+		assert.IsError(t, breakers.Call(key, verb, func() error { return nil }), ErrOpen)
+		return nil
+	}))
+	assert.Equal(t, []State{Open, HalfOpen, Open, HalfOpen, Closed}, states(*transitions))
+	assert.NoError(t, breakers.Call(key, verb, func() error { return nil }))
+}
+
+func TestBreakerCountsSlowCallsAsFailures(t *testing.T) {
+	breakers, now, transitions := newTestBreakers()
+	verb := &schema.Verb{Name: "charge", Metadata: []schema.Metadata{&schema.MetadataCircuitBreaker{Latency: "1s"}}}
+	key := Key{Deployment: model.NewDeploymentKey("payments"), Verb: schema.RefKey{Module: "payments", Name: "charge"}}
+
+	for range MinRequests {
+		assert.NoError(t, breakers.Call(key, verb, func() error {
+			*now = now.Add(2 * time.Second)
+			return nil
+		}))
+	}
+	assert.Equal(t, []State{Open}, states(*transitions))
+}
+
+func TestBreakerIgnoresInvalidCalls(t *testing.T) {
+	breakers, _, transitions := newTestBreakers()
+	verb := &schema.Verb{Name: "charge", Metadata: []schema.Metadata{&schema.MetadataCircuitBreaker{}}}
+	key := Key{Deployment: model.NewDeploymentKey("payments"), Verb: schema.RefKey{Module: "payments", Name: "charge"}}
+
+	var invalid error = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	for range MinRequests * 2 {
+		assert.Equal(t, invalid, breakers.Call(key, verb, func() error { return invalid }))
+	}
+	assert.Equal(t, 0, len(*transitions))
+
+	// Verbs without circuit breaker metadata are never broken.
+	unguarded := &schema.Verb{Name: "refund"}
+	for range MinRequests * 2 {
+		assert.Equal(t, errCall, breakers.Call(key, unguarded, func() error { return errCall }))
+	}
+	assert.Equal(t, 0, len(*transitions))
+}
+
+func newTestBreakers() (*Breakers, *time.Time, *[]Transition) {
+	now := time.Now()
+	transitions := &[]Transition{}
+	breakers := New(func(t Transition) { *transitions = append(*transitions, t) })
+	breakers.now = func() time.Time { return now }
+	return breakers, &now, transitions
+}
+
+func states(transitions []Transition) []State {
+	out := make([]State, len(transitions))
+	for i, t := range transitions {
+		out[i] = t.To
+	}
+	return out
+}
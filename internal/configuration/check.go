@@ -0,0 +1,89 @@
+package configuration
+
+import (
+	"fmt"
+
+	"github.com/block/ftl/common/encoding"
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/slices"
+)
+
+// ValueProblem is a config value or secret used by a module that is missing or invalid.
+type ValueProblem struct {
+	Module string
+	Name   string
+	Secret bool
+	Err    error
+}
+
+func (p ValueProblem) Error() string {
+	kind := "config"
+	if p.Secret {
+		kind = "secret"
+	}
+	return fmt.Sprintf("%s %s.%s: %s", kind, p.Module, p.Name, p.Err)
+}
+
+func (p ValueProblem) Unwrap() error { return p.Err }
+
+// CheckModuleValues checks that every config value and secret declared by a module
+// is set and valid for its declared type.
+//
+// configs and secrets are the values visible to the module, as returned by
+// MapConfigsForModule and MapSecretsForModule. Values that are not set are allowed
+// for optional types and for configs with a default.
+func CheckModuleValues(sch *schema.Schema, module *schema.Module, configs, secrets map[string][]byte) []ValueProblem {
+	problems := []ValueProblem{}
+	for _, decl := range module.Decls {
+		var (
+			typ        schema.Type
+			secret     bool
+			values     map[string][]byte
+			hasDefault bool
+		)
+		switch decl := decl.(type) {
+		case *schema.Config:
+			typ, values, hasDefault = decl.Type, configs, decl.Default != nil
+		case *schema.Secret:
+			typ, values, secret = decl.Type, secrets, true
+		default:
+			continue
+		}
+		problem := ValueProblem{Module: module.Name, Name: decl.GetName(), Secret: secret}
+		value, ok := values[decl.GetName()]
+		if !ok {
+			if _, isOptional := typ.(*schema.Optional); isOptional || hasDefault {
+				continue
+			}
+			problem.Err = fmt.Errorf("not set")
+			problems = append(problems, problem)
+			continue
+		}
+		var v any
+		if err := encoding.Unmarshal(value, &v); err != nil {
+			problem.Err = fmt.Errorf("could not unmarshal JSON value: %w", err)
+			problems = append(problems, problem)
+			continue
+		}
+		if err := schema.ValidateJSONValue(typ, []string{decl.GetName()}, v, sch); err != nil {
+			problem.Err = err
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+// WithConfigDefaults returns configs with the declared default of each config in
+// the module that is not set.
+func WithConfigDefaults(module *schema.Module, configs map[string][]byte) map[string][]byte {
+	out := make(map[string][]byte, len(configs))
+	for name, value := range configs {
+		out[name] = value
+	}
+	for config := range slices.FilterVariants[*schema.Config](module.Decls) {
+		if _, ok := out[config.Name]; !ok && config.Default != nil {
+			out[config.Name] = []byte(*config.Default)
+		}
+	}
+	return out
+}
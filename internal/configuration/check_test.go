@@ -0,0 +1,41 @@
+package configuration
+
+import (
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/slices"
+)
+
+func TestCheckModuleValues(t *testing.T) {
+	sch, err := schema.ParseString("", `
+		module echo {
+			data Settings {
+				retries Int
+			}
+
+			config port Int
+			config name String = "\"echo\""
+			config settings echo.Settings
+			config nickname String?
+			secret apiKey String
+			secret token String
+		}
+	`)
+	assert.NoError(t, err)
+	module := sch.Module("echo").MustGet()
+
+	problems := CheckModuleValues(sch, module,
+		map[string][]byte{"port": []byte(`"eighty"`), "settings": []byte(`{"retries": 3}`)},
+		map[string][]byte{"apiKey": []byte(`"secret"`)},
+	)
+	assert.Equal(t, []string{
+		"config echo.port: port has wrong type, expected Int found string",
+		"secret echo.token: not set",
+	}, slices.Map(problems, func(p ValueProblem) string { return p.Error() }))
+
+	configs := WithConfigDefaults(module, map[string][]byte{"port": []byte(`8080`)})
+	assert.Equal(t, map[string][]byte{"port": []byte(`8080`), "name": []byte(`"echo"`)}, configs)
+}
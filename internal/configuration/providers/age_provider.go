@@ -0,0 +1,286 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"filippo.io/age"
+
+	"github.com/block/ftl/internal/configuration"
+)
+
+const AgeProviderKey configuration.ProviderKey = "age"
+
+const (
+	// AgeSecretsFile is the file, alongside ftl-project.toml, that age encrypted secrets are stored in.
+	AgeSecretsFile = "ftl-secrets.age.json"
+	// DefaultProfile is the profile that secrets are stored for when none is set with [ContextWithProfile].
+	DefaultProfile = "default"
+)
+
+type profileContextKey struct{}
+
+// ContextWithProfile sets the profile that providers store values for.
+func ContextWithProfile(ctx context.Context, profile string) context.Context {
+	return context.WithValue(ctx, profileContextKey{}, profile)
+}
+
+func profileFromContext(ctx context.Context) string {
+	if profile, ok := ctx.Value(profileContextKey{}).(string); ok && profile != "" {
+		return profile
+	}
+	return DefaultProfile
+}
+
+// Age is a secrets provider that stores values in a file that can be committed
+// alongside the project, encrypted to the age X25519 recipients listed for
+// each profile.
+//
+// Values are decrypted with the identities in $FTL_AGE_IDENTITY, or if that
+// is not set, the file at [AgeIdentityPath].
+type Age struct {
+	path    string
+	profile string
+}
+
+var _ configuration.SynchronousProvider[configuration.Secrets] = Age{}
+
+// NewAge creates a provider for the secrets of a profile stored in the age secrets file at path.
+func NewAge(path string, profile string) Age {
+	return Age{path: path, profile: profile}
+}
+
+// NewAgeFactory creates a factory for providers storing secrets in the project
+// root, for the profile set with [ContextWithProfile].
+func NewAgeFactory(projectRoot string) (configuration.ProviderKey, Factory[configuration.Secrets]) {
+	return AgeProviderKey, func(ctx context.Context) (configuration.Provider[configuration.Secrets], error) {
+		if projectRoot == "" {
+			return nil, fmt.Errorf("%s: secrets provider requires a project", AgeProviderKey)
+		}
+		return NewAge(filepath.Join(projectRoot, AgeSecretsFile), profileFromContext(ctx)), nil
+	}
+}
+
+func (Age) Role() configuration.Secrets      { return configuration.Secrets{} }
+func (a Age) Key() configuration.ProviderKey { return AgeProviderKey }
+
+func (a Age) Load(ctx context.Context, ref configuration.Ref, key *url.URL) ([]byte, error) {
+	file, err := loadAgeSecretsFile(a.path)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, ok := file.Secrets[a.profile][key.Host]
+	if !ok {
+		return nil, fmt.Errorf("no age secret for %q in profile %q: %w", key.Host, a.profile, configuration.ErrNotFound)
+	}
+	identities, err := AgeIdentities()
+	if err != nil {
+		return nil, err
+	}
+	value, err := decryptAge(ciphertext, identities)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt age secret for %q: %w", key.Host, err)
+	}
+	return value, nil
+}
+
+func (a Age) Store(ctx context.Context, ref configuration.Ref, value []byte) (*url.URL, error) {
+	file, err := loadAgeSecretsFile(a.path)
+	if err != nil {
+		return nil, err
+	}
+	recipients, err := file.recipients(a.profile)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := encryptAge(value, recipients)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt age secret for %q: %w", ref, err)
+	}
+	file.set(a.profile, ref.String(), ciphertext)
+	if err := file.save(a.path); err != nil {
+		return nil, err
+	}
+	return &url.URL{Scheme: string(AgeProviderKey), Host: ref.String()}, nil
+}
+
+func (a Age) Delete(ctx context.Context, ref configuration.Ref) error {
+	file, err := loadAgeSecretsFile(a.path)
+	if err != nil {
+		return err
+	}
+	if _, ok := file.Secrets[a.profile][ref.String()]; !ok {
+		return fmt.Errorf("no age secret for %q in profile %q: %w", ref, a.profile, configuration.ErrNotFound)
+	}
+	delete(file.Secrets[a.profile], ref.String())
+	return file.save(a.path)
+}
+
+// RotateKey updates the recipients of the profile, then re-encrypts all of its
+// secrets to the new recipients so that removed recipients can no longer
+// decrypt them.
+//
+// Existing secrets are decrypted with the given identities.
+func (a Age) RotateKey(identities []age.Identity, add []string, remove []string) error {
+	file, err := loadAgeSecretsFile(a.path)
+	if err != nil {
+		return err
+	}
+	updated := slices.DeleteFunc(slices.Clone(file.Recipients[a.profile]), func(r string) bool { return slices.Contains(remove, r) })
+	for _, r := range add {
+		if !slices.Contains(updated, r) {
+			updated = append(updated, r)
+		}
+	}
+	if file.Recipients == nil {
+		file.Recipients = map[string][]string{}
+	}
+	file.Recipients[a.profile] = updated
+	recipients, err := file.recipients(a.profile)
+	if err != nil {
+		return err
+	}
+	for ref, ciphertext := range file.Secrets[a.profile] {
+		value, err := decryptAge(ciphertext, identities)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt age secret for %q: %w", ref, err)
+		}
+		ciphertext, err = encryptAge(value, recipients)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt age secret for %q: %w", ref, err)
+		}
+		file.set(a.profile, ref, ciphertext)
+	}
+	return file.save(a.path)
+}
+
+// AgeIdentityPath returns the path of the file that age identities are read
+// from when $FTL_AGE_IDENTITY is not set.
+//
+// This is $FTL_AGE_IDENTITY_FILE if set, otherwise ftl/age-identity.txt in the user's config directory.
+func AgeIdentityPath() (string, error) {
+	if path, ok := os.LookupEnv("FTL_AGE_IDENTITY_FILE"); ok {
+		return path, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find age identity: %w", err)
+	}
+	return filepath.Join(dir, "ftl", "age-identity.txt"), nil
+}
+
+// AgeIdentities returns the identities used to decrypt age secrets.
+func AgeIdentities() ([]age.Identity, error) {
+	if identity, ok := os.LookupEnv("FTL_AGE_IDENTITY"); ok {
+		identities, err := age.ParseIdentities(strings.NewReader(identity))
+		if err != nil {
+			return nil, fmt.Errorf("invalid age identity in FTL_AGE_IDENTITY: %w", err)
+		}
+		return identities, nil
+	}
+	path, err := AgeIdentityPath()
+	if err != nil {
+		return nil, err
+	}
+	r, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read age identity: %w", err)
+	}
+	defer r.Close()
+	identities, err := age.ParseIdentities(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid age identity in %s: %w", path, err)
+	}
+	return identities, nil
+}
+
+// ageSecretsFile is the serialised form of the age secrets file.
+type ageSecretsFile struct {
+	// Recipients that secrets are encrypted to, by profile.
+	Recipients map[string][]string `json:"recipients"`
+	// Base64 encoded ciphertexts, by profile and ref.
+	Secrets map[string]map[string]string `json:"secrets"`
+}
+
+func loadAgeSecretsFile(path string) (*ageSecretsFile, error) {
+	file := &ageSecretsFile{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read age secrets: %w", err)
+	}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return file, nil
+}
+
+func (f *ageSecretsFile) save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write age secrets: %w", err)
+	}
+	return nil
+}
+
+func (f *ageSecretsFile) set(profile, ref, ciphertext string) {
+	if f.Secrets == nil {
+		f.Secrets = map[string]map[string]string{}
+	}
+	if f.Secrets[profile] == nil {
+		f.Secrets[profile] = map[string]string{}
+	}
+	f.Secrets[profile][ref] = ciphertext
+}
+
+func (f *ageSecretsFile) recipients(profile string) ([]age.Recipient, error) {
+	if len(f.Recipients[profile]) == 0 {
+		return nil, fmt.Errorf("no age recipients configured for profile %q", profile)
+	}
+	recipients, err := age.ParseRecipients(strings.NewReader(strings.Join(f.Recipients[profile], "\n")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid age recipients for profile %q: %w", profile, err)
+	}
+	return recipients, nil
+}
+
+func encryptAge(value []byte, recipients []age.Recipient) (string, error) {
+	buf := &bytes.Buffer{}
+	w, err := age.Encrypt(buf, recipients...)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+	if _, err := w.Write(value); err != nil {
+		return "", err //nolint:wrapcheck
+	}
+	if err := w.Close(); err != nil {
+		return "", err //nolint:wrapcheck
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decryptAge(ciphertext string, identities []age.Identity) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	r, err := age.Decrypt(bytes.NewReader(data), identities...)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return io.ReadAll(r) //nolint:wrapcheck
+}
@@ -0,0 +1,57 @@
+package providers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/configuration"
+)
+
+func TestAge(t *testing.T) {
+	ctx := context.Background()
+	alice, err := age.GenerateX25519Identity()
+	assert.NoError(t, err)
+	bob, err := age.GenerateX25519Identity()
+	assert.NoError(t, err)
+	t.Setenv("FTL_AGE_IDENTITY", alice.String())
+
+	path := filepath.Join(t.TempDir(), AgeSecretsFile)
+	_, factory := NewAgeFactory(filepath.Dir(path))
+	provider, err := factory(ContextWithProfile(ctx, "staging"))
+	assert.NoError(t, err)
+	sp := provider.(configuration.SynchronousProvider[configuration.Secrets]) //nolint:forcetypeassert
+	ref := configuration.NewRef("echo", "apiKey")
+
+	_, err = sp.Store(ctx, ref, []byte(`"secret"`))
+	assert.EqualError(t, err, `no age recipients configured for profile "staging"`)
+
+	assert.NoError(t, NewAge(path, "staging").RotateKey(nil, []string{alice.Recipient().String()}, nil))
+	key, err := sp.Store(ctx, ref, []byte(`"secret"`))
+	assert.NoError(t, err)
+	assert.Equal(t, "age://echo.apiKey", key.String())
+	value, err := sp.Load(ctx, ref, key)
+	assert.NoError(t, err)
+	assert.Equal(t, `"secret"`, string(value))
+
+	// Secrets are stored per profile.
+	_, err = NewAge(path, DefaultProfile).Load(ctx, ref, key)
+	assert.IsError(t, err, configuration.ErrNotFound)
+
+	// Once alice is rotated out, only bob can decrypt the secret.
+	err = NewAge(path, "staging").RotateKey([]age.Identity{alice}, []string{bob.Recipient().String()}, []string{alice.Recipient().String()})
+	assert.NoError(t, err)
+	_, err = sp.Load(ctx, ref, key)
+	assert.Error(t, err)
+	t.Setenv("FTL_AGE_IDENTITY", bob.String())
+	value, err = sp.Load(ctx, ref, key)
+	assert.NoError(t, err)
+	assert.Equal(t, `"secret"`, string(value))
+
+	assert.NoError(t, sp.Delete(ctx, ref))
+	_, err = sp.Load(ctx, ref, key)
+	assert.IsError(t, err, configuration.ErrNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"path/filepath"
 	"slices"
 
 	"github.com/block/ftl/internal/configuration"
@@ -27,9 +28,17 @@ func NewDefaultSecretsRegistry(config projectconfig.Config, onePasswordVault str
 	registry.Register(NewInlineFactory[configuration.Secrets]())
 	registry.Register(NewOnePasswordFactory(onePasswordVault, config.Name))
 	registry.Register(NewKeychainFactory())
+	registry.Register(NewAgeFactory(projectRoot(config)))
 	return registry
 }
 
+func projectRoot(config projectconfig.Config) string {
+	if config.Path == "" {
+		return ""
+	}
+	return filepath.Dir(config.Path)
+}
+
 // Registry that lazily constructs configuration
 type Registry[R configuration.Role] struct {
 	factories map[configuration.ProviderKey]Factory[R]
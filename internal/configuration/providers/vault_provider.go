@@ -0,0 +1,156 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/vault"
+)
+
+const VaultProviderKey configuration.ProviderKey = "vault"
+
+// vaultValueKey is the key in each KV secret that holds the value.
+const vaultValueKey = "value"
+
+// Vault is a secrets provider for a HashiCorp Vault KV v2 secrets engine.
+//
+// Each secret is stored at <prefix>/<module>/<name>, or <prefix>/<name> for
+// global secrets. The KV version of each secret is used as its version token,
+// so only secrets that have changed are read on each sync.
+type Vault struct {
+	client *vault.Client
+	mount  string
+	prefix string
+
+	lock   sync.Mutex
+	synced map[configuration.Ref]configuration.SyncedValue
+}
+
+var _ configuration.AsynchronousProvider[configuration.Secrets] = &Vault{}
+
+func NewVault(client *vault.Client, mount, prefix string) *Vault {
+	return &Vault{
+		client: client,
+		mount:  mount,
+		prefix: strings.Trim(prefix, "/"),
+		synced: map[configuration.Ref]configuration.SyncedValue{},
+	}
+}
+
+func (*Vault) Role() configuration.Secrets    { return configuration.Secrets{} }
+func (*Vault) Key() configuration.ProviderKey { return VaultProviderKey }
+func (*Vault) SyncInterval() time.Duration    { return time.Second * 10 }
+
+func (v *Vault) path(ref configuration.Ref) string {
+	if module, ok := ref.Module.Get(); ok {
+		return v.prefix + "/" + module + "/" + ref.Name
+	}
+	return v.prefix + "/" + ref.Name
+}
+
+func (v *Vault) Sync(ctx context.Context) (map[configuration.Ref]configuration.SyncedValue, error) {
+	refs := []configuration.Ref{}
+	keys, err := v.client.KVList(ctx, v.mount, v.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list secrets: %w", VaultProviderKey, err)
+	}
+	for _, key := range keys {
+		module, ok := strings.CutSuffix(key, "/")
+		if !ok {
+			refs = append(refs, configuration.NewRef("", key))
+			continue
+		}
+		names, err := v.client.KVList(ctx, v.mount, v.prefix+"/"+module)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to list secrets for %s: %w", VaultProviderKey, module, err)
+		}
+		for _, name := range names {
+			if !strings.HasSuffix(name, "/") {
+				refs = append(refs, configuration.NewRef(module, name))
+			}
+		}
+	}
+
+	// Unchanged secrets are reused from the previous sync. The lock is not held while reading from Vault.
+	v.lock.Lock()
+	synced := v.synced
+	v.lock.Unlock()
+	values := make(map[configuration.Ref]configuration.SyncedValue, len(refs))
+	for _, ref := range refs {
+		metadata, err := v.client.KVMetadata(ctx, v.mount, v.path(ref))
+		if errors.Is(err, vault.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: failed to get version of %s: %w", VaultProviderKey, ref, err)
+		}
+		if previous, ok := synced[ref]; ok {
+			if version, ok := previous.VersionToken.Get(); ok && version == configuration.VersionToken(metadata.Version) {
+				values[ref] = previous
+				continue
+			}
+		}
+		data, version, err := v.client.KVRead(ctx, v.mount, v.path(ref))
+		if errors.Is(err, vault.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: failed to read %s: %w", VaultProviderKey, ref, err)
+		}
+		value, err := vaultValue(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: secret for %s: %w", VaultProviderKey, ref, err)
+		}
+		values[ref] = configuration.SyncedValue{
+			Value:        value,
+			VersionToken: optional.Some[configuration.VersionToken](version),
+		}
+	}
+	v.lock.Lock()
+	v.synced = values
+	v.lock.Unlock()
+	return values, nil
+}
+
+// vaultValue returns the JSON encoded secret in the data of a KV secret.
+//
+// FTL stores the JSON encoded secret as a string, but secrets written by other
+// clients may hold the value itself, which is encoded as JSON.
+func vaultValue(data map[string]any) ([]byte, error) {
+	value, ok := data[vaultValueKey]
+	if !ok {
+		return nil, fmt.Errorf("no %q key", vaultValueKey)
+	}
+	if str, ok := value.(string); ok {
+		return []byte(str), nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %q: %w", vaultValueKey, err)
+	}
+	return encoded, nil
+}
+
+// Store and if the secret already exists, update it.
+func (v *Vault) Store(ctx context.Context, ref configuration.Ref, value []byte) (*url.URL, error) {
+	_, err := v.client.KVWrite(ctx, v.mount, v.path(ref), map[string]any{vaultValueKey: string(value)})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to store %s: %w", VaultProviderKey, ref, err)
+	}
+	return &url.URL{Scheme: string(VaultProviderKey), Host: ref.String()}, nil
+}
+
+func (v *Vault) Delete(ctx context.Context, ref configuration.Ref) error {
+	err := v.client.KVDelete(ctx, v.mount, v.path(ref))
+	if err != nil {
+		return fmt.Errorf("%s: failed to delete %s: %w", VaultProviderKey, ref, err)
+	}
+	return nil
+}
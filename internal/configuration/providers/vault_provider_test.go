@@ -0,0 +1,73 @@
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/internal/configuration"
+	"github.com/block/ftl/internal/vault"
+	"github.com/block/ftl/internal/vault/vaulttest"
+)
+
+func TestVault(t *testing.T) {
+	ctx := context.Background()
+	server := vaulttest.New(t)
+	client, err := vault.New(vault.Config{Address: server.URL, Token: vaulttest.Token})
+	assert.NoError(t, err)
+	provider := NewVault(client, "secret", "/ftl/")
+
+	values, err := provider.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(values))
+
+	moduleRef := configuration.NewRef("echo", "apiKey")
+	globalRef := configuration.NewRef("", "token")
+	key, err := provider.Store(ctx, moduleRef, []byte(`"one"`))
+	assert.NoError(t, err)
+	assert.Equal(t, "vault://echo.apiKey", key.String())
+	_, err = provider.Store(ctx, globalRef, []byte(`"global"`))
+	assert.NoError(t, err)
+
+	values, err = provider.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, map[configuration.Ref]configuration.SyncedValue{
+		moduleRef: {Value: []byte(`"one"`), VersionToken: optional.Some[configuration.VersionToken](1)},
+		globalRef: {Value: []byte(`"global"`), VersionToken: optional.Some[configuration.VersionToken](1)},
+	}, values)
+
+	// Updating a secret bumps its version token.
+	_, err = provider.Store(ctx, moduleRef, []byte(`"two"`))
+	assert.NoError(t, err)
+	values, err = provider.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, configuration.SyncedValue{Value: []byte(`"two"`), VersionToken: optional.Some[configuration.VersionToken](2)}, values[moduleRef])
+
+	assert.NoError(t, provider.Delete(ctx, moduleRef))
+	values, err = provider.Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []configuration.Ref{globalRef}, keys(values))
+}
+
+func TestVaultReadsValuesWrittenByOtherClients(t *testing.T) {
+	ctx := context.Background()
+	server := vaulttest.New(t)
+	client, err := vault.New(vault.Config{Address: server.URL, Token: vaulttest.Token})
+	assert.NoError(t, err)
+	_, err = client.KVWrite(ctx, "secret", "ftl/echo/credentials", map[string]any{"value": map[string]any{"user": "bob"}})
+	assert.NoError(t, err)
+
+	values, err := NewVault(client, "secret", "ftl").Sync(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"user":"bob"}`, string(values[configuration.NewRef("echo", "credentials")].Value))
+}
+
+func keys[K comparable, V any](m map[K]V) []K {
+	out := make([]K, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
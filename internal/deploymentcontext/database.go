@@ -42,6 +42,7 @@ const (
 	DBTypeUnspecified DBType = DBType(deploymentpb.GetDeploymentContextResponse_DB_TYPE_UNSPECIFIED)
 	DBTypePostgres    DBType = DBType(deploymentpb.GetDeploymentContextResponse_DB_TYPE_POSTGRES)
 	DBTypeMySQL       DBType = DBType(deploymentpb.GetDeploymentContextResponse_DB_TYPE_MYSQL)
+	DBTypeSQLite      DBType = DBType(deploymentpb.GetDeploymentContextResponse_DB_TYPE_SQLITE)
 )
 
 func DBTypeFromString(dt string) (DBType, error) {
@@ -50,6 +51,8 @@ func DBTypeFromString(dt string) (DBType, error) {
 		return DBTypePostgres, nil
 	} else if dt == "mysql" {
 		return DBTypeMySQL, nil
+	} else if dt == "sqlite" {
+		return DBTypeSQLite, nil
 	}
 	return DBTypeUnspecified, fmt.Errorf("unknown DB type: %s", dt)
 }
@@ -60,6 +63,8 @@ func (x DBType) String() string {
 		return "postgres"
 	case DBTypeMySQL:
 		return "mysql"
+	case DBTypeSQLite:
+		return "sqlite"
 	case DBTypeUnspecified:
 		return "unspecified"
 	default:
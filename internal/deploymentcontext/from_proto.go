@@ -14,6 +14,8 @@ func DBTypeFromProto(x deploymentpb.GetDeploymentContextResponse_DbType) DBType
 		return DBTypePostgres
 	case deploymentpb.GetDeploymentContextResponse_DB_TYPE_MYSQL:
 		return DBTypeMySQL
+	case deploymentpb.GetDeploymentContextResponse_DB_TYPE_SQLITE:
+		return DBTypeSQLite
 	default:
 		panic(fmt.Sprintf("unknown DB type: %d", x))
 	}
@@ -28,5 +30,7 @@ func FromProto(response *deploymentpb.GetDeploymentContextResponse) (DeploymentC
 		}
 		databases[entry.Name] = db
 	}
-	return NewBuilder(response.Module).AddConfigs(response.Configs).AddSecrets(response.Secrets).AddDatabases(databases).Build(), nil
+	builder := NewBuilder(response.Module).AddConfigs(response.Configs).AddSecrets(response.Secrets).AddDatabases(databases)
+	builder.deployment = response.Deployment
+	return builder.Build(), nil
 }
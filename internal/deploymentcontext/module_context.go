@@ -1,11 +1,13 @@
 package deploymentcontext
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	stdreflect "reflect"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +24,7 @@ import (
 	"github.com/block/ftl/common/reflect"
 	"github.com/block/ftl/common/reflection"
 	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/rpc"
 )
 
@@ -30,15 +33,21 @@ import (
 // It is used for definitions of mock verbs as well as real implementations of verbs to directly execute
 type Verb func(ctx context.Context, req any) (resp any, err error)
 
+// EncodedResponse is a JSON encoded response returned by a mock Verb that does not know the Go type of the verb's
+// response, such as one replaying recorded calls. Callers decode it into the response type.
+type EncodedResponse []byte
+
 // DeploymentContext holds the context needed for a module, including configs, secrets and DSNs
 //
 // DeploymentContext is immutable
 type DeploymentContext struct {
-	module    string
-	configs   map[string][]byte
-	secrets   map[string][]byte
-	routes    map[string]string
-	databases map[string]Database
+	module string
+	// deployment is the key of the deployment the context is for, if known.
+	deployment string
+	configs    map[string][]byte
+	secrets    map[string][]byte
+	routes     map[string]string
+	databases  map[string]Database
 
 	isTesting                     bool
 	mockVerbs                     map[schema.RefKey]Verb
@@ -76,6 +85,7 @@ func NewBuilder(module string) *Builder {
 func NewBuilderFromContext(ctx DeploymentContext) *Builder {
 	return &Builder{
 		module:                        ctx.module,
+		deployment:                    ctx.deployment,
 		configs:                       ctx.configs,
 		secrets:                       ctx.secrets,
 		databases:                     ctx.databases,
@@ -158,7 +168,71 @@ func (m DeploymentContext) GetSecret(name string, value any) error {
 	if !ok {
 		return fmt.Errorf("no secret value for %q", name)
 	}
-	return json.Unmarshal(data, value)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err //nolint:wrapcheck
+	}
+	log.RedactSecret(m.redactionScope(), name, sensitiveLeaves(stdreflect.ValueOf(value))...)
+	return nil
+}
+
+// redactionScope is the scope that secrets read from the context are redacted from logs in.
+func (m DeploymentContext) redactionScope() string {
+	if m.deployment != "" {
+		return m.deployment
+	}
+	return m.module
+}
+
+// forgetChangedSecrets stops redacting the values of secrets that have changed or been removed in next.
+func (m DeploymentContext) forgetChangedSecrets(next DeploymentContext) {
+	if m.redactionScope() != next.redactionScope() {
+		log.ForgetSecrets(m.redactionScope())
+		return
+	}
+	for name, data := range m.secrets {
+		if !bytes.Equal(data, next.secrets[name]) {
+			log.RedactSecret(m.redactionScope(), name)
+		}
+	}
+}
+
+// sensitiveLeaves returns the strings within a secret value that are redacted from logs.
+//
+// Every string in a secret is sensitive, except within struct fields tagged with `ftl:"nonsensitive"`.
+func sensitiveLeaves(v stdreflect.Value) []string {
+	switch v.Kind() {
+	case stdreflect.Pointer, stdreflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return sensitiveLeaves(v.Elem())
+	case stdreflect.String:
+		return []string{v.String()}
+	case stdreflect.Slice, stdreflect.Array:
+		out := []string{}
+		for i := range v.Len() {
+			out = append(out, sensitiveLeaves(v.Index(i))...)
+		}
+		return out
+	case stdreflect.Map:
+		out := []string{}
+		for iter := v.MapRange(); iter.Next(); {
+			out = append(out, sensitiveLeaves(iter.Value())...)
+		}
+		return out
+	case stdreflect.Struct:
+		out := []string{}
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() || field.Tag.Get("ftl") == "nonsensitive" {
+				continue
+			}
+			out = append(out, sensitiveLeaves(v.Field(i))...)
+		}
+		return out
+	default:
+	}
+	return nil
 }
 
 // GetDatabase gets a database DSN by name and type.
@@ -176,6 +250,10 @@ func (m DeploymentContext) GetDatabase(name string, dbType DBType) (string, bool
 		} else if dbType == DBTypeMySQL {
 			proxyAddress := os.Getenv("FTL_PROXY_MYSQL_ADDRESS_" + strings.ToUpper(name))
 			return "ftl:ftl@tcp(" + proxyAddress + ")/" + name, false, nil
+		} else if dbType == DBTypeSQLite {
+			if dsn := os.Getenv("FTL_SQLITE_DSN_" + strings.ToUpper(name)); dsn != "" {
+				return dsn, false, nil
+			}
 		}
 		return "", false, fmt.Errorf("missing DSN for database %s", name)
 	}
@@ -262,12 +340,20 @@ func NewDynamicContext(ctx context.Context, supplier DeploymentContextSupplier,
 	g, _ := errgroup.WithContext(deadline)
 	defer timeoutCancel()
 
+	// Secrets of the deployment are no longer redacted once it stops.
+	go func() {
+		<-ctx.Done()
+		log.ForgetSecrets(result.CurrentContext().redactionScope())
+	}()
+
 	// asynchronously consumes a subscription of DeploymentContext changes and signals the arrival of the first
 	supplier.Subscribe(
 		ctx,
 		deploymentName,
 		func(ctx context.Context, moduleContext DeploymentContext) {
+			previous := result.current.Load()
 			result.current.Store(moduleContext)
+			previous.forgetChangedSecrets(moduleContext)
 			releaseOnce.Do(func() {
 				await.Done()
 			})
@@ -2,6 +2,7 @@ package deploymentcontext_test
 
 import (
 	"context" //nolint:depguard
+	"strings"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -36,6 +37,58 @@ func TestDynamicContextUpdate(t *testing.T) {
 	assert.Equal(t, mc2, dynamic.CurrentContext())
 }
 
+func TestGetSecretRedactsLogs(t *testing.T) {
+	moduleCtx := NewBuilder("test").AddSecrets(map[string][]byte{
+		"creds":   []byte(`{"user":"admin","password":"correct-horse-battery"}`),
+		"account": []byte(`{"user":"operator","password":"staple-battery-horse"}`),
+	}).Build()
+	t.Cleanup(func() { log.ForgetSecrets("test") })
+	var creds struct {
+		User     string `json:"user"`
+		Password string `json:"password"`
+	}
+	assert.NoError(t, moduleCtx.GetSecret("creds", &creds))
+	var account struct {
+		User     string `json:"user" ftl:"nonsensitive"`
+		Password string `json:"password"`
+	}
+	assert.NoError(t, moduleCtx.GetSecret("account", &account))
+
+	w := &strings.Builder{}
+	logger := log.Configure(w, log.Config{Level: log.Info, JSON: true})
+	logger.Infof("connecting as %s with %s", creds.User, creds.Password)
+	logger.Infof("connecting as %s with %s", account.User, account.Password)
+	assert.Contains(t, w.String(), `"message":"connecting as [redacted] with [redacted]"`)
+	assert.Contains(t, w.String(), `"message":"connecting as operator with [redacted]"`)
+}
+
+func TestRotatedSecretsAreNotRedacted(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	defer cancel()
+	mc1 := NewBuilder("test").AddSecrets(map[string][]byte{"token": []byte(`"first-token"`)}).Build()
+	mc2 := NewBuilder("test").AddSecrets(map[string][]byte{"token": []byte(`"second-token"`)}).Build()
+	mcs := &manualContextSupplier{initialCtx: mc1}
+	dynamic, err := NewDynamicContext(ctx, DeploymentContextSupplier(mcs), "test")
+	assert.NoError(t, err)
+	t.Cleanup(func() { log.ForgetSecrets("test") })
+
+	redacted := func(value string) bool {
+		w := &strings.Builder{}
+		logger := log.Configure(w, log.Config{Level: log.Info, JSON: true})
+		logger.Infof("%s", value)
+		return strings.Contains(w.String(), `"message":"[redacted]"`)
+	}
+
+	var token string
+	assert.NoError(t, dynamic.CurrentContext().GetSecret("token", &token))
+	assert.True(t, redacted("first-token"))
+
+	mcs.sink(ctx, mc2)
+	assert.False(t, redacted("first-token"), "rotated secret should no longer be redacted")
+	assert.NoError(t, dynamic.CurrentContext().GetSecret("token", &token))
+	assert.True(t, redacted("second-token"))
+}
+
 func (mcs *manualContextSupplier) Subscribe(ctx context.Context, _ string, sink func(ctx context.Context, mCtx DeploymentContext), _ func(error) bool) {
 	sink(ctx, mcs.initialCtx)
 	mcs.sink = sink
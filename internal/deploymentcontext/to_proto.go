@@ -41,6 +41,8 @@ func (x DBType) ToProto() deploymentpb.GetDeploymentContextResponse_DbType {
 		return deploymentpb.GetDeploymentContextResponse_DB_TYPE_POSTGRES
 	case DBTypeMySQL:
 		return deploymentpb.GetDeploymentContextResponse_DB_TYPE_MYSQL
+	case DBTypeSQLite:
+		return deploymentpb.GetDeploymentContextResponse_DB_TYPE_SQLITE
 	default:
 		panic(fmt.Sprintf("unknown DB type: %s", strconv.Itoa(int(x))))
 	}
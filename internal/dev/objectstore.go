@@ -0,0 +1,46 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/s3local"
+)
+
+var (
+	objectStoreLock sync.Mutex
+	objectStores    = map[int]*s3local.Server{}
+)
+
+// SetupObjectStore starts a local S3-compatible object store listening on
+// port and storing buckets in dir, unless one is already running in this
+// process. It returns the store and its endpoint.
+func SetupObjectStore(ctx context.Context, dir string, port int) (*s3local.Server, string, error) {
+	objectStoreLock.Lock()
+	defer objectStoreLock.Unlock()
+	endpoint := fmt.Sprintf("http://127.0.0.1:%d", port)
+	if server, ok := objectStores[port]; ok {
+		return server, endpoint, nil
+	}
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, "", fmt.Errorf("could not start object store: %w", err)
+	}
+	server := s3local.New(dir)
+	httpServer := &http.Server{Handler: server, ReadHeaderTimeout: 10 * time.Second}
+	logger := log.FromContext(ctx)
+	go func() {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf(err, "Object store failed")
+		}
+	}()
+	objectStores[port] = server
+	logger.Debugf("Started local object store at %s serving from %s", endpoint, dir)
+	return server, endpoint, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
 
 	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/vault"
 )
 
 type dsnOptions struct {
@@ -49,6 +50,11 @@ func MySQLDSN(dbName string, options ...Option) string {
 	return fmt.Sprintf("root:secret@tcp(%s:%d)/%s?allowNativePasswords=True", opts.host, opts.port, dbName)
 }
 
+// SQLiteDSN returns a SQLiteDSN string for connecting to a local SQLite database file.
+func SQLiteDSN(path string) string {
+	return "file:" + path
+}
+
 func ResolvePostgresDSN(ctx context.Context, connector schema.DatabaseConnector) (string, error) {
 	switch c := connector.(type) {
 	case *schema.DSNDatabaseConnector:
@@ -73,6 +79,20 @@ func ResolvePostgresDSN(ctx context.Context, connector schema.DatabaseConnector)
 			return "", fmt.Errorf("failed to split host and port: %w", err)
 		}
 		return fmt.Sprintf("host=%s port=%s dbname=%s user=%s password=%s", host, port, c.Database, c.Username, authenticationToken), nil
+	case *schema.VaultDatabaseConnector:
+		creds, ok := vault.DatabaseCredentialsFromContext(ctx)
+		if !ok {
+			return "", fmt.Errorf("vault is not configured, cannot resolve credentials for %s", c)
+		}
+		lease, err := creds.Get(ctx, c.Mount, c.Role)
+		if err != nil {
+			return "", fmt.Errorf("failed to get database credentials: %w", err)
+		}
+		host, port, err := net.SplitHostPort(c.Endpoint)
+		if err != nil {
+			return "", fmt.Errorf("failed to split host and port: %w", err)
+		}
+		return fmt.Sprintf("host=%s port=%s dbname=%s user=%s password=%s", host, port, c.Database, lease.Username, lease.Password), nil
 	default:
 		return "", fmt.Errorf("unexpected database connector type: %T", connector)
 	}
@@ -95,3 +115,11 @@ func ResolveMySQLDSN(ctx context.Context, connector schema.DatabaseConnector) (s
 	}
 	return dsnRuntime.DSN, nil
 }
+
+func ResolveSQLiteDSN(ctx context.Context, connector schema.DatabaseConnector) (string, error) {
+	dsnRuntime, ok := connector.(*schema.DSNDatabaseConnector)
+	if !ok {
+		return "", fmt.Errorf("unexpected database connector type: %T", connector)
+	}
+	return dsnRuntime.DSN, nil
+}
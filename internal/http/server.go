@@ -8,11 +8,15 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 )
 
 const ShutdownGracePeriod = 5 * time.Second
 
+// Serve handler on listen until the context is cancelled.
+//
+// If the listen URL is https:// the server uses TLS, as configured with [certs.Configure].
 func Serve(ctx context.Context, listen *url.URL, handler http.Handler) error {
 	httpServer := &http.Server{
 		Addr:              listen.Host,
@@ -37,7 +41,16 @@ func Serve(ctx context.Context, listen *url.URL, handler http.Handler) error {
 		}
 	}()
 
-	err := httpServer.ListenAndServe()
+	var err error
+	if listen.Scheme == "https" {
+		httpServer.TLSConfig, err = certs.ServerConfig(listen.Hostname())
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		err = httpServer.ListenAndServeTLS("", "")
+	} else {
+		err = httpServer.ListenAndServe()
+	}
 	if errors.Is(err, http.ErrServerClosed) {
 		return nil
 	}
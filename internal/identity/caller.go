@@ -0,0 +1,68 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/rbac"
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+// Caller is the module that made a call.
+type Caller struct {
+	Module string
+	// Verified is true if the module was identified by a valid token, rather than the caller chain alone.
+	Verified bool
+}
+
+// ResolveCaller returns the module that made a call, from the token of the request if it is valid.
+//
+// Otherwise the caller chain is unverified, and the caller is the module of its last verb, if any. An unverified
+// caller must only be used to restrict what may be called, and is never treated as a member of its module.
+//
+// verifier may be nil if no keys are available yet, in which case tokens are ignored.
+func ResolveCaller(ctx context.Context, verifier Verifier, header http.Header, callers []*schema.Ref) optional.Option[Caller] {
+	var unverified optional.Option[Caller]
+	if len(callers) > 0 {
+		unverified = optional.Some(Caller{Module: callers[len(callers)-1].Module})
+	}
+	token, ok := headers.GetCallerToken(header)
+	if !ok || verifier == nil {
+		return unverified
+	}
+	claims, err := verifier.Verify(token)
+	if err != nil {
+		log.FromContext(ctx).Warnf("Ignoring caller token: %s", err)
+		return unverified
+	}
+	return optional.Some(Caller{Module: claims.Module(), Verified: true})
+}
+
+// MayCallUnexported returns true if a call from caller may call verbs of module that are not exported.
+//
+// Calls without a caller are external, such as those from ingress, cron or the CLI, and are not restricted.
+// Otherwise only a caller verified to be the module itself may call its unexported verbs, so a forged or
+// unverifiable caller chain is limited to exported verbs.
+func MayCallUnexported(caller optional.Option[Caller], module string) bool {
+	c, ok := caller.Get()
+	if !ok {
+		return true
+	}
+	return c.Verified && c.Module == module
+}
+
+// AuthenticateCaller returns a function that identifies the subject of a request as
+// [rbac.ModuleSubject] of the module whose valid caller token it carries.
+func AuthenticateCaller(verifier Verifier) func(ctx context.Context, header http.Header) (string, bool) {
+	return func(ctx context.Context, header http.Header) (string, bool) {
+		caller, ok := ResolveCaller(ctx, verifier, header, nil).Get()
+		if !ok || !caller.Verified {
+			return "", false
+		}
+		return rbac.ModuleSubject(caller.Module), true
+	}
+}
@@ -0,0 +1,79 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+func TestResolveCaller(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	signer, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	token, _, err := signer.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+	other, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	forged, _, err := other.Mint(model.NewDeploymentKey("time"))
+	assert.NoError(t, err)
+
+	callers := []*schema.Ref{{Module: "time", Name: "time"}}
+	for _, test := range []struct {
+		name     string
+		token    string
+		callers  []*schema.Ref
+		verifier Verifier
+		expected optional.Option[Caller]
+	}{
+		{name: "External", expected: optional.None[Caller](), verifier: signer},
+		{name: "Unverified", callers: callers, verifier: signer, expected: optional.Some(Caller{Module: "time"})},
+		{name: "Verified", token: token, verifier: signer, expected: optional.Some(Caller{Module: "echo", Verified: true})},
+		{name: "TokenOverridesCallers", token: token, callers: callers, verifier: signer, expected: optional.Some(Caller{Module: "echo", Verified: true})},
+		{name: "InvalidToken", token: forged, callers: callers, verifier: signer, expected: optional.Some(Caller{Module: "time"})},
+		{name: "NoVerifier", token: token, callers: callers, expected: optional.Some(Caller{Module: "time"})},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			headers.SetCallers(header, test.callers)
+			if test.token != "" {
+				headers.SetCallerToken(header, test.token)
+			}
+			assert.Equal(t, test.expected, ResolveCaller(ctx, test.verifier, header, test.callers))
+		})
+	}
+}
+
+func TestMayCallUnexported(t *testing.T) {
+	assert.True(t, MayCallUnexported(optional.None[Caller](), "echo"))
+	assert.True(t, MayCallUnexported(optional.Some(Caller{Module: "echo", Verified: true}), "echo"))
+	assert.False(t, MayCallUnexported(optional.Some(Caller{Module: "echo"}), "echo"))
+	assert.False(t, MayCallUnexported(optional.Some(Caller{Module: "time", Verified: true}), "echo"))
+}
+
+func TestAuthenticateCaller(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	signer, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	token, _, err := signer.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+	authenticate := AuthenticateCaller(signer)
+
+	header := http.Header{}
+	headers.SetCallers(header, []*schema.Ref{{Module: "time", Name: "time"}})
+	_, ok := authenticate(ctx, header)
+	assert.False(t, ok, "an unverified caller chain must not authenticate a request")
+
+	headers.SetCallerToken(header, token)
+	subject, ok := authenticate(ctx, header)
+	assert.True(t, ok)
+	assert.Equal(t, "module:echo", subject)
+}
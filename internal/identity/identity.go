@@ -0,0 +1,175 @@
+// Package identity mints and verifies short-lived signed tokens that identify the deployment making a verb call.
+//
+// The controller signs a token for each deployment, which the deployment's runner attaches to its outbound calls.
+// Receivers verify the token before trusting the caller chain in the request headers, which is otherwise set by the
+// calling module and can be forged.
+package identity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/block/ftl/internal/model"
+)
+
+// ErrInvalidToken is returned when a token is malformed, has an invalid signature or has expired.
+var ErrInvalidToken = errors.New("invalid caller token")
+
+// Claims are the verified contents of a token.
+type Claims struct {
+	KeyID      string
+	Deployment model.DeploymentKey
+	Expires    time.Time
+}
+
+// Module that the token identifies.
+func (c Claims) Module() string { return c.Deployment.Payload.Module }
+
+// PublicKey is a key that tokens can be verified with.
+type PublicKey struct {
+	ID  string
+	Key ed25519.PublicKey
+}
+
+// Verifier verifies tokens.
+type Verifier interface {
+	Verify(token string) (Claims, error)
+}
+
+type claims struct {
+	KeyID      string `json:"kid"`
+	Deployment string `json:"dpl"`
+	Expires    int64  `json:"exp"`
+}
+
+// KeyID returns the ID of a public key, derived from the key itself.
+func KeyID(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// GenerateKey returns a new private key, encoded as with ParseKey.
+func GenerateKey() (string, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(private.Seed()), nil
+}
+
+// ParseKey parses a base64 encoded Ed25519 seed into a private key.
+func ParseKey(key string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid signing key: expected %d bytes but got %d", ed25519.SeedSize, len(seed))
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+func sign(key ed25519.PrivateKey, deployment model.DeploymentKey, expires time.Time) (string, error) {
+	payload, err := json.Marshal(claims{
+		KeyID:      KeyID(key.Public().(ed25519.PublicKey)), //nolint:forcetypeassert
+		Deployment: deployment.String(),
+		Expires:    expires.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode token: %w", err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	signature := ed25519.Sign(key, []byte(encoded))
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func verify(keys map[string]ed25519.PublicKey, token string, now time.Time) (Claims, error) {
+	encoded, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return Claims{}, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed payload", ErrInvalidToken)
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed payload", ErrInvalidToken)
+	}
+	key, ok := keys[c.KeyID]
+	if !ok {
+		return Claims{}, fmt.Errorf("%w: unknown key %q", ErrInvalidToken, c.KeyID)
+	}
+	if !ed25519.Verify(key, []byte(encoded), signature) {
+		return Claims{}, fmt.Errorf("%w: bad signature", ErrInvalidToken)
+	}
+	expires := time.Unix(c.Expires, 0)
+	if !now.Before(expires) {
+		return Claims{}, fmt.Errorf("%w: expired at %s", ErrInvalidToken, expires.UTC().Format(time.RFC3339))
+	}
+	deployment, err := model.ParseDeploymentKey(c.Deployment)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	return Claims{KeyID: c.KeyID, Deployment: deployment, Expires: expires}, nil
+}
+
+// KeySet verifies tokens against a set of public keys, such as those published by the controller.
+type KeySet struct {
+	lock sync.RWMutex
+	keys map[string]ed25519.PublicKey
+	now  func() time.Time
+}
+
+var _ Verifier = (*KeySet)(nil)
+
+// NewKeySet creates a KeySet that verifies tokens signed by any of the given keys.
+func NewKeySet(keys ...PublicKey) *KeySet {
+	k := &KeySet{now: time.Now}
+	k.Set(keys)
+	return k
+}
+
+// NewKeySetFromSigningKeys creates a KeySet that verifies tokens signed by any of the given private keys, as
+// encoded by GenerateKey, for services that are configured with the controller's keys rather than sent them.
+func NewKeySetFromSigningKeys(keys ...string) (*KeySet, error) {
+	public := make([]PublicKey, 0, len(keys))
+	for i, key := range keys {
+		private, err := ParseKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %d: %w", i, err)
+		}
+		public = append(public, signingKey{private: private}.publicKey())
+	}
+	return NewKeySet(public...), nil
+}
+
+// Set replaces the keys that tokens are verified against.
+func (k *KeySet) Set(keys []PublicKey) {
+	byID := make(map[string]ed25519.PublicKey, len(keys))
+	for _, key := range keys {
+		byID[key.ID] = key.Key
+	}
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.keys = byID
+}
+
+func (k *KeySet) Verify(token string) (Claims, error) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	return verify(k.keys, token, k.now())
+}
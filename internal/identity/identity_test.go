@@ -0,0 +1,125 @@
+package identity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/model"
+)
+
+func TestMintAndVerify(t *testing.T) {
+	signer, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	deployment := model.NewDeploymentKey("echo")
+	token, expires, err := signer.Mint(deployment)
+	assert.NoError(t, err)
+	assert.True(t, expires.After(time.Now()))
+
+	claims, err := signer.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, deployment, claims.Deployment)
+	assert.Equal(t, "echo", claims.Module())
+
+	keys := NewKeySet(signer.PublicKeys()...)
+	claims, err = keys.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, deployment, claims.Deployment)
+}
+
+func TestVerifyRejectsInvalidTokens(t *testing.T) {
+	signer, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	token, _, err := signer.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+	other, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	otherToken, _, err := other.Mint(model.NewDeploymentKey("time"))
+	assert.NoError(t, err)
+
+	payload, signature, _ := strings.Cut(token, ".")
+	_, otherSignature, _ := strings.Cut(otherToken, ".")
+	otherPayload, _, _ := strings.Cut(otherToken, ".")
+
+	for name, token := range map[string]string{
+		"Empty":         "",
+		"Malformed":     "not-a-token",
+		"UnknownKey":    otherToken,
+		"BadSignature":  payload + "." + otherSignature,
+		"SwappedClaims": otherPayload + "." + signature,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := signer.Verify(token)
+			assert.IsError(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestVerifyRejectsExpiredTokens(t *testing.T) {
+	signer, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	token, _, err := signer.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+
+	keys := NewKeySet(signer.PublicKeys()...)
+	keys.now = func() time.Time { return time.Now().Add(time.Minute * 2) }
+	_, err = keys.Verify(token)
+	assert.IsError(t, err, ErrInvalidToken)
+	assert.Contains(t, err.Error(), "expired")
+}
+
+func TestRotate(t *testing.T) {
+	signer, err := NewSigner(time.Minute)
+	assert.NoError(t, err)
+	now := time.Now()
+	signer.now = func() time.Time { return now }
+
+	before := signer.PublicKeys()
+	assert.Equal(t, 2, len(before), "the current key and the next key should be published")
+	token, _, err := signer.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+
+	// Receivers with the keys published before the rotation can verify tokens minted after it.
+	assert.NoError(t, signer.Rotate())
+	rotated, _, err := signer.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+	_, err = NewKeySet(before...).Verify(rotated)
+	assert.NoError(t, err)
+
+	// Tokens minted before the rotation are valid until they expire.
+	assert.Equal(t, 3, len(signer.PublicKeys()))
+	_, err = signer.Verify(token)
+	assert.NoError(t, err)
+
+	now = now.Add(time.Minute * 2)
+	assert.NoError(t, signer.Rotate())
+	assert.Equal(t, 3, len(signer.PublicKeys()), "keys retired before the last rotation should be dropped once expired")
+}
+
+func TestProvidedKeys(t *testing.T) {
+	current, err := GenerateKey()
+	assert.NoError(t, err)
+	previous, err := GenerateKey()
+	assert.NoError(t, err)
+
+	old, err := NewSigner(time.Minute, previous)
+	assert.NoError(t, err)
+	token, _, err := old.Mint(model.NewDeploymentKey("echo"))
+	assert.NoError(t, err)
+
+	signer, err := NewSigner(time.Minute, current, previous)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(signer.PublicKeys()))
+	_, err = signer.Verify(token)
+	assert.NoError(t, err)
+
+	// Provided keys are not rotated.
+	keys := signer.PublicKeys()
+	assert.NoError(t, signer.Rotate())
+	assert.Equal(t, keys, signer.PublicKeys())
+
+	_, err = NewSigner(time.Minute, "bm90IGEga2V5")
+	assert.EqualError(t, err, "key 0: invalid signing key: expected 32 bytes but got 9")
+}
@@ -0,0 +1,98 @@
+package identity
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/rbac"
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+const runnerKeySize = 32
+
+// GenerateRunnerKey returns a new key for runner credentials, encoded as with ParseRunnerKey.
+func GenerateRunnerKey() (string, error) {
+	key := make([]byte, runnerKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate runner key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ParseRunnerKey parses a base64 encoded key for runner credentials.
+func ParseRunnerKey(key string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("invalid runner key: %w", err)
+	}
+	if len(decoded) < runnerKeySize {
+		return nil, fmt.Errorf("invalid runner key: expected at least %d bytes but got %d", runnerKeySize, len(decoded))
+	}
+	return decoded, nil
+}
+
+// RunnerCredential returns the credential that identifies the runners of a deployment to the controller.
+//
+// Runners of every deployment share a client certificate, so the credential is what distinguishes them. It is
+// derived from a key shared by the controller and whatever starts runners, and is given to each runner at startup.
+func RunnerCredential(key []byte, deployment model.DeploymentKey) string {
+	return deployment.String() + "." + base64.RawURLEncoding.EncodeToString(runnerMAC(key, deployment.String()))
+}
+
+func runnerMAC(key []byte, deployment string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(deployment))
+	return mac.Sum(nil)
+}
+
+// AuthenticateRunner returns a function that identifies the subject of a request as [rbac.ModuleSubject] of the
+// deployment whose valid runner credential it carries.
+func AuthenticateRunner(key []byte) func(ctx context.Context, header http.Header) (string, bool) {
+	return func(ctx context.Context, header http.Header) (string, bool) {
+		credential, ok := headers.GetRunnerCredential(header)
+		if !ok {
+			return "", false
+		}
+		deployment, err := verifyRunnerCredential(key, credential)
+		if err != nil {
+			log.FromContext(ctx).Warnf("Ignoring runner credential: %s", err)
+			return "", false
+		}
+		return rbac.ModuleSubject(deployment.Payload.Module), true
+	}
+}
+
+func verifyRunnerCredential(key []byte, credential string) (model.DeploymentKey, error) {
+	encoded, encodedMAC, ok := cutLast(credential, ".")
+	if !ok {
+		return model.DeploymentKey{}, errors.New("malformed runner credential")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return model.DeploymentKey{}, errors.New("malformed runner credential signature")
+	}
+	if !hmac.Equal(mac, runnerMAC(key, encoded)) {
+		return model.DeploymentKey{}, errors.New("bad runner credential signature")
+	}
+	deployment, err := model.ParseDeploymentKey(encoded)
+	if err != nil {
+		return model.DeploymentKey{}, fmt.Errorf("invalid runner credential: %w", err)
+	}
+	return deployment, nil
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
@@ -0,0 +1,54 @@
+package identity
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/model"
+	"github.com/block/ftl/internal/rpc/headers"
+)
+
+func TestAuthenticateRunner(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	encoded, err := GenerateRunnerKey()
+	assert.NoError(t, err)
+	key, err := ParseRunnerKey(encoded)
+	assert.NoError(t, err)
+	otherEncoded, err := GenerateRunnerKey()
+	assert.NoError(t, err)
+	other, err := ParseRunnerKey(otherEncoded)
+	assert.NoError(t, err)
+
+	deployment := model.NewDeploymentKey("echo")
+	credential := RunnerCredential(key, deployment)
+	forged := model.NewDeploymentKey("time").String() + credential[len(deployment.String()):]
+
+	for _, test := range []struct {
+		name       string
+		credential string
+		subject    string
+	}{
+		{name: "Valid", credential: credential, subject: "module:echo"},
+		{name: "Missing"},
+		{name: "OtherKey", credential: RunnerCredential(other, deployment)},
+		{name: "OtherDeployment", credential: forged},
+		{name: "Malformed", credential: "echo"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.credential != "" {
+				headers.SetRunnerCredential(header, test.credential)
+			}
+			subject, ok := AuthenticateRunner(key)(ctx, header)
+			assert.Equal(t, test.subject, subject)
+			assert.Equal(t, test.subject != "", ok)
+		})
+	}
+
+	_, err = ParseRunnerKey("c2hvcnQ=")
+	assert.EqualError(t, err, "invalid runner key: expected at least 32 bytes but got 5")
+}
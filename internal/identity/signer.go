@@ -0,0 +1,159 @@
+package identity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alecthomas/types/optional"
+
+	"github.com/block/ftl/internal/model"
+)
+
+type signingKey struct {
+	private ed25519.PrivateKey
+	// Time after which the key is no longer needed to verify tokens, if it has been retired.
+	expires time.Time
+}
+
+func (k signingKey) publicKey() PublicKey {
+	public := k.private.Public().(ed25519.PublicKey) //nolint:forcetypeassert
+	return PublicKey{ID: KeyID(public), Key: public}
+}
+
+// Signer mints tokens, and verifies tokens it has minted.
+//
+// Keys are either generated, in which case they can be rotated with Rotate, or provided by the operator. Generated
+// keys are published for verification one rotation before they are used to sign, and retired keys are kept until
+// the tokens they signed have expired, so that receivers always have the keys they need to verify a token.
+type Signer struct {
+	lock sync.RWMutex
+	ttl  time.Duration
+	// Generated keys can be rotated.
+	generated bool
+	// The key tokens are signed with.
+	current signingKey
+	// The key that will be used to sign once the keys are rotated, if generated.
+	next optional.Option[signingKey]
+	// Keys that are only used for verification.
+	verifyOnly []signingKey
+	now        func() time.Time
+}
+
+var _ Verifier = (*Signer)(nil)
+
+// NewSigner creates a Signer that mints tokens valid for ttl.
+//
+// If keys are provided, as encoded by GenerateKey, the first is used to sign tokens and the rest are only used to
+// verify them. Otherwise, keys are generated in memory, which is suitable for a single controller.
+func NewSigner(ttl time.Duration, keys ...string) (*Signer, error) {
+	if ttl <= 0 {
+		return nil, errors.New("caller token TTL must be positive")
+	}
+	s := &Signer{ttl: ttl, now: time.Now}
+	if len(keys) == 0 {
+		s.generated = true
+		current, err := generateSigningKey()
+		if err != nil {
+			return nil, err
+		}
+		next, err := generateSigningKey()
+		if err != nil {
+			return nil, err
+		}
+		s.current = current
+		s.next = optional.Some(next)
+		return s, nil
+	}
+	for i, key := range keys {
+		private, err := ParseKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("key %d: %w", i, err)
+		}
+		if i == 0 {
+			s.current = signingKey{private: private}
+		} else {
+			s.verifyOnly = append(s.verifyOnly, signingKey{private: private})
+		}
+	}
+	return s, nil
+}
+
+func generateSigningKey() (signingKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return signingKey{}, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	return signingKey{private: private}, nil
+}
+
+// TTL is the lifetime of minted tokens.
+func (s *Signer) TTL() time.Duration { return s.ttl }
+
+// Rotate generated keys, signing with the previously published next key.
+//
+// Does nothing if the keys were provided by the operator.
+func (s *Signer) Rotate() error {
+	if !s.generated {
+		return nil
+	}
+	next, err := generateSigningKey()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := s.now()
+	retired := s.current
+	retired.expires = now.Add(s.ttl)
+	verifyOnly := []signingKey{retired}
+	for _, key := range s.verifyOnly {
+		if key.expires.After(now) {
+			verifyOnly = append(verifyOnly, key)
+		}
+	}
+	s.verifyOnly = verifyOnly
+	s.current = s.next.MustGet()
+	s.next = optional.Some(next)
+	return nil
+}
+
+// Mint a token identifying a deployment, returning the token and its expiry.
+func (s *Signer) Mint(deployment model.DeploymentKey) (string, time.Time, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	expires := s.now().Add(s.ttl).Truncate(time.Second)
+	token, err := sign(s.current.private, deployment, expires)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expires, nil
+}
+
+// PublicKeys returns the keys that receivers should verify tokens against.
+func (s *Signer) PublicKeys() []PublicKey {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	keys := []PublicKey{s.current.publicKey()}
+	if next, ok := s.next.Get(); ok {
+		keys = append(keys, next.publicKey())
+	}
+	now := s.now()
+	for _, key := range s.verifyOnly {
+		if key.expires.IsZero() || key.expires.After(now) {
+			keys = append(keys, key.publicKey())
+		}
+	}
+	return keys
+}
+
+func (s *Signer) Verify(token string) (Claims, error) {
+	keys := map[string]ed25519.PublicKey{}
+	for _, key := range s.PublicKeys() {
+		keys[key.ID] = key.Key
+	}
+	return verify(keys, token, s.now())
+}
@@ -98,6 +98,7 @@ func (l *Logger) Log(entry Entry) {
 	maps.Copy(mergedAttributes, l.attributes)
 	maps.Copy(mergedAttributes, entry.Attributes)
 	entry.Attributes = mergedAttributes
+	entry = redactSecrets(entry)
 
 	if err := l.sink.Log(entry); err != nil {
 		fmt.Fprintf(os.Stderr, "ftl:log: failed to log entry: %v", err)
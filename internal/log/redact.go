@@ -0,0 +1,117 @@
+package log
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Redacted replaces secret values in log entries.
+const Redacted = "[redacted]"
+
+// minSecretLength is the length below which secret values are not redacted, as
+// they are likely to match unrelated text.
+const minSecretLength = 4
+
+var (
+	secretsLock sync.Mutex
+	// secrets are the values to redact, by scope and secret name.
+	secrets = map[string]map[string][]string{}
+	// secretReplacer is nil while no secrets are registered.
+	secretReplacer atomic.Pointer[strings.Replacer]
+)
+
+// RedactSecret replaces all occurrences of values in the messages, attributes
+// and errors of subsequent log entries in this process with [Redacted].
+//
+// scope identifies the owner of the secret, such as a deployment. The values
+// replace any previously registered for the same scope and name, so that the
+// values of a rotated secret are no longer redacted once it is registered again,
+// and calling it without values stops redacting the secret. Values shorter than
+// four characters are ignored.
+func RedactSecret(scope, name string, values ...string) {
+	secretsLock.Lock()
+	defer secretsLock.Unlock()
+	values = slices.DeleteFunc(slices.Clone(values), func(value string) bool { return len(value) < minSecretLength })
+	if len(values) == 0 {
+		delete(secrets[scope], name)
+		if len(secrets[scope]) == 0 {
+			delete(secrets, scope)
+		}
+	} else {
+		if secrets[scope] == nil {
+			secrets[scope] = map[string][]string{}
+		}
+		secrets[scope][name] = values
+	}
+	updateSecretReplacer()
+}
+
+// ForgetSecrets stops redacting the values of all secrets registered for scope.
+func ForgetSecrets(scope string) {
+	secretsLock.Lock()
+	defer secretsLock.Unlock()
+	delete(secrets, scope)
+	updateSecretReplacer()
+}
+
+// updateSecretReplacer must be called with secretsLock held.
+func updateSecretReplacer() {
+	unique := map[string]bool{}
+	for _, scoped := range secrets {
+		for _, values := range scoped {
+			for _, value := range values {
+				unique[value] = true
+			}
+		}
+	}
+	if len(unique) == 0 {
+		secretReplacer.Store(nil)
+		return
+	}
+	values := make([]string, 0, len(unique))
+	for secret := range unique {
+		values = append(values, secret)
+	}
+	// Replace longer values first, so that secrets containing other secrets are fully redacted.
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	oldnew := make([]string, 0, len(values)*2)
+	for _, secret := range values {
+		oldnew = append(oldnew, secret, Redacted)
+	}
+	secretReplacer.Store(strings.NewReplacer(oldnew...))
+}
+
+// redactSecrets replaces registered secret values in an entry.
+func redactSecrets(entry Entry) Entry {
+	replacer := secretReplacer.Load()
+	if replacer == nil {
+		return entry
+	}
+	entry.Message = replacer.Replace(entry.Message)
+	for key, value := range entry.Attributes {
+		entry.Attributes[key] = replacer.Replace(value)
+	}
+	if entry.Error != nil {
+		if msg := replacer.Replace(entry.Error.Error()); msg != entry.Error.Error() {
+			entry.Error = redactedError{msg: msg, err: entry.Error}
+		}
+	}
+	return entry
+}
+
+// redactedError is an error whose message has had secret values redacted.
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (r redactedError) Error() string { return r.msg }
+func (r redactedError) Unwrap() error { return r.err }
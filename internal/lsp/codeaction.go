@@ -0,0 +1,360 @@
+package lsp
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/strcase"
+)
+
+const ftlImport = "github.com/block/ftl/go-runtime/ftl"
+
+// quickFix builds a code action for an FTL diagnostic whose message matches pattern.
+type quickFix struct {
+	pattern *regexp.Regexp
+	fix     func(s *Server, sch *schema.Schema, path string, lines []string, diagnostic protocol.Diagnostic, match []string) (protocol.CodeAction, bool)
+}
+
+// quickFixes for errors reported by the Go schema extractor and schema validation.
+var quickFixes = []quickFix{
+	{regexp.MustCompile(`^\w+ "(\w+)\.(\w+)(?:<.*>)?" must be exported$`), (*Server).exportFix},
+	{regexp.MustCompile(`^direct verb calls are not allowed; use the provided (\w+)Client instead`), (*Server).callsFix},
+	{regexp.MustCompile(`^unsupported external type "(.+)\.(\w+)"`), (*Server).typeAliasFix},
+}
+
+var (
+	directiveRe    = regexp.MustCompile(`^\s*//\s*ftl:(verb|data|enum|typealias)\b`)
+	majorVersionRe = regexp.MustCompile(`^v\d+$`)
+)
+
+func (s *Server) textDocumentCodeAction() protocol.TextDocumentCodeActionFunc {
+	return func(context *glsp.Context, params *protocol.CodeActionParams) (any, error) {
+		uri := params.TextDocument.URI
+		if filepath.Ext(uri) != ".go" {
+			return nil, nil
+		}
+		path := strings.TrimPrefix(uri, "file://")
+		lines, ok := s.lines(path)
+		if !ok {
+			return nil, nil
+		}
+		sch, ok := s.currentSchema()
+		if !ok {
+			sch = &schema.Schema{}
+		}
+		return s.codeActions(sch, path, lines, params.Range, params.Context.Diagnostics), nil
+	}
+}
+
+func (s *Server) codeActions(sch *schema.Schema, path string, lines []string, rng protocol.Range, diagnostics []protocol.Diagnostic) []protocol.CodeAction {
+	actions := []protocol.CodeAction{}
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Source == nil || *diagnostic.Source != "ftl" {
+			continue
+		}
+		for _, qf := range quickFixes {
+			match := qf.pattern.FindStringSubmatch(diagnostic.Message)
+			if match == nil {
+				continue
+			}
+			if action, ok := qf.fix(s, sch, path, lines, diagnostic, match); ok {
+				kind := protocol.CodeActionKindQuickFix
+				action.Kind = &kind
+				action.Diagnostics = []protocol.Diagnostic{diagnostic}
+				actions = append(actions, action)
+			}
+		}
+	}
+	for _, action := range append(declareConfigActions(sch, path, lines, rng.Start), subscriberActions(sch, path, lines, rng.Start)...) {
+		kind := protocol.CodeActionKindRefactor
+		action.Kind = &kind
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// exportFix marks a declaration referenced from another module as exported, in its own module's source.
+func (s *Server) exportFix(sch *schema.Schema, path string, lines []string, diagnostic protocol.Diagnostic, match []string) (protocol.CodeAction, bool) {
+	ref := &schema.Ref{Module: match[1], Name: match[2]}
+	decl, ok := sch.Resolve(ref).Get()
+	if !ok {
+		return protocol.CodeAction{}, false
+	}
+	pos := decl.Position()
+	declLines, ok := s.lines(pos.Filename)
+	if !ok || pos.Line < 1 || pos.Line > len(declLines) {
+		return protocol.CodeAction{}, false
+	}
+	var edit protocol.TextEdit
+	found := false
+	for i := pos.Line - 2; i >= 0 && strings.HasPrefix(strings.TrimSpace(declLines[i]), "//"); i-- {
+		if loc := directiveRe.FindStringIndex(declLines[i]); loc != nil {
+			if strings.Contains(declLines[i][loc[1]:], "export") {
+				return protocol.CodeAction{}, false
+			}
+			edit = protocol.TextEdit{Range: pointRange(i, loc[1]), NewText: " export"}
+			found = true
+			break
+		}
+	}
+	if !found {
+		edit = protocol.TextEdit{Range: pointRange(pos.Line-1, 0), NewText: "//ftl:export\n"}
+	}
+	return protocol.CodeAction{
+		Title: fmt.Sprintf("Export %s", ref),
+		Edit: &protocol.WorkspaceEdit{Changes: map[protocol.DocumentUri][]protocol.TextEdit{
+			"file://" + pos.Filename: {edit},
+		}},
+	}, true
+}
+
+// callsFix replaces a direct verb call with a call through a verb client injected as a parameter of the calling
+// verb, which adds the callee to the verb's +calls.
+func (s *Server) callsFix(sch *schema.Schema, path string, lines []string, diagnostic protocol.Diagnostic, match []string) (protocol.CodeAction, bool) {
+	line, start := int(diagnostic.Range.Start.Line), int(diagnostic.Range.Start.Character)
+	if line >= len(lines) || start >= len(lines[line]) {
+		return protocol.CodeAction{}, false
+	}
+	end := start
+	for end < len(lines[line]) && (isIdentChar(lines[line][end]) || lines[line][end] == '.') {
+		end++
+	}
+	callee := lines[line][start:end]
+	if callee == "" || !strings.HasSuffix(callee, match[1]) {
+		return protocol.CodeAction{}, false
+	}
+	clientType := callee + "Client"
+	paramName := strcase.ToLowerCamel(match[1]) + "Client"
+
+	funcLine := -1
+	for i := line; i >= 0; i-- {
+		if strings.HasPrefix(lines[i], "func ") {
+			funcLine = i
+			break
+		}
+	}
+	if funcLine < 0 {
+		return protocol.CodeAction{}, false
+	}
+	closing, trailingComma, ok := paramsEnd(lines, funcLine)
+	if !ok {
+		return protocol.CodeAction{}, false
+	}
+	signature := strings.Join(lines[funcLine:closing.Line+1], "\n")
+	edits := []protocol.TextEdit{}
+	if existing := regexp.MustCompile(`(\w+)\s+` + regexp.QuoteMeta(clientType) + `\b`).FindStringSubmatch(signature); existing != nil {
+		paramName = existing[1]
+	} else {
+		param := ", " + paramName + " " + clientType
+		if trailingComma {
+			param = paramName + " " + clientType + ","
+		}
+		edits = append(edits, protocol.TextEdit{Range: protocol.Range{Start: closing, End: closing}, NewText: param})
+	}
+	edits = append(edits, protocol.TextEdit{
+		Range:   protocol.Range{Start: protocol.Position{Line: uint32(line), Character: uint32(start)}, End: protocol.Position{Line: uint32(line), Character: uint32(end)}},
+		NewText: paramName,
+	})
+	return protocol.CodeAction{
+		Title: fmt.Sprintf("Call %s through an injected %s", callee, clientType),
+		Edit:  &protocol.WorkspaceEdit{Changes: map[protocol.DocumentUri][]protocol.TextEdit{"file://" + path: edits}},
+	}, true
+}
+
+// typeAliasFix declares an FTL type alias for an unsupported external type, which maps the type with +typemap,
+// and uses it in place of the external type.
+func (s *Server) typeAliasFix(sch *schema.Schema, path string, lines []string, diagnostic protocol.Diagnostic, match []string) (protocol.CodeAction, bool) {
+	pkg, name := goPackageName(match[1]), match[2]
+	external := pkg + "." + name
+	line, start := int(diagnostic.Range.Start.Line), int(diagnostic.Range.Start.Character)
+	if line >= len(lines) || !strings.HasPrefix(lines[line][min(start, len(lines[line])):], external) {
+		return protocol.CodeAction{}, false
+	}
+	edits := []protocol.TextEdit{{
+		Range:   protocol.Range{Start: protocol.Position{Line: uint32(line), Character: uint32(start)}, End: protocol.Position{Line: uint32(line), Character: uint32(start + len(external))}},
+		NewText: name,
+	}}
+	if !declaresType(lines, name) {
+		edits = append(edits, appendEdit(lines, fmt.Sprintf("//ftl:typealias\ntype %s = %s\n", name, external)))
+	}
+	return protocol.CodeAction{
+		Title: fmt.Sprintf("Map external type %s.%s with a type alias", match[1], name),
+		Edit:  &protocol.WorkspaceEdit{Changes: map[protocol.DocumentUri][]protocol.TextEdit{"file://" + path: edits}},
+	}, true
+}
+
+// declareConfigActions offers to declare an undeclared type used in a verb signature as a config or secret.
+//
+// The Go compiler reports these as undefined, so there is no FTL diagnostic to attach them to.
+func declareConfigActions(sch *schema.Schema, path string, lines []string, position protocol.Position) []protocol.CodeAction {
+	if int(position.Line) >= len(lines) || !strings.HasPrefix(lines[position.Line], "func ") {
+		return nil
+	}
+	ident, qualifier, ok := identifierAt(lines[position.Line], int(position.Character))
+	if !ok || qualifier != "" || ident[0] < 'A' || ident[0] > 'Z' || strings.HasPrefix(lines[position.Line], "func "+ident) || declaresType(lines, ident) {
+		return nil
+	}
+	dir := filepath.Dir(path)
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			if filepath.Dir(decl.Position().Filename) == dir && matchesDecl(ident, decl) {
+				return nil
+			}
+		}
+	}
+	var actions []protocol.CodeAction
+	for _, kind := range []string{"Config", "Secret"} {
+		edits := ensureImport(lines, ftlImport)
+		edits = append(edits, appendEdit(lines, fmt.Sprintf("type %s = ftl.%s[string]\n", ident, kind)))
+		actions = append(actions, protocol.CodeAction{
+			Title: fmt.Sprintf("Declare %s as a %s", ident, strings.ToLower(kind)),
+			Edit:  &protocol.WorkspaceEdit{Changes: map[protocol.DocumentUri][]protocol.TextEdit{"file://" + path: edits}},
+		})
+	}
+	return actions
+}
+
+// subscriberActions offers to scaffold a subscriber for a topic declared at the position that has none.
+func subscriberActions(sch *schema.Schema, path string, lines []string, position protocol.Position) []protocol.CodeAction {
+	var actions []protocol.CodeAction
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			topic, ok := decl.(*schema.Topic)
+			if !ok || topic.Pos.Filename != path || topic.Pos.Line != int(position.Line)+1 || hasSubscriber(sch, module.Name, topic.Name) {
+				continue
+			}
+			verb := "Consume" + strcase.ToUpperCamel(topic.Name)
+			scaffold := fmt.Sprintf("//ftl:verb\n//ftl:subscribe %s from=beginning\nfunc %s(ctx context.Context, event %s) error {\n\treturn nil\n}\n",
+				topic.Name, verb, goTypeName(module.Name, topic.Event))
+			edits := ensureImport(lines, "context")
+			edits = append(edits, appendEdit(lines, scaffold))
+			actions = append(actions, protocol.CodeAction{
+				Title: fmt.Sprintf("Add a subscriber for topic %s", topic.Name),
+				Edit:  &protocol.WorkspaceEdit{Changes: map[protocol.DocumentUri][]protocol.TextEdit{"file://" + path: edits}},
+			})
+		}
+	}
+	return actions
+}
+
+func hasSubscriber(sch *schema.Schema, module, topic string) bool {
+	for _, m := range sch.Modules {
+		for _, decl := range m.Decls {
+			verb, ok := decl.(*schema.Verb)
+			if !ok {
+				continue
+			}
+			for _, md := range verb.Metadata {
+				if sub, ok := md.(*schema.MetadataSubscriber); ok && refKey(m.Name, sub.Topic) == (schema.RefKey{Module: module, Name: topic}) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// goTypeName returns the Go type used for a schema type in the given module.
+func goTypeName(module string, t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.Ref:
+		if t.Module == "" || t.Module == module {
+			return t.Name
+		}
+		return t.Module + "." + t.Name
+	case *schema.String:
+		return "string"
+	case *schema.Int:
+		return "int"
+	case *schema.Float:
+		return "float64"
+	case *schema.Bool:
+		return "bool"
+	default:
+		return "any"
+	}
+}
+
+// paramsEnd finds the closing parenthesis of the parameters of the function declared at funcLine, and whether
+// the last parameter is followed by a trailing comma.
+func paramsEnd(lines []string, funcLine int) (protocol.Position, bool, bool) {
+	depth := 0
+	last := byte(0)
+	for i := funcLine; i < len(lines); i++ {
+		for j := 0; j < len(lines[i]); j++ {
+			c := lines[i][j]
+			switch c {
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					return protocol.Position{Line: uint32(i), Character: uint32(j)}, last == ',', true
+				}
+			}
+			if c != ' ' && c != '\t' {
+				last = c
+			}
+		}
+	}
+	return protocol.Position{}, false, false
+}
+
+// goPackageName returns the conventional package name for an import path, skipping major version suffixes.
+func goPackageName(importPath string) string {
+	parts := strings.Split(importPath, "/")
+	name := parts[len(parts)-1]
+	if len(parts) > 1 && majorVersionRe.MatchString(name) {
+		name = parts[len(parts)-2]
+	}
+	return name
+}
+
+func declaresType(lines []string, name string) bool {
+	re := regexp.MustCompile(`^\s*type\s+` + regexp.QuoteMeta(name) + `\b`)
+	for _, line := range lines {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureImport returns the edits needed to import a package, if the file does not already.
+func ensureImport(lines []string, importPath string) []protocol.TextEdit {
+	quoted := fmt.Sprintf("%q", importPath)
+	pkgLine := -1
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasSuffix(trimmed, quoted):
+			return nil
+		case trimmed == "import (":
+			return []protocol.TextEdit{{Range: pointRange(i+1, 0), NewText: "\t" + quoted + "\n"}}
+		case strings.HasPrefix(trimmed, "package ") && pkgLine < 0:
+			pkgLine = i
+		}
+	}
+	return []protocol.TextEdit{{Range: pointRange(pkgLine+1, 0), NewText: "\nimport " + quoted + "\n"}}
+}
+
+// appendEdit appends a block of code to the end of a file, separated by a blank line.
+func appendEdit(lines []string, block string) protocol.TextEdit {
+	last := len(lines) - 1
+	prefix := "\n"
+	if lines[last] != "" {
+		prefix = "\n\n"
+	}
+	return protocol.TextEdit{Range: pointRange(last, len(lines[last])), NewText: prefix + block}
+}
+
+func pointRange(line, character int) protocol.Range {
+	pos := protocol.Position{Line: uint32(line), Character: uint32(character)}
+	return protocol.Range{Start: pos, End: pos}
+}
@@ -0,0 +1,115 @@
+package lsp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/block/ftl/common/schema"
+)
+
+func ftlDiagnostic(line, character int, message string) protocol.Diagnostic {
+	source := "ftl"
+	return protocol.Diagnostic{Range: pointRange(line, character), Source: &source, Message: message}
+}
+
+func TestCodeActionExport(t *testing.T) {
+	sch, echoPath, timePath := testSchema(t)
+	s := testServer(sch)
+	lines, _ := s.lines(echoPath)
+
+	actions := s.codeActions(sch, echoPath, lines, pointRange(8, 22), []protocol.Diagnostic{
+		ftlDiagnostic(8, 22, `data "time.TimeRequest" must be exported`),
+	})
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, "Export time.TimeRequest", actions[0].Title)
+	assert.Equal(t, map[protocol.DocumentUri][]protocol.TextEdit{
+		"file://" + timePath: {{Range: pointRange(2, 0), NewText: "//ftl:export\n"}},
+	}, actions[0].Edit.Changes)
+}
+
+func TestCodeActionCalls(t *testing.T) {
+	path := "/src/echo/echo.go"
+	lines := strings.Split(`package echo
+
+//ftl:verb
+func Echo(ctx context.Context, req EchoRequest) (string, error) {
+	return time.Time(ctx, time.TimeRequest{})
+}
+`, "\n")
+	s := testServer(&schema.Schema{})
+	actions := s.codeActions(&schema.Schema{}, path, lines, pointRange(4, 8), []protocol.Diagnostic{
+		ftlDiagnostic(4, 8, "direct verb calls are not allowed; use the provided TimeClient instead. See https://block.github.io/ftl/docs/reference/verbs/#calling-verbs"),
+	})
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, []protocol.TextEdit{
+		{Range: pointRange(3, 46), NewText: ", timeClient time.TimeClient"},
+		{Range: protocol.Range{Start: protocol.Position{Line: 4, Character: 8}, End: protocol.Position{Line: 4, Character: 17}}, NewText: "timeClient"},
+	}, actions[0].Edit.Changes["file://"+path])
+}
+
+func TestCodeActionTypeAlias(t *testing.T) {
+	path := "/src/echo/echo.go"
+	lines := strings.Split(`package echo
+
+type EchoRequest struct {
+	ID uuid.UUID
+}
+`, "\n")
+	s := testServer(&schema.Schema{})
+	actions := s.codeActions(&schema.Schema{}, path, lines, pointRange(3, 4), []protocol.Diagnostic{
+		ftlDiagnostic(3, 4, `unsupported external type "github.com/google/uuid.UUID"; see FTL docs on using external types: block.github.io/ftl/docs/reference/externaltypes/`),
+	})
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, []protocol.TextEdit{
+		{Range: protocol.Range{Start: protocol.Position{Line: 3, Character: 4}, End: protocol.Position{Line: 3, Character: 13}}, NewText: "UUID"},
+		{Range: pointRange(5, 0), NewText: "\n//ftl:typealias\ntype UUID = uuid.UUID\n"},
+	}, actions[0].Edit.Changes["file://"+path])
+}
+
+func TestCodeActionDeclareConfig(t *testing.T) {
+	path := "/src/echo/echo.go"
+	lines := strings.Split(`package echo
+
+import (
+	"context"
+)
+
+//ftl:verb
+func Echo(ctx context.Context, req EchoRequest, key ApiKey) (string, error) {
+	return "", nil
+}
+`, "\n")
+	actions := testServer(&schema.Schema{}).codeActions(&schema.Schema{}, path, lines, pointRange(7, 55), nil)
+	assert.Equal(t, []string{"Declare ApiKey as a config", "Declare ApiKey as a secret"}, []string{actions[0].Title, actions[1].Title})
+	assert.Equal(t, []protocol.TextEdit{
+		{Range: pointRange(3, 0), NewText: "\t\"github.com/block/ftl/go-runtime/ftl\"\n"},
+		{Range: pointRange(10, 0), NewText: "\ntype ApiKey = ftl.Secret[string]\n"},
+	}, actions[1].Edit.Changes["file://"+path])
+}
+
+func TestCodeActionSubscriber(t *testing.T) {
+	path := "/src/pubsub/pubsub.go"
+	lines := strings.Split(`package pubsub
+
+import "context"
+
+type Payins = ftl.TopicHandle[PayinEvent, ftl.SinglePartitionMap[PayinEvent]]`, "\n")
+	sch := &schema.Schema{Modules: []*schema.Module{{Name: "pubsub", Decls: []schema.Decl{
+		&schema.Topic{Pos: schema.Position{Filename: path, Line: 5, Column: 1}, Name: "payins", Event: &schema.Ref{Module: "pubsub", Name: "PayinEvent"}},
+	}}}}
+	actions := testServer(sch).codeActions(sch, path, lines, pointRange(4, 6), nil)
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, "Add a subscriber for topic payins", actions[0].Title)
+	assert.Equal(t, []protocol.TextEdit{{
+		Range:   pointRange(4, 77),
+		NewText: "\n\n//ftl:verb\n//ftl:subscribe payins from=beginning\nfunc ConsumePayins(ctx context.Context, event PayinEvent) error {\n\treturn nil\n}\n",
+	}}, actions[0].Edit.Changes["file://"+path])
+
+	sch.Modules[0].Decls = append(sch.Modules[0].Decls, &schema.Verb{Name: "consume", Metadata: []schema.Metadata{
+		&schema.MetadataSubscriber{Topic: &schema.Ref{Module: "pubsub", Name: "payins"}},
+	}})
+	assert.Equal(t, 0, len(testServer(sch).codeActions(sch, path, lines, pointRange(4, 6), nil)))
+}
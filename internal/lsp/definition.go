@@ -0,0 +1,38 @@
+package lsp
+
+import (
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+)
+
+func (s *Server) textDocumentDefinition() protocol.TextDocumentDefinitionFunc {
+	return func(context *glsp.Context, params *protocol.DefinitionParams) (any, error) {
+		sym, ok := s.resolve(params.TextDocument.URI, params.Position)
+		if !ok {
+			return nil, nil
+		}
+		pos := sym.decl.Position()
+		if pos.Filename == "" {
+			return nil, nil
+		}
+		return s.location(pos, sym.decl), nil
+	}
+}
+
+func (s *Server) textDocumentReferences() protocol.TextDocumentReferencesFunc {
+	return func(context *glsp.Context, params *protocol.ReferenceParams) ([]protocol.Location, error) {
+		sym, ok := s.resolve(params.TextDocument.URI, params.Position)
+		if !ok {
+			return nil, nil
+		}
+		sch, _ := s.currentSchema()
+		locations := []protocol.Location{}
+		if pos := sym.decl.Position(); params.Context.IncludeDeclaration && pos.Filename != "" {
+			locations = append(locations, s.location(pos, sym.decl))
+		}
+		for _, pos := range references(sch, sym.ref()) {
+			locations = append(locations, s.location(pos, sym.decl))
+		}
+		return locations, nil
+	}
+}
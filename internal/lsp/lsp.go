@@ -8,7 +8,6 @@ import (
 	"os"
 	"strings"
 
-	"github.com/alecthomas/types/pubsub"
 	"github.com/puzpuzpuz/xsync/v3"
 	_ "github.com/tliron/commonlog/simple"
 	"github.com/tliron/glsp"
@@ -18,6 +17,7 @@ import (
 
 	"github.com/block/ftl/common/builderrors"
 	ftlErrors "github.com/block/ftl/common/errors"
+	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/internal/buildengine"
 	"github.com/block/ftl/internal/channels"
 	"github.com/block/ftl/internal/log"
@@ -33,6 +33,8 @@ type Server struct {
 	logger      log.Logger
 	diagnostics *xsync.MapOf[protocol.DocumentUri, []protocol.Diagnostic]
 	documents   *documentStore
+	// schema returns the latest schema of all modules, used to navigate between declarations and references.
+	schema func() *schema.Schema
 }
 
 // NewServer creates a new language server.
@@ -59,6 +61,11 @@ func NewServer(ctx context.Context) *Server {
 	handler.TextDocumentCompletion = server.textDocumentCompletion()
 	handler.CompletionItemResolve = server.completionItemResolve()
 	handler.TextDocumentHover = server.textDocumentHover()
+	handler.TextDocumentDefinition = server.textDocumentDefinition()
+	handler.TextDocumentReferences = server.textDocumentReferences()
+	handler.TextDocumentRename = server.textDocumentRename()
+	handler.WorkspaceSymbol = server.workspaceSymbol()
+	handler.TextDocumentCodeAction = server.textDocumentCodeAction()
 	handler.Initialize = server.initialize()
 
 	return server
@@ -74,7 +81,10 @@ func (s *Server) Run() error {
 
 type errSet []builderrors.Error
 
-func (s *Server) Subscribe(ctx context.Context, topic *pubsub.Topic[buildengine.EngineEvent]) {
+// Subscribe publishes build state and diagnostics from the engine, and uses its schema to navigate between modules.
+func (s *Server) Subscribe(ctx context.Context, engine *buildengine.Engine) {
+	s.schema = engine.Schema
+	topic := engine.EngineUpdates
 	events := make(chan buildengine.EngineEvent, 64)
 	topic.Subscribe(events)
 	go func() {
@@ -263,6 +273,13 @@ func (s *Server) initialize() protocol.InitializeFunc {
 		serverCapabilities := s.handler.CreateServerCapabilities()
 		serverCapabilities.TextDocumentSync = protocol.TextDocumentSyncKindIncremental
 		serverCapabilities.HoverProvider = true
+		serverCapabilities.DefinitionProvider = true
+		serverCapabilities.ReferencesProvider = true
+		serverCapabilities.RenameProvider = true
+		serverCapabilities.WorkspaceSymbolProvider = true
+		serverCapabilities.CodeActionProvider = &protocol.CodeActionOptions{
+			CodeActionKinds: []protocol.CodeActionKind{protocol.CodeActionKindQuickFix, protocol.CodeActionKindRefactor},
+		}
 
 		trueValue := true
 		serverCapabilities.CompletionProvider = &protocol.CompletionOptions{
@@ -0,0 +1,186 @@
+package lsp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/block/ftl/common/schema"
+	"github.com/block/ftl/common/strcase"
+)
+
+func (s *Server) textDocumentRename() protocol.TextDocumentRenameFunc {
+	return func(context *glsp.Context, params *protocol.RenameParams) (*protocol.WorkspaceEdit, error) {
+		sym, ok := s.resolve(params.TextDocument.URI, params.Position)
+		if !ok {
+			return nil, nil
+		}
+		sch, _ := s.currentSchema()
+		return s.rename(sch, sym, params.NewName)
+	}
+}
+
+// rename builds the edits that rename a verb or data type, along with every reference to it in any module.
+//
+// Only the declaration and the references recorded in the schema, such as those in signatures, can be found.
+// If the source files of any module refer to the declaration anywhere else, such as in a function body, the
+// rename is refused rather than leaving those references behind.
+func (s *Server) rename(sch *schema.Schema, sym symbol, newName string) (*protocol.WorkspaceEdit, error) {
+	switch sym.decl.(type) {
+	case *schema.Verb, *schema.Data:
+	default:
+		return nil, fmt.Errorf("only verbs and data types can be renamed, not %s", schema.TypeName(sym.decl))
+	}
+	if !schema.ValidateName(newName) {
+		return nil, fmt.Errorf("invalid name %q", newName)
+	}
+	if module, ok := sch.Module(sym.module).Get(); ok {
+		for _, decl := range module.Decls {
+			if decl != sym.decl && normaliseName(decl.GetName()) == normaliseName(newName) {
+				return nil, fmt.Errorf("%s.%s already exists", sym.module, decl.GetName())
+			}
+		}
+	}
+
+	positions := append([]schema.Position{sym.decl.Position()}, references(sch, sym.ref())...)
+	changes := map[protocol.DocumentUri][]protocol.TextEdit{}
+	seen := map[protocol.Location]bool{}
+	for _, pos := range positions {
+		if pos.Filename == "" {
+			continue
+		}
+		lines, ok := s.lines(pos.Filename)
+		if !ok {
+			return nil, fmt.Errorf("could not read %s", pos.Filename)
+		}
+		r, ok := identifierLocation(lines, pos, sym.decl)
+		if !ok {
+			continue
+		}
+		uri := "file://" + pos.Filename
+		loc := protocol.Location{URI: uri, Range: r}
+		if seen[loc] {
+			continue
+		}
+		seen[loc] = true
+		ident := lines[r.Start.Line][r.Start.Character:r.End.Character]
+		changes[uri] = append(changes[uri], protocol.TextEdit{
+			Range:   r,
+			NewText: renameIdentifier(ident, sym.decl, newName),
+		})
+	}
+	if err := s.checkUnrecordedReferences(sch, sym, seen); err != nil {
+		return nil, err
+	}
+	return &protocol.WorkspaceEdit{Changes: changes}, nil
+}
+
+// checkUnrecordedReferences returns an error if an identifier matching the declaration appears in the source
+// files of any module at a location that is not being renamed.
+//
+// Source files are those alongside the files the schema records positions in. Generated files, and package and
+// import clauses, which may name a module that shares the declaration's name, are ignored.
+func (s *Server) checkUnrecordedReferences(sch *schema.Schema, sym symbol, renamed map[protocol.Location]bool) error {
+	modules := map[string]bool{}
+	dirs := map[string]bool{}
+	exts := map[string]bool{}
+	for _, module := range sch.Modules {
+		modules[module.Name] = true
+		_ = schema.Visit(module, func(n schema.Node, next func() error) error { //nolint:errcheck
+			if filename := n.Position().Filename; filename != "" {
+				dirs[filepath.Dir(filename)] = true
+				exts[filepath.Ext(filename)] = true
+			}
+			return next()
+		})
+	}
+	sortedDirs := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		sortedDirs = append(sortedDirs, dir)
+	}
+	sort.Strings(sortedDirs)
+	for _, dir := range sortedDirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || !exts[filepath.Ext(entry.Name())] {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			lines, ok := s.lines(path)
+			if !ok || isGenerated(lines) {
+				continue
+			}
+			for i, line := range lines {
+				if isPackageOrImportClause(line) {
+					continue
+				}
+				for _, r := range identifierRanges(line, 0) {
+					ident := line[r[0]:r[1]]
+					if modules[ident] || !matchesDecl(ident, sym.decl) {
+						continue
+					}
+					loc := protocol.Location{URI: "file://" + path, Range: protocol.Range{
+						Start: protocol.Position{Line: uint32(i), Character: uint32(r[0])},
+						End:   protocol.Position{Line: uint32(i), Character: uint32(r[1])},
+					}}
+					if !renamed[loc] {
+						return fmt.Errorf("%s is also referred to at %s:%d:%d, which must be renamed with the language's own tooling", sym.ref(), path, i+1, r[0]+1)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isGenerated returns true if a source file is marked as generated, and so is regenerated rather than edited.
+func isGenerated(lines []string) bool {
+	for _, line := range lines[:min(len(lines), 5)] {
+		if strings.Contains(line, "Code generated") && strings.Contains(line, "DO NOT EDIT") {
+			return true
+		}
+	}
+	return false
+}
+
+func isPackageOrImportClause(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, prefix := range []string{"package ", "import ", "from ", `"`} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// renameIdentifier returns the replacement for a source identifier referring to a renamed declaration,
+// preserving the language's naming convention and any generated client suffix.
+func renameIdentifier(ident string, decl schema.Decl, newName string) string {
+	if ident == decl.GetName() {
+		return newName
+	}
+	suffix := ""
+	if _, isVerb := decl.(*schema.Verb); isVerb && normaliseName(ident) != normaliseName(decl.GetName()) {
+		suffix = ident[len(ident)-len("client"):]
+		ident = ident[:len(ident)-len("client")]
+	}
+	first, _ := utf8.DecodeRuneInString(ident)
+	switch {
+	case strings.Contains(ident, "_"):
+		return strcase.ToLowerSnake(newName) + suffix
+	case unicode.IsUpper(first):
+		return strcase.ToUpperCamel(newName) + suffix
+	default:
+		return strcase.ToLowerCamel(newName) + suffix
+	}
+}
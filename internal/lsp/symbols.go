@@ -0,0 +1,267 @@
+package lsp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/block/ftl/common/schema"
+)
+
+// symbol is a declaration in the schema that source identifiers can refer to.
+type symbol struct {
+	module string
+	decl   schema.Decl
+}
+
+func (s symbol) ref() schema.RefKey {
+	return schema.RefKey{Module: s.module, Name: s.decl.GetName()}
+}
+
+// identifierAt returns the identifier spanning the given character of a line, along with the identifier that
+// qualifies it with a "." (eg. the module in "other.Echo"), if any.
+func identifierAt(line string, character int) (ident string, qualifier string, ok bool) {
+	if character > len(line) {
+		character = len(line)
+	}
+	start, end := character, character
+	for start > 0 && isIdentChar(line[start-1]) {
+		start--
+	}
+	for end < len(line) && isIdentChar(line[end]) {
+		end++
+	}
+	if start == end {
+		return "", "", false
+	}
+	ident = line[start:end]
+	if start > 0 && line[start-1] == '.' {
+		qstart := start - 1
+		for qstart > 0 && isIdentChar(line[qstart-1]) {
+			qstart--
+		}
+		qualifier = line[qstart : start-1]
+	}
+	return ident, qualifier, true
+}
+
+// identifierRanges returns the ranges of all identifiers on a line at or after the given character, excluding
+// qualifiers such as the package in "time.TimeClient".
+func identifierRanges(line string, character int) [][2]int {
+	var out [][2]int
+	for i := 0; i < len(line); {
+		if !isIdentChar(line[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(line) && isIdentChar(line[i]) {
+			i++
+		}
+		if i > character && (i == len(line) || line[i] != '.') {
+			out = append(out, [2]int{start, i})
+		}
+	}
+	return out
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// normaliseName maps an identifier to a form that can be compared across languages, so that the Go identifier
+// "EchoRequest", the Python identifier "echo_request" and the schema name "EchoRequest" all match.
+func normaliseName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// matchesDecl returns true if a source identifier refers to a declaration, including through the generated
+// client type of a verb (eg. "EchoClient").
+func matchesDecl(ident string, decl schema.Decl) bool {
+	n := normaliseName(ident)
+	name := normaliseName(decl.GetName())
+	if n == name {
+		return true
+	}
+	_, isVerb := decl.(*schema.Verb)
+	return isVerb && n == name+"client"
+}
+
+// resolveSymbol finds the declaration referred to by the identifier at a position in a source file.
+//
+// Declarations and references recorded in the schema at the same line of the same file take precedence.
+// Otherwise, for example in a call through a generated client stub, the identifier is matched against every
+// declaration by name, preferring the module it is qualified with and then the module closest to the file.
+func resolveSymbol(sch *schema.Schema, path string, lines []string, position protocol.Position) (symbol, bool) {
+	if int(position.Line) >= len(lines) {
+		return symbol{}, false
+	}
+	ident, qualifier, ok := identifierAt(lines[position.Line], int(position.Character))
+	if !ok {
+		return symbol{}, false
+	}
+	line := int(position.Line) + 1
+
+	var candidates []symbol
+	var positional []symbol
+	for _, module := range sch.Modules {
+		for _, decl := range module.Decls {
+			if !matchesDecl(ident, decl) {
+				continue
+			}
+			sym := symbol{module: module.Name, decl: decl}
+			candidates = append(candidates, sym)
+			if pos := decl.Position(); pos.Filename == path && pos.Line == line {
+				positional = append(positional, sym)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return symbol{}, false
+	}
+	for _, module := range sch.Modules {
+		_ = schema.Visit(module, func(n schema.Node, next func() error) error { //nolint:errcheck
+			if ref, ok := n.(*schema.Ref); ok && ref.Pos.Filename == path && ref.Pos.Line == line {
+				for _, c := range candidates {
+					if c.ref() == refKey(module.Name, ref) {
+						positional = append(positional, c)
+					}
+				}
+			}
+			return next()
+		})
+	}
+	if len(positional) > 0 {
+		candidates = positional
+	}
+
+	if qualifier != "" {
+		var qualified []symbol
+		for _, c := range candidates {
+			if normaliseName(c.module) == normaliseName(qualifier) {
+				qualified = append(qualified, c)
+			}
+		}
+		if len(qualified) > 0 {
+			candidates = qualified
+		}
+	}
+
+	best, bestScore := candidates[0], -1
+	for _, c := range candidates {
+		if score := commonPathPrefix(c.decl.Position().Filename, path); score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	return best, true
+}
+
+// references returns the positions of every reference to a declaration in the schema.
+func references(sch *schema.Schema, key schema.RefKey) []schema.Position {
+	seen := map[schema.Position]bool{}
+	var out []schema.Position
+	for _, module := range sch.Modules {
+		_ = schema.Visit(module, func(n schema.Node, next func() error) error { //nolint:errcheck
+			if ref, ok := n.(*schema.Ref); ok && ref.Pos.Filename != "" && refKey(module.Name, ref) == key && !seen[ref.Pos] {
+				seen[ref.Pos] = true
+				out = append(out, ref.Pos)
+			}
+			return next()
+		})
+	}
+	return out
+}
+
+// refKey returns the fully qualified key of a reference, which may omit the module it is declared in.
+func refKey(module string, ref *schema.Ref) schema.RefKey {
+	if ref.Module == "" {
+		return schema.RefKey{Module: module, Name: ref.Name}
+	}
+	return schema.RefKey{Module: ref.Module, Name: ref.Name}
+}
+
+// identifierLocation finds the identifier matching a declaration at a schema position.
+//
+// Schema positions point at the start of the node, which for declarations is typically a keyword preceding
+// the name, so the first matching identifier at or after the position's column is used.
+func identifierLocation(lines []string, pos schema.Position, decl schema.Decl) (protocol.Range, bool) {
+	if pos.Line < 1 || pos.Line > len(lines) {
+		return protocol.Range{}, false
+	}
+	line := lines[pos.Line-1]
+	for _, r := range identifierRanges(line, max(pos.Column-1, 0)) {
+		if matchesDecl(line[r[0]:r[1]], decl) {
+			return protocol.Range{
+				Start: protocol.Position{Line: uint32(pos.Line - 1), Character: uint32(r[0])},
+				End:   protocol.Position{Line: uint32(pos.Line - 1), Character: uint32(r[1])},
+			}, true
+		}
+	}
+	return protocol.Range{}, false
+}
+
+// location converts a schema position to an LSP location, narrowed to the declaration's identifier if it can
+// be found.
+func (s *Server) location(pos schema.Position, decl schema.Decl) protocol.Location {
+	loc := protocol.Location{
+		URI: "file://" + pos.Filename,
+		Range: protocol.Range{
+			Start: protocol.Position{Line: uint32(max(pos.Line-1, 0)), Character: uint32(max(pos.Column-1, 0))},
+			End:   protocol.Position{Line: uint32(max(pos.Line-1, 0)), Character: uint32(max(pos.Column-1, 0))},
+		},
+	}
+	if lines, ok := s.lines(pos.Filename); ok {
+		if r, ok := identifierLocation(lines, pos, decl); ok {
+			loc.Range = r
+		}
+	}
+	return loc
+}
+
+// lines returns the lines of a file, preferring the editor's copy if it is open.
+func (s *Server) lines(path string) ([]string, bool) {
+	if doc, ok := s.documents.get("file://" + path); ok {
+		return doc.lines, true
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return strings.Split(string(content), "\n"), true
+}
+
+// currentSchema returns the latest schema known to the build engine, if any.
+func (s *Server) currentSchema() (*schema.Schema, bool) {
+	if s.schema == nil {
+		return nil, false
+	}
+	sch := s.schema()
+	return sch, sch != nil
+}
+
+// resolve finds the declaration at a position in an open document.
+func (s *Server) resolve(uri protocol.DocumentUri, position protocol.Position) (symbol, bool) {
+	sch, ok := s.currentSchema()
+	if !ok {
+		return symbol{}, false
+	}
+	path := strings.TrimPrefix(uri, "file://")
+	lines, ok := s.lines(path)
+	if !ok {
+		return symbol{}, false
+	}
+	return resolveSymbol(sch, path, lines, position)
+}
+
+// commonPathPrefix returns the number of leading path elements shared by two paths.
+func commonPathPrefix(a, b string) int {
+	as := strings.Split(filepath.Clean(a), string(filepath.Separator))
+	bs := strings.Split(filepath.Clean(b), string(filepath.Separator))
+	n := 0
+	for n < len(as) && n < len(bs) && as[n] == bs[n] {
+		n++
+	}
+	return n
+}
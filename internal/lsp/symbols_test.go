@@ -0,0 +1,129 @@
+package lsp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/block/ftl/common/schema"
+)
+
+const echoSource = `package echo
+
+type EchoRequest struct {
+	Name string
+}
+
+//ftl:verb
+func Echo(ctx context.Context, req EchoRequest, tc time.TimeClient) (string, error) {
+	return tc(ctx, time.TimeRequest{})
+}
+`
+
+const timeSource = `package time
+
+type TimeRequest struct{}
+
+//ftl:verb export
+func Time(ctx context.Context, req TimeRequest) (TimeResponse, error) {
+	return TimeResponse{}, nil
+}
+`
+
+func testSchema(t *testing.T) (*schema.Schema, string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	echoPath := filepath.Join(dir, "echo", "echo.go")
+	timePath := filepath.Join(dir, "time", "time.go")
+	for path, content := range map[string]string{echoPath: echoSource, timePath: timeSource} {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	timeReq := &schema.Data{Pos: schema.Position{Filename: timePath, Line: 3, Column: 1}, Name: "TimeRequest"}
+	timeVerb := &schema.Verb{
+		Pos:      schema.Position{Filename: timePath, Line: 6, Column: 1},
+		Name:     "time",
+		Request:  &schema.Ref{Pos: schema.Position{Filename: timePath, Line: 6, Column: 36}, Module: "time", Name: "TimeRequest"},
+		Response: &schema.Ref{Module: "time", Name: "TimeResponse"},
+	}
+	echoReq := &schema.Data{Pos: schema.Position{Filename: echoPath, Line: 3, Column: 1}, Name: "EchoRequest"}
+	echoVerb := &schema.Verb{
+		Pos:      schema.Position{Filename: echoPath, Line: 8, Column: 1},
+		Name:     "echo",
+		Request:  &schema.Ref{Pos: schema.Position{Filename: echoPath, Line: 8, Column: 36}, Module: "echo", Name: "EchoRequest"},
+		Response: &schema.String{},
+		Metadata: []schema.Metadata{&schema.MetadataCalls{Calls: []*schema.Ref{
+			{Pos: schema.Position{Filename: echoPath, Line: 8, Column: 49}, Module: "time", Name: "time"},
+		}}},
+	}
+	sch := &schema.Schema{Modules: []*schema.Module{
+		{Name: "echo", Decls: []schema.Decl{echoReq, echoVerb}},
+		{Name: "time", Decls: []schema.Decl{timeReq, timeVerb}},
+	}}
+	return sch, echoPath, timePath
+}
+
+func testServer(sch *schema.Schema) *Server {
+	return &Server{documents: newDocumentStore(), schema: func() *schema.Schema { return sch }}
+}
+
+func TestDefinition(t *testing.T) {
+	sch, echoPath, timePath := testSchema(t)
+	s := testServer(sch)
+
+	// Generated client stub in the call signature resolves to the verb in the other module.
+	sym, ok := s.resolve("file://"+echoPath, protocol.Position{Line: 7, Character: 60})
+	assert.True(t, ok)
+	assert.Equal(t, schema.RefKey{Module: "time", Name: "time"}, sym.ref())
+	assert.Equal(t, protocol.Location{
+		URI:   "file://" + timePath,
+		Range: protocol.Range{Start: protocol.Position{Line: 5, Character: 5}, End: protocol.Position{Line: 5, Character: 9}},
+	}, s.location(sym.decl.Position(), sym.decl))
+
+	// Qualified identifiers without a schema ref at that line are resolved by name.
+	sym, ok = s.resolve("file://"+echoPath, protocol.Position{Line: 8, Character: 24})
+	assert.True(t, ok)
+	assert.Equal(t, schema.RefKey{Module: "time", Name: "TimeRequest"}, sym.ref())
+
+	_, ok = s.resolve("file://"+echoPath, protocol.Position{Line: 0, Character: 2})
+	assert.False(t, ok)
+}
+
+func TestReferences(t *testing.T) {
+	sch, echoPath, _ := testSchema(t)
+	assert.Equal(t, []schema.Position{{Filename: echoPath, Line: 8, Column: 49}}, references(sch, schema.RefKey{Module: "time", Name: "time"}))
+}
+
+func TestRename(t *testing.T) {
+	sch, echoPath, timePath := testSchema(t)
+	s := testServer(sch)
+	sym, ok := s.resolve("file://"+timePath, protocol.Position{Line: 5, Character: 6})
+	assert.True(t, ok)
+
+	edit, err := s.rename(sch, sym, "clock")
+	assert.NoError(t, err)
+	assert.Equal(t, map[protocol.DocumentUri][]protocol.TextEdit{
+		"file://" + timePath: {{
+			Range:   protocol.Range{Start: protocol.Position{Line: 5, Character: 5}, End: protocol.Position{Line: 5, Character: 9}},
+			NewText: "Clock",
+		}},
+		"file://" + echoPath: {{
+			Range:   protocol.Range{Start: protocol.Position{Line: 7, Character: 56}, End: protocol.Position{Line: 7, Character: 66}},
+			NewText: "ClockClient",
+		}},
+	}, edit.Changes)
+
+	_, err = s.rename(sch, sym, "TimeRequest")
+	assert.EqualError(t, err, "time.TimeRequest already exists")
+	_, err = s.rename(sch, sym, "not-valid")
+	assert.EqualError(t, err, `invalid name "not-valid"`)
+
+	// TimeRequest is also constructed in the body of echo.Echo, which the schema does not record.
+	sym, ok = s.resolve("file://"+timePath, protocol.Position{Line: 2, Character: 6})
+	assert.True(t, ok)
+	_, err = s.rename(sch, sym, "ClockRequest")
+	assert.EqualError(t, err, "time.TimeRequest is also referred to at "+echoPath+":9:22, which must be renamed with the language's own tooling")
+}
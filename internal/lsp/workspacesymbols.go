@@ -0,0 +1,54 @@
+package lsp
+
+import (
+	"strings"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/block/ftl/common/schema"
+)
+
+func (s *Server) workspaceSymbol() protocol.WorkspaceSymbolFunc {
+	return func(context *glsp.Context, params *protocol.WorkspaceSymbolParams) ([]protocol.SymbolInformation, error) {
+		sch, ok := s.currentSchema()
+		if !ok {
+			return nil, nil
+		}
+		query := strings.ToLower(params.Query)
+		symbols := []protocol.SymbolInformation{}
+		for _, module := range sch.Modules {
+			for _, decl := range module.Decls {
+				kind, ok := symbolKind(decl)
+				if !ok || decl.Position().Filename == "" {
+					continue
+				}
+				if !strings.Contains(strings.ToLower(decl.GetName()), query) {
+					continue
+				}
+				containerName := module.Name
+				symbols = append(symbols, protocol.SymbolInformation{
+					Name:          decl.GetName(),
+					Kind:          kind,
+					Location:      s.location(decl.Position(), decl),
+					ContainerName: &containerName,
+				})
+			}
+		}
+		return symbols, nil
+	}
+}
+
+// symbolKind returns the LSP symbol kind of the declarations that are listed as workspace symbols.
+func symbolKind(decl schema.Decl) (protocol.SymbolKind, bool) {
+	switch decl.(type) {
+	case *schema.Verb:
+		return protocol.SymbolKindFunction, true
+	case *schema.Data:
+		return protocol.SymbolKindStruct, true
+	case *schema.Topic:
+		return protocol.SymbolKindEvent, true
+	default:
+		return 0, false
+	}
+}
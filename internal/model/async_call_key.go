@@ -0,0 +1,32 @@
+package model
+
+import (
+	"errors"
+)
+
+type AsyncCallKey = KeyType[AsyncCallPayload, *AsyncCallPayload]
+
+func NewAsyncCallKey(module, verb string) AsyncCallKey {
+	return newKey[AsyncCallPayload](module, verb)
+}
+
+func ParseAsyncCallKey(key string) (AsyncCallKey, error) { return parseKey[AsyncCallPayload](key) }
+
+type AsyncCallPayload struct {
+	Module string
+	Verb   string
+}
+
+var _ KeyPayload = (*AsyncCallPayload)(nil)
+
+func (d *AsyncCallPayload) Kind() string   { return "acl" }
+func (d *AsyncCallPayload) String() string { return d.Module + "-" + d.Verb }
+func (d *AsyncCallPayload) Parse(parts []string) error {
+	if len(parts) != 2 {
+		return errors.New("expected <module>-<verb> but got empty string")
+	}
+	d.Module = parts[0]
+	d.Verb = parts[1]
+	return nil
+}
+func (d *AsyncCallPayload) RandomBytes() int { return 10 }
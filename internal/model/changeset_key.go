@@ -0,0 +1,26 @@
+package model
+
+import (
+	"errors"
+)
+
+// A ChangesetKey identifies a set of deployments that are activated, or rolled back, together.
+type ChangesetKey = KeyType[ChangesetPayload, *ChangesetPayload]
+
+func NewChangesetKey() ChangesetKey { return newKey[ChangesetPayload]() }
+
+func ParseChangesetKey(key string) (ChangesetKey, error) { return parseKey[ChangesetPayload](key) }
+
+type ChangesetPayload struct{}
+
+var _ KeyPayload = (*ChangesetPayload)(nil)
+
+func (c *ChangesetPayload) Kind() string   { return "chs" }
+func (c *ChangesetPayload) String() string { return "" }
+func (c *ChangesetPayload) Parse(parts []string) error {
+	if len(parts) != 0 {
+		return errors.New("expected no payload for changeset key")
+	}
+	return nil
+}
+func (c *ChangesetPayload) RandomBytes() int { return 10 }
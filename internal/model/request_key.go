@@ -24,6 +24,7 @@ const (
 	OriginIngress Origin = "ingress"
 	OriginCron    Origin = "cron"
 	OriginPubsub  Origin = "pubsub"
+	OriginAsync   Origin = "async"
 )
 
 func ParseOrigin(origin string) (Origin, error) {
@@ -34,6 +35,8 @@ func ParseOrigin(origin string) (Origin, error) {
 		return OriginCron, nil
 	case "pubsub":
 		return OriginPubsub, nil
+	case "async":
+		return OriginAsync, nil
 	default:
 		return "", fmt.Errorf("unknown origin %q", origin)
 	}
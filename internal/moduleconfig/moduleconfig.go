@@ -38,6 +38,8 @@ type ModuleConfig struct {
 	LanguageConfig map[string]any `toml:"-"`
 	// SQLMigrationDirectory is the directory to look for SQL migrations.
 	SQLMigrationDirectory string `toml:"sql-migration-directory"`
+	// Test is the command to run the module's tests.
+	Test string `toml:"test"`
 }
 
 func (c *ModuleConfig) UnmarshalTOML(data []byte) error {
@@ -62,6 +64,7 @@ type CustomDefaults struct {
 	Build              optional.Option[string]
 	DevModeBuild       optional.Option[string]
 	GeneratedSchemaDir optional.Option[string]
+	Test               optional.Option[string]
 
 	// only the root keys in LanguageConfig are used to find missing values that can be defaulted
 	LanguageConfig map[string]any `toml:"-"`
@@ -154,6 +157,9 @@ func (c UnvalidatedModuleConfig) FillDefaultsAndValidate(customDefaults CustomDe
 	if defaultValue, ok := customDefaults.DevModeBuild.Get(); ok && c.DevModeBuild == "" {
 		c.DevModeBuild = defaultValue
 	}
+	if defaultValue, ok := customDefaults.Test.Get(); ok && c.Test == "" {
+		c.Test = defaultValue
+	}
 	if c.BuildLock == "" {
 		if defaultValue, ok := customDefaults.BuildLock.Get(); ok {
 			c.BuildLock = defaultValue
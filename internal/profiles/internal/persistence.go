@@ -163,6 +163,15 @@ func (p Project) SaveProfile(profile Profile) error {
 	return nil
 }
 
+// DeleteProfile removes a profile, including its local secrets and configuration, from the project.
+func (p Project) DeleteProfile(name string) error {
+	profileDir := filepath.Join(p.Root, ".ftl-project", "profiles", name)
+	if err := os.RemoveAll(profileDir); err != nil {
+		return fmt.Errorf("remove %s: %w", profileDir, err)
+	}
+	return nil
+}
+
 func (p Project) Save() error {
 	profilePath := filepath.Join(p.Root, ".ftl-project", "project.json")
 	if err := os.MkdirAll(filepath.Dir(profilePath), 0700); err != nil {
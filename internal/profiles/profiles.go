@@ -224,6 +224,34 @@ func (p *Project) New(profileConfig ProfileConfig) error {
 	return nil
 }
 
+// Delete a profile from the project.
+//
+// The default profile cannot be deleted. If the profile is active, the default profile becomes active.
+func (p *Project) Delete(profile string) error {
+	if profile == p.project.DefaultProfile {
+		return fmt.Errorf("%s: cannot delete the default profile", profile)
+	}
+	if _, err := p.project.LoadProfile(profile); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%s: profile does not exist", profile)
+		}
+		return fmt.Errorf("%s: load profile: %w", profile, err)
+	}
+	active, err := p.project.ActiveProfile()
+	if err != nil {
+		return fmt.Errorf("active profile: %w", err)
+	}
+	if active == profile {
+		if err := p.project.SetActiveProfile(p.project.DefaultProfile); err != nil {
+			return fmt.Errorf("set active profile: %w", err)
+		}
+	}
+	if err := p.project.DeleteProfile(profile); err != nil {
+		return fmt.Errorf("%s: delete profile: %w", profile, err)
+	}
+	return nil
+}
+
 // Load a profile from the project.
 func (p *Project) Load(ctx context.Context, profile string) (Profile, error) {
 	prof, err := p.project.LoadProfile(profile)
@@ -239,7 +267,7 @@ func (p *Project) Load(ctx context.Context, profile string) (Profile, error) {
 	var cm *manager.Manager[configuration.Configuration]
 	switch prof.Type {
 	case internal.ProfileTypeLocal:
-		sp, err := p.secretsRegistry.Get(ctx, prof.SecretsProvider)
+		sp, err := p.secretsRegistry.Get(providers.ContextWithProfile(ctx, profile), prof.SecretsProvider)
 		if err != nil {
 			return Profile{}, fmt.Errorf("get secrets provider: %w", err)
 		}
@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
+	"github.com/alecthomas/types/either"
 	"github.com/alecthomas/types/must"
 
 	"github.com/block/ftl"
+	"github.com/block/ftl/common/slices"
 	"github.com/block/ftl/internal/configuration"
 	"github.com/block/ftl/internal/configuration/providers"
 	"github.com/block/ftl/internal/log"
@@ -65,3 +67,32 @@ func TestProfile(t *testing.T) {
 
 	assert.Equal(t, "hello", passwordValue)
 }
+
+func TestDeleteProfile(t *testing.T) {
+	root := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	sr := providers.NewRegistry[configuration.Secrets]()
+	cr := providers.NewRegistry[configuration.Configuration]()
+	_, err := profiles.Init(profiles.ProjectConfig{Root: root, Realm: "test", NoGit: true}, sr, cr)
+	assert.NoError(t, err)
+	project, err := profiles.Open(root, sr, cr)
+	assert.NoError(t, err)
+
+	err = project.New(profiles.ProfileConfig{
+		Name:   "preview-feature",
+		Config: either.RightOf[profiles.LocalProfileConfig](profiles.RemoteProfileConfig{Endpoint: must.Get(url.Parse("http://127.0.0.1:9101"))}),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, project.Switch("preview-feature"))
+
+	assert.NoError(t, project.Delete("preview-feature"))
+	active, err := project.ActiveProfile()
+	assert.NoError(t, err)
+	assert.Equal(t, "local", active)
+	names, err := project.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"local"}, slices.Map(names, func(p profiles.ProfileConfig) string { return p.Name }))
+
+	assert.EqualError(t, project.Delete("local"), "local: cannot delete the default profile")
+	assert.EqualError(t, project.Delete("preview-feature"), "preview-feature: profile does not exist")
+}
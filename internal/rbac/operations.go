@@ -0,0 +1,185 @@
+package rbac
+
+import (
+	deploymentpb "github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1/deploymentpbconnect"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/common/slices"
+	"github.com/block/ftl/internal/model"
+)
+
+// Operation is the permission required by a request, and the modules it applies to.
+//
+// If Modules is empty the operation applies to all modules.
+type Operation struct {
+	Permission Permission
+	Modules    []string
+}
+
+// operationFunc returns the operation performed by a request message.
+type operationFunc func(msg any) Operation
+
+// operations maps each checked procedure to the operation it performs.
+//
+// Procedures that are not listed, such as Ping, are not checked.
+var operations = map[string]operationFunc{
+	// Admin service.
+	ftlv1connect.AdminServiceConfigListProcedure: request(func(req *ftlv1.ConfigListRequest) Operation {
+		return Operation{PermissionRead, optionalModule(req.Module)}
+	}),
+	ftlv1connect.AdminServiceConfigGetProcedure: request(func(req *ftlv1.ConfigGetRequest) Operation {
+		return Operation{PermissionRead, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceConfigSetProcedure: request(func(req *ftlv1.ConfigSetRequest) Operation {
+		return Operation{PermissionWriteConfig, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceConfigUnsetProcedure: request(func(req *ftlv1.ConfigUnsetRequest) Operation {
+		return Operation{PermissionWriteConfig, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceConfigHistoryProcedure: request(func(req *ftlv1.ConfigHistoryRequest) Operation {
+		return Operation{PermissionRead, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceConfigRestoreProcedure: request(func(req *ftlv1.ConfigRestoreRequest) Operation {
+		return Operation{PermissionWriteConfig, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceConfigCheckProcedure: request(func(req *ftlv1.ConfigCheckRequest) Operation {
+		return Operation{PermissionRead, optionalModule(req.Module)}
+	}),
+	ftlv1connect.AdminServiceSecretsListProcedure: request(func(req *ftlv1.SecretsListRequest) Operation {
+		if req.GetIncludeValues() {
+			return Operation{PermissionReadSecrets, optionalModule(req.Module)}
+		}
+		return Operation{PermissionRead, optionalModule(req.Module)}
+	}),
+	ftlv1connect.AdminServiceSecretGetProcedure: request(func(req *ftlv1.SecretGetRequest) Operation {
+		return Operation{PermissionReadSecrets, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceSecretSetProcedure: request(func(req *ftlv1.SecretSetRequest) Operation {
+		return Operation{PermissionWriteSecrets, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceSecretUnsetProcedure: request(func(req *ftlv1.SecretUnsetRequest) Operation {
+		return Operation{PermissionWriteSecrets, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceSecretHistoryProcedure: request(func(req *ftlv1.SecretHistoryRequest) Operation {
+		return Operation{PermissionReadSecrets, refModule(req.Ref)}
+	}),
+	ftlv1connect.AdminServiceMapConfigsForModuleProcedure: request(func(req *ftlv1.MapConfigsForModuleRequest) Operation {
+		return Operation{PermissionRead, []string{req.Module}}
+	}),
+	ftlv1connect.AdminServiceMapSecretsForModuleProcedure: request(func(req *ftlv1.MapSecretsForModuleRequest) Operation {
+		return Operation{PermissionReadSecrets, []string{req.Module}}
+	}),
+	ftlv1connect.AdminServiceGetCircuitBreakersProcedure: unscoped(PermissionRead),
+
+	// Controller service.
+	ftlv1connect.ControllerServiceProcessListProcedure:      unscoped(PermissionRead),
+	ftlv1connect.ControllerServiceStatusProcedure:           unscoped(PermissionRead),
+	ftlv1connect.ControllerServiceGetArtefactDiffsProcedure: unscoped(PermissionDeploy),
+	ftlv1connect.ControllerServiceUploadArtefactProcedure:   unscoped(PermissionDeploy),
+	ftlv1connect.ControllerServiceCreateDeploymentProcedure: request(func(req *ftlv1.CreateDeploymentRequest) Operation {
+		return Operation{PermissionDeploy, []string{req.GetSchema().GetName()}}
+	}),
+	ftlv1connect.ControllerServiceGetDeploymentProcedure: request(func(req *ftlv1.GetDeploymentRequest) Operation {
+		return Operation{PermissionRead, deploymentModule(req.DeploymentKey)}
+	}),
+	ftlv1connect.ControllerServiceGetDeploymentArtefactsProcedure: request(func(req *ftlv1.GetDeploymentArtefactsRequest) Operation {
+		return Operation{PermissionRead, deploymentModule(req.DeploymentKey)}
+	}),
+	ftlv1connect.ControllerServiceRegisterRunnerProcedure: unscoped(PermissionOperate),
+	ftlv1connect.ControllerServiceUpdateDeployProcedure: request(func(req *ftlv1.UpdateDeployRequest) Operation {
+		return Operation{PermissionOperate, deploymentModule(req.DeploymentKey)}
+	}),
+	ftlv1connect.ControllerServiceReplaceDeployProcedure: request(func(req *ftlv1.ReplaceDeployRequest) Operation {
+		return Operation{PermissionDeploy, deploymentModule(req.DeploymentKey)}
+	}),
+	ftlv1connect.ControllerServiceCreateChangesetProcedure: request(func(req *ftlv1.CreateChangesetRequest) Operation {
+		return Operation{PermissionDeploy, slices.Map(req.Modules, func(m *schemapb.Module) string { return m.GetName() })}
+	}),
+	ftlv1connect.ControllerServiceCommitChangesetProcedure:   unscoped(PermissionDeploy),
+	ftlv1connect.ControllerServiceRollbackChangesetProcedure: unscoped(PermissionDeploy),
+	ftlv1connect.ControllerServiceDeployChangesetProcedure: request(func(req *ftlv1.DeployChangesetRequest) Operation {
+		return Operation{PermissionDeploy, slices.Map(req.Deployments, func(d *ftlv1.CreateDeploymentRequest) string { return d.GetSchema().GetName() })}
+	}),
+	ftlv1connect.ControllerServiceStreamDeploymentLogsProcedure: unscoped(PermissionOperate),
+
+	// Schema service.
+	ftlv1connect.SchemaServiceGetSchemaProcedure:  unscoped(PermissionRead),
+	ftlv1connect.SchemaServicePullSchemaProcedure: unscoped(PermissionRead),
+	ftlv1connect.SchemaServiceUpdateDeploymentRuntimeProcedure: request(func(req *ftlv1.UpdateDeploymentRuntimeRequest) Operation {
+		return Operation{PermissionOperate, deploymentModule(req.Deployment)}
+	}),
+
+	// Verb service.
+	ftlv1connect.VerbServiceCallProcedure: request(func(req *ftlv1.CallRequest) Operation {
+		return Operation{PermissionCall, []string{req.GetVerb().GetModule()}}
+	}),
+
+	// Deployment service.
+	deploymentpbconnect.DeploymentServiceGetDeploymentContextProcedure: request(func(req *deploymentpb.GetDeploymentContextRequest) Operation {
+		return Operation{PermissionReadSecrets, deploymentModule(req.Deployment)}
+	}),
+}
+
+// OperationForProcedure returns the operation performed by a request to a procedure.
+//
+// msg is the request message, or nil if it is not known. It returns false if the
+// procedure is not checked.
+func OperationForProcedure(procedure string, msg any) (Operation, bool) {
+	op, ok := operations[procedure]
+	if !ok {
+		return Operation{}, false
+	}
+	return op(msg), true
+}
+
+// Checked returns true if requests to the procedure are checked.
+func Checked(procedure string) bool {
+	_, ok := operations[procedure]
+	return ok
+}
+
+// request returns an operationFunc for a request message type.
+//
+// If the message is not of the expected type, the operation applies to all modules.
+func request[Req any](f func(req *Req) Operation) operationFunc {
+	return func(msg any) Operation {
+		req, ok := msg.(*Req)
+		if !ok || req == nil {
+			return Operation{Permission: f(new(Req)).Permission}
+		}
+		op := f(req)
+		op.Modules = slices.Filter(op.Modules, func(module string) bool { return module != "" })
+		return op
+	}
+}
+
+func unscoped(permission Permission) operationFunc {
+	return func(any) Operation { return Operation{Permission: permission} }
+}
+
+func optionalModule(module *string) []string {
+	if module == nil {
+		return nil
+	}
+	return []string{*module}
+}
+
+// refModule returns the module of a config or secret. Global values apply to all modules.
+func refModule(ref *ftlv1.ConfigRef) []string {
+	if ref == nil {
+		return nil
+	}
+	return optionalModule(ref.Module)
+}
+
+// deploymentModule returns the module of a deployment key. If the key is invalid the
+// operation applies to all modules, and the request fails validation later.
+func deploymentModule(key string) []string {
+	dkey, err := model.ParseDeploymentKey(key)
+	if err != nil {
+		return nil
+	}
+	return []string{dkey.Payload.Module}
+}
@@ -0,0 +1,153 @@
+// Package rbac implements role-based access control for FTL's admin and controller APIs.
+//
+// A [Policy] binds subjects, identified by the credentials of each request, to roles.
+// Each role grants a set of permissions, optionally scoped to a set of modules.
+package rbac
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Permission is an operation that can be granted by a role.
+type Permission string
+
+const (
+	// PermissionRead allows viewing deployments, schemas and configuration.
+	PermissionRead Permission = "read"
+	// PermissionDeploy allows creating and replacing deployments.
+	PermissionDeploy Permission = "deploy"
+	// PermissionWriteConfig allows setting and unsetting configuration values.
+	PermissionWriteConfig Permission = "write-config"
+	// PermissionOperate allows scaling and killing deployments.
+	PermissionOperate Permission = "operate"
+	// PermissionReadSecrets allows reading secret values and their history.
+	PermissionReadSecrets Permission = "read-secrets"
+	// PermissionWriteSecrets allows setting and unsetting secrets.
+	PermissionWriteSecrets Permission = "write-secrets"
+	// PermissionCall allows calling verbs through the cluster.
+	PermissionCall Permission = "call"
+)
+
+// Role is a named set of permissions.
+type Role string
+
+const (
+	RoleViewer      Role = "viewer"
+	RoleDeveloper   Role = "developer"
+	RoleOperator    Role = "operator"
+	RoleSecretAdmin Role = "secret-admin"
+	RoleCaller      Role = "caller"
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleViewer:      {PermissionRead},
+	RoleDeveloper:   {PermissionRead, PermissionDeploy, PermissionWriteConfig, PermissionCall},
+	RoleOperator:    {PermissionRead, PermissionDeploy, PermissionWriteConfig, PermissionCall, PermissionOperate},
+	RoleSecretAdmin: {PermissionRead, PermissionReadSecrets, PermissionWriteSecrets},
+	RoleCaller:      {PermissionCall},
+}
+
+// Grants returns true if the role grants the permission.
+func (r Role) Grants(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+// AnySubject matches every authenticated subject.
+const AnySubject = "*"
+
+const moduleSubjectPrefix = "module:"
+
+// ModuleSubject is the subject of a module's deployments, when they are identified by a
+// caller token or runner credential.
+func ModuleSubject(module string) string { return moduleSubjectPrefix + module }
+
+// ownModulePermissions are granted to every [ModuleSubject] for its own module, so that
+// a deployment can always read its own schema, configuration and secrets without being
+// granted them for other modules.
+var ownModulePermissions = []Permission{PermissionRead, PermissionReadSecrets}
+
+// Binding grants a role to subjects.
+type Binding struct {
+	Subjects []string `toml:"subjects"`
+	Role     Role     `toml:"role"`
+	// Modules the role is granted for. If empty the role is granted for all modules,
+	// including requests that are not specific to a module.
+	Modules []string `toml:"modules"`
+}
+
+func (b Binding) matches(subject string) bool {
+	return slices.Contains(b.Subjects, subject) || slices.Contains(b.Subjects, AnySubject)
+}
+
+// Policy is a role-based access control policy.
+type Policy struct {
+	Bindings []Binding `toml:"bindings"`
+}
+
+// Load a policy from a TOML file.
+func Load(path string) (*Policy, error) {
+	policy := &Policy{}
+	md, err := toml.DecodeFile(path, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policy %s: %w", path, err)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return nil, fmt.Errorf("%s: unknown policy keys %v", path, undecoded)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Validate the policy.
+func (p *Policy) Validate() error {
+	for i, binding := range p.Bindings {
+		if _, ok := rolePermissions[binding.Role]; !ok {
+			return fmt.Errorf("binding %d: unknown role %q", i, binding.Role)
+		}
+		if len(binding.Subjects) == 0 {
+			return fmt.Errorf("binding %d: no subjects", i)
+		}
+	}
+	return nil
+}
+
+// Authorize returns an error if the subject is not granted the permission for every module.
+//
+// If modules is empty, the permission must be granted for all modules.
+func (p *Policy) Authorize(subject string, permission Permission, modules []string) error {
+	if len(modules) == 0 {
+		for _, binding := range p.Bindings {
+			if len(binding.Modules) == 0 && binding.matches(subject) && binding.Role.Grants(permission) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s is not granted %s for all modules", subject, permission)
+	}
+	for _, module := range modules {
+		if !p.granted(subject, permission, module) {
+			return fmt.Errorf("%s is not granted %s for module %s", subject, permission, module)
+		}
+	}
+	return nil
+}
+
+func (p *Policy) granted(subject string, permission Permission, module string) bool {
+	if own, ok := strings.CutPrefix(subject, moduleSubjectPrefix); ok && own == module && slices.Contains(ownModulePermissions, permission) {
+		return true
+	}
+	for _, binding := range p.Bindings {
+		if !binding.matches(subject) || !binding.Role.Grants(permission) {
+			continue
+		}
+		if len(binding.Modules) == 0 || slices.Contains(binding.Modules, module) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package rbac
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	deploymentpb "github.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1"
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	schemapb "github.com/block/ftl/common/protos/xyz/block/ftl/schema/v1"
+	"github.com/block/ftl/internal/model"
+)
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.toml")
+	err := os.WriteFile(path, []byte(`
+[[bindings]]
+subjects = ["alice"]
+role = "developer"
+modules = ["echo"]
+`), 0600)
+	assert.NoError(t, err)
+	policy, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &Policy{
+		Bindings: []Binding{{Subjects: []string{"alice"}, Role: RoleDeveloper, Modules: []string{"echo"}}},
+	}, policy)
+
+	err = os.WriteFile(path, []byte(`
+[[bindings]]
+subjects = ["alice"]
+role = "admin"
+`), 0600)
+	assert.NoError(t, err)
+	_, err = Load(path)
+	assert.EqualError(t, err, path+`: binding 0: unknown role "admin"`)
+
+	// Subjects are only identified by verified credentials, so an identity header is rejected.
+	err = os.WriteFile(path, []byte(`identity-header = "X-Forwarded-User"`), 0600)
+	assert.NoError(t, err)
+	_, err = Load(path)
+	assert.EqualError(t, err, path+": unknown policy keys [identity-header]")
+}
+
+func TestAuthorize(t *testing.T) {
+	policy := &Policy{Bindings: []Binding{
+		{Subjects: []string{AnySubject}, Role: RoleViewer},
+		{Subjects: []string{"alice"}, Role: RoleDeveloper, Modules: []string{"echo", "time"}},
+		{Subjects: []string{"bob"}, Role: RoleOperator},
+		{Subjects: []string{"carol"}, Role: RoleSecretAdmin, Modules: []string{"echo"}},
+		{Subjects: []string{"module:time"}, Role: RoleCaller, Modules: []string{"echo"}},
+	}}
+	assert.NoError(t, policy.Validate())
+
+	tests := []struct {
+		subject    string
+		permission Permission
+		modules    []string
+		err        string
+	}{
+		{subject: "alice", permission: PermissionRead},
+		{subject: "alice", permission: PermissionDeploy, modules: []string{"echo", "time"}},
+		{subject: "alice", permission: PermissionDeploy, modules: []string{"echo", "other"}, err: "alice is not granted deploy for module other"},
+		{subject: "alice", permission: PermissionDeploy, err: "alice is not granted deploy for all modules"},
+		{subject: "alice", permission: PermissionOperate, modules: []string{"echo"}, err: "alice is not granted operate for module echo"},
+		{subject: "bob", permission: PermissionOperate, modules: []string{"echo"}},
+		{subject: "bob", permission: PermissionReadSecrets, modules: []string{"echo"}, err: "bob is not granted read-secrets for module echo"},
+		{subject: "carol", permission: PermissionReadSecrets, modules: []string{"echo"}},
+		{subject: "carol", permission: PermissionReadSecrets, err: "carol is not granted read-secrets for all modules"},
+		{subject: "dave", permission: PermissionRead, modules: []string{"echo"}},
+		{subject: "module:time", permission: PermissionCall, modules: []string{"echo"}},
+		{subject: "module:time", permission: PermissionCall, modules: []string{"other"}, err: "module:time is not granted call for module other"},
+		// Modules are always granted read-secrets for themselves, but nothing else without a binding.
+		{subject: "module:echo", permission: PermissionReadSecrets, modules: []string{"echo"}},
+		{subject: "module:echo", permission: PermissionReadSecrets, modules: []string{"echo", "time"}, err: "module:echo is not granted read-secrets for module time"},
+		{subject: "module:echo", permission: PermissionReadSecrets, err: "module:echo is not granted read-secrets for all modules"},
+		{subject: "module:echo", permission: PermissionWriteSecrets, modules: []string{"echo"}, err: "module:echo is not granted write-secrets for module echo"},
+	}
+	for _, test := range tests {
+		err := policy.Authorize(test.subject, test.permission, test.modules)
+		if test.err == "" {
+			assert.NoError(t, err, "%s %s %v", test.subject, test.permission, test.modules)
+		} else {
+			assert.EqualError(t, err, test.err)
+		}
+	}
+}
+
+func TestOperationForProcedure(t *testing.T) {
+	module := "echo"
+	deployment := model.NewDeploymentKey("echo").String()
+	tests := []struct {
+		procedure string
+		msg       any
+		expected  Operation
+	}{
+		{ftlv1connect.AdminServiceSecretGetProcedure, &ftlv1.SecretGetRequest{Ref: &ftlv1.ConfigRef{Module: &module, Name: "key"}},
+			Operation{PermissionReadSecrets, []string{"echo"}}},
+		{ftlv1connect.AdminServiceConfigSetProcedure, &ftlv1.ConfigSetRequest{Ref: &ftlv1.ConfigRef{Name: "global"}},
+			Operation{PermissionWriteConfig, nil}},
+		{ftlv1connect.AdminServiceSecretsListProcedure, &ftlv1.SecretsListRequest{},
+			Operation{PermissionRead, nil}},
+		{ftlv1connect.AdminServiceSecretsListProcedure, &ftlv1.SecretsListRequest{IncludeValues: &[]bool{true}[0], Module: &module},
+			Operation{PermissionReadSecrets, []string{"echo"}}},
+		{ftlv1connect.ControllerServiceUpdateDeployProcedure, &ftlv1.UpdateDeployRequest{DeploymentKey: deployment},
+			Operation{PermissionOperate, []string{"echo"}}},
+		{ftlv1connect.ControllerServiceCreateChangesetProcedure, &ftlv1.CreateChangesetRequest{Modules: []*schemapb.Module{{Name: "echo"}, {Name: "time"}}},
+			Operation{PermissionDeploy, []string{"echo", "time"}}},
+		{ftlv1connect.VerbServiceCallProcedure, &ftlv1.CallRequest{Verb: &schemapb.Ref{Module: "echo", Name: "echo"}},
+			Operation{PermissionCall, []string{"echo"}}},
+		// An unexpected message applies to all modules.
+		{ftlv1connect.ControllerServiceUpdateDeployProcedure, nil,
+			Operation{Permission: PermissionOperate}},
+	}
+	for _, test := range tests {
+		op, ok := OperationForProcedure(test.procedure, test.msg)
+		assert.True(t, ok, test.procedure)
+		assert.Equal(t, test.expected, op, test.procedure)
+	}
+	_, ok := OperationForProcedure(ftlv1connect.AdminServicePingProcedure, &ftlv1.PingRequest{})
+	assert.False(t, ok)
+}
+
+func TestAllProceduresChecked(t *testing.T) {
+	files := []protoreflect.FileDescriptor{
+		ftlv1.File_xyz_block_ftl_v1_admin_proto,
+		ftlv1.File_xyz_block_ftl_v1_controller_proto,
+		ftlv1.File_xyz_block_ftl_v1_schemaservice_proto,
+		ftlv1.File_xyz_block_ftl_v1_verb_proto,
+		deploymentpb.File_xyz_block_ftl_deployment_v1_deployment_proto,
+	}
+	for _, file := range files {
+		for i := range file.Services().Len() {
+			service := file.Services().Get(i)
+			for j := range service.Methods().Len() {
+				method := service.Methods().Get(j)
+				if method.Name() == "Ping" {
+					continue
+				}
+				procedure := "/" + string(service.FullName()) + "/" + string(method.Name())
+				assert.True(t, Checked(procedure), "%s is not checked", procedure)
+			}
+		}
+	}
+}
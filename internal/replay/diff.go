@@ -0,0 +1,260 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Difference is a value that differs between a recorded and a replayed response.
+type Difference struct {
+	// Path to the value, eg. "$.items[0].id".
+	Path string `json:"path"`
+	// Expected is the recorded value, or empty if the value was added.
+	Expected json.RawMessage `json:"expected,omitempty"`
+	// Actual is the replayed value, or empty if the value was removed.
+	Actual json.RawMessage `json:"actual,omitempty"`
+}
+
+func (d Difference) String() string {
+	switch {
+	case d.Expected == nil:
+		return fmt.Sprintf("%s: unexpected %s", d.Path, d.Actual)
+	case d.Actual == nil:
+		return fmt.Sprintf("%s: missing, expected %s", d.Path, d.Expected)
+	default:
+		return fmt.Sprintf("%s: expected %s but got %s", d.Path, d.Expected, d.Actual)
+	}
+}
+
+// IgnorePath is a path to values that should not be compared, such as timestamps or generated IDs.
+//
+// Paths are of the form "$.field[0].field", where "*" matches any field and "[*]" any element of an array. Ignoring
+// a value also ignores everything within it. A path may be prefixed with a verb, as in "echo.echo:$.time", to only
+// apply to responses from that verb.
+type IgnorePath struct {
+	Verb     string
+	segments []string
+	raw      string
+}
+
+func (p IgnorePath) String() string { return p.raw }
+
+// ParseIgnorePath parses an IgnorePath.
+func ParseIgnorePath(path string) (IgnorePath, error) {
+	out := IgnorePath{raw: path}
+	if verb, rest, ok := strings.Cut(path, ":"); ok {
+		out.Verb = verb
+		path = rest
+	}
+	if !strings.HasPrefix(path, "$") {
+		return IgnorePath{}, fmt.Errorf("invalid ignore path %q: must start with \"$\"", out.raw)
+	}
+	segments, err := splitPath(path[1:])
+	if err != nil {
+		return IgnorePath{}, fmt.Errorf("invalid ignore path %q: %w", out.raw, err)
+	}
+	out.segments = segments
+	return out, nil
+}
+
+// UnmarshalText allows IgnorePath to be used as a flag.
+func (p *IgnorePath) UnmarshalText(text []byte) error {
+	path, err := ParseIgnorePath(string(text))
+	if err != nil {
+		return err
+	}
+	*p = path
+	return nil
+}
+
+func (p IgnorePath) matches(verb string, path []string) bool {
+	if p.Verb != "" && p.Verb != verb {
+		return false
+	}
+	if len(path) < len(p.segments) {
+		return false
+	}
+	for i, segment := range p.segments {
+		switch {
+		case segment == path[i]:
+		case segment == "*" && !strings.HasPrefix(path[i], "["):
+		case segment == "[*]" && strings.HasPrefix(path[i], "["):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath splits a path such as ".a[0].b" into segments such as ["a", "[0]", "b"].
+func splitPath(path string) ([]string, error) {
+	segments := []string{}
+	for path != "" {
+		switch path[0] {
+		case '.':
+			end := strings.IndexAny(path[1:], ".[")
+			if end == -1 {
+				end = len(path) - 1
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty field name")
+			}
+			segments = append(segments, path[1:end+1])
+			path = path[end+1:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated \"[\"")
+			}
+			index := path[1:end]
+			if _, err := strconv.Atoi(index); err != nil && index != "*" {
+				return nil, fmt.Errorf("invalid index %q", index)
+			}
+			segments = append(segments, path[:end+1])
+			path = path[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q", path[0])
+		}
+	}
+	return segments, nil
+}
+
+// Diff compares a recorded JSON response against a replayed one, returning the differences that are not ignored.
+//
+// Object keys are compared regardless of order, and numbers are compared by value.
+func Diff(verb string, expected, actual []byte, ignore []IgnorePath) ([]Difference, error) {
+	expectedValue, err := decode(expected)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recorded response: %w", err)
+	}
+	actualValue, err := decode(actual)
+	if err != nil {
+		return nil, fmt.Errorf("invalid replayed response: %w", err)
+	}
+	d := &differ{verb: verb, ignore: ignore}
+	d.diff(nil, expectedValue, actualValue)
+	return d.differences, nil
+}
+
+type differ struct {
+	verb        string
+	ignore      []IgnorePath
+	differences []Difference
+}
+
+func (d *differ) diff(path []string, expected, actual any) {
+	for _, ignore := range d.ignore {
+		if ignore.matches(d.verb, path) {
+			return
+		}
+	}
+	switch expected := expected.(type) {
+	case map[string]any:
+		if actual, ok := actual.(map[string]any); ok {
+			keys := map[string]bool{}
+			for key := range expected {
+				keys[key] = true
+			}
+			for key := range actual {
+				keys[key] = true
+			}
+			for _, key := range sortedKeys(keys) {
+				d.diff(append(path[:len(path):len(path)], key), lookup(expected, key), lookup(actual, key))
+			}
+			return
+		}
+	case []any:
+		if actual, ok := actual.([]any); ok {
+			for i := range max(len(expected), len(actual)) {
+				var e, a any = missing{}, missing{}
+				if i < len(expected) {
+					e = expected[i]
+				}
+				if i < len(actual) {
+					a = actual[i]
+				}
+				d.diff(append(path[:len(path):len(path)], "["+strconv.Itoa(i)+"]"), e, a)
+			}
+			return
+		}
+	case json.Number:
+		if actual, ok := actual.(json.Number); ok && numbersEqual(expected, actual) {
+			return
+		}
+	default:
+		if reflect.DeepEqual(expected, actual) {
+			return
+		}
+	}
+	d.differences = append(d.differences, Difference{
+		Path:     formatPath(path),
+		Expected: encode(expected),
+		Actual:   encode(actual),
+	})
+}
+
+// missing marks a value that is absent, as opposed to null.
+type missing struct{}
+
+func decode(data []byte) (any, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return missing{}, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+	return value, nil
+}
+
+func lookup(object map[string]any, key string) any {
+	if value, ok := object[key]; ok {
+		return value
+	}
+	return missing{}
+}
+
+func encode(value any) json.RawMessage {
+	if _, ok := value.(missing); ok {
+		return nil
+	}
+	data, _ := json.Marshal(value) //nolint:errcheck
+	return data
+}
+
+func numbersEqual(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+	af, aerr := a.Float64()
+	bf, berr := b.Float64()
+	return aerr == nil && berr == nil && af == bf
+}
+
+func sortedKeys(keys map[string]bool) []string {
+	out := make([]string, 0, len(keys))
+	for key := range keys {
+		out = append(out, key)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func formatPath(path []string) string {
+	out := &strings.Builder{}
+	out.WriteString("$")
+	for _, segment := range path {
+		if !strings.HasPrefix(segment, "[") {
+			out.WriteString(".")
+		}
+		out.WriteString(segment)
+	}
+	return out.String()
+}
@@ -0,0 +1,80 @@
+// Package replay records verb calls from the timeline into fixtures, and compares the responses of replayed calls
+// against those recorded.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	timelinepb "github.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1"
+	"github.com/block/ftl/common/reflection"
+)
+
+// Call is a single recorded call to a verb.
+type Call struct {
+	Verb     string          `json:"verb"`
+	Time     time.Time       `json:"time"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	// Error is the error returned by the verb, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// CallFromEvent converts a call recorded in the timeline into a Call.
+func CallFromEvent(event *timelinepb.CallEvent) Call {
+	call := Call{
+		Verb:    reflection.RefFromProto(event.DestinationVerbRef).String(),
+		Time:    event.Timestamp.AsTime(),
+		Request: rawJSON(event.Request),
+	}
+	if event.Error != nil {
+		call.Error = *event.Error
+	} else {
+		call.Response = rawJSON(event.Response)
+	}
+	return call
+}
+
+// Fixture is a set of recorded calls to replay, in the order they were made.
+type Fixture struct {
+	Calls []Call `json:"calls"`
+}
+
+// Load a fixture from a file.
+func Load(path string) (Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Fixture{}, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	fixture := Fixture{}
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return Fixture{}, fmt.Errorf("%s: failed to decode fixture: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// Save the fixture to a file.
+func (f Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
+
+// rawJSON returns s as raw JSON, or as a JSON string if it is not valid JSON, so that fixtures are always valid.
+func rawJSON(s string) json.RawMessage {
+	if s == "" {
+		return nil
+	}
+	if json.Valid([]byte(s)) {
+		return json.RawMessage(s)
+	}
+	data, _ := json.Marshal(s) //nolint:errcheck
+	return data
+}
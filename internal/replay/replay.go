@@ -0,0 +1,101 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/block/ftl/common/reflection"
+)
+
+// Caller calls a verb, returning either its response or the error it returned.
+//
+// err is only returned if the call could not be made at all.
+type Caller func(ctx context.Context, verb reflection.Ref, request []byte) (response []byte, verbErr string, err error)
+
+// Result is the outcome of replaying a single call.
+type Result struct {
+	Verb    string          `json:"verb"`
+	Time    time.Time       `json:"time"`
+	Request json.RawMessage `json:"request"`
+	// Differences between the recorded and replayed responses.
+	Differences []Difference `json:"differences,omitempty"`
+	// Error is set if the call could not be replayed, or failed differently to the recorded call.
+	Error string `json:"error,omitempty"`
+}
+
+// Passed returns true if the replayed call matched the recorded call.
+func (r Result) Passed() bool { return r.Error == "" && len(r.Differences) == 0 }
+
+// Report summarises the replay of a fixture.
+type Report struct {
+	Total   int      `json:"total"`
+	Passed  int      `json:"passed"`
+	Failed  int      `json:"failed"`
+	Results []Result `json:"results"`
+}
+
+// Save the report as JSON.
+func (r Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// Run replays each call in the fixture in order, comparing the responses against those recorded.
+//
+// A call that was recorded as failing passes if it fails again, regardless of the error message, as messages often
+// include details specific to a deployment.
+func Run(ctx context.Context, fixture Fixture, call Caller, ignore []IgnorePath) (Report, error) {
+	report := Report{Results: make([]Result, 0, len(fixture.Calls))}
+	for _, recorded := range fixture.Calls {
+		if err := ctx.Err(); err != nil {
+			return report, err //nolint:wrapcheck
+		}
+		result := Result{Verb: recorded.Verb, Time: recorded.Time, Request: recorded.Request}
+		verb, err := reflection.ParseRef(recorded.Verb)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			replayCall(ctx, &result, recorded, verb, call, ignore)
+		}
+		report.Total++
+		if result.Passed() {
+			report.Passed++
+		} else {
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
+
+func replayCall(ctx context.Context, result *Result, recorded Call, verb reflection.Ref, call Caller, ignore []IgnorePath) {
+	request := []byte(recorded.Request)
+	if len(request) == 0 {
+		request = []byte("{}")
+	}
+	response, verbErr, err := call(ctx, verb, request)
+	switch {
+	case err != nil:
+		result.Error = fmt.Sprintf("call failed: %s", err)
+	case recorded.Error != "" && verbErr == "":
+		result.Error = fmt.Sprintf("expected error %q but call succeeded", recorded.Error)
+	case recorded.Error == "" && verbErr != "":
+		result.Error = fmt.Sprintf("unexpected error: %s", verbErr)
+	case recorded.Error != "":
+	default:
+		differences, err := Diff(recorded.Verb, recorded.Response, response, ignore)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Differences = differences
+	}
+}
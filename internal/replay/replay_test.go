@@ -0,0 +1,108 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/reflection"
+)
+
+func ignorePaths(t *testing.T, paths ...string) []IgnorePath {
+	t.Helper()
+	out := []IgnorePath{}
+	for _, path := range paths {
+		p, err := ParseIgnorePath(path)
+		assert.NoError(t, err)
+		out = append(out, p)
+	}
+	return out
+}
+
+func TestDiff(t *testing.T) {
+	expected := `{"id":"a1","total":10,"items":[{"id":1,"name":"x"},{"id":2,"name":"y"}],"meta":{"at":"then"}}`
+	actual := `{"meta":{"at":"now"},"total":10.0,"items":[{"id":3,"name":"x"},{"id":4,"name":"z"},{"id":5}],"id":"a1","extra":null}`
+
+	differences, err := Diff("shop.order", []byte(expected), []byte(actual), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`$.extra: unexpected null`,
+		`$.items[0].id: expected 1 but got 3`,
+		`$.items[1].id: expected 2 but got 4`,
+		`$.items[1].name: expected "y" but got "z"`,
+		`$.items[2]: unexpected {"id":5}`,
+		`$.meta.at: expected "then" but got "now"`,
+	}, differenceStrings(differences))
+
+	differences, err = Diff("shop.order", []byte(expected), []byte(actual), ignorePaths(t,
+		"$.meta", "$.items[*].id", "shop.order:$.extra", "other.verb:$.items",
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`$.items[1].name: expected "y" but got "z"`,
+		`$.items[2]: unexpected {"id":5}`,
+	}, differenceStrings(differences))
+
+	differences, err = Diff("shop.order", []byte(`{"a":{"b":1,"c":2}}`), []byte(`{"a":{"b":2,"c":3}}`), ignorePaths(t, "$.*.b"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`$.a.c: expected 2 but got 3`}, differenceStrings(differences))
+
+	_, err = Diff("shop.order", []byte(`{`), []byte(`{}`), nil)
+	assert.Error(t, err)
+}
+
+func TestParseIgnorePath(t *testing.T) {
+	for _, path := range []string{"items[0].id", "$.items[x]", "$.items[0", "$..id"} {
+		_, err := ParseIgnorePath(path)
+		assert.Error(t, err, path)
+	}
+	p, err := ParseIgnorePath("echo.echo:$.items[*].id")
+	assert.NoError(t, err)
+	assert.Equal(t, "echo.echo", p.Verb)
+	assert.Equal(t, []string{"items", "[*]", "id"}, p.segments)
+}
+
+func TestRun(t *testing.T) {
+	fixture := Fixture{Calls: []Call{
+		{Verb: "echo.echo", Request: json.RawMessage(`{"name":"a"}`), Response: json.RawMessage(`{"message":"Hello, a!"}`)},
+		{Verb: "echo.echo", Request: json.RawMessage(`{"name":"b"}`), Response: json.RawMessage(`{"message":"Hello, b!"}`)},
+		{Verb: "echo.fail", Request: json.RawMessage(`{}`), Error: "boom"},
+		{Verb: "echo.fail", Request: json.RawMessage(`{"ok":true}`), Error: "boom"},
+		{Verb: "echo.missing", Request: json.RawMessage(`{}`), Response: json.RawMessage(`{}`)},
+	}}
+	call := func(ctx context.Context, verb reflection.Ref, request []byte) ([]byte, string, error) {
+		switch verb.Name {
+		case "echo":
+			if string(request) == `{"name":"b"}` {
+				return []byte(`{"message":"Hi, b!"}`), "", nil
+			}
+			return []byte(`{"message":"Hello, a!"}`), "", nil
+		case "fail":
+			if string(request) == `{"ok":true}` {
+				return []byte(`{}`), "", nil
+			}
+			return nil, "a different boom", nil
+		default:
+			return nil, "", errors.New("connection refused")
+		}
+	}
+	report, err := Run(context.Background(), fixture, call, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, report.Total)
+	assert.Equal(t, 2, report.Passed)
+	assert.Equal(t, 3, report.Failed)
+	assert.Equal(t, []string{`$.message: expected "Hello, b!" but got "Hi, b!"`}, differenceStrings(report.Results[1].Differences))
+	assert.Equal(t, `expected error "boom" but call succeeded`, report.Results[3].Error)
+	assert.Equal(t, "call failed: connection refused", report.Results[4].Error)
+}
+
+func differenceStrings(differences []Difference) []string {
+	out := []string{}
+	for _, d := range differences {
+		out = append(out, d.String())
+	}
+	return out
+}
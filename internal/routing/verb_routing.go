@@ -16,6 +16,7 @@ import (
 	"github.com/block/ftl/backend/timeline"
 	"github.com/block/ftl/common/schema"
 	"github.com/block/ftl/internal/channels"
+	"github.com/block/ftl/internal/circuitbreaker"
 	"github.com/block/ftl/internal/log"
 	"github.com/block/ftl/internal/model"
 	"github.com/block/ftl/internal/rpc"
@@ -34,6 +35,7 @@ type VerbCallRouter struct {
 	routingTable   *RouteTable
 	moduleClients  *xsync.MapOf[string, optional.Option[ftlv1connect.VerbServiceClient]]
 	timelineClient *timeline.Client
+	breakers       *circuitbreaker.Breakers
 }
 
 func (s *VerbCallRouter) Call(ctx context.Context, req *connect.Request[ftlv1.CallRequest]) (*connect.Response[ftlv1.CallResponse], error) {
@@ -69,11 +71,21 @@ func (s *VerbCallRouter) Call(ctx context.Context, req *connect.Request[ftlv1.Ca
 		Request:       req.Msg,
 	}
 
-	originalResp, err := client.Call(ctx, req)
+	verb := &schema.Verb{}
+	if err := s.routingTable.Current().Schema().ResolveToType(callEvent.DestVerb, verb); err != nil {
+		// Calls to verbs missing from the schema are left to the runner to reject.
+		verb = &schema.Verb{Name: callEvent.DestVerb.Name}
+	}
+	var originalResp *connect.Response[ftlv1.CallResponse]
+	breakerKey := circuitbreaker.Key{Deployment: deployment, Verb: callEvent.DestVerb.ToRefKey()}
+	err = s.breakers.Call(breakerKey, verb, func() (err error) {
+		originalResp, err = client.Call(ctx, req)
+		return err //nolint:wrapcheck
+	})
 	if err != nil {
 		callEvent.Response = result.Err[*ftlv1.CallResponse](err)
 		s.timelineClient.Publish(ctx, callEvent)
-		observability.Calls.Request(ctx, req.Msg.Verb, start, optional.Some("verb call failed"))
+		observability.Calls.RequestFailed(ctx, req.Msg.Verb, start, err)
 		return nil, fmt.Errorf("failed to call %s: %w", callEvent.DestVerb, err)
 	}
 	resp := connect.NewResponse(originalResp.Msg)
@@ -83,11 +95,13 @@ func (s *VerbCallRouter) Call(ctx context.Context, req *connect.Request[ftlv1.Ca
 	return resp, nil
 }
 
-func NewVerbRouterFromTable(ctx context.Context, routeTable *RouteTable, timelineClient *timeline.Client) *VerbCallRouter {
+// NewVerbRouterFromTable creates a router for calls made by service, such as "ingress" or "cron".
+func NewVerbRouterFromTable(ctx context.Context, routeTable *RouteTable, timelineClient *timeline.Client, service string) *VerbCallRouter {
 	svc := &VerbCallRouter{
 		routingTable:   routeTable,
 		moduleClients:  xsync.NewMapOf[string, optional.Option[ftlv1connect.VerbServiceClient]](),
 		timelineClient: timelineClient,
+		breakers:       circuitbreaker.New(timeline.PublishCircuitBreakerTransitions(ctx, timelineClient, service)),
 	}
 	routeUpdates := svc.routingTable.Subscribe()
 	go func() {
@@ -97,8 +111,10 @@ func NewVerbRouterFromTable(ctx context.Context, routeTable *RouteTable, timelin
 	}()
 	return svc
 }
-func NewVerbRouter(ctx context.Context, changes schemaeventsource.EventSource, timelineClient *timeline.Client) *VerbCallRouter {
-	return NewVerbRouterFromTable(ctx, New(ctx, changes), timelineClient)
+
+// NewVerbRouter creates a router for calls made by service, such as "ingress" or "cron".
+func NewVerbRouter(ctx context.Context, changes schemaeventsource.EventSource, timelineClient *timeline.Client, service string) *VerbCallRouter {
+	return NewVerbRouterFromTable(ctx, New(ctx, changes), timelineClient, service)
 }
 
 func (s *VerbCallRouter) LookupClient(module string) (client ftlv1connect.VerbServiceClient, deployment model.DeploymentKey, ok bool) {
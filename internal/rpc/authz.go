@@ -0,0 +1,241 @@
+package rpc
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"connectrpc.com/connect"
+
+	"github.com/block/ftl/internal/rbac"
+)
+
+// AccessDenied describes a request that was rejected by an [AuthorizationInterceptor].
+type AccessDenied struct {
+	Procedure string
+	// Identity of the subject, or empty if the request was not authenticated.
+	Identity   string
+	Permission rbac.Permission
+	// Modules the request applied to. If empty, it applied to all modules.
+	Modules []string
+	Reason  string
+}
+
+// Authenticator returns the subject identified by credentials in the request headers, if they are valid.
+type Authenticator func(ctx context.Context, header http.Header) (subject string, ok bool)
+
+// AuthenticateHeader returns an Authenticator that trusts the subject in a header set by an
+// authenticating proxy, such as one that verifies the credentials added by the
+// authenticators in [github.com/block/ftl/internal/authn].
+//
+// The proxy must be the only route to the server, and must remove the header from the
+// requests it receives, because it is otherwise set by any client.
+func AuthenticateHeader(name string) Authenticator {
+	return func(ctx context.Context, header http.Header) (string, bool) {
+		subject := header.Get(name)
+		return subject, subject != ""
+	}
+}
+
+// AuthorizationInterceptor rejects requests that are not permitted by the policy
+// with [connect.CodePermissionDenied].
+//
+// The subject of each request is identified by the first of authenticators that accepts
+// its credentials, or otherwise by its verified client certificate, as returned by
+// [PeerIdentity]. Credentials in headers take precedence because they are specific to
+// the caller, whereas a client certificate may be shared, such as by every runner.
+// Requests that are not authenticated are rejected with
+// [connect.CodeUnauthenticated]. onDenied is called for each rejected request, so that
+// it can be audited. Client calls and procedures that are not checked by [rbac] pass
+// through.
+func AuthorizationInterceptor(policy *rbac.Policy, onDenied func(ctx context.Context, denied AccessDenied), authenticators ...Authenticator) connect.Interceptor {
+	return &authorizationInterceptor{policy: policy, onDenied: onDenied, authenticators: authenticators}
+}
+
+type authorizationInterceptor struct {
+	policy         *rbac.Policy
+	onDenied       func(ctx context.Context, denied AccessDenied)
+	authenticators []Authenticator
+}
+
+// identity returns the authenticated subject of a request, or an empty string.
+func (a *authorizationInterceptor) identity(ctx context.Context, header http.Header) string {
+	return authenticate(ctx, header, a.authenticators)
+}
+
+func (a *authorizationInterceptor) authorize(ctx context.Context, procedure, identity string, msg any) error {
+	op, ok := rbac.OperationForProcedure(procedure, msg)
+	if !ok {
+		return nil
+	}
+	code := connect.CodePermissionDenied
+	var err error
+	if identity == "" {
+		code = connect.CodeUnauthenticated
+		err = errors.New("request is not authenticated")
+	} else {
+		err = a.policy.Authorize(identity, op.Permission, op.Modules)
+	}
+	if err == nil {
+		return nil
+	}
+	if a.onDenied != nil {
+		a.onDenied(ctx, AccessDenied{
+			Procedure:  procedure,
+			Identity:   identity,
+			Permission: op.Permission,
+			Modules:    op.Modules,
+			Reason:     err.Error(),
+		})
+	}
+	return connect.NewError(code, fmt.Errorf("%s: %w", procedure, err))
+}
+
+func (a *authorizationInterceptor) WrapUnary(uf connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return uf(ctx, req)
+		}
+		if err := a.authorize(ctx, req.Spec().Procedure, a.identity(ctx, req.Header()), req.Any()); err != nil {
+			return nil, err
+		}
+		return uf(ctx, req)
+	}
+}
+
+func (a *authorizationInterceptor) WrapStreamingClient(req connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return req
+}
+
+func (a *authorizationInterceptor) WrapStreamingHandler(req connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		spec := conn.Spec()
+		if !rbac.Checked(spec.Procedure) {
+			return req(ctx, conn)
+		}
+		identity := a.identity(ctx, conn.RequestHeader())
+		if spec.StreamType == connect.StreamTypeServer {
+			// The request for a server stream is only known once it is received.
+			return req(ctx, &authorizedStreamingHandlerConn{
+				StreamingHandlerConn: conn,
+				authorize: func(msg any) error {
+					return a.authorize(ctx, spec.Procedure, identity, msg)
+				},
+			})
+		}
+		if err := a.authorize(ctx, spec.Procedure, identity, nil); err != nil {
+			return err
+		}
+		return req(ctx, conn)
+	}
+}
+
+// authorizedStreamingHandlerConn authorizes the first message received on a stream.
+type authorizedStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	authorize func(msg any) error
+	once      sync.Once
+	err       error
+}
+
+func (c *authorizedStreamingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err //nolint:wrapcheck
+	}
+	c.once.Do(func() { c.err = c.authorize(msg) })
+	return c.err
+}
+
+// AuthenticationInterceptor records the subject of each request handled in its context, as
+// returned by [Subject].
+//
+// The subject is identified as by [AuthorizationInterceptor], but requests that are not
+// authenticated are not rejected.
+func AuthenticationInterceptor(authenticators ...Authenticator) connect.Interceptor {
+	return &authenticationInterceptor{authenticators: authenticators}
+}
+
+type authenticationInterceptor struct {
+	authenticators []Authenticator
+}
+
+func (a *authenticationInterceptor) withSubject(ctx context.Context, header http.Header) context.Context {
+	if subject := authenticate(ctx, header, a.authenticators); subject != "" {
+		return context.WithValue(ctx, subjectKey{}, subject)
+	}
+	return ctx
+}
+
+func (a *authenticationInterceptor) WrapUnary(uf connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return uf(ctx, req)
+		}
+		return uf(a.withSubject(ctx, req.Header()), req)
+	}
+}
+
+func (a *authenticationInterceptor) WrapStreamingClient(req connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return req
+}
+
+func (a *authenticationInterceptor) WrapStreamingHandler(req connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return req(a.withSubject(ctx, conn.RequestHeader()), conn)
+	}
+}
+
+type subjectKey struct{}
+
+// Subject returns the authenticated subject of the request being handled, if it was
+// identified by an [AuthenticationInterceptor].
+func Subject(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(string)
+	return subject, ok
+}
+
+// authenticate returns the subject of a request, or an empty string if it is not authenticated.
+func authenticate(ctx context.Context, header http.Header, authenticators []Authenticator) string {
+	for _, authenticate := range authenticators {
+		if subject, ok := authenticate(ctx, header); ok {
+			return subject
+		}
+	}
+	if subject, ok := PeerIdentity(ctx); ok {
+		return subject
+	}
+	return ""
+}
+
+type peerIdentityKey struct{}
+
+// verifiedPeerMiddleware records the identity of the client certificate of each
+// request in its context, if the certificate was verified by the server.
+func verifiedPeerMiddleware(handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			if subject := certificateIdentity(r.TLS.VerifiedChains[0][0]); subject != "" {
+				r = r.WithContext(context.WithValue(r.Context(), peerIdentityKey{}, subject))
+			}
+		}
+		handler.ServeHTTP(w, r)
+	}
+}
+
+// PeerIdentity returns the subject of the verified client certificate of the request being handled, if any.
+//
+// The subject is the first email address of the certificate, or its common name if it has none.
+func PeerIdentity(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(peerIdentityKey{}).(string)
+	return subject, ok
+}
+
+func certificateIdentity(cert *x509.Certificate) string {
+	if len(cert.EmailAddresses) > 0 {
+		return cert.EmailAddresses[0]
+	}
+	return cert.Subject.CommonName
+}
@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	ftlv1 "github.com/block/ftl/backend/protos/xyz/block/ftl/v1"
+	"github.com/block/ftl/backend/protos/xyz/block/ftl/v1/ftlv1connect"
+	"github.com/block/ftl/internal/certs"
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/rbac"
+)
+
+type unimplementedAdminService struct {
+	ftlv1connect.UnimplementedAdminServiceHandler
+}
+
+type unimplementedSchemaService struct {
+	ftlv1connect.UnimplementedSchemaServiceHandler
+}
+
+func TestAuthorizationInterceptor(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	policy := &rbac.Policy{Bindings: []rbac.Binding{
+		{Subjects: []string{"alice"}, Role: rbac.RoleSecretAdmin, Modules: []string{"echo"}},
+		{Subjects: []string{"bob"}, Role: rbac.RoleViewer},
+	}}
+	assert.NoError(t, policy.Validate())
+	denials := []AccessDenied{}
+	interceptor := connect.WithInterceptors(AuthorizationInterceptor(policy, func(ctx context.Context, denied AccessDenied) {
+		denials = append(denials, denied)
+	}, func(ctx context.Context, header http.Header) (string, bool) {
+		module := header.Get("Test-Module")
+		return "module:" + module, module != ""
+	}))
+	adminPath, adminHandler := ftlv1connect.NewAdminServiceHandler(unimplementedAdminService{}, interceptor)
+	schemaPath, schemaHandler := ftlv1connect.NewSchemaServiceHandler(unimplementedSchemaService{}, interceptor)
+	mux := http.NewServeMux()
+	mux.Handle(adminPath, adminHandler)
+	mux.Handle(schemaPath, schemaHandler)
+
+	ca, err := certs.LoadOrCreateDevCA(t.TempDir())
+	assert.NoError(t, err)
+	serverCert, err := ca.Issue("localhost", "127.0.0.1")
+	assert.NoError(t, err)
+	server := httptest.NewUnstartedServer(verifiedPeerMiddleware(mux))
+	server.TLS = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    ca.Pool(),
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	client := func(subject string) *http.Client {
+		config := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: ca.Pool()}
+		if subject != "" {
+			cert, err := ca.Issue(subject)
+			assert.NoError(t, err)
+			config.Certificates = []tls.Certificate{cert}
+		}
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+	}
+
+	module := "echo"
+	secretGet := func(subject string, header http.Header) error {
+		admin := ftlv1connect.NewAdminServiceClient(client(subject), server.URL)
+		req := connect.NewRequest(&ftlv1.SecretGetRequest{Ref: &ftlv1.ConfigRef{Module: &module, Name: "apiKey"}})
+		for key, values := range header {
+			req.Header()[key] = values
+		}
+		_, err := admin.SecretGet(ctx, req)
+		return err
+	}
+
+	// Allowed requests reach the handler.
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(secretGet("alice", nil)))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(secretGet("bob", nil)))
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(secretGet("", nil)))
+
+	// Requests without a client certificate are identified by authenticators, and modules may read their own secrets.
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(secretGet("", http.Header{"Test-Module": {"echo"}})))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(secretGet("", http.Header{"Test-Module": {"time"}})))
+	// Authenticators take precedence over shared client certificates.
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(secretGet("alice", http.Header{"Test-Module": {"time"}})))
+
+	// Unchecked procedures are allowed.
+	admin := ftlv1connect.NewAdminServiceClient(client(""), server.URL)
+	_, err = admin.Ping(ctx, connect.NewRequest(&ftlv1.PingRequest{}))
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+
+	// Server streams are authorized when the request is received.
+	schema := ftlv1connect.NewSchemaServiceClient(client(""), server.URL)
+	stream, err := schema.PullSchema(ctx, connect.NewRequest(&ftlv1.PullSchemaRequest{}))
+	assert.NoError(t, err)
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(stream.Err()))
+
+	assert.Equal(t, []AccessDenied{
+		{
+			Procedure:  ftlv1connect.AdminServiceSecretGetProcedure,
+			Identity:   "bob",
+			Permission: rbac.PermissionReadSecrets,
+			Modules:    []string{"echo"},
+			Reason:     "bob is not granted read-secrets for module echo",
+		},
+		{
+			Procedure:  ftlv1connect.AdminServiceSecretGetProcedure,
+			Permission: rbac.PermissionReadSecrets,
+			Modules:    []string{"echo"},
+			Reason:     "request is not authenticated",
+		},
+		{
+			Procedure:  ftlv1connect.AdminServiceSecretGetProcedure,
+			Identity:   "module:time",
+			Permission: rbac.PermissionReadSecrets,
+			Modules:    []string{"echo"},
+			Reason:     "module:time is not granted read-secrets for module echo",
+		},
+		{
+			Procedure:  ftlv1connect.AdminServiceSecretGetProcedure,
+			Identity:   "module:time",
+			Permission: rbac.PermissionReadSecrets,
+			Modules:    []string{"echo"},
+			Reason:     "module:time is not granted read-secrets for module echo",
+		},
+		{
+			Procedure:  ftlv1connect.SchemaServicePullSchemaProcedure,
+			Permission: rbac.PermissionRead,
+			Reason:     "request is not authenticated",
+		},
+	}, denials)
+}
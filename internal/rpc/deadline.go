@@ -0,0 +1,32 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/block/ftl/common/schema"
+)
+
+// ContextWithVerbTimeout bounds the context by the verb's timeout, if it has one.
+//
+// A deadline inherited from the caller that expires sooner is kept. Connect
+// propagates the remaining time to downstream calls in the request headers, so
+// any calls made by the verb share its deadline.
+func ContextWithVerbTimeout(ctx context.Context, verb *schema.Verb) (context.Context, context.CancelFunc) {
+	md, ok := verb.GetMetadataTimeout().Get()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	timeout, err := md.Timeout()
+	if err != nil {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// IsDeadlineExceeded returns true if the error is the result of a call exceeding its deadline.
+func IsDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || connect.CodeOf(err) == connect.CodeDeadlineExceeded
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/common/schema"
+)
+
+func TestContextWithVerbTimeout(t *testing.T) {
+	verb := &schema.Verb{Name: "charge", Metadata: []schema.Metadata{&schema.MetadataTimeout{Duration: "1h"}}}
+
+	ctx, cancel := ContextWithVerbTimeout(context.Background(), &schema.Verb{Name: "charge"})
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.False(t, ok, "verbs without a timeout should not have a deadline")
+
+	ctx, cancel = ContextWithVerbTimeout(context.Background(), verb)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.True(t, time.Until(deadline) > 59*time.Minute)
+
+	// An earlier deadline inherited from the caller is kept.
+	parent, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	expected, _ := parent.Deadline()
+	ctx, cancel = ContextWithVerbTimeout(parent, verb)
+	defer cancel()
+	deadline, _ = ctx.Deadline()
+	assert.Equal(t, expected, deadline)
+}
+
+func TestIsDeadlineExceeded(t *testing.T) {
+	assert.True(t, IsDeadlineExceeded(fmt.Errorf("call failed: %w", context.DeadlineExceeded)))
+	assert.True(t, IsDeadlineExceeded(fmt.Errorf("call failed: %w", connect.NewError(connect.CodeDeadlineExceeded, nil))))
+	assert.False(t, IsDeadlineExceeded(context.Canceled))
+	assert.False(t, IsDeadlineExceeded(connect.NewError(connect.CodeInternal, nil)))
+}
@@ -23,6 +23,16 @@ const (
 	// ParentRequestIDHeader is the header used to pass the parent request ID,
 	// i.e. the publisher that initiated this call.
 	ParentRequestIDHeader = "Ftl-Parent-Request-Id"
+	// IdempotencyKeyHeader is the HTTP header clients use to pass an
+	// idempotency key to ingress. It is also the key under which the
+	// idempotency key is passed in CallRequest metadata.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// CallerTokenHeader is the header used to pass the signed token identifying the deployment that made a call.
+	//
+	// It is set by the runner on outbound calls, and verifies the last module in the VerbHeader chain.
+	CallerTokenHeader = "Ftl-Caller-Token"
+	// RunnerCredentialHeader is the header used to pass the credential identifying the deployment of a runner to the controller.
+	RunnerCredentialHeader = "Ftl-Runner-Credential"
 
 	transferEncoding = "Transfer-Encoding"
 	headerHost       = "Host"
@@ -134,3 +144,29 @@ func SetCallers(header http.Header, refs []*schema.Ref) {
 		AddCaller(header, ref)
 	}
 }
+
+// SetCallerToken on an outgoing request, replacing any existing token.
+func SetCallerToken(header http.Header, token string) {
+	header.Set(CallerTokenHeader, token)
+}
+
+// GetCallerToken from an incoming request.
+//
+// Will return ("", false) if no token is present.
+func GetCallerToken(header http.Header) (string, bool) {
+	token := header.Get(CallerTokenHeader)
+	return token, token != ""
+}
+
+// SetRunnerCredential on an outgoing request, replacing any existing credential.
+func SetRunnerCredential(header http.Header, credential string) {
+	header.Set(RunnerCredentialHeader, credential)
+}
+
+// GetRunnerCredential from an incoming request.
+//
+// Will return ("", false) if no credential is present.
+func GetRunnerCredential(header http.Header) (string, bool) {
+	credential := header.Get(RunnerCredentialHeader)
+	return credential, credential != ""
+}
@@ -17,6 +17,7 @@ import (
 	"golang.org/x/net/http2"
 
 	"github.com/block/ftl/internal/authn"
+	"github.com/block/ftl/internal/certs"
 	"github.com/block/ftl/internal/log"
 )
 
@@ -39,14 +40,14 @@ type Pingable[Req any, Resp any, RespPtr PingResponse[Resp]] interface {
 //
 // "allowInsecure" skips certificate verification, making TLS susceptible to machine-in-the-middle attacks.
 func InitialiseClients(authenticators map[string]string, allowInsecure bool) {
+	clientAuthenticators = authenticators
+	clientAllowInsecure = allowInsecure
 	// We can't have a client-wide timeout because it also applies to
 	// streaming RPCs, timing them out.
 	h2cClient = &http.Client{
 		Transport: authn.Transport(&http2.Transport{
-			AllowHTTP: true,
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: allowInsecure, // #nosec G402
-			},
+			AllowHTTP:       true,
+			TLSClientConfig: certs.ClientConfig(allowInsecure),
 			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
 				conn, err := dialer.Dial(network, addr)
 				return conn, err
@@ -55,9 +56,7 @@ func InitialiseClients(authenticators map[string]string, allowInsecure bool) {
 	}
 	tlsClient = &http.Client{
 		Transport: authn.Transport(&http2.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: allowInsecure, // #nosec G402
-			},
+			TLSClientConfig: certs.ClientConfig(allowInsecure),
 			DialTLSContext: func(ctx context.Context, network, addr string, config *tls.Config) (net.Conn, error) {
 				tlsDialer := tls.Dialer{Config: config, NetDialer: dialer}
 				conn, err := tlsDialer.DialContext(ctx, network, addr)
@@ -67,16 +66,15 @@ func InitialiseClients(authenticators map[string]string, allowInsecure bool) {
 	}
 
 	// Use a separate client for HTTP/1.1 with TLS.
+	http1TLSConfig := certs.ClientConfig(allowInsecure)
 	http1TLSClient = &http.Client{
 		Transport: authn.Transport(&http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: allowInsecure, // #nosec G402
-			},
+			TLSClientConfig: http1TLSConfig,
 			DialTLSContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
 				logger := log.FromContext(ctx)
 				logger.Debugf("HTTP/1.1 connecting to %s %s", network, addr)
 
-				tlsDialer := tls.Dialer{NetDialer: dialer}
+				tlsDialer := tls.Dialer{Config: http1TLSConfig, NetDialer: dialer}
 				conn, err := tlsDialer.DialContext(ctx, network, addr)
 				return conn, fmt.Errorf("HTTP/1.1 TLS dial failed: %w", err)
 			},
@@ -84,6 +82,16 @@ func InitialiseClients(authenticators map[string]string, allowInsecure bool) {
 	}
 }
 
+// InitialiseTLS configures TLS for servers bound to https:// URLs, and reinitialises the global HTTP clients to
+// trust the configured CAs and present the configured client certificate.
+func InitialiseTLS(config certs.Config) error {
+	if err := certs.Configure(config); err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+	InitialiseClients(clientAuthenticators, clientAllowInsecure)
+	return nil
+}
+
 func init() {
 	InitialiseClients(map[string]string{}, false)
 }
@@ -96,6 +104,10 @@ var (
 	tlsClient *http.Client
 	// Temporary client for HTTP/1.1 with TLS to help with debugging.
 	http1TLSClient *http.Client
+
+	// Arguments to the last call to InitialiseClients.
+	clientAuthenticators map[string]string
+	clientAllowInsecure  bool
 )
 
 // GetHTTPClient returns a HTTP client usable for the given URL.
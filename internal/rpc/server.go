@@ -2,7 +2,9 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
@@ -16,6 +18,7 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/block/ftl/internal/certs"
 	gaphttp "github.com/block/ftl/internal/http"
 )
 
@@ -97,7 +100,7 @@ func NewServer(ctx context.Context, listen *url.URL, options ...Option) (*Server
 	reflector := grpcreflect.NewStaticReflector(opts.reflectionPaths...)
 	opts.mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	opts.mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
-	root := ContextValuesMiddleware(ctx, opts.mux)
+	root := ContextValuesMiddleware(ctx, verifiedPeerMiddleware(opts.mux))
 
 	http1Server := &http.Server{
 		Handler:           h2c.NewHandler(root, &http2.Server{}),
@@ -113,11 +116,26 @@ func NewServer(ctx context.Context, listen *url.URL, options ...Option) (*Server
 }
 
 // Serve runs the server, updating .Bind with the actual bind address.
+//
+// If the listen URL is https:// the server uses TLS, configured by [InitialiseTLS], otherwise it uses h2c.
 func (s *Server) Serve(ctx context.Context) error {
 	listener, err := net.Listen("tcp", s.listen.Host)
 	if err != nil {
 		return err
 	}
+	if s.listen.Scheme == "https" {
+		tlsConfig, err := certs.ServerConfig(s.listen.Hostname())
+		if err != nil {
+			_ = listener.Close()
+			return err //nolint:wrapcheck
+		}
+		s.Server.TLSConfig = tlsConfig
+		if err := http2.ConfigureServer(s.Server, &http2.Server{}); err != nil {
+			_ = listener.Close()
+			return fmt.Errorf("failed to configure HTTP/2: %w", err)
+		}
+		listener = tls.NewListener(listener, s.Server.TLSConfig)
+	}
 	if s.listen.Port() == "0" {
 		s.listen.Host = listener.Addr().String()
 	}
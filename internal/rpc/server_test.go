@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/certs"
+	"github.com/block/ftl/internal/log"
+)
+
+func TestServeTLS(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, InitialiseTLS(certs.Config{})) })
+	assert.NoError(t, InitialiseTLS(certs.Config{DevCA: filepath.Join(t.TempDir(), "ca"), VerifyClients: true}))
+
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	t.Cleanup(cancel)
+	listen, err := url.Parse("https://127.0.0.1:0")
+	assert.NoError(t, err)
+	server, err := NewServer(ctx, listen)
+	assert.NoError(t, err)
+	bind := server.Bind.Subscribe(nil)
+	go server.Serve(ctx) //nolint:errcheck
+	endpoint := <-bind
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String()+"/healthz", nil)
+	assert.NoError(t, err)
+	resp, err := GetHTTPClient(endpoint.String()).Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, resp.ProtoMajor)
+}
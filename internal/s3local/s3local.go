@@ -0,0 +1,429 @@
+// Package s3local implements a minimal S3-compatible object store backed by
+// the local filesystem, for use in development.
+//
+// Only path-style requests are supported, and request signatures are not
+// verified. The supported operations are CreateBucket, HeadBucket, PutObject,
+// GetObject, HeadObject, DeleteObject and ListObjectsV2.
+package s3local
+
+import (
+	"bufio"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var bucketNameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// Server is an S3-compatible HTTP server that stores buckets as directories.
+//
+// Each bucket is a directory under the root directory containing an "objects"
+// directory with one file per object, and a "meta" directory with the
+// metadata for each object.
+type Server struct {
+	dir string
+}
+
+var _ http.Handler = (*Server)(nil)
+
+// New creates a new Server storing buckets under dir.
+func New(dir string) *Server {
+	return &Server{dir: dir}
+}
+
+// CreateBucket creates a bucket if it does not already exist.
+func (s *Server) CreateBucket(bucket string) error {
+	if !bucketNameRe.MatchString(bucket) {
+		return fmt.Errorf("invalid bucket name %q", bucket)
+	}
+	for _, sub := range []string{"objects", "meta"} {
+		if err := os.MkdirAll(filepath.Join(s.dir, bucket, sub), 0700); err != nil {
+			return fmt.Errorf("failed to create bucket %q: %w", bucket, err)
+		}
+	}
+	return nil
+}
+
+// DeleteBucket deletes a bucket and all of its objects.
+func (s *Server) DeleteBucket(bucket string) error {
+	if !bucketNameRe.MatchString(bucket) {
+		return fmt.Errorf("invalid bucket name %q", bucket)
+	}
+	if err := os.RemoveAll(filepath.Join(s.dir, bucket)); err != nil {
+		return fmt.Errorf("failed to delete bucket %q: %w", bucket, err)
+	}
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if !bucketNameRe.MatchString(bucket) {
+		writeError(w, r, http.StatusBadRequest, "InvalidBucketName", "The specified bucket is not valid.")
+		return
+	}
+	if key == "" {
+		switch r.Method {
+		case http.MethodPut:
+			if err := s.CreateBucket(bucket); err != nil {
+				writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodHead:
+			if !s.bucketExists(w, r, bucket) {
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			s.listObjects(w, r, bucket)
+		default:
+			writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+		}
+		return
+	}
+	if key == "." || key == ".." {
+		writeError(w, r, http.StatusBadRequest, "InvalidArgument", "Invalid object key.")
+		return
+	}
+	if !s.bucketExists(w, r, bucket) {
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		s.putObject(w, r, bucket, key)
+	case http.MethodGet, http.MethodHead:
+		s.getObject(w, r, bucket, key)
+	case http.MethodDelete:
+		s.deleteObject(w, r, bucket, key)
+	default:
+		writeError(w, r, http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+	}
+}
+
+type objectMeta struct {
+	ContentType  string    `json:"contentType"`
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+func (s *Server) objectPath(bucket, key string) string {
+	return filepath.Join(s.dir, bucket, "objects", url.PathEscape(key))
+}
+
+func (s *Server) metaPath(bucket, key string) string {
+	return filepath.Join(s.dir, bucket, "meta", url.PathEscape(key))
+}
+
+func (s *Server) bucketExists(w http.ResponseWriter, r *http.Request, bucket string) bool {
+	if _, err := os.Stat(filepath.Join(s.dir, bucket, "objects")); err != nil {
+		writeError(w, r, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist.")
+		return false
+	}
+	return true
+}
+
+func (s *Server) readMeta(bucket, key string) (objectMeta, error) {
+	data, err := os.ReadFile(s.metaPath(bucket, key))
+	if err != nil {
+		return objectMeta{}, err //nolint:wrapcheck
+	}
+	meta := objectMeta{}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return objectMeta{}, fmt.Errorf("failed to decode metadata for %q: %w", key, err)
+	}
+	return meta, nil
+}
+
+func (s *Server) putObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	var body io.Reader = r.Body
+	if isChunked(r) {
+		body = newChunkedReader(r.Body)
+	}
+	tmp, err := os.CreateTemp(filepath.Join(s.dir, bucket), ".upload-*")
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+	hash := md5.New()           //nolint:gosec
+	size, err := io.Copy(io.MultiWriter(tmp, hash), body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "IncompleteBody", err.Error())
+		return
+	}
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "binary/octet-stream"
+	}
+	meta := objectMeta{
+		ContentType:  contentType,
+		ETag:         `"` + hex.EncodeToString(hash.Sum(nil)) + `"`,
+		Size:         size,
+		LastModified: time.Now().UTC().Truncate(time.Second),
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	if err := os.Rename(tmp.Name(), s.objectPath(bucket, key)); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	if err := os.WriteFile(s.metaPath(bucket, key), data, 0600); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	w.Header().Set("ETag", meta.ETag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	meta, err := s.readMeta(bucket, key)
+	if errors.Is(err, os.ErrNotExist) {
+		writeError(w, r, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		return
+	} else if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	f, err := os.Open(s.objectPath(bucket, key))
+	if errors.Is(err, os.ErrNotExist) {
+		writeError(w, r, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+		return
+	} else if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	defer f.Close()
+	w.Header().Set("Content-Type", meta.ContentType)
+	w.Header().Set("ETag", meta.ETag)
+	http.ServeContent(w, r, key, meta.LastModified, f)
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	for _, path := range []string{s.objectPath(bucket, key), s.metaPath(bucket, key)} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name       `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name                  string         `xml:"Name"`
+	Prefix                string         `xml:"Prefix"`
+	Delimiter             string         `xml:"Delimiter,omitempty"`
+	MaxKeys               int            `xml:"MaxKeys"`
+	KeyCount              int            `xml:"KeyCount"`
+	IsTruncated           bool           `xml:"IsTruncated"`
+	ContinuationToken     string         `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string         `xml:"NextContinuationToken,omitempty"`
+	StartAfter            string         `xml:"StartAfter,omitempty"`
+	Contents              []listObject   `xml:"Contents"`
+	CommonPrefixes        []commonPrefix `xml:"CommonPrefixes"`
+}
+
+type listObject struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type commonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	if !s.bucketExists(w, r, bucket) {
+		return
+	}
+	query := r.URL.Query()
+	if query.Get("list-type") != "2" {
+		writeError(w, r, http.StatusNotImplemented, "NotImplemented", "Only ListObjectsV2 is supported.")
+		return
+	}
+	result := listBucketResult{
+		Name:              bucket,
+		Prefix:            query.Get("prefix"),
+		Delimiter:         query.Get("delimiter"),
+		MaxKeys:           1000,
+		ContinuationToken: query.Get("continuation-token"),
+		StartAfter:        query.Get("start-after"),
+	}
+	if maxKeys := query.Get("max-keys"); maxKeys != "" {
+		n, err := strconv.Atoi(maxKeys)
+		if err != nil || n < 0 {
+			writeError(w, r, http.StatusBadRequest, "InvalidArgument", "Invalid max-keys.")
+			return
+		}
+		result.MaxKeys = min(n, 1000)
+	}
+	after := result.StartAfter
+	if result.ContinuationToken != "" {
+		after = result.ContinuationToken
+	}
+
+	entries, err := os.ReadDir(filepath.Join(s.dir, bucket, "objects"))
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "InternalError", err.Error())
+		return
+	}
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		key, err := url.PathUnescape(entry.Name())
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	seenPrefixes := map[string]bool{}
+	last := ""
+	for _, key := range keys {
+		if !strings.HasPrefix(key, result.Prefix) || key <= after {
+			continue
+		}
+		prefix := ""
+		if result.Delimiter != "" {
+			if i := strings.Index(key[len(result.Prefix):], result.Delimiter); i >= 0 {
+				prefix = key[:len(result.Prefix)+i+len(result.Delimiter)]
+			}
+		}
+		// A prefix containing the key listing resumes from was returned by an earlier page.
+		if prefix != "" && (seenPrefixes[prefix] || strings.HasPrefix(after, prefix)) {
+			last = key
+			continue
+		}
+		if result.KeyCount >= result.MaxKeys {
+			result.IsTruncated = true
+			result.NextContinuationToken = last
+			break
+		}
+		if prefix != "" {
+			seenPrefixes[prefix] = true
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: prefix})
+			result.KeyCount++
+			last = key
+			continue
+		}
+		meta, err := s.readMeta(bucket, key)
+		if err != nil {
+			continue
+		}
+		result.Contents = append(result.Contents, listObject{
+			Key:          key,
+			LastModified: meta.LastModified.Format(time.RFC3339),
+			ETag:         meta.ETag,
+			Size:         meta.Size,
+			StorageClass: "STANDARD",
+		})
+		result.KeyCount++
+		last = key
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+type errorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	if r.Method == http.MethodHead {
+		w.WriteHeader(status)
+		return
+	}
+	writeXML(w, status, errorResponse{Code: code, Message: message, Resource: r.URL.Path})
+}
+
+func writeXML(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, xml.Header) //nolint:errcheck
+	_ = xml.NewEncoder(w).Encode(value)  //nolint:errcheck
+}
+
+// isChunked returns true if the request body uses the aws-chunked encoding.
+func isChunked(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") ||
+		strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked")
+}
+
+// chunkedReader decodes an aws-chunked request body, ignoring chunk
+// signatures and trailing headers.
+type chunkedReader struct {
+	r         *bufio.Reader
+	remaining int64
+	done      bool
+}
+
+func newChunkedReader(r io.Reader) *chunkedReader {
+	return &chunkedReader{r: bufio.NewReader(r)}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for c.remaining == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	if int64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.r.Read(p)
+	c.remaining -= int64(n)
+	if c.remaining == 0 && err == nil {
+		// Consume the CRLF that terminates the chunk data.
+		_, err = c.r.Discard(2)
+	}
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (c *chunkedReader) nextChunk() error {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read chunk header: %w", err)
+	}
+	size, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+	n, err := strconv.ParseInt(size, 16, 64)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid chunk size %q", size)
+	}
+	if n == 0 {
+		c.done = true
+		return nil
+	}
+	c.remaining = n
+	return nil
+}
@@ -0,0 +1,124 @@
+package s3local
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	server := New(t.TempDir())
+	assert.NoError(t, server.CreateBucket("echo-uploads"))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	client := s3.New(s3.Options{
+		BaseEndpoint: aws.String(httpServer.URL),
+		Region:       "us-east-1",
+		Credentials:  credentials.NewStaticCredentialsProvider("ftl", "ftl", ""),
+		UsePathStyle: true,
+	})
+	bucket := aws.String("echo-uploads")
+
+	for _, key := range []string{"a.txt", "images/b.png", "images/a.png", "images/thumbs/a.png"} {
+		_, err := client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:      bucket,
+			Key:         aws.String(key),
+			Body:        strings.NewReader("content of " + key),
+			ContentType: aws.String("text/plain"),
+		})
+		assert.NoError(t, err)
+	}
+
+	resp, err := client.GetObject(ctx, &s3.GetObjectInput{Bucket: bucket, Key: aws.String("images/a.png")})
+	assert.NoError(t, err)
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "content of images/a.png", string(data))
+	assert.Equal(t, "text/plain", aws.ToString(resp.ContentType))
+
+	_, err = client.GetObject(ctx, &s3.GetObjectInput{Bucket: bucket, Key: aws.String("missing")})
+	var noSuchKey *types.NoSuchKey
+	assert.True(t, errors.As(err, &noSuchKey), "expected NoSuchKey but got %v", err)
+
+	list, err := client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: bucket, Prefix: aws.String("images/")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"images/a.png", "images/b.png", "images/thumbs/a.png"}, objectKeys(list))
+
+	list, err = client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: bucket, Prefix: aws.String("images/"), Delimiter: aws.String("/")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"images/a.png", "images/b.png"}, objectKeys(list))
+	assert.Equal(t, 1, len(list.CommonPrefixes))
+	assert.Equal(t, "images/thumbs/", aws.ToString(list.CommonPrefixes[0].Prefix))
+
+	keys := []string{}
+	pages := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{Bucket: bucket, MaxKeys: aws.Int32(1)})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		assert.NoError(t, err)
+		keys = append(keys, objectKeys(page)...)
+	}
+	assert.Equal(t, []string{"a.txt", "images/a.png", "images/b.png", "images/thumbs/a.png"}, keys)
+
+	prefixes := []string{}
+	keys = []string{}
+	pages = s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{Bucket: bucket, Prefix: aws.String("images/"), Delimiter: aws.String("/"), MaxKeys: aws.Int32(1)})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		assert.NoError(t, err)
+		keys = append(keys, objectKeys(page)...)
+		for _, prefix := range page.CommonPrefixes {
+			prefixes = append(prefixes, aws.ToString(prefix.Prefix))
+		}
+	}
+	assert.Equal(t, []string{"images/a.png", "images/b.png"}, keys)
+	assert.Equal(t, []string{"images/thumbs/"}, prefixes)
+
+	list, err = client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: bucket, Delimiter: aws.String("/"), StartAfter: aws.String("images/a.png")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, objectKeys(list))
+	assert.Equal(t, 0, len(list.CommonPrefixes))
+
+	presigned, err := s3.NewPresignClient(client).PresignPutObject(ctx, &s3.PutObjectInput{Bucket: bucket, Key: aws.String("uploaded.txt")},
+		s3.WithPresignExpires(time.Minute))
+	assert.NoError(t, err)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, presigned.URL, strings.NewReader("uploaded"))
+	assert.NoError(t, err)
+	put, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	put.Body.Close()
+	assert.Equal(t, http.StatusOK, put.StatusCode)
+
+	_, err = client.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: bucket, Key: aws.String("uploaded.txt")})
+	assert.NoError(t, err)
+	_, err = client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: bucket, Key: aws.String("uploaded.txt")})
+	assert.Error(t, err)
+}
+
+func TestChunkedReader(t *testing.T) {
+	body := "5;chunk-signature=abc\r\nhello\r\n6;chunk-signature=def\r\n world\r\n0;chunk-signature=ghi\r\nx-amz-checksum-crc32:AAAAAA==\r\n\r\n"
+	data, err := io.ReadAll(newChunkedReader(strings.NewReader(body)))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+}
+
+func objectKeys(list *s3.ListObjectsV2Output) []string {
+	out := []string{}
+	for _, object := range list.Contents {
+		out = append(out, aws.ToString(object.Key))
+	}
+	return out
+}
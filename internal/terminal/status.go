@@ -32,6 +32,7 @@ type BuildState string
 const BuildStateWaiting BuildState = "Waiting"
 const BuildStateBuilding BuildState = "Building"
 const BuildStateBuilt BuildState = "Built"
+const BuildStateCached BuildState = "Cached"
 const BuildStateDeploying BuildState = "Deploying"
 const BuildStateDeployed BuildState = "Deployed"
 const BuildStateFailed BuildState = "Failed"
@@ -54,6 +55,7 @@ func init() {
 		BuildStateWaiting:   "\u001B[93m",
 		BuildStateBuilding:  "\u001B[94m",
 		BuildStateBuilt:     "\u001B[92m",
+		BuildStateCached:    "\u001B[96m",
 		BuildStateDeploying: "\u001B[94m",
 		BuildStateDeployed:  "\u001B[92m",
 		BuildStateFailed:    "\u001B[91m",
@@ -74,6 +76,7 @@ func init() {
 		BuildStateWaiting:   empty,
 		BuildStateBuilding:  spin,
 		BuildStateBuilt:     block,
+		BuildStateCached:    block,
 		BuildStateDeploying: spin,
 		BuildStateDeployed:  block,
 		BuildStateFailed:    cross,
@@ -229,7 +232,7 @@ func NewStatusManager(ctx context.Context) StatusManager {
 			// only redraw if not stable
 			stable := true
 			for _, state := range sm.moduleStates {
-				if state != BuildStateDeployed && state != BuildStateBuilt {
+				if state != BuildStateDeployed && state != BuildStateBuilt && state != BuildStateCached {
 					stable = false
 					break
 				}
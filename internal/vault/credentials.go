@@ -0,0 +1,150 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/block/ftl/internal/log"
+)
+
+// Credentials are dynamic database credentials leased from Vault.
+type Credentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	LeaseID       string        `json:"-"`
+	LeaseDuration time.Duration `json:"-"`
+	Renewable     bool          `json:"-"`
+}
+
+// renewInterval is how often leases are checked for renewal.
+const renewInterval = 10 * time.Second
+
+// DatabaseCredentials leases credentials from Vault's database secrets engine
+// and renews the leases while they are in use.
+//
+// Leases are renewed once half of their duration has elapsed. Once a lease
+// can no longer be extended, such as when it reaches its maximum TTL, new
+// credentials are generated for subsequent connections.
+type DatabaseCredentials struct {
+	client *Client
+	now    func() time.Time
+
+	lock   sync.Mutex
+	leases map[string]*lease
+}
+
+type lease struct {
+	creds Credentials
+	// duration is the duration originally granted, which renewals request.
+	duration time.Duration
+	renewed  time.Time
+	expires  time.Time
+}
+
+// NewDatabaseCredentials creates a new DatabaseCredentials, renewing leases until ctx is cancelled.
+func NewDatabaseCredentials(ctx context.Context, client *Client) *DatabaseCredentials {
+	d := newDatabaseCredentials(client)
+	go d.renewLoop(ctx)
+	return d
+}
+
+func newDatabaseCredentials(client *Client) *DatabaseCredentials {
+	return &DatabaseCredentials{
+		client: client,
+		now:    time.Now,
+		leases: map[string]*lease{},
+	}
+}
+
+type databaseCredentialsKey struct{}
+
+// ContextWithDatabaseCredentials returns a context that [DatabaseCredentialsFromContext] returns creds from.
+func ContextWithDatabaseCredentials(ctx context.Context, creds *DatabaseCredentials) context.Context {
+	return context.WithValue(ctx, databaseCredentialsKey{}, creds)
+}
+
+// DatabaseCredentialsFromContext returns the DatabaseCredentials set with [ContextWithDatabaseCredentials], if any.
+func DatabaseCredentialsFromContext(ctx context.Context) (*DatabaseCredentials, bool) {
+	creds, ok := ctx.Value(databaseCredentialsKey{}).(*DatabaseCredentials)
+	return creds, ok
+}
+
+// Get returns credentials for a role in the database secrets engine mounted at mount.
+//
+// Credentials are reused until less than a third of their lease remains.
+func (d *DatabaseCredentials) Get(ctx context.Context, mount, role string) (Credentials, error) {
+	key := mount + "/" + role
+	d.lock.Lock()
+	now := d.now()
+	l, ok := d.leases[key]
+	d.lock.Unlock()
+	if ok && l.expires.Sub(now) > l.duration/3 {
+		return l.creds, nil
+	}
+	// The lock is not held while waiting for Vault, so concurrent callers may
+	// each lease new credentials. The most recent lease is kept for reuse.
+	creds, err := d.client.DatabaseCredentials(ctx, mount, role)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("failed to get database credentials for %s: %w", key, err)
+	}
+	d.lock.Lock()
+	d.leases[key] = &lease{creds: creds, duration: creds.LeaseDuration, renewed: now, expires: now.Add(creds.LeaseDuration)}
+	d.lock.Unlock()
+	return creds, nil
+}
+
+func (d *DatabaseCredentials) renewLoop(ctx context.Context) {
+	ticker := time.NewTicker(renewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.renew(ctx)
+		}
+	}
+}
+
+// renew extends leases that are half way to expiry, and forgets leases that have expired.
+func (d *DatabaseCredentials) renew(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	d.lock.Lock()
+	now := d.now()
+	due := map[string]*lease{}
+	for key, l := range d.leases {
+		if !now.Before(l.expires) {
+			delete(d.leases, key)
+			continue
+		}
+		if l.creds.Renewable && now.Sub(l.renewed) >= l.duration/2 {
+			due[key] = l
+		}
+	}
+	d.lock.Unlock()
+
+	for key, l := range due {
+		granted, err := d.client.RenewLease(ctx, l.creds.LeaseID, l.duration)
+		if err != nil {
+			logger.Warnf("Failed to renew database credentials for %s: %s", key, err)
+			continue
+		}
+		d.lock.Lock()
+		if d.leases[key] == l {
+			// Leases are copied so that callers of Get never see a partially updated lease.
+			renewed := *l
+			renewed.renewed = now
+			renewed.expires = now.Add(granted)
+			if granted < l.duration {
+				// The lease is capped by its maximum TTL, so it can't be renewed any further.
+				logger.Debugf("Database credentials for %s expire at %s and will be replaced", key, renewed.expires)
+				renewed.creds.Renewable = false
+			}
+			d.leases[key] = &renewed
+		}
+		d.lock.Unlock()
+	}
+}
@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/log"
+	"github.com/block/ftl/internal/vault/vaulttest"
+)
+
+func TestDatabaseCredentials(t *testing.T) {
+	ctx := log.ContextWithNewDefaultLogger(context.Background())
+	server := vaulttest.New(t)
+	server.LeaseDuration = time.Hour
+	server.MaxTTL = 40 * time.Minute
+	client, err := New(Config{Address: server.URL, Token: vaulttest.Token})
+	assert.NoError(t, err)
+	creds := newDatabaseCredentials(client)
+	now := time.Now()
+	creds.now = func() time.Time { return now }
+
+	first, err := creds.Get(ctx, "database", "payments")
+	assert.NoError(t, err)
+	assert.Equal(t, "v-payments-1", first.Username)
+	again, err := creds.Get(ctx, "database", "payments")
+	assert.NoError(t, err)
+	assert.Equal(t, first, again, "credentials should be reused while the lease is valid")
+
+	// Half way through the lease it is renewed, but only up to its maximum TTL.
+	now = now.Add(31 * time.Minute)
+	creds.renew(ctx)
+	again, err = creds.Get(ctx, "database", "payments")
+	assert.NoError(t, err)
+	assert.Equal(t, first.Username, again.Username)
+
+	// Once the lease is close to expiry new credentials are generated.
+	now = now.Add(30 * time.Minute)
+	next, err := creds.Get(ctx, "database", "payments")
+	assert.NoError(t, err)
+	assert.Equal(t, "v-payments-2", next.Username)
+	assert.Equal(t, 2, len(server.Leases()))
+}
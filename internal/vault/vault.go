@@ -0,0 +1,294 @@
+// Package vault is a minimal client for the HashiCorp Vault HTTP API, covering
+// the KV v2 and database secrets engines, and token, AppRole and Kubernetes
+// authentication.
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned when a path does not exist in Vault.
+var ErrNotFound = errors.New("not found in vault")
+
+type Config struct {
+	Address             *url.URL `help:"Vault server address. Vault is not used if this is not set." env:"VAULT_ADDR"`
+	Namespace           string   `help:"Vault Enterprise namespace." env:"VAULT_NAMESPACE"`
+	Token               string   `help:"Vault token to authenticate with." env:"VAULT_TOKEN"`
+	AppRoleID           string   `help:"AppRole role ID to authenticate with." env:"FTL_VAULT_APPROLE_ROLE_ID"`
+	AppRoleSecretID     string   `help:"AppRole secret ID to authenticate with." env:"FTL_VAULT_APPROLE_SECRET_ID"`
+	AppRoleMount        string   `help:"Mount path of the AppRole auth method." env:"FTL_VAULT_APPROLE_MOUNT" default:"approle"`
+	KubernetesRole      string   `help:"Kubernetes auth role to authenticate with." env:"FTL_VAULT_KUBERNETES_ROLE"`
+	KubernetesTokenPath string   `help:"Path to the Kubernetes service account token." env:"FTL_VAULT_KUBERNETES_TOKEN_PATH" default:"/var/run/secrets/kubernetes.io/serviceaccount/token"`
+	KubernetesMount     string   `help:"Mount path of the Kubernetes auth method." env:"FTL_VAULT_KUBERNETES_MOUNT" default:"kubernetes"`
+}
+
+// Enabled returns true if a Vault server is configured.
+func (c Config) Enabled() bool { return c.Address != nil && c.Address.String() != "" }
+
+// Client for the Vault HTTP API.
+//
+// The client logs in with the configured auth method when first used, and
+// again whenever Vault rejects its token.
+type Client struct {
+	config Config
+	http   *http.Client
+
+	lock  sync.Mutex
+	token string
+}
+
+// New creates a new Vault client.
+func New(config Config) (*Client, error) {
+	if !config.Enabled() {
+		return nil, errors.New("vault address is required")
+	}
+	if config.Token == "" && config.AppRoleID == "" && config.KubernetesRole == "" {
+		return nil, errors.New("a vault token, AppRole or Kubernetes role is required")
+	}
+	return &Client{
+		config: config,
+		http:   &http.Client{Timeout: 30 * time.Second},
+		token:  config.Token,
+	}, nil
+}
+
+// Secret is the response to a Vault request.
+type Secret struct {
+	LeaseID       string          `json:"lease_id"`
+	LeaseDuration int             `json:"lease_duration"`
+	Renewable     bool            `json:"renewable"`
+	Data          json.RawMessage `json:"data"`
+	Auth          *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int    `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+// KVVersion is the metadata of the current version of a KV v2 secret.
+type KVVersion struct {
+	Version   int
+	UpdatedAt time.Time
+}
+
+// KVMetadata returns the current version of the KV v2 secret at path in the engine mounted at mount.
+func (c *Client) KVMetadata(ctx context.Context, mount, path string) (KVVersion, error) {
+	secret, err := c.request(ctx, http.MethodGet, mount+"/metadata/"+path, nil)
+	if err != nil {
+		return KVVersion{}, err
+	}
+	var metadata struct {
+		CurrentVersion int       `json:"current_version"`
+		UpdatedTime    time.Time `json:"updated_time"`
+	}
+	if err := json.Unmarshal(secret.Data, &metadata); err != nil {
+		return KVVersion{}, fmt.Errorf("invalid metadata for %s: %w", path, err)
+	}
+	return KVVersion{Version: metadata.CurrentVersion, UpdatedAt: metadata.UpdatedTime}, nil
+}
+
+// KVList returns the keys under path in the KV v2 engine mounted at mount.
+//
+// Keys ending in "/" are directories.
+func (c *Client) KVList(ctx context.Context, mount, path string) ([]string, error) {
+	secret, err := c.request(ctx, "LIST", mount+"/metadata/"+path, nil)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var list struct {
+		Keys []string `json:"keys"`
+	}
+	if err := json.Unmarshal(secret.Data, &list); err != nil {
+		return nil, fmt.Errorf("invalid listing for %s: %w", path, err)
+	}
+	return list.Keys, nil
+}
+
+// KVRead returns the data and version of the current version of the KV v2 secret at path.
+//
+// Values are decoded from JSON, as secrets written by other clients may hold values of any type.
+func (c *Client) KVRead(ctx context.Context, mount, path string) (map[string]any, int, error) {
+	secret, err := c.request(ctx, http.MethodGet, mount+"/data/"+path, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	var data struct {
+		Data     map[string]any `json:"data"`
+		Metadata struct {
+			Version int `json:"version"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(secret.Data, &data); err != nil {
+		return nil, 0, fmt.Errorf("invalid data for %s: %w", path, err)
+	}
+	return data.Data, data.Metadata.Version, nil
+}
+
+// KVWrite writes a new version of the KV v2 secret at path, returning the new version.
+func (c *Client) KVWrite(ctx context.Context, mount, path string, data map[string]any) (int, error) {
+	secret, err := c.request(ctx, http.MethodPost, mount+"/data/"+path, map[string]any{"data": data})
+	if err != nil {
+		return 0, err
+	}
+	var metadata struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(secret.Data, &metadata); err != nil {
+		return 0, fmt.Errorf("invalid response writing %s: %w", path, err)
+	}
+	return metadata.Version, nil
+}
+
+// KVDelete permanently deletes all versions of the KV v2 secret at path.
+func (c *Client) KVDelete(ctx context.Context, mount, path string) error {
+	_, err := c.request(ctx, http.MethodDelete, mount+"/metadata/"+path, nil)
+	return err
+}
+
+// DatabaseCredentials generates credentials for a role in the database secrets engine mounted at mount.
+func (c *Client) DatabaseCredentials(ctx context.Context, mount, role string) (Credentials, error) {
+	secret, err := c.request(ctx, http.MethodGet, mount+"/creds/"+role, nil)
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds := Credentials{
+		LeaseID:       secret.LeaseID,
+		LeaseDuration: time.Duration(secret.LeaseDuration) * time.Second,
+		Renewable:     secret.Renewable,
+	}
+	if err := json.Unmarshal(secret.Data, &creds); err != nil {
+		return Credentials{}, fmt.Errorf("invalid credentials for %s: %w", role, err)
+	}
+	return creds, nil
+}
+
+// RenewLease extends a lease by increment, returning the duration of the renewed lease.
+//
+// Vault may grant less than the increment, such as when the lease is close to its maximum TTL.
+func (c *Client) RenewLease(ctx context.Context, leaseID string, increment time.Duration) (time.Duration, error) {
+	secret, err := c.request(ctx, http.MethodPut, "sys/leases/renew", map[string]any{
+		"lease_id":  leaseID,
+		"increment": int(increment.Seconds()),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(secret.LeaseDuration) * time.Second, nil
+}
+
+func (c *Client) request(ctx context.Context, method, path string, body any) (*Secret, error) {
+	token, err := c.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secret, status, err := c.do(ctx, method, path, token, body)
+	if status == http.StatusForbidden && c.config.Token == "" {
+		// The token may have expired, so log in again.
+		c.lock.Lock()
+		if c.token == token {
+			c.token = ""
+		}
+		c.lock.Unlock()
+		if token, err = c.currentToken(ctx); err != nil {
+			return nil, err
+		}
+		secret, _, err = c.do(ctx, method, path, token, body)
+	}
+	return secret, err
+}
+
+func (c *Client) currentToken(ctx context.Context) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.token != "" {
+		return c.token, nil
+	}
+	var path string
+	var body map[string]any
+	switch {
+	case c.config.AppRoleID != "":
+		path = "auth/" + c.config.AppRoleMount + "/login"
+		body = map[string]any{"role_id": c.config.AppRoleID, "secret_id": c.config.AppRoleSecretID}
+	case c.config.KubernetesRole != "":
+		jwt, err := os.ReadFile(c.config.KubernetesTokenPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read kubernetes service account token: %w", err)
+		}
+		path = "auth/" + c.config.KubernetesMount + "/login"
+		body = map[string]any{"role": c.config.KubernetesRole, "jwt": strings.TrimSpace(string(jwt))}
+	default:
+		return "", errors.New("no vault auth method configured")
+	}
+	secret, _, err := c.do(ctx, http.MethodPost, path, "", body)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in to vault: %w", err)
+	}
+	if secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return "", errors.New("failed to log in to vault: no token returned")
+	}
+	c.token = secret.Auth.ClientToken
+	return c.token, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path, token string, body any) (*Secret, int, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to encode vault request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.config.Address.JoinPath("v1", path).String(), reader)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create vault request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.config.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("vault request to %s failed: %w", path, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to read vault response from %s: %w", path, err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, resp.StatusCode, fmt.Errorf("%s: %w", path, ErrNotFound)
+	case resp.StatusCode >= 400:
+		var errs struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(data, &errs)
+		return nil, resp.StatusCode, fmt.Errorf("vault request to %s failed with %d: %s", path, resp.StatusCode, strings.Join(errs.Errors, "; "))
+	case resp.StatusCode == http.StatusNoContent || len(data) == 0:
+		return &Secret{}, resp.StatusCode, nil
+	}
+	secret := &Secret{}
+	if err := json.Unmarshal(data, secret); err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("invalid vault response from %s: %w", path, err)
+	}
+	return secret, resp.StatusCode, nil
+}
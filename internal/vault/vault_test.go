@@ -0,0 +1,76 @@
+package vault_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/vault"
+	"github.com/block/ftl/internal/vault/vaulttest"
+)
+
+func TestKV(t *testing.T) {
+	ctx := context.Background()
+	server := vaulttest.New(t)
+	client, err := vault.New(vault.Config{Address: server.URL, Token: vaulttest.Token})
+	assert.NoError(t, err)
+
+	keys, err := client.KVList(ctx, "secret", "ftl")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	version, err := client.KVWrite(ctx, "secret", "ftl/echo/apiKey", map[string]any{"value": `"one"`})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, version)
+	version, err = client.KVWrite(ctx, "secret", "ftl/echo/apiKey", map[string]any{"value": `"two"`})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, version)
+	_, err = client.KVWrite(ctx, "secret", "ftl/token", map[string]any{"value": `"global"`})
+	assert.NoError(t, err)
+
+	keys, err = client.KVList(ctx, "secret", "ftl")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"echo/", "token"}, keys)
+
+	metadata, err := client.KVMetadata(ctx, "secret", "ftl/echo/apiKey")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, metadata.Version)
+	data, version, err := client.KVRead(ctx, "secret", "ftl/echo/apiKey")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, version)
+	assert.Equal(t, map[string]any{"value": `"two"`}, data)
+
+	assert.NoError(t, client.KVDelete(ctx, "secret", "ftl/echo/apiKey"))
+	_, _, err = client.KVRead(ctx, "secret", "ftl/echo/apiKey")
+	assert.IsError(t, err, vault.ErrNotFound)
+}
+
+func TestLogin(t *testing.T) {
+	ctx := context.Background()
+	server := vaulttest.New(t)
+	jwt := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(jwt, []byte("service-account-jwt\n"), 0600))
+
+	for name, config := range map[string]vault.Config{
+		"AppRole":    {Address: server.URL, AppRoleID: "role", AppRoleSecretID: "secret", AppRoleMount: "approle"},
+		"Kubernetes": {Address: server.URL, KubernetesRole: "ftl", KubernetesTokenPath: jwt, KubernetesMount: "kubernetes"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, err := vault.New(config)
+			assert.NoError(t, err)
+			_, err = client.KVWrite(ctx, "secret", "ftl/token", map[string]any{"value": `"global"`})
+			assert.NoError(t, err)
+
+			// Expired tokens are replaced by logging in again.
+			server.RevokeTokens()
+			_, _, err = client.KVRead(ctx, "secret", "ftl/token")
+			assert.NoError(t, err)
+		})
+	}
+
+	_, err := vault.New(vault.Config{Address: server.URL})
+	assert.EqualError(t, err, "a vault token, AppRole or Kubernetes role is required")
+}
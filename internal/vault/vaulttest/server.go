@@ -0,0 +1,186 @@
+//go:build !release
+
+// Package vaulttest provides an in-memory stand-in for a Vault server, for testing.
+package vaulttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Token is the token that the server issues and accepts.
+const Token = "test-token"
+
+// Server implements enough of the Vault HTTP API for FTL's Vault client: the
+// KV v2 and database secrets engines, AppRole and Kubernetes login, and lease
+// renewal.
+type Server struct {
+	// LeaseDuration is the duration of leases on generated database credentials.
+	LeaseDuration time.Duration
+	// MaxTTL is the maximum duration a lease can be renewed to.
+	MaxTTL time.Duration
+
+	URL *url.URL
+
+	lock    sync.Mutex
+	kv      map[string][]map[string]any
+	leases  map[string]time.Time
+	counter int
+	revoked bool
+}
+
+// New starts a server that is closed when the test completes.
+func New(t *testing.T) *Server {
+	t.Helper()
+	s := &Server{
+		LeaseDuration: time.Hour,
+		MaxTTL:        24 * time.Hour,
+		kv:            map[string][]map[string]any{},
+		leases:        map[string]time.Time{},
+	}
+	server := httptest.NewServer(s)
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.URL = u
+	return s
+}
+
+// RevokeTokens causes the server to reject the token until the client logs in again.
+func (s *Server) RevokeTokens() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.revoked = true
+}
+
+// Leases returns the IDs of the leases that have been issued.
+func (s *Server) Leases() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	out := make([]string, 0, len(s.leases))
+	for id := range s.leases {
+		out = append(out, id)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	var body map[string]any
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+	if strings.HasPrefix(path, "auth/") && strings.HasSuffix(path, "/login") {
+		s.revoked = false
+		respond(w, map[string]any{"auth": map[string]any{"client_token": Token, "lease_duration": 3600, "renewable": true}})
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != Token || s.revoked {
+		w.WriteHeader(http.StatusForbidden)
+		respond(w, map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+	if path == "sys/leases/renew" {
+		s.renew(w, body)
+		return
+	}
+	mount, rest, _ := strings.Cut(path, "/")
+	engine, key, _ := strings.Cut(rest, "/")
+	switch {
+	case engine == "creds":
+		s.counter++
+		id := fmt.Sprintf("%s/creds/%s/%d", mount, key, s.counter)
+		s.leases[id] = time.Now().Add(s.MaxTTL)
+		respond(w, map[string]any{
+			"lease_id":       id,
+			"lease_duration": int(s.LeaseDuration.Seconds()),
+			"renewable":      true,
+			"data":           map[string]any{"username": fmt.Sprintf("v-%s-%d", key, s.counter), "password": fmt.Sprintf("password-%d", s.counter)},
+		})
+	case engine == "data" && r.Method == http.MethodGet:
+		versions, ok := s.kv[mount+"/"+key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		respond(w, map[string]any{"data": map[string]any{"data": versions[len(versions)-1], "metadata": map[string]any{"version": len(versions)}}})
+	case engine == "data" && (r.Method == http.MethodPost || r.Method == http.MethodPut):
+		data := body["data"].(map[string]any) //nolint:forcetypeassert
+		s.kv[mount+"/"+key] = append(s.kv[mount+"/"+key], data)
+		respond(w, map[string]any{"data": map[string]any{"version": len(s.kv[mount+"/"+key])}})
+	case engine == "metadata" && r.Method == "LIST":
+		s.list(w, mount, key)
+	case engine == "metadata" && r.Method == http.MethodGet:
+		versions, ok := s.kv[mount+"/"+key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		respond(w, map[string]any{"data": map[string]any{"current_version": len(versions)}})
+	case engine == "metadata" && r.Method == http.MethodDelete:
+		delete(s.kv, mount+"/"+key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, mount, dir string) {
+	prefix := mount + "/" + strings.TrimSuffix(dir, "/")
+	if dir != "" {
+		prefix += "/"
+	}
+	keys := map[string]bool{}
+	for path := range s.kv {
+		rest, ok := strings.CutPrefix(path, prefix)
+		if !ok {
+			continue
+		}
+		if i := strings.Index(rest, "/"); i >= 0 {
+			keys[rest[:i+1]] = true
+		} else {
+			keys[rest] = true
+		}
+	}
+	if len(keys) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	out := make([]string, 0, len(keys))
+	for key := range keys {
+		out = append(out, key)
+	}
+	sort.Strings(out)
+	respond(w, map[string]any{"data": map[string]any{"keys": out}})
+}
+
+func (s *Server) renew(w http.ResponseWriter, body map[string]any) {
+	id, _ := body["lease_id"].(string)
+	increment, _ := body["increment"].(float64)
+	maxExpiry, ok := s.leases[id]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		respond(w, map[string]any{"errors": []string{"lease not found"}})
+		return
+	}
+	granted := min(time.Duration(increment)*time.Second, time.Until(maxExpiry).Truncate(time.Second))
+	respond(w, map[string]any{"lease_id": id, "lease_duration": int(granted.Seconds()), "renewable": true})
+}
+
+func respond(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,390 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alecthomas/types/pubsub"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/block/ftl/internal/log"
+)
+
+// coalesceDelay is how long the filesystem must be quiet before pending
+// changes are published, so that bursts of changes (eg. a git checkout)
+// result in a single event per module.
+const coalesceDelay = 100 * time.Millisecond
+
+// errWatchLimit is returned when the OS limit on filesystem watches is reached.
+var errWatchLimit = errors.New("filesystem watch limit reached")
+
+// notifier tracks the state of an event driven watch.
+type notifier struct {
+	watcher *Watcher
+	fsw     *fsnotify.Watcher
+	// watched directories
+	watched map[string]bool
+	// gitignore patterns applying to entries in each directory
+	ignores map[string][]string
+}
+
+// notify watches for changes using filesystem notifications, incrementally
+// rehashing only the paths that have changed.
+//
+// It returns nil once the context is cancelled or the topic is closed, or an
+// error if notifications are unavailable and the caller should poll instead.
+func (w *Watcher) notify(ctx context.Context, topic *pubsub.Topic[WatchEvent], period time.Duration, moduleDirs []string) error {
+	logger := log.FromContext(ctx)
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("could not create file watcher: %w", err)
+	}
+	defer fsw.Close()
+	n := &notifier{
+		watcher: w,
+		fsw:     fsw,
+		watched: map[string]bool{},
+		ignores: map[string][]string{},
+	}
+
+	// Watches are added before the initial scan so that no changes are missed.
+	for _, dir := range moduleDirs {
+		if _, err := n.addWatches(dir); err != nil {
+			return err
+		}
+	}
+	w.scan(ctx, topic, moduleDirs, false)
+	if err := n.watchModuleRoots(); err != nil {
+		return err
+	}
+
+	wait := topic.Wait()
+	pending := map[string]bool{}
+	var rediscover, rehash bool
+	var quiet, deadline *time.Timer
+	stop := func(t *time.Timer) {
+		if t != nil {
+			t.Stop()
+		}
+	}
+	defer func() {
+		stop(quiet)
+		stop(deadline)
+	}()
+	timerC := func(t *time.Timer) <-chan time.Time {
+		if t == nil {
+			return nil
+		}
+		return t.C
+	}
+	schedule := func() {
+		stop(quiet)
+		quiet = time.NewTimer(coalesceDelay)
+		if deadline == nil {
+			// Publish at least once per period while changes are ongoing.
+			deadline = time.NewTimer(period)
+		}
+	}
+
+	for {
+		flush := false
+		select {
+		case <-wait:
+			return nil
+
+		case <-ctx.Done():
+			_ = topic.Close()
+			return nil
+
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return errors.New("file watcher closed")
+			}
+			changed, err := n.handleEvent(event)
+			if err != nil {
+				return err
+			}
+			if changed {
+				rediscover = true
+			}
+			pending[filepath.Clean(event.Name)] = true
+			schedule()
+
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return errors.New("file watcher closed")
+			}
+			if isWatchLimitError(err) {
+				return fmt.Errorf("%w: %w", errWatchLimit, err)
+			}
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				// Events have been lost, so rehash everything.
+				logger.Debugf("File watcher overflowed, rescanning all modules")
+			} else {
+				logger.Debugf("File watcher error, rescanning all modules: %s", err)
+			}
+			rehash = true
+			schedule()
+
+		case <-timerC(quiet):
+			flush = true
+		case <-timerC(deadline):
+			flush = true
+		}
+
+		if !flush {
+			continue
+		}
+		stop(quiet)
+		stop(deadline)
+		quiet, deadline = nil, nil
+
+		if rediscover || rehash {
+			w.scan(ctx, topic, moduleDirs, rehash)
+			if err := n.watchModuleRoots(); err != nil {
+				return err
+			}
+		}
+		if rehash {
+			pending = map[string]bool{}
+		}
+		rediscover, rehash = false, false
+
+		pending = w.update(ctx, topic, n, pending)
+		if len(pending) > 0 {
+			// Modules with open transactions are retried later.
+			deadline = time.NewTimer(period)
+		}
+	}
+}
+
+// handleEvent adds watches for new directories and returns true if the event
+// may have added or removed a module.
+func (n *notifier) handleEvent(event fsnotify.Event) (bool, error) {
+	path := filepath.Clean(event.Name)
+	if filepath.Base(path) == ".gitignore" {
+		n.ignores = map[string][]string{}
+	}
+	if filepath.Base(path) == "ftl.toml" {
+		return true, nil
+	}
+	if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+		if !n.watched[path] {
+			return false, nil
+		}
+		for dir := range n.watched {
+			if dir == path || isBeneath(path, dir) {
+				delete(n.watched, dir)
+			}
+		}
+		return true, nil
+	}
+	if event.Has(fsnotify.Create) {
+		info, err := os.Stat(path)
+		if err != nil || !info.IsDir() {
+			return false, nil
+		}
+		return n.addWatches(path)
+	}
+	return false, nil
+}
+
+// addWatches watches dir and all of its subdirectories that are not ignored
+// by git, returning true if a module was found beneath it.
+func (n *notifier) addWatches(dir string) (foundModule bool, err error) {
+	err = WalkDir(dir, true, func(path string, d fs.DirEntry) error {
+		if !d.IsDir() {
+			if d.Name() == "ftl.toml" {
+				foundModule = true
+			}
+			return nil
+		}
+		if n.watched[path] {
+			return nil
+		}
+		if err := n.fsw.Add(path); err != nil {
+			if isWatchLimitError(err) {
+				return fmt.Errorf("%w: %w", errWatchLimit, err)
+			}
+			// The directory may have been removed or be unreadable, neither of
+			// which should prevent watching everything else.
+			return ErrSkip
+		}
+		n.watched[path] = true
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return foundModule, nil
+	}
+	return foundModule, err
+}
+
+// watchModuleRoots watches directories outside the module directories that
+// are matched by watch patterns.
+func (n *notifier) watchModuleRoots() error {
+	n.watcher.mutex.Lock()
+	var roots []string
+	for dir := range n.watcher.existingModules {
+		roots = append(roots, computeRootDirs(dir, n.watcher.patterns)...)
+	}
+	n.watcher.mutex.Unlock()
+	for _, root := range roots {
+		if _, err := n.addWatches(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ignored returns true if path, or any directory between root and path, is
+// ignored by git.
+func (n *notifier) ignored(root, path string) (bool, error) {
+	for current := path; current != root && isBeneath(root, current); current = filepath.Dir(current) {
+		parent := filepath.Dir(current)
+		ignores, ok := n.ignores[parent]
+		if !ok {
+			ignores = initGitIgnore(parent)
+			n.ignores[parent] = ignores
+		}
+		match, err := matchesIgnore(current, ignores)
+		if err != nil {
+			return false, err
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// update rehashes the given paths for each module they belong to, publishing
+// an event for each module that has changed.
+//
+// Paths belonging to modules with open transactions are returned to be
+// retried later.
+func (w *Watcher) update(ctx context.Context, topic *pubsub.Topic[WatchEvent], n *notifier, paths map[string]bool) map[string]bool {
+	logger := log.FromContext(ctx)
+	deferred := map[string]bool{}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for dir, existing := range w.existingModules {
+		var affected []string
+		fullRehash := false
+		for path := range paths {
+			if path == dir || isBeneath(dir, path) {
+				affected = append(affected, path)
+				continue
+			}
+			for _, root := range computeRootDirs(dir, w.patterns) {
+				if path == root || isBeneath(root, path) {
+					affected = append(affected, path)
+					fullRehash = true
+				}
+			}
+		}
+		if len(affected) == 0 {
+			continue
+		}
+		if transactions, ok := w.moduleTransactions[dir]; ok && len(transactions) > 0 {
+			for _, path := range affected {
+				deferred[path] = true
+			}
+			continue
+		}
+
+		var hashes FileHashes
+		if fullRehash {
+			var err error
+			hashes, err = ComputeFileHashes(dir, true, w.patterns)
+			if err != nil {
+				logger.Tracef("error computing file hashes for %s: %v", dir, err)
+				continue
+			}
+		} else {
+			hashes = make(FileHashes, len(existing.Hashes))
+			for path, hash := range existing.Hashes {
+				hashes[path] = hash
+			}
+			if err := n.rehashPaths(dir, hashes, affected); err != nil {
+				logger.Tracef("error computing file hashes for %s: %v", dir, err)
+				continue
+			}
+		}
+		w.publishChanges(ctx, topic, existing, hashes)
+	}
+	return deferred
+}
+
+// rehashPaths updates the hashes of the given paths within a module directory,
+// recursing into directories and removing paths that no longer exist.
+func (n *notifier) rehashPaths(dir string, hashes FileHashes, paths []string) error {
+	for _, path := range paths {
+		// Anything previously beneath the path is rehashed below, if it still exists.
+		delete(hashes, path)
+		for existing := range hashes {
+			if isBeneath(path, existing) {
+				delete(hashes, existing)
+			}
+		}
+		ignored, err := n.ignored(dir, path)
+		if err != nil {
+			return err
+		}
+		if ignored {
+			continue
+		}
+		info, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err //nolint:wrapcheck
+		}
+		if !info.IsDir() {
+			if err := n.rehashFile(dir, hashes, path); err != nil {
+				return err
+			}
+			continue
+		}
+		err = WalkDir(path, true, func(file string, d fs.DirEntry) error {
+			if d.IsDir() {
+				return nil
+			}
+			return n.rehashFile(dir, hashes, file)
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *notifier) rehashFile(dir string, hashes FileHashes, path string) error {
+	hash, matched, err := computeFileHash(dir, path, n.watcher.patterns)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if matched {
+		hashes[path] = hash
+	}
+	return nil
+}
+
+// isBeneath returns true if path is strictly beneath dir.
+func isBeneath(dir, path string) bool {
+	return strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+// isWatchLimitError returns true if err indicates that the OS limit on the
+// number of watches or watchers has been reached.
+func isWatchLimitError(err error) bool {
+	return errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE)
+}
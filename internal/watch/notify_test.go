@@ -0,0 +1,121 @@
+package watch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/block/ftl/internal/exec"
+	"github.com/block/ftl/internal/log"
+)
+
+func TestNotifyCoalescesChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(log.ContextWithNewDefaultLogger(context.Background()))
+	t.Cleanup(cancel)
+
+	dir := t.TempDir()
+	// .gitignore files are only respected within a git repository.
+	_, err := exec.Capture(ctx, dir, "git", "init", "-q")
+	assert.NoError(t, err)
+	moduleDir := filepath.Join(dir, "echo")
+	assert.NoError(t, os.MkdirAll(moduleDir, 0700))
+	writeFile(t, filepath.Join(moduleDir, "ftl.toml"), "module = \"echo\"\nlanguage = \"go\"\n")
+	writeFile(t, filepath.Join(moduleDir, ".gitignore"), "build/\n")
+	writeFile(t, filepath.Join(moduleDir, "echo.go"), "package echo")
+
+	w := NewWatcher("**/*.go")
+	topic, err := w.Watch(ctx, time.Hour, []string{dir})
+	assert.NoError(t, err)
+	events := make(chan WatchEvent, 16)
+	topic.Subscribe(events)
+
+	// Wait for the initial scan, which may have completed before subscribing.
+	assert.True(t, waitFor(func() bool {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		_, ok := w.existingModules[moduleDir]
+		return ok
+	}), "module was not discovered")
+
+	// A burst of changes, including a new directory, results in a single event.
+	writeFile(t, filepath.Join(moduleDir, "echo.go"), "package echo // changed")
+	assert.NoError(t, os.MkdirAll(filepath.Join(moduleDir, "internal"), 0700))
+	writeFile(t, filepath.Join(moduleDir, "internal", "util.go"), "package internal")
+	writeFile(t, filepath.Join(moduleDir, "README.md"), "not watched")
+	changed := nextChange(t, events)
+	assert.Equal(t, []string{"*echo.go", "+internal/util.go"}, sortedChanges(changed))
+	assertNoEvent(t, events)
+
+	// Files ignored by git do not trigger events.
+	assert.NoError(t, os.MkdirAll(filepath.Join(moduleDir, "build"), 0700))
+	writeFile(t, filepath.Join(moduleDir, "build", "generated.go"), "package build")
+	assertNoEvent(t, events)
+
+	// Removing a directory removes its files.
+	assert.NoError(t, os.RemoveAll(filepath.Join(moduleDir, "internal")))
+	changed = nextChange(t, events)
+	assert.Equal(t, []string{"*internal/util.go", "-internal/util.go"}, sortedChanges(changed))
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func waitFor(condition func() bool) bool {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if condition() {
+			return true
+		}
+	}
+	return false
+}
+
+// nextChange returns the next change event, skipping the module added event.
+func nextChange(t *testing.T, events chan WatchEvent) WatchEventModuleChanged {
+	t.Helper()
+	for {
+		select {
+		case event := <-events:
+			switch event := event.(type) {
+			case WatchEventModuleChanged:
+				return event
+			case WatchEventModuleAdded:
+			default:
+				t.Fatalf("unexpected watch event: %#v", event)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for watch event")
+		}
+	}
+}
+
+func assertNoEvent(t *testing.T, events chan WatchEvent) {
+	t.Helper()
+	for {
+		select {
+		case event := <-events:
+			if _, ok := event.(WatchEventModuleAdded); ok {
+				continue
+			}
+			t.Fatalf("unexpected watch event: %#v", event)
+		case <-time.After(coalesceDelay * 5):
+			return
+		}
+	}
+}
+
+func sortedChanges(event WatchEventModuleChanged) []string {
+	out := []string{}
+	for _, change := range event.Changes {
+		rel, _ := filepath.Rel(event.Config.Dir, change.Path) //nolint:errcheck
+		out = append(out, change.Change.String()+filepath.ToSlash(rel))
+	}
+	sort.Strings(out)
+	return out
+}
@@ -54,16 +54,9 @@ func walkDir(dir string, ignores []string, fn func(path string, d fs.DirEntry) e
 		fullPath := filepath.Join(dir, entry.Name())
 
 		// Check if the path matches any ignore pattern
-		shouldIgnore := false
-		for _, pattern := range ignores {
-			match, err := doublestar.PathMatch(pattern, fullPath)
-			if err != nil {
-				return err
-			}
-			if match {
-				shouldIgnore = true
-				break
-			}
+		shouldIgnore, err := matchesIgnore(fullPath, ignores)
+		if err != nil {
+			return err
 		}
 
 		if shouldIgnore {
@@ -97,6 +90,20 @@ func walkDir(dir string, ignores []string, fn func(path string, d fs.DirEntry) e
 	return nil
 }
 
+// matchesIgnore returns true if path matches any of the ignore patterns.
+func matchesIgnore(path string, ignores []string) (bool, error) {
+	for _, pattern := range ignores {
+		match, err := doublestar.PathMatch(pattern, path)
+		if err != nil {
+			return false, err
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func initGitIgnore(dir string) []string {
 	ignore := []string{
 		"**/.*",
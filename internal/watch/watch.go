@@ -91,6 +91,10 @@ func (w *Watcher) GetTransaction(moduleDir string) ModifyFilesTransaction {
 
 // Watch the given directories for new modules, deleted modules, and changes to
 // existing modules, publishing a change event for each.
+//
+// Changes are detected with filesystem notifications where possible, falling
+// back to rehashing every module each period if notifications are unavailable,
+// for example because the inotify watch limit has been reached.
 func (w *Watcher) Watch(ctx context.Context, period time.Duration, moduleDirs []string) (*pubsub.Topic[WatchEvent], error) {
 	if w.isWatching {
 		return nil, fmt.Errorf("file watcher is already watching")
@@ -101,89 +105,117 @@ func (w *Watcher) Watch(ctx context.Context, period time.Duration, moduleDirs []
 	topic := pubsub.New[WatchEvent]()
 
 	go func() {
-		wait := topic.Wait()
-
-		isFirstLoop := true
-		for {
-			var delayChan <-chan time.Time
-			if isFirstLoop {
-				// No delay on the first loop
-				isFirstLoop = false
-				delayChan = time.After(0)
-			} else {
-				delayChan = time.After(period)
-			}
-
-			select {
-			case <-delayChan:
-
-			case <-wait:
-				return
-
-			case <-ctx.Done():
-				_ = topic.Close()
-				return
-			}
-
-			modules, err := DiscoverModules(ctx, moduleDirs)
-			if err != nil {
-				logger.Tracef("error discovering modules: %v", err)
-				continue
-			}
-
-			modulesByDir := maps.FromSlice(modules, func(config moduleconfig.UnvalidatedModuleConfig) (string, moduleconfig.UnvalidatedModuleConfig) {
-				return config.Dir, config
-			})
-
-			w.mutex.Lock()
-			// Trigger events for removed modules.
-			for _, existingModule := range w.existingModules {
-				if transactions, ok := w.moduleTransactions[existingModule.Config.Dir]; ok && len(transactions) > 0 {
-					// Skip modules that currently have transactions
-					continue
-				}
-				existingConfig := existingModule.Config
-				if _, haveModule := modulesByDir[existingConfig.Dir]; !haveModule {
-					logger.Debugf("removed %q", existingModule.Config.Module)
-					topic.Publish(WatchEventModuleRemoved{Config: existingModule.Config})
-					delete(w.existingModules, existingConfig.Dir)
-				}
-			}
-
-			// Compare the modules to the existing modules.
-			for _, config := range modulesByDir {
-				if transactions, ok := w.moduleTransactions[config.Dir]; ok && len(transactions) > 0 {
-					// Skip modules that currently have transactions
-					continue
-				}
-				existingModule, haveExistingModule := w.existingModules[config.Dir]
-				hashes, err := ComputeFileHashes(config.Dir, true, w.patterns)
-				if err != nil {
-					logger.Tracef("error computing file hashes for %s: %v", config.Dir, err)
-					continue
-				}
-
-				if haveExistingModule {
-					changes := CompareFileHashes(existingModule.Hashes, hashes)
-					if len(changes) == 0 {
-						continue
-					}
-					event := WatchEventModuleChanged{Config: existingModule.Config, Changes: changes, Time: time.Now()}
-					logger.Debugf("changed %q: %s", config.Module, event)
-					topic.Publish(event)
-					w.existingModules[config.Dir] = moduleHashes{Hashes: hashes, Config: existingModule.Config}
-					continue
-				}
-				logger.Debugf("added %q", config.Module)
-				topic.Publish(WatchEventModuleAdded{Config: config})
-				w.existingModules[config.Dir] = moduleHashes{Hashes: hashes, Config: config}
-			}
-			w.mutex.Unlock()
+		if err := w.notify(ctx, topic, period, moduleDirs); err != nil {
+			logger.Warnf("Falling back to polling for file changes: %s", err)
+			w.poll(ctx, topic, period, moduleDirs)
 		}
 	}()
 	return topic, nil
 }
 
+// poll rescans all modules each period until the context is cancelled or the
+// topic is closed.
+func (w *Watcher) poll(ctx context.Context, topic *pubsub.Topic[WatchEvent], period time.Duration, moduleDirs []string) {
+	wait := topic.Wait()
+
+	isFirstLoop := true
+	for {
+		var delayChan <-chan time.Time
+		if isFirstLoop {
+			// No delay on the first loop
+			isFirstLoop = false
+			delayChan = time.After(0)
+		} else {
+			delayChan = time.After(period)
+		}
+
+		select {
+		case <-delayChan:
+
+		case <-wait:
+			return
+
+		case <-ctx.Done():
+			_ = topic.Close()
+			return
+		}
+
+		w.scan(ctx, topic, moduleDirs, true)
+	}
+}
+
+// scan discovers added and removed modules, publishing an event for each.
+//
+// If rehash is true the files of every existing module are also rehashed,
+// publishing an event for each module that has changed.
+func (w *Watcher) scan(ctx context.Context, topic *pubsub.Topic[WatchEvent], moduleDirs []string, rehash bool) {
+	logger := log.FromContext(ctx)
+	modules, err := DiscoverModules(ctx, moduleDirs)
+	if err != nil {
+		logger.Tracef("error discovering modules: %v", err)
+		return
+	}
+
+	modulesByDir := maps.FromSlice(modules, func(config moduleconfig.UnvalidatedModuleConfig) (string, moduleconfig.UnvalidatedModuleConfig) {
+		return config.Dir, config
+	})
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	// Trigger events for removed modules.
+	for _, existingModule := range w.existingModules {
+		if transactions, ok := w.moduleTransactions[existingModule.Config.Dir]; ok && len(transactions) > 0 {
+			// Skip modules that currently have transactions
+			continue
+		}
+		existingConfig := existingModule.Config
+		if _, haveModule := modulesByDir[existingConfig.Dir]; !haveModule {
+			logger.Debugf("removed %q", existingModule.Config.Module)
+			topic.Publish(WatchEventModuleRemoved{Config: existingModule.Config})
+			delete(w.existingModules, existingConfig.Dir)
+		}
+	}
+
+	// Compare the modules to the existing modules.
+	for _, config := range modulesByDir {
+		if transactions, ok := w.moduleTransactions[config.Dir]; ok && len(transactions) > 0 {
+			// Skip modules that currently have transactions
+			continue
+		}
+		existingModule, haveExistingModule := w.existingModules[config.Dir]
+		if haveExistingModule && !rehash {
+			continue
+		}
+		hashes, err := ComputeFileHashes(config.Dir, true, w.patterns)
+		if err != nil {
+			logger.Tracef("error computing file hashes for %s: %v", config.Dir, err)
+			continue
+		}
+
+		if haveExistingModule {
+			w.publishChanges(ctx, topic, existingModule, hashes)
+			continue
+		}
+		logger.Debugf("added %q", config.Module)
+		topic.Publish(WatchEventModuleAdded{Config: config})
+		w.existingModules[config.Dir] = moduleHashes{Hashes: hashes, Config: config}
+	}
+}
+
+// publishChanges publishes a change event if hashes differ from those of the
+// existing module, and records the new hashes. It must be called with the
+// mutex held.
+func (w *Watcher) publishChanges(ctx context.Context, topic *pubsub.Topic[WatchEvent], existingModule moduleHashes, hashes FileHashes) {
+	changes := CompareFileHashes(existingModule.Hashes, hashes)
+	if len(changes) == 0 {
+		return
+	}
+	event := WatchEventModuleChanged{Config: existingModule.Config, Changes: changes, Time: time.Now()}
+	log.FromContext(ctx).Debugf("changed %q: %s", existingModule.Config.Module, event)
+	topic.Publish(event)
+	w.existingModules[existingModule.Config.Dir] = moduleHashes{Hashes: hashes, Config: existingModule.Config}
+}
+
 // ModifyFilesTransaction allows builds to modify files in a module without triggering a watch event.
 // This helps us avoid infinite loops with builds changing files, and those changes triggering new builds.as a no-op
 type ModifyFilesTransaction interface {
@@ -44,6 +44,7 @@ func TestJavaConfigDefaults(t *testing.T) {
 					"target/generated-sources",
 				},
 				SQLMigrationDir: "src/main/db",
+				Test:            optional.Some("mvn -B test"),
 			},
 		},
 		{
@@ -64,6 +65,7 @@ func TestJavaConfigDefaults(t *testing.T) {
 					"target/generated-sources",
 				},
 				SQLMigrationDir: "src/main/db",
+				Test:            optional.Some("mvn -B test"),
 			},
 		},
 	} {
@@ -598,11 +598,13 @@ func (s *Service) ModuleConfigDefaults(ctx context.Context, req *connect.Request
 		defaults.LanguageConfig.Fields["build-tool"] = structpb.NewStringValue(JavaBuildToolMaven)
 		defaults.DevModeBuild = ptr("mvn clean quarkus:dev")
 		defaults.Build = ptr("mvn -B clean package")
+		defaults.Test = ptr("mvn -B test")
 		defaults.DeployDir = "target"
 	} else if fileExists(buildGradle) || fileExists(buildGradleKts) {
 		defaults.LanguageConfig.Fields["build-tool"] = structpb.NewStringValue(JavaBuildToolGradle)
 		defaults.DevModeBuild = ptr("gradle clean quarkusDev")
 		defaults.Build = ptr("gradle clean build")
+		defaults.Test = ptr("gradle test")
 		defaults.DeployDir = "build"
 	} else {
 		return nil, fmt.Errorf("could not find JVM build file in %s", dir)
@@ -0,0 +1,70 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: xyz/block/ftl/asynccall/v1/asynccall.proto
+# Protobuf Python Version: 5.29.1
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    29,
+    1,
+    '',
+    'xyz/block/ftl/asynccall/v1/asynccall.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+from xyz.block.ftl.schema.v1 import schema_pb2 as xyz_dot_block_dot_ftl_dot_schema_dot_v1_dot_schema__pb2
+from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n*xyz/block/ftl/asynccall/v1/asynccall.proto\x12\x1axyz.block.ftl.asynccall.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\x9f\x05\n\tAsyncCall\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x30\n\x04verb\x18\x02 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x04verb\x12(\n\rcaller_module\x18\x0e \x01(\tH\x00R\x0c\x63\x61llerModule\x88\x01\x01\x12\x18\n\x07request\x18\x04 \x01(\x0cR\x07request\x12@\n\x05state\x18\x05 \x01(\x0e\x32*.xyz.block.ftl.asynccall.v1.AsyncCallStateR\x05state\x12\x1a\n\x08\x61ttempts\x18\x06 \x01(\x03R\x08\x61ttempts\x12\x1f\n\x08response\x18\x07 \x01(\x0cH\x01R\x08response\x88\x01\x01\x12\x19\n\x05\x65rror\x18\x08 \x01(\tH\x02R\x05\x65rror\x88\x01\x01\x12@\n\ncatch_verb\x18\t \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefH\x03R\tcatchVerb\x88\x01\x01\x12\x1f\n\x0brequest_key\x18\n \x01(\tR\nrequestKey\x12\x39\n\ncreated_at\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x39\n\nupdated_at\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.TimestampR\tupdatedAt\x12G\n\x0fnext_attempt_at\x18\r \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x04R\rnextAttemptAt\x88\x01\x01\x42\x10\n\x0e_caller_moduleB\x0b\n\t_responseB\x08\n\x06_errorB\r\n\x0b_catch_verbB\x12\n\x10_next_attempt_atJ\x04\x08\x03\x10\x04\"\xa0\x01\n\x12\x45nqueueCallRequest\x12\x30\n\x04verb\x18\x01 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x04verb\x12\x12\n\x04\x62ody\x18\x02 \x01(\x0cR\x04\x62ody\x12\x39\n\x06\x63\x61ller\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefH\x00R\x06\x63\x61ller\x88\x01\x01\x42\t\n\x07_caller\"%\n\x13\x45nqueueCallResponse\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\" \n\x0eGetCallRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"L\n\x0fGetCallResponse\x12\x39\n\x04\x63\x61ll\x18\x01 \x01(\x0b\x32%.xyz.block.ftl.asynccall.v1.AsyncCallR\x04\x63\x61ll\"\xa1\x01\n\x10ListCallsRequest\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12\x45\n\x05state\x18\x02 \x01(\x0e\x32*.xyz.block.ftl.asynccall.v1.AsyncCallStateH\x01R\x05state\x88\x01\x01\x12\x14\n\x05limit\x18\x03 \x01(\x05R\x05limitB\t\n\x07_moduleB\x08\n\x06_state\"P\n\x11ListCallsResponse\x12;\n\x05\x63\x61lls\x18\x01 \x03(\x0b\x32%.xyz.block.ftl.asynccall.v1.AsyncCallR\x05\x63\x61lls\"\"\n\x10RetryCallRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x13\n\x11RetryCallResponse\"#\n\x11\x43\x61ncelCallRequest\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\"\x14\n\x12\x43\x61ncelCallResponse*\xcb\x01\n\x0e\x41syncCallState\x12 \n\x1c\x41SYNC_CALL_STATE_UNSPECIFIED\x10\x00\x12\x1c\n\x18\x41SYNC_CALL_STATE_PENDING\x10\x01\x12\x1c\n\x18\x41SYNC_CALL_STATE_RUNNING\x10\x02\x12\x1e\n\x1a\x41SYNC_CALL_STATE_SUCCEEDED\x10\x03\x12\x1b\n\x17\x41SYNC_CALL_STATE_FAILED\x10\x04\x12\x1e\n\x1a\x41SYNC_CALL_STATE_CANCELLED\x10\x05\x32\xf9\x04\n\x0c\x41syncService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12n\n\x0b\x45nqueueCall\x12..xyz.block.ftl.asynccall.v1.EnqueueCallRequest\x1a/.xyz.block.ftl.asynccall.v1.EnqueueCallResponse\x12g\n\x07GetCall\x12*.xyz.block.ftl.asynccall.v1.GetCallRequest\x1a+.xyz.block.ftl.asynccall.v1.GetCallResponse\"\x03\x90\x02\x01\x12m\n\tListCalls\x12,.xyz.block.ftl.asynccall.v1.ListCallsRequest\x1a-.xyz.block.ftl.asynccall.v1.ListCallsResponse\"\x03\x90\x02\x01\x12h\n\tRetryCall\x12,.xyz.block.ftl.asynccall.v1.RetryCallRequest\x1a-.xyz.block.ftl.asynccall.v1.RetryCallResponse\x12k\n\nCancelCall\x12-.xyz.block.ftl.asynccall.v1.CancelCallRequest\x1a..xyz.block.ftl.asynccall.v1.CancelCallResponseBNP\x01ZJgithub.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1;asynccallpbb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'xyz.block.ftl.asynccall.v1.asynccall_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'P\001ZJgithub.com/block/ftl/backend/protos/xyz/block/ftl/asynccall/v1;asynccallpb'
+  _globals['_ASYNCSERVICE'].methods_by_name['Ping']._loaded_options = None
+  _globals['_ASYNCSERVICE'].methods_by_name['Ping']._serialized_options = b'\220\002\001'
+  _globals['_ASYNCSERVICE'].methods_by_name['GetCall']._loaded_options = None
+  _globals['_ASYNCSERVICE'].methods_by_name['GetCall']._serialized_options = b'\220\002\001'
+  _globals['_ASYNCSERVICE'].methods_by_name['ListCalls']._loaded_options = None
+  _globals['_ASYNCSERVICE'].methods_by_name['ListCalls']._serialized_options = b'\220\002\001'
+  _globals['_ASYNCCALLSTATE']._serialized_start=1524
+  _globals['_ASYNCCALLSTATE']._serialized_end=1727
+  _globals['_ASYNCCALL']._serialized_start=174
+  _globals['_ASYNCCALL']._serialized_end=845
+  _globals['_ENQUEUECALLREQUEST']._serialized_start=848
+  _globals['_ENQUEUECALLREQUEST']._serialized_end=1008
+  _globals['_ENQUEUECALLRESPONSE']._serialized_start=1010
+  _globals['_ENQUEUECALLRESPONSE']._serialized_end=1047
+  _globals['_GETCALLREQUEST']._serialized_start=1049
+  _globals['_GETCALLREQUEST']._serialized_end=1081
+  _globals['_GETCALLRESPONSE']._serialized_start=1083
+  _globals['_GETCALLRESPONSE']._serialized_end=1159
+  _globals['_LISTCALLSREQUEST']._serialized_start=1162
+  _globals['_LISTCALLSREQUEST']._serialized_end=1323
+  _globals['_LISTCALLSRESPONSE']._serialized_start=1325
+  _globals['_LISTCALLSRESPONSE']._serialized_end=1405
+  _globals['_RETRYCALLREQUEST']._serialized_start=1407
+  _globals['_RETRYCALLREQUEST']._serialized_end=1441
+  _globals['_RETRYCALLRESPONSE']._serialized_start=1443
+  _globals['_RETRYCALLRESPONSE']._serialized_end=1462
+  _globals['_CANCELCALLREQUEST']._serialized_start=1464
+  _globals['_CANCELCALLREQUEST']._serialized_end=1499
+  _globals['_CANCELCALLRESPONSE']._serialized_start=1501
+  _globals['_CANCELCALLRESPONSE']._serialized_end=1521
+  _globals['_ASYNCSERVICE']._serialized_start=1730
+  _globals['_ASYNCSERVICE']._serialized_end=2363
+# @@protoc_insertion_point(module_scope)
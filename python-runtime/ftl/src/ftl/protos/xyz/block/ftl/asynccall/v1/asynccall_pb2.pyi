@@ -0,0 +1,119 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from xyz.block.ftl.schema.v1 import schema_pb2 as _schema_pb2
+from xyz.block.ftl.v1 import ftl_pb2 as _ftl_pb2
+from google.protobuf.internal import containers as _containers
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class AsyncCallState(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    ASYNC_CALL_STATE_UNSPECIFIED: _ClassVar[AsyncCallState]
+    ASYNC_CALL_STATE_PENDING: _ClassVar[AsyncCallState]
+    ASYNC_CALL_STATE_RUNNING: _ClassVar[AsyncCallState]
+    ASYNC_CALL_STATE_SUCCEEDED: _ClassVar[AsyncCallState]
+    ASYNC_CALL_STATE_FAILED: _ClassVar[AsyncCallState]
+    ASYNC_CALL_STATE_CANCELLED: _ClassVar[AsyncCallState]
+ASYNC_CALL_STATE_UNSPECIFIED: AsyncCallState
+ASYNC_CALL_STATE_PENDING: AsyncCallState
+ASYNC_CALL_STATE_RUNNING: AsyncCallState
+ASYNC_CALL_STATE_SUCCEEDED: AsyncCallState
+ASYNC_CALL_STATE_FAILED: AsyncCallState
+ASYNC_CALL_STATE_CANCELLED: AsyncCallState
+
+class AsyncCall(_message.Message):
+    __slots__ = ("id", "verb", "caller_module", "request", "state", "attempts", "response", "error", "catch_verb", "request_key", "created_at", "updated_at", "next_attempt_at")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    VERB_FIELD_NUMBER: _ClassVar[int]
+    CALLER_MODULE_FIELD_NUMBER: _ClassVar[int]
+    REQUEST_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    RESPONSE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    CATCH_VERB_FIELD_NUMBER: _ClassVar[int]
+    REQUEST_KEY_FIELD_NUMBER: _ClassVar[int]
+    CREATED_AT_FIELD_NUMBER: _ClassVar[int]
+    UPDATED_AT_FIELD_NUMBER: _ClassVar[int]
+    NEXT_ATTEMPT_AT_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    verb: _schema_pb2.Ref
+    caller_module: str
+    request: bytes
+    state: AsyncCallState
+    attempts: int
+    response: bytes
+    error: str
+    catch_verb: _schema_pb2.Ref
+    request_key: str
+    created_at: _timestamp_pb2.Timestamp
+    updated_at: _timestamp_pb2.Timestamp
+    next_attempt_at: _timestamp_pb2.Timestamp
+    def __init__(self, id: _Optional[str] = ..., verb: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., caller_module: _Optional[str] = ..., request: _Optional[bytes] = ..., state: _Optional[_Union[AsyncCallState, str]] = ..., attempts: _Optional[int] = ..., response: _Optional[bytes] = ..., error: _Optional[str] = ..., catch_verb: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., request_key: _Optional[str] = ..., created_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., updated_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., next_attempt_at: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class EnqueueCallRequest(_message.Message):
+    __slots__ = ("verb", "body", "caller")
+    VERB_FIELD_NUMBER: _ClassVar[int]
+    BODY_FIELD_NUMBER: _ClassVar[int]
+    CALLER_FIELD_NUMBER: _ClassVar[int]
+    verb: _schema_pb2.Ref
+    body: bytes
+    caller: _schema_pb2.Ref
+    def __init__(self, verb: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., body: _Optional[bytes] = ..., caller: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ...) -> None: ...
+
+class EnqueueCallResponse(_message.Message):
+    __slots__ = ("id",)
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class GetCallRequest(_message.Message):
+    __slots__ = ("id",)
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class GetCallResponse(_message.Message):
+    __slots__ = ("call",)
+    CALL_FIELD_NUMBER: _ClassVar[int]
+    call: AsyncCall
+    def __init__(self, call: _Optional[_Union[AsyncCall, _Mapping]] = ...) -> None: ...
+
+class ListCallsRequest(_message.Message):
+    __slots__ = ("module", "state", "limit")
+    MODULE_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    LIMIT_FIELD_NUMBER: _ClassVar[int]
+    module: str
+    state: AsyncCallState
+    limit: int
+    def __init__(self, module: _Optional[str] = ..., state: _Optional[_Union[AsyncCallState, str]] = ..., limit: _Optional[int] = ...) -> None: ...
+
+class ListCallsResponse(_message.Message):
+    __slots__ = ("calls",)
+    CALLS_FIELD_NUMBER: _ClassVar[int]
+    calls: _containers.RepeatedCompositeFieldContainer[AsyncCall]
+    def __init__(self, calls: _Optional[_Iterable[_Union[AsyncCall, _Mapping]]] = ...) -> None: ...
+
+class RetryCallRequest(_message.Message):
+    __slots__ = ("id",)
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class RetryCallResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class CancelCallRequest(_message.Message):
+    __slots__ = ("id",)
+    ID_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    def __init__(self, id: _Optional[str] = ...) -> None: ...
+
+class CancelCallResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n,xyz/block/ftl/deployment/v1/deployment.proto\x12\x1bxyz.block.ftl.deployment.v1\x1a\x1axyz/block/ftl/v1/ftl.proto\"=\n\x1bGetDeploymentContextRequest\x12\x1e\n\ndeployment\x18\x01 \x01(\tR\ndeployment\"\xd3\x06\n\x1cGetDeploymentContextResponse\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\x12\x1e\n\ndeployment\x18\x02 \x01(\tR\ndeployment\x12`\n\x07\x63onfigs\x18\x03 \x03(\x0b\x32\x46.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.ConfigsEntryR\x07\x63onfigs\x12`\n\x07secrets\x18\x04 \x03(\x0b\x32\x46.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.SecretsEntryR\x07secrets\x12[\n\tdatabases\x18\x05 \x03(\x0b\x32=.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSNR\tdatabases\x12W\n\x06routes\x18\x06 \x03(\x0b\x32?.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.RouteR\x06routes\x1a\x81\x01\n\x03\x44SN\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12T\n\x04type\x18\x02 \x01(\x0e\x32@.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbTypeR\x04type\x12\x10\n\x03\x64sn\x18\x03 \x01(\tR\x03\x64sn\x1a\x39\n\x05Route\x12\x1e\n\ndeployment\x18\x01 \x01(\tR\ndeployment\x12\x10\n\x03uri\x18\x02 \x01(\tR\x03uri\x1a:\n\x0c\x43onfigsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\x1a:\n\x0cSecretsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\"J\n\x06\x44\x62Type\x12\x17\n\x13\x44\x42_TYPE_UNSPECIFIED\x10\x00\x12\x14\n\x10\x44\x42_TYPE_POSTGRES\x10\x01\x12\x11\n\rDB_TYPE_MYSQL\x10\x02\x32\xef\x01\n\x11\x44\x65ploymentService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12\x8d\x01\n\x14GetDeploymentContext\x12\x38.xyz.block.ftl.deployment.v1.GetDeploymentContextRequest\x1a\x39.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse0\x01\x42PP\x01ZLgithub.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1;deploymentpbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n,xyz/block/ftl/deployment/v1/deployment.proto\x12\x1bxyz.block.ftl.deployment.v1\x1a\x1axyz/block/ftl/v1/ftl.proto\"=\n\x1bGetDeploymentContextRequest\x12\x1e\n\ndeployment\x18\x01 \x01(\tR\ndeployment\"\xac\x08\n\x1cGetDeploymentContextResponse\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\x12\x1e\n\ndeployment\x18\x02 \x01(\tR\ndeployment\x12`\n\x07\x63onfigs\x18\x03 \x03(\x0b\x32\x46.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.ConfigsEntryR\x07\x63onfigs\x12`\n\x07secrets\x18\x04 \x03(\x0b\x32\x46.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.SecretsEntryR\x07secrets\x12[\n\tdatabases\x18\x05 \x03(\x0b\x32=.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DSNR\tdatabases\x12W\n\x06routes\x18\x06 \x03(\x0b\x32?.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.RouteR\x06routes\x12!\n\x0c\x63\x61ller_token\x18\x07 \x01(\tR\x0b\x63\x61llerToken\x12\x64\n\x0b\x63\x61ller_keys\x18\x08 \x03(\x0b\x32\x43.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.CallerKeyR\ncallerKeys\x1a\x81\x01\n\x03\x44SN\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12T\n\x04type\x18\x02 \x01(\x0e\x32@.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse.DbTypeR\x04type\x12\x10\n\x03\x64sn\x18\x03 \x01(\tR\x03\x64sn\x1a\x39\n\x05Route\x12\x1e\n\ndeployment\x18\x01 \x01(\tR\ndeployment\x12\x10\n\x03uri\x18\x02 \x01(\tR\x03uri\x1a:\n\tCallerKey\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n\npublic_key\x18\x02 \x01(\x0cR\tpublicKey\x1a:\n\x0c\x43onfigsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\x1a:\n\x0cSecretsEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\"^\n\x06\x44\x62Type\x12\x17\n\x13\x44\x42_TYPE_UNSPECIFIED\x10\x00\x12\x14\n\x10\x44\x42_TYPE_POSTGRES\x10\x01\x12\x11\n\rDB_TYPE_MYSQL\x10\x02\x12\x12\n\x0e\x44\x42_TYPE_SQLITE\x10\x03\x32\xef\x01\n\x11\x44\x65ploymentService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12\x8d\x01\n\x14GetDeploymentContext\x12\x38.xyz.block.ftl.deployment.v1.GetDeploymentContextRequest\x1a\x39.xyz.block.ftl.deployment.v1.GetDeploymentContextResponse0\x01\x42PP\x01ZLgithub.com/block/ftl/backend/protos/xyz/block/ftl/deployment/v1;deploymentpbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -42,17 +42,19 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_GETDEPLOYMENTCONTEXTREQUEST']._serialized_start=105
   _globals['_GETDEPLOYMENTCONTEXTREQUEST']._serialized_end=166
   _globals['_GETDEPLOYMENTCONTEXTRESPONSE']._serialized_start=169
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE']._serialized_end=1020
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DSN']._serialized_start=636
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DSN']._serialized_end=765
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_ROUTE']._serialized_start=767
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_ROUTE']._serialized_end=824
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_CONFIGSENTRY']._serialized_start=826
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_CONFIGSENTRY']._serialized_end=884
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_SECRETSENTRY']._serialized_start=886
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_SECRETSENTRY']._serialized_end=944
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DBTYPE']._serialized_start=946
-  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DBTYPE']._serialized_end=1020
-  _globals['_DEPLOYMENTSERVICE']._serialized_start=1023
-  _globals['_DEPLOYMENTSERVICE']._serialized_end=1262
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE']._serialized_end=1237
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DSN']._serialized_start=773
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DSN']._serialized_end=902
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_ROUTE']._serialized_start=904
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_ROUTE']._serialized_end=961
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_CALLERKEY']._serialized_start=963
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_CALLERKEY']._serialized_end=1021
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_CONFIGSENTRY']._serialized_start=1023
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_CONFIGSENTRY']._serialized_end=1081
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_SECRETSENTRY']._serialized_start=1083
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_SECRETSENTRY']._serialized_end=1141
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DBTYPE']._serialized_start=1143
+  _globals['_GETDEPLOYMENTCONTEXTRESPONSE_DBTYPE']._serialized_end=1237
+  _globals['_DEPLOYMENTSERVICE']._serialized_start=1240
+  _globals['_DEPLOYMENTSERVICE']._serialized_end=1479
 # @@protoc_insertion_point(module_scope)
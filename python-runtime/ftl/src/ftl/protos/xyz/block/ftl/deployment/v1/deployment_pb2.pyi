@@ -14,15 +14,17 @@ class GetDeploymentContextRequest(_message.Message):
     def __init__(self, deployment: _Optional[str] = ...) -> None: ...
 
 class GetDeploymentContextResponse(_message.Message):
-    __slots__ = ("module", "deployment", "configs", "secrets", "databases", "routes")
+    __slots__ = ("module", "deployment", "configs", "secrets", "databases", "routes", "caller_token", "caller_keys")
     class DbType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = ()
         DB_TYPE_UNSPECIFIED: _ClassVar[GetDeploymentContextResponse.DbType]
         DB_TYPE_POSTGRES: _ClassVar[GetDeploymentContextResponse.DbType]
         DB_TYPE_MYSQL: _ClassVar[GetDeploymentContextResponse.DbType]
+        DB_TYPE_SQLITE: _ClassVar[GetDeploymentContextResponse.DbType]
     DB_TYPE_UNSPECIFIED: GetDeploymentContextResponse.DbType
     DB_TYPE_POSTGRES: GetDeploymentContextResponse.DbType
     DB_TYPE_MYSQL: GetDeploymentContextResponse.DbType
+    DB_TYPE_SQLITE: GetDeploymentContextResponse.DbType
     class DSN(_message.Message):
         __slots__ = ("name", "type", "dsn")
         NAME_FIELD_NUMBER: _ClassVar[int]
@@ -39,6 +41,13 @@ class GetDeploymentContextResponse(_message.Message):
         deployment: str
         uri: str
         def __init__(self, deployment: _Optional[str] = ..., uri: _Optional[str] = ...) -> None: ...
+    class CallerKey(_message.Message):
+        __slots__ = ("id", "public_key")
+        ID_FIELD_NUMBER: _ClassVar[int]
+        PUBLIC_KEY_FIELD_NUMBER: _ClassVar[int]
+        id: str
+        public_key: bytes
+        def __init__(self, id: _Optional[str] = ..., public_key: _Optional[bytes] = ...) -> None: ...
     class ConfigsEntry(_message.Message):
         __slots__ = ("key", "value")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -59,10 +68,14 @@ class GetDeploymentContextResponse(_message.Message):
     SECRETS_FIELD_NUMBER: _ClassVar[int]
     DATABASES_FIELD_NUMBER: _ClassVar[int]
     ROUTES_FIELD_NUMBER: _ClassVar[int]
+    CALLER_TOKEN_FIELD_NUMBER: _ClassVar[int]
+    CALLER_KEYS_FIELD_NUMBER: _ClassVar[int]
     module: str
     deployment: str
     configs: _containers.ScalarMap[str, bytes]
     secrets: _containers.ScalarMap[str, bytes]
     databases: _containers.RepeatedCompositeFieldContainer[GetDeploymentContextResponse.DSN]
     routes: _containers.RepeatedCompositeFieldContainer[GetDeploymentContextResponse.Route]
-    def __init__(self, module: _Optional[str] = ..., deployment: _Optional[str] = ..., configs: _Optional[_Mapping[str, bytes]] = ..., secrets: _Optional[_Mapping[str, bytes]] = ..., databases: _Optional[_Iterable[_Union[GetDeploymentContextResponse.DSN, _Mapping]]] = ..., routes: _Optional[_Iterable[_Union[GetDeploymentContextResponse.Route, _Mapping]]] = ...) -> None: ...
+    caller_token: str
+    caller_keys: _containers.RepeatedCompositeFieldContainer[GetDeploymentContextResponse.CallerKey]
+    def __init__(self, module: _Optional[str] = ..., deployment: _Optional[str] = ..., configs: _Optional[_Mapping[str, bytes]] = ..., secrets: _Optional[_Mapping[str, bytes]] = ..., databases: _Optional[_Iterable[_Union[GetDeploymentContextResponse.DSN, _Mapping]]] = ..., routes: _Optional[_Iterable[_Union[GetDeploymentContextResponse.Route, _Mapping]]] = ..., caller_token: _Optional[str] = ..., caller_keys: _Optional[_Iterable[_Union[GetDeploymentContextResponse.CallerKey, _Mapping]]] = ...) -> None: ...
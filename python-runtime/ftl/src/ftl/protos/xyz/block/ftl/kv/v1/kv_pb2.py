@@ -0,0 +1,60 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: xyz/block/ftl/kv/v1/kv.proto
+# Protobuf Python Version: 5.29.1
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    29,
+    1,
+    '',
+    'xyz/block/ftl/kv/v1/kv.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
+from xyz.block.ftl.schema.v1 import schema_pb2 as xyz_dot_block_dot_ftl_dot_schema_dot_v1_dot_schema__pb2
+from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1cxyz/block/ftl/kv/v1/kv.proto\x12\x13xyz.block.ftl.kv.v1\x1a\x1egoogle/protobuf/duration.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"L\n\nGetRequest\x12,\n\x02kv\x18\x01 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x02kv\x12\x10\n\x03key\x18\x02 \x01(\x0cR\x03key\"2\n\x0bGetResponse\x12\x19\n\x05value\x18\x01 \x01(\x0cH\x00R\x05value\x88\x01\x01\x42\x08\n\x06_value\"\xb4\x01\n\nPutRequest\x12,\n\x02kv\x18\x01 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x02kv\x12\x10\n\x03key\x18\x02 \x01(\x0cR\x03key\x12\x14\n\x05value\x18\x03 \x01(\x0cR\x05value\x12\x30\n\x03ttl\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationH\x00R\x03ttl\x88\x01\x01\x12\x16\n\x06\x63\x61ller\x18\x05 \x01(\tR\x06\x63\x61llerB\x06\n\x04_ttl\"\r\n\x0bPutResponse\"g\n\rDeleteRequest\x12,\n\x02kv\x18\x01 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x02kv\x12\x10\n\x03key\x18\x02 \x01(\x0cR\x03key\x12\x16\n\x06\x63\x61ller\x18\x03 \x01(\tR\x06\x63\x61ller\"\x10\n\x0e\x44\x65leteResponse\"\xda\x01\n\x15\x43ompareAndSwapRequest\x12,\n\x02kv\x18\x01 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x02kv\x12\x10\n\x03key\x18\x02 \x01(\x0cR\x03key\x12\x15\n\x03old\x18\x03 \x01(\x0cH\x00R\x03old\x88\x01\x01\x12\x10\n\x03new\x18\x04 \x01(\x0cR\x03new\x12\x30\n\x03ttl\x18\x05 \x01(\x0b\x32\x19.google.protobuf.DurationH\x01R\x03ttl\x88\x01\x01\x12\x16\n\x06\x63\x61ller\x18\x06 \x01(\tR\x06\x63\x61llerB\x06\n\x04_oldB\x06\n\x04_ttl\"2\n\x16\x43ompareAndSwapResponse\x12\x18\n\x07swapped\x18\x01 \x01(\x08R\x07swapped2\xae\x03\n\tKVService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12M\n\x03Get\x12\x1f.xyz.block.ftl.kv.v1.GetRequest\x1a .xyz.block.ftl.kv.v1.GetResponse\"\x03\x90\x02\x01\x12H\n\x03Put\x12\x1f.xyz.block.ftl.kv.v1.PutRequest\x1a .xyz.block.ftl.kv.v1.PutResponse\x12Q\n\x06\x44\x65lete\x12\".xyz.block.ftl.kv.v1.DeleteRequest\x1a#.xyz.block.ftl.kv.v1.DeleteResponse\x12i\n\x0e\x43ompareAndSwap\x12*.xyz.block.ftl.kv.v1.CompareAndSwapRequest\x1a+.xyz.block.ftl.kv.v1.CompareAndSwapResponseB@P\x01Z<github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1;kvpbb\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'xyz.block.ftl.kv.v1.kv_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'P\001Z<github.com/block/ftl/backend/protos/xyz/block/ftl/kv/v1;kvpb'
+  _globals['_KVSERVICE'].methods_by_name['Ping']._loaded_options = None
+  _globals['_KVSERVICE'].methods_by_name['Ping']._serialized_options = b'\220\002\001'
+  _globals['_KVSERVICE'].methods_by_name['Get']._loaded_options = None
+  _globals['_KVSERVICE'].methods_by_name['Get']._serialized_options = b'\220\002\001'
+  _globals['_GETREQUEST']._serialized_start=151
+  _globals['_GETREQUEST']._serialized_end=227
+  _globals['_GETRESPONSE']._serialized_start=229
+  _globals['_GETRESPONSE']._serialized_end=279
+  _globals['_PUTREQUEST']._serialized_start=282
+  _globals['_PUTREQUEST']._serialized_end=462
+  _globals['_PUTRESPONSE']._serialized_start=464
+  _globals['_PUTRESPONSE']._serialized_end=477
+  _globals['_DELETEREQUEST']._serialized_start=479
+  _globals['_DELETEREQUEST']._serialized_end=582
+  _globals['_DELETERESPONSE']._serialized_start=584
+  _globals['_DELETERESPONSE']._serialized_end=600
+  _globals['_COMPAREANDSWAPREQUEST']._serialized_start=603
+  _globals['_COMPAREANDSWAPREQUEST']._serialized_end=821
+  _globals['_COMPAREANDSWAPRESPONSE']._serialized_start=823
+  _globals['_COMPAREANDSWAPRESPONSE']._serialized_end=873
+  _globals['_KVSERVICE']._serialized_start=876
+  _globals['_KVSERVICE']._serialized_end=1306
+# @@protoc_insertion_point(module_scope)
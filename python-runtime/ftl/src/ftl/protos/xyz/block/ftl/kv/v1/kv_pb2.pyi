@@ -0,0 +1,76 @@
+from google.protobuf import duration_pb2 as _duration_pb2
+from xyz.block.ftl.schema.v1 import schema_pb2 as _schema_pb2
+from xyz.block.ftl.v1 import ftl_pb2 as _ftl_pb2
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class GetRequest(_message.Message):
+    __slots__ = ("kv", "key")
+    KV_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    kv: _schema_pb2.Ref
+    key: bytes
+    def __init__(self, kv: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., key: _Optional[bytes] = ...) -> None: ...
+
+class GetResponse(_message.Message):
+    __slots__ = ("value",)
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    value: bytes
+    def __init__(self, value: _Optional[bytes] = ...) -> None: ...
+
+class PutRequest(_message.Message):
+    __slots__ = ("kv", "key", "value", "ttl", "caller")
+    KV_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    TTL_FIELD_NUMBER: _ClassVar[int]
+    CALLER_FIELD_NUMBER: _ClassVar[int]
+    kv: _schema_pb2.Ref
+    key: bytes
+    value: bytes
+    ttl: _duration_pb2.Duration
+    caller: str
+    def __init__(self, kv: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., key: _Optional[bytes] = ..., value: _Optional[bytes] = ..., ttl: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., caller: _Optional[str] = ...) -> None: ...
+
+class PutResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class DeleteRequest(_message.Message):
+    __slots__ = ("kv", "key", "caller")
+    KV_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    CALLER_FIELD_NUMBER: _ClassVar[int]
+    kv: _schema_pb2.Ref
+    key: bytes
+    caller: str
+    def __init__(self, kv: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., key: _Optional[bytes] = ..., caller: _Optional[str] = ...) -> None: ...
+
+class DeleteResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class CompareAndSwapRequest(_message.Message):
+    __slots__ = ("kv", "key", "old", "new", "ttl", "caller")
+    KV_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    OLD_FIELD_NUMBER: _ClassVar[int]
+    NEW_FIELD_NUMBER: _ClassVar[int]
+    TTL_FIELD_NUMBER: _ClassVar[int]
+    CALLER_FIELD_NUMBER: _ClassVar[int]
+    kv: _schema_pb2.Ref
+    key: bytes
+    old: bytes
+    new: bytes
+    ttl: _duration_pb2.Duration
+    caller: str
+    def __init__(self, kv: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., key: _Optional[bytes] = ..., old: _Optional[bytes] = ..., new: _Optional[bytes] = ..., ttl: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., caller: _Optional[str] = ...) -> None: ...
+
+class CompareAndSwapResponse(_message.Message):
+    __slots__ = ("swapped",)
+    SWAPPED_FIELD_NUMBER: _ClassVar[int]
+    swapped: bool
+    def __init__(self, swapped: bool = ...) -> None: ...
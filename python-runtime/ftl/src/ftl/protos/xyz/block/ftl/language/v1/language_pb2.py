@@ -27,7 +27,7 @@ from xyz.block.ftl.schema.v1 import schema_pb2 as xyz_dot_block_dot_ftl_dot_sche
 from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(xyz/block/ftl/language/v1/language.proto\x12\x19xyz.block.ftl.language.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\xf5\x02\n\x0cModuleConfig\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n\x03\x64ir\x18\x02 \x01(\tR\x03\x64ir\x12\x1a\n\x08language\x18\x03 \x01(\tR\x08language\x12\x1d\n\ndeploy_dir\x18\x04 \x01(\tR\tdeployDir\x12\x19\n\x05\x62uild\x18\x05 \x01(\tH\x00R\x05\x62uild\x88\x01\x01\x12)\n\x0e\x64\x65v_mode_build\x18\x06 \x01(\tH\x01R\x0c\x64\x65vModeBuild\x88\x01\x01\x12\x1d\n\nbuild_lock\x18\x07 \x01(\tR\tbuildLock\x12\x14\n\x05watch\x18\t \x03(\tR\x05watch\x12@\n\x0flanguage_config\x18\n \x01(\x0b\x32\x17.google.protobuf.StructR\x0elanguageConfig\x12*\n\x11sql_migration_dir\x18\x0b \x01(\tR\x0fsqlMigrationDirB\x08\n\x06_buildB\x11\n\x0f_dev_mode_build\"d\n\rProjectConfig\x12\x10\n\x03\x64ir\x18\x01 \x01(\tR\x03\x64ir\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x15\n\x06no_git\x18\x03 \x01(\x08R\x05noGit\x12\x16\n\x06hermit\x18\x04 \x01(\x08R\x06hermit\"9\n\x1bGetCreateModuleFlagsRequest\x12\x1a\n\x08language\x18\x01 \x01(\tR\x08language\"\xcf\x02\n\x1cGetCreateModuleFlagsResponse\x12R\n\x05\x66lags\x18\x01 \x03(\x0b\x32<.xyz.block.ftl.language.v1.GetCreateModuleFlagsResponse.FlagR\x05\x66lags\x1a\xda\x01\n\x04\x46lag\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n\x04help\x18\x02 \x01(\tR\x04help\x12\x19\n\x05\x65nvar\x18\x03 \x01(\tH\x00R\x05\x65nvar\x88\x01\x01\x12\x19\n\x05short\x18\x04 \x01(\tH\x01R\x05short\x88\x01\x01\x12%\n\x0bplaceholder\x18\x05 \x01(\tH\x02R\x0bplaceholder\x88\x01\x01\x12\x1d\n\x07\x64\x65\x66\x61ult\x18\x06 \x01(\tH\x03R\x07\x64\x65\x66\x61ult\x88\x01\x01\x42\x08\n\x06_envarB\x08\n\x06_shortB\x0e\n\x0c_placeholderB\n\n\x08_default\"\xbb\x01\n\x13\x43reateModuleRequest\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n\x03\x64ir\x18\x02 \x01(\tR\x03\x64ir\x12O\n\x0eproject_config\x18\x03 \x01(\x0b\x32(.xyz.block.ftl.language.v1.ProjectConfigR\rprojectConfig\x12-\n\x05\x66lags\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructR\x05\x66lags\"\x16\n\x14\x43reateModuleResponse\"/\n\x1bModuleConfigDefaultsRequest\x12\x10\n\x03\x64ir\x18\x01 \x01(\tR\x03\x64ir\"\xa7\x03\n\x1cModuleConfigDefaultsResponse\x12\x1d\n\ndeploy_dir\x18\x01 \x01(\tR\tdeployDir\x12\x19\n\x05\x62uild\x18\x02 \x01(\tH\x00R\x05\x62uild\x88\x01\x01\x12)\n\x0e\x64\x65v_mode_build\x18\x03 \x01(\tH\x01R\x0c\x64\x65vModeBuild\x88\x01\x01\x12\"\n\nbuild_lock\x18\x04 \x01(\tH\x02R\tbuildLock\x88\x01\x01\x12\x35\n\x14generated_schema_dir\x18\x05 \x01(\tH\x03R\x12generatedSchemaDir\x88\x01\x01\x12\x14\n\x05watch\x18\x06 \x03(\tR\x05watch\x12@\n\x0flanguage_config\x18\x07 \x01(\x0b\x32\x17.google.protobuf.StructR\x0elanguageConfig\x12*\n\x11sql_migration_dir\x18\x08 \x01(\tR\x0fsqlMigrationDirB\x08\n\x06_buildB\x11\n\x0f_dev_mode_buildB\r\n\x0b_build_lockB\x17\n\x15_generated_schema_dir\"f\n\x16GetDependenciesRequest\x12L\n\rmodule_config\x18\x01 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\"3\n\x17GetDependenciesResponse\x12\x18\n\x07modules\x18\x01 \x03(\tR\x07modules\"\xe6\x01\n\x0c\x42uildContext\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12L\n\rmodule_config\x18\x02 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\x12\x37\n\x06schema\x18\x03 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.SchemaR\x06schema\x12\"\n\x0c\x64\x65pendencies\x18\x04 \x03(\tR\x0c\x64\x65pendencies\x12\x1b\n\tbuild_env\x18\x05 \x03(\tR\x08\x62uildEnv\"j\n\x1a\x42uildContextUpdatedRequest\x12L\n\rbuild_context\x18\x01 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildContextR\x0c\x62uildContext\"\x1d\n\x1b\x42uildContextUpdatedResponse\"\xa4\x03\n\x05\x45rror\x12\x10\n\x03msg\x18\x01 \x01(\tR\x03msg\x12\x41\n\x05level\x18\x04 \x01(\x0e\x32+.xyz.block.ftl.language.v1.Error.ErrorLevelR\x05level\x12:\n\x03pos\x18\x05 \x01(\x0b\x32#.xyz.block.ftl.language.v1.PositionH\x00R\x03pos\x88\x01\x01\x12>\n\x04type\x18\x06 \x01(\x0e\x32*.xyz.block.ftl.language.v1.Error.ErrorTypeR\x04type\"l\n\nErrorLevel\x12\x1b\n\x17\x45RROR_LEVEL_UNSPECIFIED\x10\x00\x12\x14\n\x10\x45RROR_LEVEL_INFO\x10\x01\x12\x14\n\x10\x45RROR_LEVEL_WARN\x10\x02\x12\x15\n\x11\x45RROR_LEVEL_ERROR\x10\x03\"T\n\tErrorType\x12\x1a\n\x16\x45RROR_TYPE_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45RROR_TYPE_FTL\x10\x01\x12\x17\n\x13\x45RROR_TYPE_COMPILER\x10\x02\x42\x06\n\x04_pos\"|\n\x08Position\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12\x12\n\x04line\x18\x02 \x01(\x03R\x04line\x12!\n\x0cstart_column\x18\x03 \x01(\x03R\x0bstartColumn\x12\x1d\n\nend_column\x18\x04 \x01(\x03R\tendColumn\"E\n\tErrorList\x12\x38\n\x06\x65rrors\x18\x01 \x03(\x0b\x32 .xyz.block.ftl.language.v1.ErrorR\x06\x65rrors\"\xd3\x01\n\x0c\x42uildRequest\x12!\n\x0cproject_root\x18\x01 \x01(\tR\x0bprojectRoot\x12\x1d\n\nstubs_root\x18\x02 \x01(\tR\tstubsRoot\x12\x33\n\x15rebuild_automatically\x18\x03 \x01(\x08R\x14rebuildAutomatically\x12L\n\rbuild_context\x18\x04 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildContextR\x0c\x62uildContext\"3\n\x12\x41utoRebuildStarted\x12\x1d\n\ncontext_id\x18\x01 \x01(\tR\tcontextId\"\xcc\x03\n\x0c\x42uildSuccess\x12\x1d\n\ncontext_id\x18\x01 \x01(\tR\tcontextId\x12\x30\n\x14is_automatic_rebuild\x18\x02 \x01(\x08R\x12isAutomaticRebuild\x12\x37\n\x06module\x18\x03 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06module\x12\x16\n\x06\x64\x65ploy\x18\x04 \x03(\tR\x06\x64\x65ploy\x12!\n\x0c\x64ocker_image\x18\x05 \x01(\tR\x0b\x64ockerImage\x12<\n\x06\x65rrors\x18\x06 \x01(\x0b\x32$.xyz.block.ftl.language.v1.ErrorListR\x06\x65rrors\x12&\n\x0c\x64\x65v_endpoint\x18\x07 \x01(\tH\x00R\x0b\x64\x65vEndpoint\x88\x01\x01\x12\"\n\ndebug_port\x18\x08 \x01(\x05H\x01R\tdebugPort\x88\x01\x01\x12\x34\n\x14\x64\x65v_runner_info_file\x18\t \x01(\tH\x02R\x11\x64\x65vRunnerInfoFile\x88\x01\x01\x42\x0f\n\r_dev_endpointB\r\n\x0b_debug_portB\x17\n\x15_dev_runner_info_file\"\xd6\x01\n\x0c\x42uildFailure\x12\x1d\n\ncontext_id\x18\x01 \x01(\tR\tcontextId\x12\x30\n\x14is_automatic_rebuild\x18\x02 \x01(\x08R\x12isAutomaticRebuild\x12<\n\x06\x65rrors\x18\x03 \x01(\x0b\x32$.xyz.block.ftl.language.v1.ErrorListR\x06\x65rrors\x12\x37\n\x17invalidate_dependencies\x18\x04 \x01(\x08R\x16invalidateDependencies\"\x9b\x02\n\rBuildResponse\x12\x61\n\x14\x61uto_rebuild_started\x18\x02 \x01(\x0b\x32-.xyz.block.ftl.language.v1.AutoRebuildStartedH\x00R\x12\x61utoRebuildStarted\x12N\n\rbuild_success\x18\x03 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildSuccessH\x00R\x0c\x62uildSuccess\x12N\n\rbuild_failure\x18\x04 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildFailureH\x00R\x0c\x62uildFailureB\x07\n\x05\x65vent\"\xa8\x02\n\x14GenerateStubsRequest\x12\x10\n\x03\x64ir\x18\x01 \x01(\tR\x03\x64ir\x12\x37\n\x06module\x18\x02 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06module\x12L\n\rmodule_config\x18\x03 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\x12^\n\x14native_module_config\x18\x04 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigH\x00R\x12nativeModuleConfig\x88\x01\x01\x42\x17\n\x15_native_module_config\"\x17\n\x15GenerateStubsResponse\"\xa2\x01\n\x19SyncStubReferencesRequest\x12L\n\rmodule_config\x18\x01 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\x12\x1d\n\nstubs_root\x18\x02 \x01(\tR\tstubsRoot\x12\x18\n\x07modules\x18\x03 \x03(\tR\x07modules\"\x1c\n\x1aSyncStubReferencesResponse2\xb9\x08\n\x0fLanguageService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12\x87\x01\n\x14GetCreateModuleFlags\x12\x36.xyz.block.ftl.language.v1.GetCreateModuleFlagsRequest\x1a\x37.xyz.block.ftl.language.v1.GetCreateModuleFlagsResponse\x12o\n\x0c\x43reateModule\x12..xyz.block.ftl.language.v1.CreateModuleRequest\x1a/.xyz.block.ftl.language.v1.CreateModuleResponse\x12\x87\x01\n\x14ModuleConfigDefaults\x12\x36.xyz.block.ftl.language.v1.ModuleConfigDefaultsRequest\x1a\x37.xyz.block.ftl.language.v1.ModuleConfigDefaultsResponse\x12x\n\x0fGetDependencies\x12\x31.xyz.block.ftl.language.v1.GetDependenciesRequest\x1a\x32.xyz.block.ftl.language.v1.GetDependenciesResponse\x12\\\n\x05\x42uild\x12\'.xyz.block.ftl.language.v1.BuildRequest\x1a(.xyz.block.ftl.language.v1.BuildResponse0\x01\x12\x84\x01\n\x13\x42uildContextUpdated\x12\x35.xyz.block.ftl.language.v1.BuildContextUpdatedRequest\x1a\x36.xyz.block.ftl.language.v1.BuildContextUpdatedResponse\x12r\n\rGenerateStubs\x12/.xyz.block.ftl.language.v1.GenerateStubsRequest\x1a\x30.xyz.block.ftl.language.v1.GenerateStubsResponse\x12\x81\x01\n\x12SyncStubReferences\x12\x34.xyz.block.ftl.language.v1.SyncStubReferencesRequest\x1a\x35.xyz.block.ftl.language.v1.SyncStubReferencesResponseBLP\x01ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/language/v1;languagepbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(xyz/block/ftl/language/v1/language.proto\x12\x19xyz.block.ftl.language.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\x97\x03\n\x0cModuleConfig\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n\x03\x64ir\x18\x02 \x01(\tR\x03\x64ir\x12\x1a\n\x08language\x18\x03 \x01(\tR\x08language\x12\x1d\n\ndeploy_dir\x18\x04 \x01(\tR\tdeployDir\x12\x19\n\x05\x62uild\x18\x05 \x01(\tH\x00R\x05\x62uild\x88\x01\x01\x12)\n\x0e\x64\x65v_mode_build\x18\x06 \x01(\tH\x01R\x0c\x64\x65vModeBuild\x88\x01\x01\x12\x1d\n\nbuild_lock\x18\x07 \x01(\tR\tbuildLock\x12\x14\n\x05watch\x18\t \x03(\tR\x05watch\x12@\n\x0flanguage_config\x18\n \x01(\x0b\x32\x17.google.protobuf.StructR\x0elanguageConfig\x12*\n\x11sql_migration_dir\x18\x0b \x01(\tR\x0fsqlMigrationDir\x12\x17\n\x04test\x18\x0c \x01(\tH\x02R\x04test\x88\x01\x01\x42\x08\n\x06_buildB\x11\n\x0f_dev_mode_buildB\x07\n\x05_test\"d\n\rProjectConfig\x12\x10\n\x03\x64ir\x18\x01 \x01(\tR\x03\x64ir\x12\x12\n\x04name\x18\x02 \x01(\tR\x04name\x12\x15\n\x06no_git\x18\x03 \x01(\x08R\x05noGit\x12\x16\n\x06hermit\x18\x04 \x01(\x08R\x06hermit\"9\n\x1bGetCreateModuleFlagsRequest\x12\x1a\n\x08language\x18\x01 \x01(\tR\x08language\"\xcf\x02\n\x1cGetCreateModuleFlagsResponse\x12R\n\x05\x66lags\x18\x01 \x03(\x0b\x32<.xyz.block.ftl.language.v1.GetCreateModuleFlagsResponse.FlagR\x05\x66lags\x1a\xda\x01\n\x04\x46lag\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n\x04help\x18\x02 \x01(\tR\x04help\x12\x19\n\x05\x65nvar\x18\x03 \x01(\tH\x00R\x05\x65nvar\x88\x01\x01\x12\x19\n\x05short\x18\x04 \x01(\tH\x01R\x05short\x88\x01\x01\x12%\n\x0bplaceholder\x18\x05 \x01(\tH\x02R\x0bplaceholder\x88\x01\x01\x12\x1d\n\x07\x64\x65\x66\x61ult\x18\x06 \x01(\tH\x03R\x07\x64\x65\x66\x61ult\x88\x01\x01\x42\x08\n\x06_envarB\x08\n\x06_shortB\x0e\n\x0c_placeholderB\n\n\x08_default\"\xbb\x01\n\x13\x43reateModuleRequest\x12\x12\n\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n\x03\x64ir\x18\x02 \x01(\tR\x03\x64ir\x12O\n\x0eproject_config\x18\x03 \x01(\x0b\x32(.xyz.block.ftl.language.v1.ProjectConfigR\rprojectConfig\x12-\n\x05\x66lags\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructR\x05\x66lags\"\x16\n\x14\x43reateModuleResponse\"/\n\x1bModuleConfigDefaultsRequest\x12\x10\n\x03\x64ir\x18\x01 \x01(\tR\x03\x64ir\"\xc9\x03\n\x1cModuleConfigDefaultsResponse\x12\x1d\n\ndeploy_dir\x18\x01 \x01(\tR\tdeployDir\x12\x19\n\x05\x62uild\x18\x02 \x01(\tH\x00R\x05\x62uild\x88\x01\x01\x12)\n\x0e\x64\x65v_mode_build\x18\x03 \x01(\tH\x01R\x0c\x64\x65vModeBuild\x88\x01\x01\x12\"\n\nbuild_lock\x18\x04 \x01(\tH\x02R\tbuildLock\x88\x01\x01\x12\x35\n\x14generated_schema_dir\x18\x05 \x01(\tH\x03R\x12generatedSchemaDir\x88\x01\x01\x12\x14\n\x05watch\x18\x06 \x03(\tR\x05watch\x12@\n\x0flanguage_config\x18\x07 \x01(\x0b\x32\x17.google.protobuf.StructR\x0elanguageConfig\x12*\n\x11sql_migration_dir\x18\x08 \x01(\tR\x0fsqlMigrationDir\x12\x17\n\x04test\x18\t \x01(\tH\x04R\x04test\x88\x01\x01\x42\x08\n\x06_buildB\x11\n\x0f_dev_mode_buildB\r\n\x0b_build_lockB\x17\n\x15_generated_schema_dirB\x07\n\x05_test\"f\n\x16GetDependenciesRequest\x12L\n\rmodule_config\x18\x01 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\"3\n\x17GetDependenciesResponse\x12\x18\n\x07modules\x18\x01 \x03(\tR\x07modules\"\xe6\x01\n\x0c\x42uildContext\x12\x0e\n\x02id\x18\x01 \x01(\tR\x02id\x12L\n\rmodule_config\x18\x02 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\x12\x37\n\x06schema\x18\x03 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.SchemaR\x06schema\x12\"\n\x0c\x64\x65pendencies\x18\x04 \x03(\tR\x0c\x64\x65pendencies\x12\x1b\n\tbuild_env\x18\x05 \x03(\tR\x08\x62uildEnv\"j\n\x1a\x42uildContextUpdatedRequest\x12L\n\rbuild_context\x18\x01 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildContextR\x0c\x62uildContext\"\x1d\n\x1b\x42uildContextUpdatedResponse\"\xa4\x03\n\x05\x45rror\x12\x10\n\x03msg\x18\x01 \x01(\tR\x03msg\x12\x41\n\x05level\x18\x04 \x01(\x0e\x32+.xyz.block.ftl.language.v1.Error.ErrorLevelR\x05level\x12:\n\x03pos\x18\x05 \x01(\x0b\x32#.xyz.block.ftl.language.v1.PositionH\x00R\x03pos\x88\x01\x01\x12>\n\x04type\x18\x06 \x01(\x0e\x32*.xyz.block.ftl.language.v1.Error.ErrorTypeR\x04type\"l\n\nErrorLevel\x12\x1b\n\x17\x45RROR_LEVEL_UNSPECIFIED\x10\x00\x12\x14\n\x10\x45RROR_LEVEL_INFO\x10\x01\x12\x14\n\x10\x45RROR_LEVEL_WARN\x10\x02\x12\x15\n\x11\x45RROR_LEVEL_ERROR\x10\x03\"T\n\tErrorType\x12\x1a\n\x16\x45RROR_TYPE_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45RROR_TYPE_FTL\x10\x01\x12\x17\n\x13\x45RROR_TYPE_COMPILER\x10\x02\x42\x06\n\x04_pos\"|\n\x08Position\x12\x1a\n\x08\x66ilename\x18\x01 \x01(\tR\x08\x66ilename\x12\x12\n\x04line\x18\x02 \x01(\x03R\x04line\x12!\n\x0cstart_column\x18\x03 \x01(\x03R\x0bstartColumn\x12\x1d\n\nend_column\x18\x04 \x01(\x03R\tendColumn\"E\n\tErrorList\x12\x38\n\x06\x65rrors\x18\x01 \x03(\x0b\x32 .xyz.block.ftl.language.v1.ErrorR\x06\x65rrors\"\xd3\x01\n\x0c\x42uildRequest\x12!\n\x0cproject_root\x18\x01 \x01(\tR\x0bprojectRoot\x12\x1d\n\nstubs_root\x18\x02 \x01(\tR\tstubsRoot\x12\x33\n\x15rebuild_automatically\x18\x03 \x01(\x08R\x14rebuildAutomatically\x12L\n\rbuild_context\x18\x04 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildContextR\x0c\x62uildContext\"3\n\x12\x41utoRebuildStarted\x12\x1d\n\ncontext_id\x18\x01 \x01(\tR\tcontextId\"\xcc\x03\n\x0c\x42uildSuccess\x12\x1d\n\ncontext_id\x18\x01 \x01(\tR\tcontextId\x12\x30\n\x14is_automatic_rebuild\x18\x02 \x01(\x08R\x12isAutomaticRebuild\x12\x37\n\x06module\x18\x03 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06module\x12\x16\n\x06\x64\x65ploy\x18\x04 \x03(\tR\x06\x64\x65ploy\x12!\n\x0c\x64ocker_image\x18\x05 \x01(\tR\x0b\x64ockerImage\x12<\n\x06\x65rrors\x18\x06 \x01(\x0b\x32$.xyz.block.ftl.language.v1.ErrorListR\x06\x65rrors\x12&\n\x0c\x64\x65v_endpoint\x18\x07 \x01(\tH\x00R\x0b\x64\x65vEndpoint\x88\x01\x01\x12\"\n\ndebug_port\x18\x08 \x01(\x05H\x01R\tdebugPort\x88\x01\x01\x12\x34\n\x14\x64\x65v_runner_info_file\x18\t \x01(\tH\x02R\x11\x64\x65vRunnerInfoFile\x88\x01\x01\x42\x0f\n\r_dev_endpointB\r\n\x0b_debug_portB\x17\n\x15_dev_runner_info_file\"\xd6\x01\n\x0c\x42uildFailure\x12\x1d\n\ncontext_id\x18\x01 \x01(\tR\tcontextId\x12\x30\n\x14is_automatic_rebuild\x18\x02 \x01(\x08R\x12isAutomaticRebuild\x12<\n\x06\x65rrors\x18\x03 \x01(\x0b\x32$.xyz.block.ftl.language.v1.ErrorListR\x06\x65rrors\x12\x37\n\x17invalidate_dependencies\x18\x04 \x01(\x08R\x16invalidateDependencies\"\x9b\x02\n\rBuildResponse\x12\x61\n\x14\x61uto_rebuild_started\x18\x02 \x01(\x0b\x32-.xyz.block.ftl.language.v1.AutoRebuildStartedH\x00R\x12\x61utoRebuildStarted\x12N\n\rbuild_success\x18\x03 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildSuccessH\x00R\x0c\x62uildSuccess\x12N\n\rbuild_failure\x18\x04 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.BuildFailureH\x00R\x0c\x62uildFailureB\x07\n\x05\x65vent\"\xa8\x02\n\x14GenerateStubsRequest\x12\x10\n\x03\x64ir\x18\x01 \x01(\tR\x03\x64ir\x12\x37\n\x06module\x18\x02 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06module\x12L\n\rmodule_config\x18\x03 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\x12^\n\x14native_module_config\x18\x04 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigH\x00R\x12nativeModuleConfig\x88\x01\x01\x42\x17\n\x15_native_module_config\"\x17\n\x15GenerateStubsResponse\"\xa2\x01\n\x19SyncStubReferencesRequest\x12L\n\rmodule_config\x18\x01 \x01(\x0b\x32\'.xyz.block.ftl.language.v1.ModuleConfigR\x0cmoduleConfig\x12\x1d\n\nstubs_root\x18\x02 \x01(\tR\tstubsRoot\x12\x18\n\x07modules\x18\x03 \x03(\tR\x07modules\"\x1c\n\x1aSyncStubReferencesResponse2\xb9\x08\n\x0fLanguageService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12\x87\x01\n\x14GetCreateModuleFlags\x12\x36.xyz.block.ftl.language.v1.GetCreateModuleFlagsRequest\x1a\x37.xyz.block.ftl.language.v1.GetCreateModuleFlagsResponse\x12o\n\x0c\x43reateModule\x12..xyz.block.ftl.language.v1.CreateModuleRequest\x1a/.xyz.block.ftl.language.v1.CreateModuleResponse\x12\x87\x01\n\x14ModuleConfigDefaults\x12\x36.xyz.block.ftl.language.v1.ModuleConfigDefaultsRequest\x1a\x37.xyz.block.ftl.language.v1.ModuleConfigDefaultsResponse\x12x\n\x0fGetDependencies\x12\x31.xyz.block.ftl.language.v1.GetDependenciesRequest\x1a\x32.xyz.block.ftl.language.v1.GetDependenciesResponse\x12\\\n\x05\x42uild\x12\'.xyz.block.ftl.language.v1.BuildRequest\x1a(.xyz.block.ftl.language.v1.BuildResponse0\x01\x12\x84\x01\n\x13\x42uildContextUpdated\x12\x35.xyz.block.ftl.language.v1.BuildContextUpdatedRequest\x1a\x36.xyz.block.ftl.language.v1.BuildContextUpdatedResponse\x12r\n\rGenerateStubs\x12/.xyz.block.ftl.language.v1.GenerateStubsRequest\x1a\x30.xyz.block.ftl.language.v1.GenerateStubsResponse\x12\x81\x01\n\x12SyncStubReferences\x12\x34.xyz.block.ftl.language.v1.SyncStubReferencesRequest\x1a\x35.xyz.block.ftl.language.v1.SyncStubReferencesResponseBLP\x01ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/language/v1;languagepbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -38,61 +38,61 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_LANGUAGESERVICE'].methods_by_name['Ping']._loaded_options = None
   _globals['_LANGUAGESERVICE'].methods_by_name['Ping']._serialized_options = b'\220\002\001'
   _globals['_MODULECONFIG']._serialized_start=168
-  _globals['_MODULECONFIG']._serialized_end=541
-  _globals['_PROJECTCONFIG']._serialized_start=543
-  _globals['_PROJECTCONFIG']._serialized_end=643
-  _globals['_GETCREATEMODULEFLAGSREQUEST']._serialized_start=645
-  _globals['_GETCREATEMODULEFLAGSREQUEST']._serialized_end=702
-  _globals['_GETCREATEMODULEFLAGSRESPONSE']._serialized_start=705
-  _globals['_GETCREATEMODULEFLAGSRESPONSE']._serialized_end=1040
-  _globals['_GETCREATEMODULEFLAGSRESPONSE_FLAG']._serialized_start=822
-  _globals['_GETCREATEMODULEFLAGSRESPONSE_FLAG']._serialized_end=1040
-  _globals['_CREATEMODULEREQUEST']._serialized_start=1043
-  _globals['_CREATEMODULEREQUEST']._serialized_end=1230
-  _globals['_CREATEMODULERESPONSE']._serialized_start=1232
-  _globals['_CREATEMODULERESPONSE']._serialized_end=1254
-  _globals['_MODULECONFIGDEFAULTSREQUEST']._serialized_start=1256
-  _globals['_MODULECONFIGDEFAULTSREQUEST']._serialized_end=1303
-  _globals['_MODULECONFIGDEFAULTSRESPONSE']._serialized_start=1306
-  _globals['_MODULECONFIGDEFAULTSRESPONSE']._serialized_end=1729
-  _globals['_GETDEPENDENCIESREQUEST']._serialized_start=1731
-  _globals['_GETDEPENDENCIESREQUEST']._serialized_end=1833
-  _globals['_GETDEPENDENCIESRESPONSE']._serialized_start=1835
-  _globals['_GETDEPENDENCIESRESPONSE']._serialized_end=1886
-  _globals['_BUILDCONTEXT']._serialized_start=1889
-  _globals['_BUILDCONTEXT']._serialized_end=2119
-  _globals['_BUILDCONTEXTUPDATEDREQUEST']._serialized_start=2121
-  _globals['_BUILDCONTEXTUPDATEDREQUEST']._serialized_end=2227
-  _globals['_BUILDCONTEXTUPDATEDRESPONSE']._serialized_start=2229
-  _globals['_BUILDCONTEXTUPDATEDRESPONSE']._serialized_end=2258
-  _globals['_ERROR']._serialized_start=2261
-  _globals['_ERROR']._serialized_end=2681
-  _globals['_ERROR_ERRORLEVEL']._serialized_start=2479
-  _globals['_ERROR_ERRORLEVEL']._serialized_end=2587
-  _globals['_ERROR_ERRORTYPE']._serialized_start=2589
-  _globals['_ERROR_ERRORTYPE']._serialized_end=2673
-  _globals['_POSITION']._serialized_start=2683
-  _globals['_POSITION']._serialized_end=2807
-  _globals['_ERRORLIST']._serialized_start=2809
-  _globals['_ERRORLIST']._serialized_end=2878
-  _globals['_BUILDREQUEST']._serialized_start=2881
-  _globals['_BUILDREQUEST']._serialized_end=3092
-  _globals['_AUTOREBUILDSTARTED']._serialized_start=3094
-  _globals['_AUTOREBUILDSTARTED']._serialized_end=3145
-  _globals['_BUILDSUCCESS']._serialized_start=3148
-  _globals['_BUILDSUCCESS']._serialized_end=3608
-  _globals['_BUILDFAILURE']._serialized_start=3611
-  _globals['_BUILDFAILURE']._serialized_end=3825
-  _globals['_BUILDRESPONSE']._serialized_start=3828
-  _globals['_BUILDRESPONSE']._serialized_end=4111
-  _globals['_GENERATESTUBSREQUEST']._serialized_start=4114
-  _globals['_GENERATESTUBSREQUEST']._serialized_end=4410
-  _globals['_GENERATESTUBSRESPONSE']._serialized_start=4412
-  _globals['_GENERATESTUBSRESPONSE']._serialized_end=4435
-  _globals['_SYNCSTUBREFERENCESREQUEST']._serialized_start=4438
-  _globals['_SYNCSTUBREFERENCESREQUEST']._serialized_end=4600
-  _globals['_SYNCSTUBREFERENCESRESPONSE']._serialized_start=4602
-  _globals['_SYNCSTUBREFERENCESRESPONSE']._serialized_end=4630
-  _globals['_LANGUAGESERVICE']._serialized_start=4633
-  _globals['_LANGUAGESERVICE']._serialized_end=5714
+  _globals['_MODULECONFIG']._serialized_end=575
+  _globals['_PROJECTCONFIG']._serialized_start=577
+  _globals['_PROJECTCONFIG']._serialized_end=677
+  _globals['_GETCREATEMODULEFLAGSREQUEST']._serialized_start=679
+  _globals['_GETCREATEMODULEFLAGSREQUEST']._serialized_end=736
+  _globals['_GETCREATEMODULEFLAGSRESPONSE']._serialized_start=739
+  _globals['_GETCREATEMODULEFLAGSRESPONSE']._serialized_end=1074
+  _globals['_GETCREATEMODULEFLAGSRESPONSE_FLAG']._serialized_start=856
+  _globals['_GETCREATEMODULEFLAGSRESPONSE_FLAG']._serialized_end=1074
+  _globals['_CREATEMODULEREQUEST']._serialized_start=1077
+  _globals['_CREATEMODULEREQUEST']._serialized_end=1264
+  _globals['_CREATEMODULERESPONSE']._serialized_start=1266
+  _globals['_CREATEMODULERESPONSE']._serialized_end=1288
+  _globals['_MODULECONFIGDEFAULTSREQUEST']._serialized_start=1290
+  _globals['_MODULECONFIGDEFAULTSREQUEST']._serialized_end=1337
+  _globals['_MODULECONFIGDEFAULTSRESPONSE']._serialized_start=1340
+  _globals['_MODULECONFIGDEFAULTSRESPONSE']._serialized_end=1797
+  _globals['_GETDEPENDENCIESREQUEST']._serialized_start=1799
+  _globals['_GETDEPENDENCIESREQUEST']._serialized_end=1901
+  _globals['_GETDEPENDENCIESRESPONSE']._serialized_start=1903
+  _globals['_GETDEPENDENCIESRESPONSE']._serialized_end=1954
+  _globals['_BUILDCONTEXT']._serialized_start=1957
+  _globals['_BUILDCONTEXT']._serialized_end=2187
+  _globals['_BUILDCONTEXTUPDATEDREQUEST']._serialized_start=2189
+  _globals['_BUILDCONTEXTUPDATEDREQUEST']._serialized_end=2295
+  _globals['_BUILDCONTEXTUPDATEDRESPONSE']._serialized_start=2297
+  _globals['_BUILDCONTEXTUPDATEDRESPONSE']._serialized_end=2326
+  _globals['_ERROR']._serialized_start=2329
+  _globals['_ERROR']._serialized_end=2749
+  _globals['_ERROR_ERRORLEVEL']._serialized_start=2547
+  _globals['_ERROR_ERRORLEVEL']._serialized_end=2655
+  _globals['_ERROR_ERRORTYPE']._serialized_start=2657
+  _globals['_ERROR_ERRORTYPE']._serialized_end=2741
+  _globals['_POSITION']._serialized_start=2751
+  _globals['_POSITION']._serialized_end=2875
+  _globals['_ERRORLIST']._serialized_start=2877
+  _globals['_ERRORLIST']._serialized_end=2946
+  _globals['_BUILDREQUEST']._serialized_start=2949
+  _globals['_BUILDREQUEST']._serialized_end=3160
+  _globals['_AUTOREBUILDSTARTED']._serialized_start=3162
+  _globals['_AUTOREBUILDSTARTED']._serialized_end=3213
+  _globals['_BUILDSUCCESS']._serialized_start=3216
+  _globals['_BUILDSUCCESS']._serialized_end=3676
+  _globals['_BUILDFAILURE']._serialized_start=3679
+  _globals['_BUILDFAILURE']._serialized_end=3893
+  _globals['_BUILDRESPONSE']._serialized_start=3896
+  _globals['_BUILDRESPONSE']._serialized_end=4179
+  _globals['_GENERATESTUBSREQUEST']._serialized_start=4182
+  _globals['_GENERATESTUBSREQUEST']._serialized_end=4478
+  _globals['_GENERATESTUBSRESPONSE']._serialized_start=4480
+  _globals['_GENERATESTUBSRESPONSE']._serialized_end=4503
+  _globals['_SYNCSTUBREFERENCESREQUEST']._serialized_start=4506
+  _globals['_SYNCSTUBREFERENCESREQUEST']._serialized_end=4668
+  _globals['_SYNCSTUBREFERENCESRESPONSE']._serialized_start=4670
+  _globals['_SYNCSTUBREFERENCESRESPONSE']._serialized_end=4698
+  _globals['_LANGUAGESERVICE']._serialized_start=4701
+  _globals['_LANGUAGESERVICE']._serialized_end=5782
 # @@protoc_insertion_point(module_scope)
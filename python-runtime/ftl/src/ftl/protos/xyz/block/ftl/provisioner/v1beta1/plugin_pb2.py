@@ -26,7 +26,7 @@ from xyz.block.ftl.schema.v1 import schema_pb2 as xyz_dot_block_dot_ftl_dot_sche
 from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n.xyz/block/ftl/provisioner/v1beta1/plugin.proto\x12!xyz.block.ftl.provisioner.v1beta1\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\xe0\x01\n\x10ProvisionRequest\x12$\n\x0e\x66tl_cluster_id\x18\x01 \x01(\tR\x0c\x66tlClusterId\x12\x46\n\x0e\x64\x65sired_module\x18\x02 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\rdesiredModule\x12H\n\x0fprevious_module\x18\x03 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x0epreviousModule\x12\x14\n\x05kinds\x18\x04 \x03(\tR\x05kinds\"\x97\x02\n\x11ProvisionResponse\x12-\n\x12provisioning_token\x18\x01 \x01(\tR\x11provisioningToken\x12\x64\n\x06status\x18\x02 \x01(\x0e\x32L.xyz.block.ftl.provisioner.v1beta1.ProvisionResponse.ProvisionResponseStatusR\x06status\"m\n\x17ProvisionResponseStatus\x12)\n%PROVISION_RESPONSE_STATUS_UNSPECIFIED\x10\x00\x12\'\n#PROVISION_RESPONSE_STATUS_SUBMITTED\x10\x01\"\x86\x01\n\rStatusRequest\x12-\n\x12provisioning_token\x18\x01 \x01(\tR\x11provisioningToken\x12\x46\n\x0e\x64\x65sired_module\x18\x02 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\rdesiredModule\"\x9d\x03\n\x11ProvisioningEvent\x12_\n\x14module_runtime_event\x18\x01 \x01(\x0b\x32+.xyz.block.ftl.schema.v1.ModuleRuntimeEventH\x00R\x12moduleRuntimeEvent\x12\x65\n\x16\x64\x61tabase_runtime_event\x18\x02 \x01(\x0b\x32-.xyz.block.ftl.schema.v1.DatabaseRuntimeEventH\x00R\x14\x64\x61tabaseRuntimeEvent\x12\\\n\x13topic_runtime_event\x18\x03 \x01(\x0b\x32*.xyz.block.ftl.schema.v1.TopicRuntimeEventH\x00R\x11topicRuntimeEvent\x12Y\n\x12verb_runtime_event\x18\x04 \x01(\x0b\x32).xyz.block.ftl.schema.v1.VerbRuntimeEventH\x00R\x10verbRuntimeEventB\x07\n\x05value\"\x97\x03\n\x0eStatusResponse\x12\x61\n\x07running\x18\x01 \x01(\x0b\x32\x45.xyz.block.ftl.provisioner.v1beta1.StatusResponse.ProvisioningRunningH\x00R\x07running\x12\x61\n\x07success\x18\x02 \x01(\x0b\x32\x45.xyz.block.ftl.provisioner.v1beta1.StatusResponse.ProvisioningSuccessH\x00R\x07success\x1a\x15\n\x13ProvisioningRunning\x1a\x39\n\x12ProvisioningFailed\x12#\n\rerror_message\x18\x01 \x01(\tR\x0c\x65rrorMessage\x1a\x63\n\x13ProvisioningSuccess\x12L\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x34.xyz.block.ftl.provisioner.v1beta1.ProvisioningEventR\x06\x65ventsB\x08\n\x06status2\xc8\x02\n\x18ProvisionerPluginService\x12\x45\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\x12v\n\tProvision\x12\x33.xyz.block.ftl.provisioner.v1beta1.ProvisionRequest\x1a\x34.xyz.block.ftl.provisioner.v1beta1.ProvisionResponse\x12m\n\x06Status\x12\x30.xyz.block.ftl.provisioner.v1beta1.StatusRequest\x1a\x31.xyz.block.ftl.provisioner.v1beta1.StatusResponseBWP\x01ZSgithub.com/block/ftl/backend/protos/xyz/block/ftl/provisioner/v1beta1;provisionerpbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n.xyz/block/ftl/provisioner/v1beta1/plugin.proto\x12!xyz.block.ftl.provisioner.v1beta1\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\xe0\x01\n\x10ProvisionRequest\x12$\n\x0e\x66tl_cluster_id\x18\x01 \x01(\tR\x0c\x66tlClusterId\x12\x46\n\x0e\x64\x65sired_module\x18\x02 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\rdesiredModule\x12H\n\x0fprevious_module\x18\x03 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x0epreviousModule\x12\x14\n\x05kinds\x18\x04 \x03(\tR\x05kinds\"\x97\x02\n\x11ProvisionResponse\x12-\n\x12provisioning_token\x18\x01 \x01(\tR\x11provisioningToken\x12\x64\n\x06status\x18\x02 \x01(\x0e\x32L.xyz.block.ftl.provisioner.v1beta1.ProvisionResponse.ProvisionResponseStatusR\x06status\"m\n\x17ProvisionResponseStatus\x12)\n%PROVISION_RESPONSE_STATUS_UNSPECIFIED\x10\x00\x12\'\n#PROVISION_RESPONSE_STATUS_SUBMITTED\x10\x01\"\x86\x01\n\rStatusRequest\x12-\n\x12provisioning_token\x18\x01 \x01(\tR\x11provisioningToken\x12\x46\n\x0e\x64\x65sired_module\x18\x02 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\rdesiredModule\"\xe3\x04\n\x11ProvisioningEvent\x12_\n\x14module_runtime_event\x18\x01 \x01(\x0b\x32+.xyz.block.ftl.schema.v1.ModuleRuntimeEventH\x00R\x12moduleRuntimeEvent\x12\x65\n\x16\x64\x61tabase_runtime_event\x18\x02 \x01(\x0b\x32-.xyz.block.ftl.schema.v1.DatabaseRuntimeEventH\x00R\x14\x64\x61tabaseRuntimeEvent\x12\\\n\x13topic_runtime_event\x18\x03 \x01(\x0b\x32*.xyz.block.ftl.schema.v1.TopicRuntimeEventH\x00R\x11topicRuntimeEvent\x12Y\n\x12verb_runtime_event\x18\x04 \x01(\x0b\x32).xyz.block.ftl.schema.v1.VerbRuntimeEventH\x00R\x10verbRuntimeEvent\x12S\n\x10kv_runtime_event\x18\x05 \x01(\x0b\x32\'.xyz.block.ftl.schema.v1.KVRuntimeEventH\x00R\x0ekvRuntimeEvent\x12o\n\x1aobject_store_runtime_event\x18\x06 \x01(\x0b\x32\x30.xyz.block.ftl.schema.v1.ObjectStoreRuntimeEventH\x00R\x17objectStoreRuntimeEventB\x07\n\x05value\"\x97\x03\n\x0eStatusResponse\x12\x61\n\x07running\x18\x01 \x01(\x0b\x32\x45.xyz.block.ftl.provisioner.v1beta1.StatusResponse.ProvisioningRunningH\x00R\x07running\x12\x61\n\x07success\x18\x02 \x01(\x0b\x32\x45.xyz.block.ftl.provisioner.v1beta1.StatusResponse.ProvisioningSuccessH\x00R\x07success\x1a\x15\n\x13ProvisioningRunning\x1a\x39\n\x12ProvisioningFailed\x12#\n\rerror_message\x18\x01 \x01(\tR\x0c\x65rrorMessage\x1a\x63\n\x13ProvisioningSuccess\x12L\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x34.xyz.block.ftl.provisioner.v1beta1.ProvisioningEventR\x06\x65ventsB\x08\n\x06status2\xc8\x02\n\x18ProvisionerPluginService\x12\x45\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\x12v\n\tProvision\x12\x33.xyz.block.ftl.provisioner.v1beta1.ProvisionRequest\x1a\x34.xyz.block.ftl.provisioner.v1beta1.ProvisionResponse\x12m\n\x06Status\x12\x30.xyz.block.ftl.provisioner.v1beta1.StatusRequest\x1a\x31.xyz.block.ftl.provisioner.v1beta1.StatusResponseBWP\x01ZSgithub.com/block/ftl/backend/protos/xyz/block/ftl/provisioner/v1beta1;provisionerpbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -43,15 +43,15 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_STATUSREQUEST']._serialized_start=661
   _globals['_STATUSREQUEST']._serialized_end=795
   _globals['_PROVISIONINGEVENT']._serialized_start=798
-  _globals['_PROVISIONINGEVENT']._serialized_end=1211
-  _globals['_STATUSRESPONSE']._serialized_start=1214
-  _globals['_STATUSRESPONSE']._serialized_end=1621
-  _globals['_STATUSRESPONSE_PROVISIONINGRUNNING']._serialized_start=1430
-  _globals['_STATUSRESPONSE_PROVISIONINGRUNNING']._serialized_end=1451
-  _globals['_STATUSRESPONSE_PROVISIONINGFAILED']._serialized_start=1453
-  _globals['_STATUSRESPONSE_PROVISIONINGFAILED']._serialized_end=1510
-  _globals['_STATUSRESPONSE_PROVISIONINGSUCCESS']._serialized_start=1512
-  _globals['_STATUSRESPONSE_PROVISIONINGSUCCESS']._serialized_end=1611
-  _globals['_PROVISIONERPLUGINSERVICE']._serialized_start=1624
-  _globals['_PROVISIONERPLUGINSERVICE']._serialized_end=1952
+  _globals['_PROVISIONINGEVENT']._serialized_end=1409
+  _globals['_STATUSRESPONSE']._serialized_start=1412
+  _globals['_STATUSRESPONSE']._serialized_end=1819
+  _globals['_STATUSRESPONSE_PROVISIONINGRUNNING']._serialized_start=1628
+  _globals['_STATUSRESPONSE_PROVISIONINGRUNNING']._serialized_end=1649
+  _globals['_STATUSRESPONSE_PROVISIONINGFAILED']._serialized_start=1651
+  _globals['_STATUSRESPONSE_PROVISIONINGFAILED']._serialized_end=1708
+  _globals['_STATUSRESPONSE_PROVISIONINGSUCCESS']._serialized_start=1710
+  _globals['_STATUSRESPONSE_PROVISIONINGSUCCESS']._serialized_end=1809
+  _globals['_PROVISIONERPLUGINSERVICE']._serialized_start=1822
+  _globals['_PROVISIONERPLUGINSERVICE']._serialized_end=2150
 # @@protoc_insertion_point(module_scope)
@@ -62,16 +62,18 @@ class Bytes(_message.Message):
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ...) -> None: ...
 
 class Config(_message.Message):
-    __slots__ = ("pos", "comments", "name", "type")
+    __slots__ = ("pos", "comments", "name", "type", "default")
     POS_FIELD_NUMBER: _ClassVar[int]
     COMMENTS_FIELD_NUMBER: _ClassVar[int]
     NAME_FIELD_NUMBER: _ClassVar[int]
     TYPE_FIELD_NUMBER: _ClassVar[int]
+    DEFAULT_FIELD_NUMBER: _ClassVar[int]
     pos: Position
     comments: _containers.RepeatedScalarFieldContainer[str]
     name: str
     type: Type
-    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., comments: _Optional[_Iterable[str]] = ..., name: _Optional[str] = ..., type: _Optional[_Union[Type, _Mapping]] = ...) -> None: ...
+    default: str
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., comments: _Optional[_Iterable[str]] = ..., name: _Optional[str] = ..., type: _Optional[_Union[Type, _Mapping]] = ..., default: _Optional[str] = ...) -> None: ...
 
 class DSNDatabaseConnector(_message.Message):
     __slots__ = ("pos", "dsn")
@@ -116,12 +118,14 @@ class Database(_message.Message):
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., runtime: _Optional[_Union[DatabaseRuntime, _Mapping]] = ..., comments: _Optional[_Iterable[str]] = ..., type: _Optional[str] = ..., name: _Optional[str] = ..., metadata: _Optional[_Iterable[_Union[Metadata, _Mapping]]] = ...) -> None: ...
 
 class DatabaseConnector(_message.Message):
-    __slots__ = ("awsiam_auth_database_connector", "dsn_database_connector")
+    __slots__ = ("awsiam_auth_database_connector", "dsn_database_connector", "vault_database_connector")
     AWSIAM_AUTH_DATABASE_CONNECTOR_FIELD_NUMBER: _ClassVar[int]
     DSN_DATABASE_CONNECTOR_FIELD_NUMBER: _ClassVar[int]
+    VAULT_DATABASE_CONNECTOR_FIELD_NUMBER: _ClassVar[int]
     awsiam_auth_database_connector: AWSIAMAuthDatabaseConnector
     dsn_database_connector: DSNDatabaseConnector
-    def __init__(self, awsiam_auth_database_connector: _Optional[_Union[AWSIAMAuthDatabaseConnector, _Mapping]] = ..., dsn_database_connector: _Optional[_Union[DSNDatabaseConnector, _Mapping]] = ...) -> None: ...
+    vault_database_connector: VaultDatabaseConnector
+    def __init__(self, awsiam_auth_database_connector: _Optional[_Union[AWSIAMAuthDatabaseConnector, _Mapping]] = ..., dsn_database_connector: _Optional[_Union[DSNDatabaseConnector, _Mapping]] = ..., vault_database_connector: _Optional[_Union[VaultDatabaseConnector, _Mapping]] = ...) -> None: ...
 
 class DatabaseRuntime(_message.Message):
     __slots__ = ("connections",)
@@ -158,11 +162,13 @@ class DatabaseRuntimeEventPayload(_message.Message):
     def __init__(self, database_runtime_connections_event: _Optional[_Union[DatabaseRuntimeConnectionsEvent, _Mapping]] = ...) -> None: ...
 
 class Decl(_message.Message):
-    __slots__ = ("config", "data", "database", "enum", "secret", "topic", "type_alias", "verb")
+    __slots__ = ("config", "data", "database", "enum", "kv", "object_store", "secret", "topic", "type_alias", "verb")
     CONFIG_FIELD_NUMBER: _ClassVar[int]
     DATA_FIELD_NUMBER: _ClassVar[int]
     DATABASE_FIELD_NUMBER: _ClassVar[int]
     ENUM_FIELD_NUMBER: _ClassVar[int]
+    KV_FIELD_NUMBER: _ClassVar[int]
+    OBJECT_STORE_FIELD_NUMBER: _ClassVar[int]
     SECRET_FIELD_NUMBER: _ClassVar[int]
     TOPIC_FIELD_NUMBER: _ClassVar[int]
     TYPE_ALIAS_FIELD_NUMBER: _ClassVar[int]
@@ -171,11 +177,13 @@ class Decl(_message.Message):
     data: Data
     database: Database
     enum: Enum
+    kv: KV
+    object_store: ObjectStore
     secret: Secret
     topic: Topic
     type_alias: TypeAlias
     verb: Verb
-    def __init__(self, config: _Optional[_Union[Config, _Mapping]] = ..., data: _Optional[_Union[Data, _Mapping]] = ..., database: _Optional[_Union[Database, _Mapping]] = ..., enum: _Optional[_Union[Enum, _Mapping]] = ..., secret: _Optional[_Union[Secret, _Mapping]] = ..., topic: _Optional[_Union[Topic, _Mapping]] = ..., type_alias: _Optional[_Union[TypeAlias, _Mapping]] = ..., verb: _Optional[_Union[Verb, _Mapping]] = ...) -> None: ...
+    def __init__(self, config: _Optional[_Union[Config, _Mapping]] = ..., data: _Optional[_Union[Data, _Mapping]] = ..., database: _Optional[_Union[Database, _Mapping]] = ..., enum: _Optional[_Union[Enum, _Mapping]] = ..., kv: _Optional[_Union[KV, _Mapping]] = ..., object_store: _Optional[_Union[ObjectStore, _Mapping]] = ..., secret: _Optional[_Union[Secret, _Mapping]] = ..., topic: _Optional[_Union[Topic, _Mapping]] = ..., type_alias: _Optional[_Union[TypeAlias, _Mapping]] = ..., verb: _Optional[_Union[Verb, _Mapping]] = ...) -> None: ...
 
 class Enum(_message.Message):
     __slots__ = ("pos", "comments", "export", "name", "type", "variants")
@@ -263,6 +271,36 @@ class IntValue(_message.Message):
     value: int
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., value: _Optional[int] = ...) -> None: ...
 
+class KV(_message.Message):
+    __slots__ = ("pos", "runtime", "comments", "name", "key", "value")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    COMMENTS_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    runtime: KVRuntime
+    comments: _containers.RepeatedScalarFieldContainer[str]
+    name: str
+    key: Type
+    value: Type
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., runtime: _Optional[_Union[KVRuntime, _Mapping]] = ..., comments: _Optional[_Iterable[str]] = ..., name: _Optional[str] = ..., key: _Optional[_Union[Type, _Mapping]] = ..., value: _Optional[_Union[Type, _Mapping]] = ...) -> None: ...
+
+class KVRuntime(_message.Message):
+    __slots__ = ("path",)
+    PATH_FIELD_NUMBER: _ClassVar[int]
+    path: str
+    def __init__(self, path: _Optional[str] = ...) -> None: ...
+
+class KVRuntimeEvent(_message.Message):
+    __slots__ = ("id", "payload")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    PAYLOAD_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    payload: KVRuntime
+    def __init__(self, id: _Optional[str] = ..., payload: _Optional[_Union[KVRuntime, _Mapping]] = ...) -> None: ...
+
 class Map(_message.Message):
     __slots__ = ("pos", "key", "value")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -274,36 +312,48 @@ class Map(_message.Message):
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., key: _Optional[_Union[Type, _Mapping]] = ..., value: _Optional[_Union[Type, _Mapping]] = ...) -> None: ...
 
 class Metadata(_message.Message):
-    __slots__ = ("alias", "artefact", "calls", "config", "cron_job", "databases", "encoding", "ingress", "publisher", "retry", "sql_migration", "secrets", "subscriber", "type_map")
+    __slots__ = ("alias", "artefact", "calls", "circuit_breaker", "config", "cron_job", "databases", "encoding", "idempotent", "ingress", "kv", "object_store", "publisher", "retry", "sql_migration", "secrets", "sensitive", "subscriber", "timeout", "type_map")
     ALIAS_FIELD_NUMBER: _ClassVar[int]
     ARTEFACT_FIELD_NUMBER: _ClassVar[int]
     CALLS_FIELD_NUMBER: _ClassVar[int]
+    CIRCUIT_BREAKER_FIELD_NUMBER: _ClassVar[int]
     CONFIG_FIELD_NUMBER: _ClassVar[int]
     CRON_JOB_FIELD_NUMBER: _ClassVar[int]
     DATABASES_FIELD_NUMBER: _ClassVar[int]
     ENCODING_FIELD_NUMBER: _ClassVar[int]
+    IDEMPOTENT_FIELD_NUMBER: _ClassVar[int]
     INGRESS_FIELD_NUMBER: _ClassVar[int]
+    KV_FIELD_NUMBER: _ClassVar[int]
+    OBJECT_STORE_FIELD_NUMBER: _ClassVar[int]
     PUBLISHER_FIELD_NUMBER: _ClassVar[int]
     RETRY_FIELD_NUMBER: _ClassVar[int]
     SQL_MIGRATION_FIELD_NUMBER: _ClassVar[int]
     SECRETS_FIELD_NUMBER: _ClassVar[int]
+    SENSITIVE_FIELD_NUMBER: _ClassVar[int]
     SUBSCRIBER_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_FIELD_NUMBER: _ClassVar[int]
     TYPE_MAP_FIELD_NUMBER: _ClassVar[int]
     alias: MetadataAlias
     artefact: MetadataArtefact
     calls: MetadataCalls
+    circuit_breaker: MetadataCircuitBreaker
     config: MetadataConfig
     cron_job: MetadataCronJob
     databases: MetadataDatabases
     encoding: MetadataEncoding
+    idempotent: MetadataIdempotent
     ingress: MetadataIngress
+    kv: MetadataKV
+    object_store: MetadataObjectStore
     publisher: MetadataPublisher
     retry: MetadataRetry
     sql_migration: MetadataSQLMigration
     secrets: MetadataSecrets
+    sensitive: MetadataSensitive
     subscriber: MetadataSubscriber
+    timeout: MetadataTimeout
     type_map: MetadataTypeMap
-    def __init__(self, alias: _Optional[_Union[MetadataAlias, _Mapping]] = ..., artefact: _Optional[_Union[MetadataArtefact, _Mapping]] = ..., calls: _Optional[_Union[MetadataCalls, _Mapping]] = ..., config: _Optional[_Union[MetadataConfig, _Mapping]] = ..., cron_job: _Optional[_Union[MetadataCronJob, _Mapping]] = ..., databases: _Optional[_Union[MetadataDatabases, _Mapping]] = ..., encoding: _Optional[_Union[MetadataEncoding, _Mapping]] = ..., ingress: _Optional[_Union[MetadataIngress, _Mapping]] = ..., publisher: _Optional[_Union[MetadataPublisher, _Mapping]] = ..., retry: _Optional[_Union[MetadataRetry, _Mapping]] = ..., sql_migration: _Optional[_Union[MetadataSQLMigration, _Mapping]] = ..., secrets: _Optional[_Union[MetadataSecrets, _Mapping]] = ..., subscriber: _Optional[_Union[MetadataSubscriber, _Mapping]] = ..., type_map: _Optional[_Union[MetadataTypeMap, _Mapping]] = ...) -> None: ...
+    def __init__(self, alias: _Optional[_Union[MetadataAlias, _Mapping]] = ..., artefact: _Optional[_Union[MetadataArtefact, _Mapping]] = ..., calls: _Optional[_Union[MetadataCalls, _Mapping]] = ..., circuit_breaker: _Optional[_Union[MetadataCircuitBreaker, _Mapping]] = ..., config: _Optional[_Union[MetadataConfig, _Mapping]] = ..., cron_job: _Optional[_Union[MetadataCronJob, _Mapping]] = ..., databases: _Optional[_Union[MetadataDatabases, _Mapping]] = ..., encoding: _Optional[_Union[MetadataEncoding, _Mapping]] = ..., idempotent: _Optional[_Union[MetadataIdempotent, _Mapping]] = ..., ingress: _Optional[_Union[MetadataIngress, _Mapping]] = ..., kv: _Optional[_Union[MetadataKV, _Mapping]] = ..., object_store: _Optional[_Union[MetadataObjectStore, _Mapping]] = ..., publisher: _Optional[_Union[MetadataPublisher, _Mapping]] = ..., retry: _Optional[_Union[MetadataRetry, _Mapping]] = ..., sql_migration: _Optional[_Union[MetadataSQLMigration, _Mapping]] = ..., secrets: _Optional[_Union[MetadataSecrets, _Mapping]] = ..., sensitive: _Optional[_Union[MetadataSensitive, _Mapping]] = ..., subscriber: _Optional[_Union[MetadataSubscriber, _Mapping]] = ..., timeout: _Optional[_Union[MetadataTimeout, _Mapping]] = ..., type_map: _Optional[_Union[MetadataTypeMap, _Mapping]] = ...) -> None: ...
 
 class MetadataAlias(_message.Message):
     __slots__ = ("pos", "kind", "alias")
@@ -335,6 +385,18 @@ class MetadataCalls(_message.Message):
     calls: _containers.RepeatedCompositeFieldContainer[Ref]
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., calls: _Optional[_Iterable[_Union[Ref, _Mapping]]] = ...) -> None: ...
 
+class MetadataCircuitBreaker(_message.Message):
+    __slots__ = ("pos", "error_percent", "latency", "cooldown")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_PERCENT_FIELD_NUMBER: _ClassVar[int]
+    LATENCY_FIELD_NUMBER: _ClassVar[int]
+    COOLDOWN_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    error_percent: int
+    latency: str
+    cooldown: str
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., error_percent: _Optional[int] = ..., latency: _Optional[str] = ..., cooldown: _Optional[str] = ...) -> None: ...
+
 class MetadataConfig(_message.Message):
     __slots__ = ("pos", "config")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -369,6 +431,14 @@ class MetadataEncoding(_message.Message):
     lenient: bool
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., type: _Optional[str] = ..., lenient: bool = ...) -> None: ...
 
+class MetadataIdempotent(_message.Message):
+    __slots__ = ("pos", "window")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    window: str
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., window: _Optional[str] = ...) -> None: ...
+
 class MetadataIngress(_message.Message):
     __slots__ = ("pos", "type", "method", "path")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -381,6 +451,22 @@ class MetadataIngress(_message.Message):
     path: _containers.RepeatedCompositeFieldContainer[IngressPathComponent]
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., type: _Optional[str] = ..., method: _Optional[str] = ..., path: _Optional[_Iterable[_Union[IngressPathComponent, _Mapping]]] = ...) -> None: ...
 
+class MetadataKV(_message.Message):
+    __slots__ = ("pos", "calls")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    CALLS_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    calls: _containers.RepeatedCompositeFieldContainer[Ref]
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., calls: _Optional[_Iterable[_Union[Ref, _Mapping]]] = ...) -> None: ...
+
+class MetadataObjectStore(_message.Message):
+    __slots__ = ("pos", "calls")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    CALLS_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    calls: _containers.RepeatedCompositeFieldContainer[Ref]
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., calls: _Optional[_Iterable[_Union[Ref, _Mapping]]] = ...) -> None: ...
+
 class MetadataPublisher(_message.Message):
     __slots__ = ("pos", "topics")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -419,6 +505,12 @@ class MetadataSecrets(_message.Message):
     secrets: _containers.RepeatedCompositeFieldContainer[Ref]
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., secrets: _Optional[_Iterable[_Union[Ref, _Mapping]]] = ...) -> None: ...
 
+class MetadataSensitive(_message.Message):
+    __slots__ = ("pos",)
+    POS_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ...) -> None: ...
+
 class MetadataSubscriber(_message.Message):
     __slots__ = ("pos", "topic", "from_offset", "dead_letter")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -431,6 +523,14 @@ class MetadataSubscriber(_message.Message):
     dead_letter: bool
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., topic: _Optional[_Union[Ref, _Mapping]] = ..., from_offset: _Optional[_Union[FromOffset, str]] = ..., dead_letter: bool = ...) -> None: ...
 
+class MetadataTimeout(_message.Message):
+    __slots__ = ("pos", "duration")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    DURATION_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    duration: str
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., duration: _Optional[str] = ...) -> None: ...
+
 class MetadataTypeMap(_message.Message):
     __slots__ = ("pos", "runtime", "native_name")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -507,6 +607,36 @@ class ModuleRuntimeScaling(_message.Message):
     min_replicas: int
     def __init__(self, min_replicas: _Optional[int] = ...) -> None: ...
 
+class ObjectStore(_message.Message):
+    __slots__ = ("pos", "runtime", "comments", "name")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    COMMENTS_FIELD_NUMBER: _ClassVar[int]
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    runtime: ObjectStoreRuntime
+    comments: _containers.RepeatedScalarFieldContainer[str]
+    name: str
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., runtime: _Optional[_Union[ObjectStoreRuntime, _Mapping]] = ..., comments: _Optional[_Iterable[str]] = ..., name: _Optional[str] = ...) -> None: ...
+
+class ObjectStoreRuntime(_message.Message):
+    __slots__ = ("endpoint", "bucket", "region")
+    ENDPOINT_FIELD_NUMBER: _ClassVar[int]
+    BUCKET_FIELD_NUMBER: _ClassVar[int]
+    REGION_FIELD_NUMBER: _ClassVar[int]
+    endpoint: str
+    bucket: str
+    region: str
+    def __init__(self, endpoint: _Optional[str] = ..., bucket: _Optional[str] = ..., region: _Optional[str] = ...) -> None: ...
+
+class ObjectStoreRuntimeEvent(_message.Message):
+    __slots__ = ("id", "payload")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    PAYLOAD_FIELD_NUMBER: _ClassVar[int]
+    id: str
+    payload: ObjectStoreRuntime
+    def __init__(self, id: _Optional[str] = ..., payload: _Optional[_Union[ObjectStoreRuntime, _Mapping]] = ...) -> None: ...
+
 class Optional(_message.Message):
     __slots__ = ("pos", "type")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -538,20 +668,24 @@ class Ref(_message.Message):
     def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., module: _Optional[str] = ..., name: _Optional[str] = ..., type_parameters: _Optional[_Iterable[_Union[Type, _Mapping]]] = ...) -> None: ...
 
 class RuntimeEvent(_message.Message):
-    __slots__ = ("database_runtime_event", "module_runtime_base", "module_runtime_deployment", "module_runtime_scaling", "topic_runtime_event", "verb_runtime_event")
+    __slots__ = ("database_runtime_event", "kv_runtime_event", "module_runtime_base", "module_runtime_deployment", "module_runtime_scaling", "object_store_runtime_event", "topic_runtime_event", "verb_runtime_event")
     DATABASE_RUNTIME_EVENT_FIELD_NUMBER: _ClassVar[int]
+    KV_RUNTIME_EVENT_FIELD_NUMBER: _ClassVar[int]
     MODULE_RUNTIME_BASE_FIELD_NUMBER: _ClassVar[int]
     MODULE_RUNTIME_DEPLOYMENT_FIELD_NUMBER: _ClassVar[int]
     MODULE_RUNTIME_SCALING_FIELD_NUMBER: _ClassVar[int]
+    OBJECT_STORE_RUNTIME_EVENT_FIELD_NUMBER: _ClassVar[int]
     TOPIC_RUNTIME_EVENT_FIELD_NUMBER: _ClassVar[int]
     VERB_RUNTIME_EVENT_FIELD_NUMBER: _ClassVar[int]
     database_runtime_event: DatabaseRuntimeEvent
+    kv_runtime_event: KVRuntimeEvent
     module_runtime_base: ModuleRuntimeBase
     module_runtime_deployment: ModuleRuntimeDeployment
     module_runtime_scaling: ModuleRuntimeScaling
+    object_store_runtime_event: ObjectStoreRuntimeEvent
     topic_runtime_event: TopicRuntimeEvent
     verb_runtime_event: VerbRuntimeEvent
-    def __init__(self, database_runtime_event: _Optional[_Union[DatabaseRuntimeEvent, _Mapping]] = ..., module_runtime_base: _Optional[_Union[ModuleRuntimeBase, _Mapping]] = ..., module_runtime_deployment: _Optional[_Union[ModuleRuntimeDeployment, _Mapping]] = ..., module_runtime_scaling: _Optional[_Union[ModuleRuntimeScaling, _Mapping]] = ..., topic_runtime_event: _Optional[_Union[TopicRuntimeEvent, _Mapping]] = ..., verb_runtime_event: _Optional[_Union[VerbRuntimeEvent, _Mapping]] = ...) -> None: ...
+    def __init__(self, database_runtime_event: _Optional[_Union[DatabaseRuntimeEvent, _Mapping]] = ..., kv_runtime_event: _Optional[_Union[KVRuntimeEvent, _Mapping]] = ..., module_runtime_base: _Optional[_Union[ModuleRuntimeBase, _Mapping]] = ..., module_runtime_deployment: _Optional[_Union[ModuleRuntimeDeployment, _Mapping]] = ..., module_runtime_scaling: _Optional[_Union[ModuleRuntimeScaling, _Mapping]] = ..., object_store_runtime_event: _Optional[_Union[ObjectStoreRuntimeEvent, _Mapping]] = ..., topic_runtime_event: _Optional[_Union[TopicRuntimeEvent, _Mapping]] = ..., verb_runtime_event: _Optional[_Union[VerbRuntimeEvent, _Mapping]] = ...) -> None: ...
 
 class Schema(_message.Message):
     __slots__ = ("pos", "modules")
@@ -701,6 +835,20 @@ class Value(_message.Message):
     type_value: TypeValue
     def __init__(self, int_value: _Optional[_Union[IntValue, _Mapping]] = ..., string_value: _Optional[_Union[StringValue, _Mapping]] = ..., type_value: _Optional[_Union[TypeValue, _Mapping]] = ...) -> None: ...
 
+class VaultDatabaseConnector(_message.Message):
+    __slots__ = ("pos", "endpoint", "database", "mount", "role")
+    POS_FIELD_NUMBER: _ClassVar[int]
+    ENDPOINT_FIELD_NUMBER: _ClassVar[int]
+    DATABASE_FIELD_NUMBER: _ClassVar[int]
+    MOUNT_FIELD_NUMBER: _ClassVar[int]
+    ROLE_FIELD_NUMBER: _ClassVar[int]
+    pos: Position
+    endpoint: str
+    database: str
+    mount: str
+    role: str
+    def __init__(self, pos: _Optional[_Union[Position, _Mapping]] = ..., endpoint: _Optional[str] = ..., database: _Optional[str] = ..., mount: _Optional[str] = ..., role: _Optional[str] = ...) -> None: ...
+
 class Verb(_message.Message):
     __slots__ = ("pos", "comments", "export", "name", "request", "response", "metadata", "runtime")
     POS_FIELD_NUMBER: _ClassVar[int]
@@ -754,7 +902,11 @@ class VerbRuntimePayload(_message.Message):
     def __init__(self, verb_runtime_base: _Optional[_Union[VerbRuntimeBase, _Mapping]] = ..., verb_runtime_subscription: _Optional[_Union[VerbRuntimeSubscription, _Mapping]] = ...) -> None: ...
 
 class VerbRuntimeSubscription(_message.Message):
-    __slots__ = ("kafka_brokers",)
+    __slots__ = ("kafka_brokers", "topic_id", "consumer_group_id")
     KAFKA_BROKERS_FIELD_NUMBER: _ClassVar[int]
+    TOPIC_ID_FIELD_NUMBER: _ClassVar[int]
+    CONSUMER_GROUP_ID_FIELD_NUMBER: _ClassVar[int]
     kafka_brokers: _containers.RepeatedScalarFieldContainer[str]
-    def __init__(self, kafka_brokers: _Optional[_Iterable[str]] = ...) -> None: ...
+    topic_id: str
+    consumer_group_id: str
+    def __init__(self, kafka_brokers: _Optional[_Iterable[str]] = ..., topic_id: _Optional[str] = ..., consumer_group_id: _Optional[str] = ...) -> None: ...
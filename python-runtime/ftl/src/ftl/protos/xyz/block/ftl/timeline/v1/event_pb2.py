@@ -25,9 +25,11 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import duration_pb2 as google_dot_protobuf_dot_duration__pb2
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 from xyz.block.ftl.schema.v1 import schema_pb2 as xyz_dot_block_dot_ftl_dot_schema_dot_v1_dot_schema__pb2
+from xyz.block.ftl.v1 import circuitbreaker_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_circuitbreaker__pb2
+from xyz.block.ftl.v1 import confighistory_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_confighistory__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n%xyz/block/ftl/timeline/v1/event.proto\x12\x19xyz.block.ftl.timeline.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\"\xb6\x03\n\x08LogEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n\tlog_level\x18\x04 \x01(\x05R\x08logLevel\x12S\n\nattributes\x18\x05 \x03(\x0b\x32\x33.xyz.block.ftl.timeline.v1.LogEvent.AttributesEntryR\nattributes\x12\x18\n\x07message\x18\x06 \x01(\tR\x07message\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x12\x19\n\x05stack\x18\x08 \x01(\tH\x02R\x05stack\x88\x01\x01\x1a=\n\x0f\x41ttributesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_errorB\x08\n\x06_stack\"\x94\x04\n\tCallEvent\x12$\n\x0brequest_key\x18\x01 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12%\n\x0e\x64\x65ployment_key\x18\x02 \x01(\tR\rdeploymentKey\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12I\n\x0fsource_verb_ref\x18\x0b \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefH\x01R\rsourceVerbRef\x88\x01\x01\x12N\n\x14\x64\x65stination_verb_ref\x18\x0c \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x12\x64\x65stinationVerbRef\x12\x35\n\x08\x64uration\x18\x06 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x18\n\x07request\x18\x07 \x01(\tR\x07request\x12\x1a\n\x08response\x18\x08 \x01(\tR\x08response\x12\x19\n\x05\x65rror\x18\t \x01(\tH\x02R\x05\x65rror\x88\x01\x01\x12\x19\n\x05stack\x18\n \x01(\tH\x03R\x05stack\x88\x01\x01\x42\x0e\n\x0c_request_keyB\x12\n\x10_source_verb_refB\x08\n\x06_errorB\x08\n\x06_stackJ\x04\x08\x04\x10\x05J\x04\x08\x05\x10\x06\"\xb8\x01\n\x16\x44\x65ploymentCreatedEvent\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08language\x18\x02 \x01(\tR\x08language\x12\x1f\n\x0bmodule_name\x18\x03 \x01(\tR\nmoduleName\x12!\n\x0cmin_replicas\x18\x04 \x01(\x05R\x0bminReplicas\x12\x1f\n\x08replaced\x18\x05 \x01(\tH\x00R\x08replaced\x88\x01\x01\x42\x0b\n\t_replaced\"y\n\x16\x44\x65ploymentUpdatedEvent\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\x12*\n\x11prev_min_replicas\x18\x03 \x01(\x05R\x0fprevMinReplicas\"\x8d\x04\n\x0cIngressEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x16\n\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n\x04path\x18\x05 \x01(\tR\x04path\x12\x1f\n\x0bstatus_code\x18\x07 \x01(\x05R\nstatusCode\x12\x38\n\ttimestamp\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\t \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x18\n\x07request\x18\n \x01(\tR\x07request\x12%\n\x0erequest_header\x18\x0b \x01(\tR\rrequestHeader\x12\x1a\n\x08response\x18\x0c \x01(\tR\x08response\x12\'\n\x0fresponse_header\x18\r \x01(\tR\x0eresponseHeader\x12\x19\n\x05\x65rror\x18\x0e \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xe5\x02\n\x12\x43ronScheduledEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12\x37\n\x08verb_ref\x18\x02 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12=\n\x0cscheduled_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x0bscheduledAt\x12\x1a\n\x08schedule\x18\x06 \x01(\tR\x08schedule\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x00R\x05\x65rror\x88\x01\x01\x42\x08\n\x06_error\"\x9b\x03\n\x11\x41syncExecuteEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x05 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12Z\n\x10\x61sync_event_type\x18\x06 \x01(\x0e\x32\x30.xyz.block.ftl.timeline.v1.AsyncExecuteEventTypeR\x0e\x61syncEventType\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xa6\x03\n\x12PubSubPublishEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x05 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x14\n\x05topic\x18\x06 \x01(\tR\x05topic\x12\x18\n\x07request\x18\x07 \x01(\tR\x07request\x12\x19\n\x05\x65rror\x18\x08 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x12\x1c\n\tpartition\x18\t \x01(\x05R\tpartition\x12\x16\n\x06offset\x18\n \x01(\x03R\x06offsetB\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xd5\x03\n\x12PubSubConsumeEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12-\n\x10\x64\x65st_verb_module\x18\x03 \x01(\tH\x01R\x0e\x64\x65stVerbModule\x88\x01\x01\x12)\n\x0e\x64\x65st_verb_name\x18\x04 \x01(\tH\x02R\x0c\x64\x65stVerbName\x88\x01\x01\x12\x38\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x06 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x14\n\x05topic\x18\x07 \x01(\tR\x05topic\x12\x19\n\x05\x65rror\x18\x08 \x01(\tH\x03R\x05\x65rror\x88\x01\x01\x12\x1c\n\tpartition\x18\t \x01(\x05R\tpartition\x12\x16\n\x06offset\x18\n \x01(\x03R\x06offsetB\x0e\n\x0c_request_keyB\x13\n\x11_dest_verb_moduleB\x11\n\x0f_dest_verb_nameB\x08\n\x06_error\"\xb9\x06\n\x05\x45vent\x12\x38\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x0e\n\x02id\x18\x02 \x01(\x03R\x02id\x12\x37\n\x03log\x18\x03 \x01(\x0b\x32#.xyz.block.ftl.timeline.v1.LogEventH\x00R\x03log\x12:\n\x04\x63\x61ll\x18\x04 \x01(\x0b\x32$.xyz.block.ftl.timeline.v1.CallEventH\x00R\x04\x63\x61ll\x12\x62\n\x12\x64\x65ployment_created\x18\x05 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentCreatedEventH\x00R\x11\x64\x65ploymentCreated\x12\x62\n\x12\x64\x65ployment_updated\x18\x06 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentUpdatedEventH\x00R\x11\x64\x65ploymentUpdated\x12\x43\n\x07ingress\x18\x07 \x01(\x0b\x32\'.xyz.block.ftl.timeline.v1.IngressEventH\x00R\x07ingress\x12V\n\x0e\x63ron_scheduled\x18\x08 \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.CronScheduledEventH\x00R\rcronScheduled\x12S\n\rasync_execute\x18\t \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.AsyncExecuteEventH\x00R\x0c\x61syncExecute\x12V\n\x0epubsub_publish\x18\n \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubPublishEventH\x00R\rpubsubPublish\x12V\n\x0epubsub_consume\x18\x0b \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubConsumeEventH\x00R\rpubsubConsumeB\x07\n\x05\x65ntry*\xa9\x02\n\tEventType\x12\x1a\n\x16\x45VENT_TYPE_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45VENT_TYPE_LOG\x10\x01\x12\x13\n\x0f\x45VENT_TYPE_CALL\x10\x02\x12!\n\x1d\x45VENT_TYPE_DEPLOYMENT_CREATED\x10\x03\x12!\n\x1d\x45VENT_TYPE_DEPLOYMENT_UPDATED\x10\x04\x12\x16\n\x12\x45VENT_TYPE_INGRESS\x10\x05\x12\x1d\n\x19\x45VENT_TYPE_CRON_SCHEDULED\x10\x06\x12\x1c\n\x18\x45VENT_TYPE_ASYNC_EXECUTE\x10\x07\x12\x1d\n\x19\x45VENT_TYPE_PUBSUB_PUBLISH\x10\x08\x12\x1d\n\x19\x45VENT_TYPE_PUBSUB_CONSUME\x10\t*\x89\x01\n\x15\x41syncExecuteEventType\x12(\n$ASYNC_EXECUTE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12!\n\x1d\x41SYNC_EXECUTE_EVENT_TYPE_CRON\x10\x01\x12#\n\x1f\x41SYNC_EXECUTE_EVENT_TYPE_PUBSUB\x10\x02*\x8c\x01\n\x08LogLevel\x12\x19\n\x15LOG_LEVEL_UNSPECIFIED\x10\x00\x12\x13\n\x0fLOG_LEVEL_TRACE\x10\x01\x12\x13\n\x0fLOG_LEVEL_DEBUG\x10\x05\x12\x12\n\x0eLOG_LEVEL_INFO\x10\t\x12\x12\n\x0eLOG_LEVEL_WARN\x10\r\x12\x13\n\x0fLOG_LEVEL_ERROR\x10\x11\x42LP\x01ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1;timelinepbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n%xyz/block/ftl/timeline/v1/event.proto\x12\x19xyz.block.ftl.timeline.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a%xyz/block/ftl/v1/circuitbreaker.proto\x1a$xyz/block/ftl/v1/confighistory.proto\"\xb6\x03\n\x08LogEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1b\n\tlog_level\x18\x04 \x01(\x05R\x08logLevel\x12S\n\nattributes\x18\x05 \x03(\x0b\x32\x33.xyz.block.ftl.timeline.v1.LogEvent.AttributesEntryR\nattributes\x12\x18\n\x07message\x18\x06 \x01(\tR\x07message\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x12\x19\n\x05stack\x18\x08 \x01(\tH\x02R\x05stack\x88\x01\x01\x1a=\n\x0f\x41ttributesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_errorB\x08\n\x06_stack\"\xb1\x04\n\tCallEvent\x12$\n\x0brequest_key\x18\x01 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12%\n\x0e\x64\x65ployment_key\x18\x02 \x01(\tR\rdeploymentKey\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12I\n\x0fsource_verb_ref\x18\x0b \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefH\x01R\rsourceVerbRef\x88\x01\x01\x12N\n\x14\x64\x65stination_verb_ref\x18\x0c \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x12\x64\x65stinationVerbRef\x12\x35\n\x08\x64uration\x18\x06 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x18\n\x07request\x18\x07 \x01(\tR\x07request\x12\x1a\n\x08response\x18\x08 \x01(\tR\x08response\x12\x19\n\x05\x65rror\x18\t \x01(\tH\x02R\x05\x65rror\x88\x01\x01\x12\x19\n\x05stack\x18\n \x01(\tH\x03R\x05stack\x88\x01\x01\x12\x1b\n\ttimed_out\x18\r \x01(\x08R\x08timedOutB\x0e\n\x0c_request_keyB\x12\n\x10_source_verb_refB\x08\n\x06_errorB\x08\n\x06_stackJ\x04\x08\x04\x10\x05J\x04\x08\x05\x10\x06\"\xb8\x01\n\x16\x44\x65ploymentCreatedEvent\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08language\x18\x02 \x01(\tR\x08language\x12\x1f\n\x0bmodule_name\x18\x03 \x01(\tR\nmoduleName\x12!\n\x0cmin_replicas\x18\x04 \x01(\x05R\x0bminReplicas\x12\x1f\n\x08replaced\x18\x05 \x01(\tH\x00R\x08replaced\x88\x01\x01\x42\x0b\n\t_replaced\"y\n\x16\x44\x65ploymentUpdatedEvent\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\x12*\n\x11prev_min_replicas\x18\x03 \x01(\x05R\x0fprevMinReplicas\"\x8d\x04\n\x0cIngressEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x16\n\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n\x04path\x18\x05 \x01(\tR\x04path\x12\x1f\n\x0bstatus_code\x18\x07 \x01(\x05R\nstatusCode\x12\x38\n\ttimestamp\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\t \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x18\n\x07request\x18\n \x01(\tR\x07request\x12%\n\x0erequest_header\x18\x0b \x01(\tR\rrequestHeader\x12\x1a\n\x08response\x18\x0c \x01(\tR\x08response\x12\'\n\x0fresponse_header\x18\r \x01(\tR\x0eresponseHeader\x12\x19\n\x05\x65rror\x18\x0e \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xe5\x02\n\x12\x43ronScheduledEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12\x37\n\x08verb_ref\x18\x02 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x04 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12=\n\x0cscheduled_at\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\x0bscheduledAt\x12\x1a\n\x08schedule\x18\x06 \x01(\tR\x08schedule\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x00R\x05\x65rror\x88\x01\x01\x42\x08\n\x06_error\"\x9b\x03\n\x11\x41syncExecuteEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x05 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12Z\n\x10\x61sync_event_type\x18\x06 \x01(\x0e\x32\x30.xyz.block.ftl.timeline.v1.AsyncExecuteEventTypeR\x0e\x61syncEventType\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xa6\x03\n\x12PubSubPublishEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x05 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x14\n\x05topic\x18\x06 \x01(\tR\x05topic\x12\x18\n\x07request\x18\x07 \x01(\tR\x07request\x12\x19\n\x05\x65rror\x18\x08 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x12\x1c\n\tpartition\x18\t \x01(\x05R\tpartition\x12\x16\n\x06offset\x18\n \x01(\x03R\x06offsetB\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xd5\x03\n\x12PubSubConsumeEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12-\n\x10\x64\x65st_verb_module\x18\x03 \x01(\tH\x01R\x0e\x64\x65stVerbModule\x88\x01\x01\x12)\n\x0e\x64\x65st_verb_name\x18\x04 \x01(\tH\x02R\x0c\x64\x65stVerbName\x88\x01\x01\x12\x38\n\ttimestamp\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x06 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12\x14\n\x05topic\x18\x07 \x01(\tR\x05topic\x12\x19\n\x05\x65rror\x18\x08 \x01(\tH\x03R\x05\x65rror\x88\x01\x01\x12\x1c\n\tpartition\x18\t \x01(\x05R\tpartition\x12\x16\n\x06offset\x18\n \x01(\x03R\x06offsetB\x0e\n\x0c_request_keyB\x13\n\x11_dest_verb_moduleB\x11\n\x0f_dest_verb_nameB\x08\n\x06_error\"\xdf\x03\n\x0cKVWriteEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x37\n\x08verb_ref\x18\x03 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x35\n\x08\x64uration\x18\x05 \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x64uration\x12,\n\x02kv\x18\x06 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x02kv\x12I\n\toperation\x18\x07 \x01(\x0e\x32+.xyz.block.ftl.timeline.v1.KVWriteOperationR\toperation\x12\x10\n\x03key\x18\x08 \x01(\tR\x03key\x12\x18\n\x07\x61pplied\x18\t \x01(\x08R\x07\x61pplied\x12\x19\n\x05\x65rror\x18\n \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\xdf\x03\n\x13\x43ircuitBreakerEvent\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12\x37\n\x08verb_ref\x18\x02 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x07verbRef\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12L\n\x0eprevious_state\x18\x04 \x01(\x0e\x32%.xyz.block.ftl.v1.CircuitBreakerStateR\rpreviousState\x12;\n\x05state\x18\x05 \x01(\x0e\x32%.xyz.block.ftl.v1.CircuitBreakerStateR\x05state\x12\x1a\n\x08requests\x18\x06 \x01(\x03R\x08requests\x12\x1a\n\x08\x66\x61ilures\x18\x07 \x01(\x03R\x08\x66\x61ilures\x12\x18\n\x07service\x18\x08 \x01(\tR\x07service\x12\x35\n\x08\x63ooldown\x18\t \x01(\x0b\x32\x19.google.protobuf.DurationR\x08\x63ooldown\x12\x1a\n\x08instance\x18\n \x01(\tR\x08instance\"\xc3\x03\n\x11\x43onfigChangeEvent\x12.\n\x03ref\x18\x01 \x01(\x0b\x32\x1c.xyz.block.ftl.schema.v1.RefR\x03ref\x12\x16\n\x06secret\x18\x02 \x01(\x08R\x06secret\x12\x38\n\ttimestamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n\x05\x61\x63tor\x18\x04 \x01(\tR\x05\x61\x63tor\x12\x1a\n\x08provider\x18\x05 \x01(\tR\x08provider\x12\x45\n\toperation\x18\x06 \x01(\x0e\x32\'.xyz.block.ftl.v1.ConfigChangeOperationR\toperation\x12\x19\n\x05value\x18\x07 \x01(\x0cH\x00R\x05value\x88\x01\x01\x12%\n\x0b\x66ingerprint\x18\x08 \x01(\tH\x01R\x0b\x66ingerprint\x88\x01\x01\x12(\n\rrestored_from\x18\t \x01(\x03H\x02R\x0crestoredFrom\x88\x01\x01\x12\x1b\n\tchange_id\x18\n \x01(\x03R\x08\x63hangeIdB\x08\n\x06_valueB\x0e\n\x0c_fingerprintB\x10\n\x0e_restored_from\"\xd9\x01\n\x11\x41\x63\x63\x65ssDeniedEvent\x12\x38\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1c\n\tprocedure\x18\x02 \x01(\tR\tprocedure\x12\x1a\n\x08identity\x18\x03 \x01(\tR\x08identity\x12\x1e\n\npermission\x18\x04 \x01(\tR\npermission\x12\x18\n\x07modules\x18\x05 \x03(\tR\x07modules\x12\x16\n\x06reason\x18\x06 \x01(\tR\x06reason\"\x84\t\n\x05\x45vent\x12\x38\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x0e\n\x02id\x18\x02 \x01(\x03R\x02id\x12\x37\n\x03log\x18\x03 \x01(\x0b\x32#.xyz.block.ftl.timeline.v1.LogEventH\x00R\x03log\x12:\n\x04\x63\x61ll\x18\x04 \x01(\x0b\x32$.xyz.block.ftl.timeline.v1.CallEventH\x00R\x04\x63\x61ll\x12\x62\n\x12\x64\x65ployment_created\x18\x05 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentCreatedEventH\x00R\x11\x64\x65ploymentCreated\x12\x62\n\x12\x64\x65ployment_updated\x18\x06 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentUpdatedEventH\x00R\x11\x64\x65ploymentUpdated\x12\x43\n\x07ingress\x18\x07 \x01(\x0b\x32\'.xyz.block.ftl.timeline.v1.IngressEventH\x00R\x07ingress\x12V\n\x0e\x63ron_scheduled\x18\x08 \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.CronScheduledEventH\x00R\rcronScheduled\x12S\n\rasync_execute\x18\t \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.AsyncExecuteEventH\x00R\x0c\x61syncExecute\x12V\n\x0epubsub_publish\x18\n \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubPublishEventH\x00R\rpubsubPublish\x12V\n\x0epubsub_consume\x18\x0b \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubConsumeEventH\x00R\rpubsubConsume\x12\x44\n\x08kv_write\x18\x0c \x01(\x0b\x32\'.xyz.block.ftl.timeline.v1.KVWriteEventH\x00R\x07kvWrite\x12Y\n\x0f\x63ircuit_breaker\x18\r \x01(\x0b\x32..xyz.block.ftl.timeline.v1.CircuitBreakerEventH\x00R\x0e\x63ircuitBreaker\x12S\n\rconfig_change\x18\x0e \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.ConfigChangeEventH\x00R\x0c\x63onfigChange\x12S\n\raccess_denied\x18\x0f \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.AccessDeniedEventH\x00R\x0c\x61\x63\x63\x65ssDeniedB\x07\n\x05\x65ntry*\x9e\x03\n\tEventType\x12\x1a\n\x16\x45VENT_TYPE_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45VENT_TYPE_LOG\x10\x01\x12\x13\n\x0f\x45VENT_TYPE_CALL\x10\x02\x12!\n\x1d\x45VENT_TYPE_DEPLOYMENT_CREATED\x10\x03\x12!\n\x1d\x45VENT_TYPE_DEPLOYMENT_UPDATED\x10\x04\x12\x16\n\x12\x45VENT_TYPE_INGRESS\x10\x05\x12\x1d\n\x19\x45VENT_TYPE_CRON_SCHEDULED\x10\x06\x12\x1c\n\x18\x45VENT_TYPE_ASYNC_EXECUTE\x10\x07\x12\x1d\n\x19\x45VENT_TYPE_PUBSUB_PUBLISH\x10\x08\x12\x1d\n\x19\x45VENT_TYPE_PUBSUB_CONSUME\x10\t\x12\x17\n\x13\x45VENT_TYPE_KV_WRITE\x10\n\x12\x1e\n\x1a\x45VENT_TYPE_CIRCUIT_BREAKER\x10\x0b\x12\x1c\n\x18\x45VENT_TYPE_CONFIG_CHANGE\x10\x0c\x12\x1c\n\x18\x45VENT_TYPE_ACCESS_DENIED\x10\r*\xb2\x01\n\x15\x41syncExecuteEventType\x12(\n$ASYNC_EXECUTE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12!\n\x1d\x41SYNC_EXECUTE_EVENT_TYPE_CRON\x10\x01\x12#\n\x1f\x41SYNC_EXECUTE_EVENT_TYPE_PUBSUB\x10\x02\x12\'\n#ASYNC_EXECUTE_EVENT_TYPE_ASYNC_CALL\x10\x03*\x8c\x01\n\x08LogLevel\x12\x19\n\x15LOG_LEVEL_UNSPECIFIED\x10\x00\x12\x13\n\x0fLOG_LEVEL_TRACE\x10\x01\x12\x13\n\x0fLOG_LEVEL_DEBUG\x10\x05\x12\x12\n\x0eLOG_LEVEL_INFO\x10\t\x12\x12\n\x0eLOG_LEVEL_WARN\x10\r\x12\x13\n\x0fLOG_LEVEL_ERROR\x10\x11*\x9a\x01\n\x10KVWriteOperation\x12\"\n\x1eKV_WRITE_OPERATION_UNSPECIFIED\x10\x00\x12\x1a\n\x16KV_WRITE_OPERATION_PUT\x10\x01\x12\x1d\n\x19KV_WRITE_OPERATION_DELETE\x10\x02\x12\'\n#KV_WRITE_OPERATION_COMPARE_AND_SWAP\x10\x03\x42LP\x01ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1;timelinepbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -37,32 +39,42 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._serialized_options = b'P\001ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1;timelinepb'
   _globals['_LOGEVENT_ATTRIBUTESENTRY']._loaded_options = None
   _globals['_LOGEVENT_ATTRIBUTESENTRY']._serialized_options = b'8\001'
-  _globals['_EVENTTYPE']._serialized_start=4485
-  _globals['_EVENTTYPE']._serialized_end=4782
-  _globals['_ASYNCEXECUTEEVENTTYPE']._serialized_start=4785
-  _globals['_ASYNCEXECUTEEVENTTYPE']._serialized_end=4922
-  _globals['_LOGLEVEL']._serialized_start=4925
-  _globals['_LOGLEVEL']._serialized_end=5065
-  _globals['_LOGEVENT']._serialized_start=172
-  _globals['_LOGEVENT']._serialized_end=610
-  _globals['_LOGEVENT_ATTRIBUTESENTRY']._serialized_start=513
-  _globals['_LOGEVENT_ATTRIBUTESENTRY']._serialized_end=574
-  _globals['_CALLEVENT']._serialized_start=613
-  _globals['_CALLEVENT']._serialized_end=1145
-  _globals['_DEPLOYMENTCREATEDEVENT']._serialized_start=1148
-  _globals['_DEPLOYMENTCREATEDEVENT']._serialized_end=1332
-  _globals['_DEPLOYMENTUPDATEDEVENT']._serialized_start=1334
-  _globals['_DEPLOYMENTUPDATEDEVENT']._serialized_end=1455
-  _globals['_INGRESSEVENT']._serialized_start=1458
-  _globals['_INGRESSEVENT']._serialized_end=1983
-  _globals['_CRONSCHEDULEDEVENT']._serialized_start=1986
-  _globals['_CRONSCHEDULEDEVENT']._serialized_end=2343
-  _globals['_ASYNCEXECUTEEVENT']._serialized_start=2346
-  _globals['_ASYNCEXECUTEEVENT']._serialized_end=2757
-  _globals['_PUBSUBPUBLISHEVENT']._serialized_start=2760
-  _globals['_PUBSUBPUBLISHEVENT']._serialized_end=3182
-  _globals['_PUBSUBCONSUMEEVENT']._serialized_start=3185
-  _globals['_PUBSUBCONSUMEEVENT']._serialized_end=3654
-  _globals['_EVENT']._serialized_start=3657
-  _globals['_EVENT']._serialized_end=4482
+  _globals['_EVENTTYPE']._serialized_start=6560
+  _globals['_EVENTTYPE']._serialized_end=6974
+  _globals['_ASYNCEXECUTEEVENTTYPE']._serialized_start=6977
+  _globals['_ASYNCEXECUTEEVENTTYPE']._serialized_end=7155
+  _globals['_LOGLEVEL']._serialized_start=7158
+  _globals['_LOGLEVEL']._serialized_end=7298
+  _globals['_KVWRITEOPERATION']._serialized_start=7301
+  _globals['_KVWRITEOPERATION']._serialized_end=7455
+  _globals['_LOGEVENT']._serialized_start=249
+  _globals['_LOGEVENT']._serialized_end=687
+  _globals['_LOGEVENT_ATTRIBUTESENTRY']._serialized_start=590
+  _globals['_LOGEVENT_ATTRIBUTESENTRY']._serialized_end=651
+  _globals['_CALLEVENT']._serialized_start=690
+  _globals['_CALLEVENT']._serialized_end=1251
+  _globals['_DEPLOYMENTCREATEDEVENT']._serialized_start=1254
+  _globals['_DEPLOYMENTCREATEDEVENT']._serialized_end=1438
+  _globals['_DEPLOYMENTUPDATEDEVENT']._serialized_start=1440
+  _globals['_DEPLOYMENTUPDATEDEVENT']._serialized_end=1561
+  _globals['_INGRESSEVENT']._serialized_start=1564
+  _globals['_INGRESSEVENT']._serialized_end=2089
+  _globals['_CRONSCHEDULEDEVENT']._serialized_start=2092
+  _globals['_CRONSCHEDULEDEVENT']._serialized_end=2449
+  _globals['_ASYNCEXECUTEEVENT']._serialized_start=2452
+  _globals['_ASYNCEXECUTEEVENT']._serialized_end=2863
+  _globals['_PUBSUBPUBLISHEVENT']._serialized_start=2866
+  _globals['_PUBSUBPUBLISHEVENT']._serialized_end=3288
+  _globals['_PUBSUBCONSUMEEVENT']._serialized_start=3291
+  _globals['_PUBSUBCONSUMEEVENT']._serialized_end=3760
+  _globals['_KVWRITEEVENT']._serialized_start=3763
+  _globals['_KVWRITEEVENT']._serialized_end=4242
+  _globals['_CIRCUITBREAKEREVENT']._serialized_start=4245
+  _globals['_CIRCUITBREAKEREVENT']._serialized_end=4724
+  _globals['_CONFIGCHANGEEVENT']._serialized_start=4727
+  _globals['_CONFIGCHANGEEVENT']._serialized_end=5178
+  _globals['_ACCESSDENIEDEVENT']._serialized_start=5181
+  _globals['_ACCESSDENIEDEVENT']._serialized_end=5398
+  _globals['_EVENT']._serialized_start=5401
+  _globals['_EVENT']._serialized_end=6557
 # @@protoc_insertion_point(module_scope)
@@ -1,11 +1,13 @@
 from google.protobuf import duration_pb2 as _duration_pb2
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from xyz.block.ftl.schema.v1 import schema_pb2 as _schema_pb2
+from xyz.block.ftl.v1 import circuitbreaker_pb2 as _circuitbreaker_pb2
+from xyz.block.ftl.v1 import confighistory_pb2 as _confighistory_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
-from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+from typing import ClassVar as _ClassVar, Iterable as _Iterable, Mapping as _Mapping, Optional as _Optional, Union as _Union
 
 DESCRIPTOR: _descriptor.FileDescriptor
 
@@ -21,12 +23,17 @@ class EventType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     EVENT_TYPE_ASYNC_EXECUTE: _ClassVar[EventType]
     EVENT_TYPE_PUBSUB_PUBLISH: _ClassVar[EventType]
     EVENT_TYPE_PUBSUB_CONSUME: _ClassVar[EventType]
+    EVENT_TYPE_KV_WRITE: _ClassVar[EventType]
+    EVENT_TYPE_CIRCUIT_BREAKER: _ClassVar[EventType]
+    EVENT_TYPE_CONFIG_CHANGE: _ClassVar[EventType]
+    EVENT_TYPE_ACCESS_DENIED: _ClassVar[EventType]
 
 class AsyncExecuteEventType(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
     ASYNC_EXECUTE_EVENT_TYPE_UNSPECIFIED: _ClassVar[AsyncExecuteEventType]
     ASYNC_EXECUTE_EVENT_TYPE_CRON: _ClassVar[AsyncExecuteEventType]
     ASYNC_EXECUTE_EVENT_TYPE_PUBSUB: _ClassVar[AsyncExecuteEventType]
+    ASYNC_EXECUTE_EVENT_TYPE_ASYNC_CALL: _ClassVar[AsyncExecuteEventType]
 
 class LogLevel(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     __slots__ = ()
@@ -36,6 +43,13 @@ class LogLevel(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     LOG_LEVEL_INFO: _ClassVar[LogLevel]
     LOG_LEVEL_WARN: _ClassVar[LogLevel]
     LOG_LEVEL_ERROR: _ClassVar[LogLevel]
+
+class KVWriteOperation(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    KV_WRITE_OPERATION_UNSPECIFIED: _ClassVar[KVWriteOperation]
+    KV_WRITE_OPERATION_PUT: _ClassVar[KVWriteOperation]
+    KV_WRITE_OPERATION_DELETE: _ClassVar[KVWriteOperation]
+    KV_WRITE_OPERATION_COMPARE_AND_SWAP: _ClassVar[KVWriteOperation]
 EVENT_TYPE_UNSPECIFIED: EventType
 EVENT_TYPE_LOG: EventType
 EVENT_TYPE_CALL: EventType
@@ -46,15 +60,24 @@ EVENT_TYPE_CRON_SCHEDULED: EventType
 EVENT_TYPE_ASYNC_EXECUTE: EventType
 EVENT_TYPE_PUBSUB_PUBLISH: EventType
 EVENT_TYPE_PUBSUB_CONSUME: EventType
+EVENT_TYPE_KV_WRITE: EventType
+EVENT_TYPE_CIRCUIT_BREAKER: EventType
+EVENT_TYPE_CONFIG_CHANGE: EventType
+EVENT_TYPE_ACCESS_DENIED: EventType
 ASYNC_EXECUTE_EVENT_TYPE_UNSPECIFIED: AsyncExecuteEventType
 ASYNC_EXECUTE_EVENT_TYPE_CRON: AsyncExecuteEventType
 ASYNC_EXECUTE_EVENT_TYPE_PUBSUB: AsyncExecuteEventType
+ASYNC_EXECUTE_EVENT_TYPE_ASYNC_CALL: AsyncExecuteEventType
 LOG_LEVEL_UNSPECIFIED: LogLevel
 LOG_LEVEL_TRACE: LogLevel
 LOG_LEVEL_DEBUG: LogLevel
 LOG_LEVEL_INFO: LogLevel
 LOG_LEVEL_WARN: LogLevel
 LOG_LEVEL_ERROR: LogLevel
+KV_WRITE_OPERATION_UNSPECIFIED: KVWriteOperation
+KV_WRITE_OPERATION_PUT: KVWriteOperation
+KV_WRITE_OPERATION_DELETE: KVWriteOperation
+KV_WRITE_OPERATION_COMPARE_AND_SWAP: KVWriteOperation
 
 class LogEvent(_message.Message):
     __slots__ = ("deployment_key", "request_key", "timestamp", "log_level", "attributes", "message", "error", "stack")
@@ -84,7 +107,7 @@ class LogEvent(_message.Message):
     def __init__(self, deployment_key: _Optional[str] = ..., request_key: _Optional[str] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., log_level: _Optional[int] = ..., attributes: _Optional[_Mapping[str, str]] = ..., message: _Optional[str] = ..., error: _Optional[str] = ..., stack: _Optional[str] = ...) -> None: ...
 
 class CallEvent(_message.Message):
-    __slots__ = ("request_key", "deployment_key", "timestamp", "source_verb_ref", "destination_verb_ref", "duration", "request", "response", "error", "stack")
+    __slots__ = ("request_key", "deployment_key", "timestamp", "source_verb_ref", "destination_verb_ref", "duration", "request", "response", "error", "stack", "timed_out")
     REQUEST_KEY_FIELD_NUMBER: _ClassVar[int]
     DEPLOYMENT_KEY_FIELD_NUMBER: _ClassVar[int]
     TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
@@ -95,6 +118,7 @@ class CallEvent(_message.Message):
     RESPONSE_FIELD_NUMBER: _ClassVar[int]
     ERROR_FIELD_NUMBER: _ClassVar[int]
     STACK_FIELD_NUMBER: _ClassVar[int]
+    TIMED_OUT_FIELD_NUMBER: _ClassVar[int]
     request_key: str
     deployment_key: str
     timestamp: _timestamp_pb2.Timestamp
@@ -105,7 +129,8 @@ class CallEvent(_message.Message):
     response: str
     error: str
     stack: str
-    def __init__(self, request_key: _Optional[str] = ..., deployment_key: _Optional[str] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., source_verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., destination_verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., request: _Optional[str] = ..., response: _Optional[str] = ..., error: _Optional[str] = ..., stack: _Optional[str] = ...) -> None: ...
+    timed_out: bool
+    def __init__(self, request_key: _Optional[str] = ..., deployment_key: _Optional[str] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., source_verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., destination_verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., request: _Optional[str] = ..., response: _Optional[str] = ..., error: _Optional[str] = ..., stack: _Optional[str] = ..., timed_out: bool = ...) -> None: ...
 
 class DeploymentCreatedEvent(_message.Message):
     __slots__ = ("key", "language", "module_name", "min_replicas", "replaced")
@@ -245,8 +270,96 @@ class PubSubConsumeEvent(_message.Message):
     offset: int
     def __init__(self, deployment_key: _Optional[str] = ..., request_key: _Optional[str] = ..., dest_verb_module: _Optional[str] = ..., dest_verb_name: _Optional[str] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., topic: _Optional[str] = ..., error: _Optional[str] = ..., partition: _Optional[int] = ..., offset: _Optional[int] = ...) -> None: ...
 
+class KVWriteEvent(_message.Message):
+    __slots__ = ("deployment_key", "request_key", "verb_ref", "timestamp", "duration", "kv", "operation", "key", "applied", "error")
+    DEPLOYMENT_KEY_FIELD_NUMBER: _ClassVar[int]
+    REQUEST_KEY_FIELD_NUMBER: _ClassVar[int]
+    VERB_REF_FIELD_NUMBER: _ClassVar[int]
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    DURATION_FIELD_NUMBER: _ClassVar[int]
+    KV_FIELD_NUMBER: _ClassVar[int]
+    OPERATION_FIELD_NUMBER: _ClassVar[int]
+    KEY_FIELD_NUMBER: _ClassVar[int]
+    APPLIED_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
+    deployment_key: str
+    request_key: str
+    verb_ref: _schema_pb2.Ref
+    timestamp: _timestamp_pb2.Timestamp
+    duration: _duration_pb2.Duration
+    kv: _schema_pb2.Ref
+    operation: KVWriteOperation
+    key: str
+    applied: bool
+    error: str
+    def __init__(self, deployment_key: _Optional[str] = ..., request_key: _Optional[str] = ..., verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., duration: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., kv: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., operation: _Optional[_Union[KVWriteOperation, str]] = ..., key: _Optional[str] = ..., applied: bool = ..., error: _Optional[str] = ...) -> None: ...
+
+class CircuitBreakerEvent(_message.Message):
+    __slots__ = ("deployment_key", "verb_ref", "timestamp", "previous_state", "state", "requests", "failures", "service", "cooldown", "instance")
+    DEPLOYMENT_KEY_FIELD_NUMBER: _ClassVar[int]
+    VERB_REF_FIELD_NUMBER: _ClassVar[int]
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    PREVIOUS_STATE_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    REQUESTS_FIELD_NUMBER: _ClassVar[int]
+    FAILURES_FIELD_NUMBER: _ClassVar[int]
+    SERVICE_FIELD_NUMBER: _ClassVar[int]
+    COOLDOWN_FIELD_NUMBER: _ClassVar[int]
+    INSTANCE_FIELD_NUMBER: _ClassVar[int]
+    deployment_key: str
+    verb_ref: _schema_pb2.Ref
+    timestamp: _timestamp_pb2.Timestamp
+    previous_state: _circuitbreaker_pb2.CircuitBreakerState
+    state: _circuitbreaker_pb2.CircuitBreakerState
+    requests: int
+    failures: int
+    service: str
+    cooldown: _duration_pb2.Duration
+    instance: str
+    def __init__(self, deployment_key: _Optional[str] = ..., verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., previous_state: _Optional[_Union[_circuitbreaker_pb2.CircuitBreakerState, str]] = ..., state: _Optional[_Union[_circuitbreaker_pb2.CircuitBreakerState, str]] = ..., requests: _Optional[int] = ..., failures: _Optional[int] = ..., service: _Optional[str] = ..., cooldown: _Optional[_Union[_duration_pb2.Duration, _Mapping]] = ..., instance: _Optional[str] = ...) -> None: ...
+
+class ConfigChangeEvent(_message.Message):
+    __slots__ = ("ref", "secret", "timestamp", "actor", "provider", "operation", "value", "fingerprint", "restored_from", "change_id")
+    REF_FIELD_NUMBER: _ClassVar[int]
+    SECRET_FIELD_NUMBER: _ClassVar[int]
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    ACTOR_FIELD_NUMBER: _ClassVar[int]
+    PROVIDER_FIELD_NUMBER: _ClassVar[int]
+    OPERATION_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    FINGERPRINT_FIELD_NUMBER: _ClassVar[int]
+    RESTORED_FROM_FIELD_NUMBER: _ClassVar[int]
+    CHANGE_ID_FIELD_NUMBER: _ClassVar[int]
+    ref: _schema_pb2.Ref
+    secret: bool
+    timestamp: _timestamp_pb2.Timestamp
+    actor: str
+    provider: str
+    operation: _confighistory_pb2.ConfigChangeOperation
+    value: bytes
+    fingerprint: str
+    restored_from: int
+    change_id: int
+    def __init__(self, ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., secret: bool = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., actor: _Optional[str] = ..., provider: _Optional[str] = ..., operation: _Optional[_Union[_confighistory_pb2.ConfigChangeOperation, str]] = ..., value: _Optional[bytes] = ..., fingerprint: _Optional[str] = ..., restored_from: _Optional[int] = ..., change_id: _Optional[int] = ...) -> None: ...
+
+class AccessDeniedEvent(_message.Message):
+    __slots__ = ("timestamp", "procedure", "identity", "permission", "modules", "reason")
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    PROCEDURE_FIELD_NUMBER: _ClassVar[int]
+    IDENTITY_FIELD_NUMBER: _ClassVar[int]
+    PERMISSION_FIELD_NUMBER: _ClassVar[int]
+    MODULES_FIELD_NUMBER: _ClassVar[int]
+    REASON_FIELD_NUMBER: _ClassVar[int]
+    timestamp: _timestamp_pb2.Timestamp
+    procedure: str
+    identity: str
+    permission: str
+    modules: _containers.RepeatedScalarFieldContainer[str]
+    reason: str
+    def __init__(self, timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., procedure: _Optional[str] = ..., identity: _Optional[str] = ..., permission: _Optional[str] = ..., modules: _Optional[_Iterable[str]] = ..., reason: _Optional[str] = ...) -> None: ...
+
 class Event(_message.Message):
-    __slots__ = ("timestamp", "id", "log", "call", "deployment_created", "deployment_updated", "ingress", "cron_scheduled", "async_execute", "pubsub_publish", "pubsub_consume")
+    __slots__ = ("timestamp", "id", "log", "call", "deployment_created", "deployment_updated", "ingress", "cron_scheduled", "async_execute", "pubsub_publish", "pubsub_consume", "kv_write", "circuit_breaker", "config_change", "access_denied")
     TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
     ID_FIELD_NUMBER: _ClassVar[int]
     LOG_FIELD_NUMBER: _ClassVar[int]
@@ -258,6 +371,10 @@ class Event(_message.Message):
     ASYNC_EXECUTE_FIELD_NUMBER: _ClassVar[int]
     PUBSUB_PUBLISH_FIELD_NUMBER: _ClassVar[int]
     PUBSUB_CONSUME_FIELD_NUMBER: _ClassVar[int]
+    KV_WRITE_FIELD_NUMBER: _ClassVar[int]
+    CIRCUIT_BREAKER_FIELD_NUMBER: _ClassVar[int]
+    CONFIG_CHANGE_FIELD_NUMBER: _ClassVar[int]
+    ACCESS_DENIED_FIELD_NUMBER: _ClassVar[int]
     timestamp: _timestamp_pb2.Timestamp
     id: int
     log: LogEvent
@@ -269,4 +386,8 @@ class Event(_message.Message):
     async_execute: AsyncExecuteEvent
     pubsub_publish: PubSubPublishEvent
     pubsub_consume: PubSubConsumeEvent
-    def __init__(self, timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., id: _Optional[int] = ..., log: _Optional[_Union[LogEvent, _Mapping]] = ..., call: _Optional[_Union[CallEvent, _Mapping]] = ..., deployment_created: _Optional[_Union[DeploymentCreatedEvent, _Mapping]] = ..., deployment_updated: _Optional[_Union[DeploymentUpdatedEvent, _Mapping]] = ..., ingress: _Optional[_Union[IngressEvent, _Mapping]] = ..., cron_scheduled: _Optional[_Union[CronScheduledEvent, _Mapping]] = ..., async_execute: _Optional[_Union[AsyncExecuteEvent, _Mapping]] = ..., pubsub_publish: _Optional[_Union[PubSubPublishEvent, _Mapping]] = ..., pubsub_consume: _Optional[_Union[PubSubConsumeEvent, _Mapping]] = ...) -> None: ...
+    kv_write: KVWriteEvent
+    circuit_breaker: CircuitBreakerEvent
+    config_change: ConfigChangeEvent
+    access_denied: AccessDeniedEvent
+    def __init__(self, timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., id: _Optional[int] = ..., log: _Optional[_Union[LogEvent, _Mapping]] = ..., call: _Optional[_Union[CallEvent, _Mapping]] = ..., deployment_created: _Optional[_Union[DeploymentCreatedEvent, _Mapping]] = ..., deployment_updated: _Optional[_Union[DeploymentUpdatedEvent, _Mapping]] = ..., ingress: _Optional[_Union[IngressEvent, _Mapping]] = ..., cron_scheduled: _Optional[_Union[CronScheduledEvent, _Mapping]] = ..., async_execute: _Optional[_Union[AsyncExecuteEvent, _Mapping]] = ..., pubsub_publish: _Optional[_Union[PubSubPublishEvent, _Mapping]] = ..., pubsub_consume: _Optional[_Union[PubSubConsumeEvent, _Mapping]] = ..., kv_write: _Optional[_Union[KVWriteEvent, _Mapping]] = ..., circuit_breaker: _Optional[_Union[CircuitBreakerEvent, _Mapping]] = ..., config_change: _Optional[_Union[ConfigChangeEvent, _Mapping]] = ..., access_denied: _Optional[_Union[AccessDeniedEvent, _Mapping]] = ...) -> None: ...
@@ -28,7 +28,7 @@ from xyz.block.ftl.timeline.v1 import event_pb2 as xyz_dot_block_dot_ftl_dot_tim
 from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(xyz/block/ftl/timeline/v1/timeline.proto\x12\x19xyz.block.ftl.timeline.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%xyz/block/ftl/timeline/v1/event.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\xf0\r\n\x12GetTimelineRequest\x12N\n\x07\x66ilters\x18\x01 \x03(\x0b\x32\x34.xyz.block.ftl.timeline.v1.GetTimelineRequest.FilterR\x07\x66ilters\x12\x14\n\x05limit\x18\x02 \x01(\x05R\x05limit\x12I\n\x05order\x18\x03 \x01(\x0e\x32\x33.xyz.block.ftl.timeline.v1.GetTimelineRequest.OrderR\x05order\x1aR\n\x0eLogLevelFilter\x12@\n\tlog_level\x18\x01 \x01(\x0e\x32#.xyz.block.ftl.timeline.v1.LogLevelR\x08logLevel\x1a\x34\n\x10\x44\x65ploymentFilter\x12 \n\x0b\x64\x65ployments\x18\x01 \x03(\tR\x0b\x64\x65ployments\x1a+\n\rRequestFilter\x12\x1a\n\x08requests\x18\x01 \x03(\tR\x08requests\x1aX\n\x0f\x45ventTypeFilter\x12\x45\n\x0b\x65vent_types\x18\x01 \x03(\x0e\x32$.xyz.block.ftl.timeline.v1.EventTypeR\neventTypes\x1a\xaa\x01\n\nTimeFilter\x12>\n\nolder_than\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00R\tolderThan\x88\x01\x01\x12>\n\nnewer_than\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x01R\tnewerThan\x88\x01\x01\x42\r\n\x0b_older_thanB\r\n\x0b_newer_than\x1as\n\x08IDFilter\x12\"\n\nlower_than\x18\x01 \x01(\x03H\x00R\tlowerThan\x88\x01\x01\x12$\n\x0bhigher_than\x18\x02 \x01(\x03H\x01R\nhigherThan\x88\x01\x01\x42\r\n\x0b_lower_thanB\x0e\n\x0c_higher_than\x1a\x99\x01\n\nCallFilter\x12\x1f\n\x0b\x64\x65st_module\x18\x01 \x01(\tR\ndestModule\x12 \n\tdest_verb\x18\x02 \x01(\tH\x00R\x08\x64\x65stVerb\x88\x01\x01\x12(\n\rsource_module\x18\x03 \x01(\tH\x01R\x0csourceModule\x88\x01\x01\x42\x0c\n\n_dest_verbB\x10\n\x0e_source_module\x1aH\n\x0cModuleFilter\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\x12\x17\n\x04verb\x18\x02 \x01(\tH\x00R\x04verb\x88\x01\x01\x42\x07\n\x05_verb\x1a\xd0\x05\n\x06\x46ilter\x12[\n\tlog_level\x18\x01 \x01(\x0b\x32<.xyz.block.ftl.timeline.v1.GetTimelineRequest.LogLevelFilterH\x00R\x08logLevel\x12\x62\n\x0b\x64\x65ployments\x18\x02 \x01(\x0b\x32>.xyz.block.ftl.timeline.v1.GetTimelineRequest.DeploymentFilterH\x00R\x0b\x64\x65ployments\x12Y\n\x08requests\x18\x03 \x01(\x0b\x32;.xyz.block.ftl.timeline.v1.GetTimelineRequest.RequestFilterH\x00R\x08requests\x12`\n\x0b\x65vent_types\x18\x04 \x01(\x0b\x32=.xyz.block.ftl.timeline.v1.GetTimelineRequest.EventTypeFilterH\x00R\neventTypes\x12N\n\x04time\x18\x05 \x01(\x0b\x32\x38.xyz.block.ftl.timeline.v1.GetTimelineRequest.TimeFilterH\x00R\x04time\x12H\n\x02id\x18\x06 \x01(\x0b\x32\x36.xyz.block.ftl.timeline.v1.GetTimelineRequest.IDFilterH\x00R\x02id\x12N\n\x04\x63\x61ll\x18\x07 \x01(\x0b\x32\x38.xyz.block.ftl.timeline.v1.GetTimelineRequest.CallFilterH\x00R\x04\x63\x61ll\x12T\n\x06module\x18\x08 \x01(\x0b\x32:.xyz.block.ftl.timeline.v1.GetTimelineRequest.ModuleFilterH\x00R\x06moduleB\x08\n\x06\x66ilter\"=\n\x05Order\x12\x15\n\x11ORDER_UNSPECIFIED\x10\x00\x12\r\n\tORDER_ASC\x10\x01\x12\x0e\n\nORDER_DESC\x10\x02\"w\n\x13GetTimelineResponse\x12\x38\n\x06\x65vents\x18\x01 \x03(\x0b\x32 .xyz.block.ftl.timeline.v1.EventR\x06\x65vents\x12\x1b\n\x06\x63ursor\x18\x02 \x01(\x03H\x00R\x06\x63ursor\x88\x01\x01\x42\t\n\x07_cursor\"\xb9\x01\n\x15StreamTimelineRequest\x12G\n\x0fupdate_interval\x18\x01 \x01(\x0b\x32\x19.google.protobuf.DurationH\x00R\x0eupdateInterval\x88\x01\x01\x12\x43\n\x05query\x18\x02 \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.GetTimelineRequestR\x05queryB\x12\n\x10_update_interval\"R\n\x16StreamTimelineResponse\x12\x38\n\x06\x65vents\x18\x01 \x03(\x0b\x32 .xyz.block.ftl.timeline.v1.EventR\x06\x65vents\"\x9b\x07\n\x13\x43reateEventsRequest\x12S\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x39.xyz.block.ftl.timeline.v1.CreateEventsRequest.EventEntryR\x07\x65ntries\x1a\xae\x06\n\nEventEntry\x12\x38\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x37\n\x03log\x18\x02 \x01(\x0b\x32#.xyz.block.ftl.timeline.v1.LogEventH\x00R\x03log\x12:\n\x04\x63\x61ll\x18\x03 \x01(\x0b\x32$.xyz.block.ftl.timeline.v1.CallEventH\x00R\x04\x63\x61ll\x12\x62\n\x12\x64\x65ployment_created\x18\x04 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentCreatedEventH\x00R\x11\x64\x65ploymentCreated\x12\x62\n\x12\x64\x65ployment_updated\x18\x05 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentUpdatedEventH\x00R\x11\x64\x65ploymentUpdated\x12\x43\n\x07ingress\x18\x06 \x01(\x0b\x32\'.xyz.block.ftl.timeline.v1.IngressEventH\x00R\x07ingress\x12V\n\x0e\x63ron_scheduled\x18\x07 \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.CronScheduledEventH\x00R\rcronScheduled\x12S\n\rasync_execute\x18\x08 \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.AsyncExecuteEventH\x00R\x0c\x61syncExecute\x12V\n\x0epubsub_publish\x18\t \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubPublishEventH\x00R\rpubsubPublish\x12V\n\x0epubsub_consume\x18\n \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubConsumeEventH\x00R\rpubsubConsumeB\x07\n\x05\x65ntry\"\x16\n\x14\x43reateEventsResponse\"~\n\x16\x44\x65leteOldEventsRequest\x12\x43\n\nevent_type\x18\x01 \x01(\x0e\x32$.xyz.block.ftl.timeline.v1.EventTypeR\teventType\x12\x1f\n\x0b\x61ge_seconds\x18\x02 \x01(\x03R\nageSeconds\">\n\x17\x44\x65leteOldEventsResponse\x12#\n\rdeleted_count\x18\x01 \x01(\x03R\x0c\x64\x65letedCount2\xb8\x04\n\x0fTimelineService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12q\n\x0bGetTimeline\x12-.xyz.block.ftl.timeline.v1.GetTimelineRequest\x1a..xyz.block.ftl.timeline.v1.GetTimelineResponse\"\x03\x90\x02\x01\x12w\n\x0eStreamTimeline\x12\x30.xyz.block.ftl.timeline.v1.StreamTimelineRequest\x1a\x31.xyz.block.ftl.timeline.v1.StreamTimelineResponse0\x01\x12q\n\x0c\x43reateEvents\x12..xyz.block.ftl.timeline.v1.CreateEventsRequest\x1a/.xyz.block.ftl.timeline.v1.CreateEventsResponse\"\x00\x12z\n\x0f\x44\x65leteOldEvents\x12\x31.xyz.block.ftl.timeline.v1.DeleteOldEventsRequest\x1a\x32.xyz.block.ftl.timeline.v1.DeleteOldEventsResponse\"\x00\x42LP\x01ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1;timelinepbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n(xyz/block/ftl/timeline/v1/timeline.proto\x12\x19xyz.block.ftl.timeline.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a%xyz/block/ftl/timeline/v1/event.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"\xf0\r\n\x12GetTimelineRequest\x12N\n\x07\x66ilters\x18\x01 \x03(\x0b\x32\x34.xyz.block.ftl.timeline.v1.GetTimelineRequest.FilterR\x07\x66ilters\x12\x14\n\x05limit\x18\x02 \x01(\x05R\x05limit\x12I\n\x05order\x18\x03 \x01(\x0e\x32\x33.xyz.block.ftl.timeline.v1.GetTimelineRequest.OrderR\x05order\x1aR\n\x0eLogLevelFilter\x12@\n\tlog_level\x18\x01 \x01(\x0e\x32#.xyz.block.ftl.timeline.v1.LogLevelR\x08logLevel\x1a\x34\n\x10\x44\x65ploymentFilter\x12 \n\x0b\x64\x65ployments\x18\x01 \x03(\tR\x0b\x64\x65ployments\x1a+\n\rRequestFilter\x12\x1a\n\x08requests\x18\x01 \x03(\tR\x08requests\x1aX\n\x0f\x45ventTypeFilter\x12\x45\n\x0b\x65vent_types\x18\x01 \x03(\x0e\x32$.xyz.block.ftl.timeline.v1.EventTypeR\neventTypes\x1a\xaa\x01\n\nTimeFilter\x12>\n\nolder_than\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x00R\tolderThan\x88\x01\x01\x12>\n\nnewer_than\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x01R\tnewerThan\x88\x01\x01\x42\r\n\x0b_older_thanB\r\n\x0b_newer_than\x1as\n\x08IDFilter\x12\"\n\nlower_than\x18\x01 \x01(\x03H\x00R\tlowerThan\x88\x01\x01\x12$\n\x0bhigher_than\x18\x02 \x01(\x03H\x01R\nhigherThan\x88\x01\x01\x42\r\n\x0b_lower_thanB\x0e\n\x0c_higher_than\x1a\x99\x01\n\nCallFilter\x12\x1f\n\x0b\x64\x65st_module\x18\x01 \x01(\tR\ndestModule\x12 \n\tdest_verb\x18\x02 \x01(\tH\x00R\x08\x64\x65stVerb\x88\x01\x01\x12(\n\rsource_module\x18\x03 \x01(\tH\x01R\x0csourceModule\x88\x01\x01\x42\x0c\n\n_dest_verbB\x10\n\x0e_source_module\x1aH\n\x0cModuleFilter\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\x12\x17\n\x04verb\x18\x02 \x01(\tH\x00R\x04verb\x88\x01\x01\x42\x07\n\x05_verb\x1a\xd0\x05\n\x06\x46ilter\x12[\n\tlog_level\x18\x01 \x01(\x0b\x32<.xyz.block.ftl.timeline.v1.GetTimelineRequest.LogLevelFilterH\x00R\x08logLevel\x12\x62\n\x0b\x64\x65ployments\x18\x02 \x01(\x0b\x32>.xyz.block.ftl.timeline.v1.GetTimelineRequest.DeploymentFilterH\x00R\x0b\x64\x65ployments\x12Y\n\x08requests\x18\x03 \x01(\x0b\x32;.xyz.block.ftl.timeline.v1.GetTimelineRequest.RequestFilterH\x00R\x08requests\x12`\n\x0b\x65vent_types\x18\x04 \x01(\x0b\x32=.xyz.block.ftl.timeline.v1.GetTimelineRequest.EventTypeFilterH\x00R\neventTypes\x12N\n\x04time\x18\x05 \x01(\x0b\x32\x38.xyz.block.ftl.timeline.v1.GetTimelineRequest.TimeFilterH\x00R\x04time\x12H\n\x02id\x18\x06 \x01(\x0b\x32\x36.xyz.block.ftl.timeline.v1.GetTimelineRequest.IDFilterH\x00R\x02id\x12N\n\x04\x63\x61ll\x18\x07 \x01(\x0b\x32\x38.xyz.block.ftl.timeline.v1.GetTimelineRequest.CallFilterH\x00R\x04\x63\x61ll\x12T\n\x06module\x18\x08 \x01(\x0b\x32:.xyz.block.ftl.timeline.v1.GetTimelineRequest.ModuleFilterH\x00R\x06moduleB\x08\n\x06\x66ilter\"=\n\x05Order\x12\x15\n\x11ORDER_UNSPECIFIED\x10\x00\x12\r\n\tORDER_ASC\x10\x01\x12\x0e\n\nORDER_DESC\x10\x02\"w\n\x13GetTimelineResponse\x12\x38\n\x06\x65vents\x18\x01 \x03(\x0b\x32 .xyz.block.ftl.timeline.v1.EventR\x06\x65vents\x12\x1b\n\x06\x63ursor\x18\x02 \x01(\x03H\x00R\x06\x63ursor\x88\x01\x01\x42\t\n\x07_cursor\"\xb9\x01\n\x15StreamTimelineRequest\x12G\n\x0fupdate_interval\x18\x01 \x01(\x0b\x32\x19.google.protobuf.DurationH\x00R\x0eupdateInterval\x88\x01\x01\x12\x43\n\x05query\x18\x02 \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.GetTimelineRequestR\x05queryB\x12\n\x10_update_interval\"R\n\x16StreamTimelineResponse\x12\x38\n\x06\x65vents\x18\x01 \x03(\x0b\x32 .xyz.block.ftl.timeline.v1.EventR\x06\x65vents\"\xe6\t\n\x13\x43reateEventsRequest\x12S\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x39.xyz.block.ftl.timeline.v1.CreateEventsRequest.EventEntryR\x07\x65ntries\x1a\xf9\x08\n\nEventEntry\x12\x38\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x37\n\x03log\x18\x02 \x01(\x0b\x32#.xyz.block.ftl.timeline.v1.LogEventH\x00R\x03log\x12:\n\x04\x63\x61ll\x18\x03 \x01(\x0b\x32$.xyz.block.ftl.timeline.v1.CallEventH\x00R\x04\x63\x61ll\x12\x62\n\x12\x64\x65ployment_created\x18\x04 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentCreatedEventH\x00R\x11\x64\x65ploymentCreated\x12\x62\n\x12\x64\x65ployment_updated\x18\x05 \x01(\x0b\x32\x31.xyz.block.ftl.timeline.v1.DeploymentUpdatedEventH\x00R\x11\x64\x65ploymentUpdated\x12\x43\n\x07ingress\x18\x06 \x01(\x0b\x32\'.xyz.block.ftl.timeline.v1.IngressEventH\x00R\x07ingress\x12V\n\x0e\x63ron_scheduled\x18\x07 \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.CronScheduledEventH\x00R\rcronScheduled\x12S\n\rasync_execute\x18\x08 \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.AsyncExecuteEventH\x00R\x0c\x61syncExecute\x12V\n\x0epubsub_publish\x18\t \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubPublishEventH\x00R\rpubsubPublish\x12V\n\x0epubsub_consume\x18\n \x01(\x0b\x32-.xyz.block.ftl.timeline.v1.PubSubConsumeEventH\x00R\rpubsubConsume\x12\x44\n\x08kv_write\x18\x0b \x01(\x0b\x32\'.xyz.block.ftl.timeline.v1.KVWriteEventH\x00R\x07kvWrite\x12Y\n\x0f\x63ircuit_breaker\x18\x0c \x01(\x0b\x32..xyz.block.ftl.timeline.v1.CircuitBreakerEventH\x00R\x0e\x63ircuitBreaker\x12S\n\rconfig_change\x18\r \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.ConfigChangeEventH\x00R\x0c\x63onfigChange\x12S\n\raccess_denied\x18\x0e \x01(\x0b\x32,.xyz.block.ftl.timeline.v1.AccessDeniedEventH\x00R\x0c\x61\x63\x63\x65ssDeniedB\x07\n\x05\x65ntry\"\x16\n\x14\x43reateEventsResponse\"~\n\x16\x44\x65leteOldEventsRequest\x12\x43\n\nevent_type\x18\x01 \x01(\x0e\x32$.xyz.block.ftl.timeline.v1.EventTypeR\teventType\x12\x1f\n\x0b\x61ge_seconds\x18\x02 \x01(\x03R\nageSeconds\">\n\x17\x44\x65leteOldEventsResponse\x12#\n\rdeleted_count\x18\x01 \x01(\x03R\x0c\x64\x65letedCount2\xb8\x04\n\x0fTimelineService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12q\n\x0bGetTimeline\x12-.xyz.block.ftl.timeline.v1.GetTimelineRequest\x1a..xyz.block.ftl.timeline.v1.GetTimelineResponse\"\x03\x90\x02\x01\x12w\n\x0eStreamTimeline\x12\x30.xyz.block.ftl.timeline.v1.StreamTimelineRequest\x1a\x31.xyz.block.ftl.timeline.v1.StreamTimelineResponse0\x01\x12q\n\x0c\x43reateEvents\x12..xyz.block.ftl.timeline.v1.CreateEventsRequest\x1a/.xyz.block.ftl.timeline.v1.CreateEventsResponse\"\x00\x12z\n\x0f\x44\x65leteOldEvents\x12\x31.xyz.block.ftl.timeline.v1.DeleteOldEventsRequest\x1a\x32.xyz.block.ftl.timeline.v1.DeleteOldEventsResponse\"\x00\x42LP\x01ZHgithub.com/block/ftl/backend/protos/xyz/block/ftl/timeline/v1;timelinepbb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -69,15 +69,15 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_STREAMTIMELINERESPONSE']._serialized_start=2291
   _globals['_STREAMTIMELINERESPONSE']._serialized_end=2373
   _globals['_CREATEEVENTSREQUEST']._serialized_start=2376
-  _globals['_CREATEEVENTSREQUEST']._serialized_end=3299
+  _globals['_CREATEEVENTSREQUEST']._serialized_end=3630
   _globals['_CREATEEVENTSREQUEST_EVENTENTRY']._serialized_start=2485
-  _globals['_CREATEEVENTSREQUEST_EVENTENTRY']._serialized_end=3299
-  _globals['_CREATEEVENTSRESPONSE']._serialized_start=3301
-  _globals['_CREATEEVENTSRESPONSE']._serialized_end=3323
-  _globals['_DELETEOLDEVENTSREQUEST']._serialized_start=3325
-  _globals['_DELETEOLDEVENTSREQUEST']._serialized_end=3451
-  _globals['_DELETEOLDEVENTSRESPONSE']._serialized_start=3453
-  _globals['_DELETEOLDEVENTSRESPONSE']._serialized_end=3515
-  _globals['_TIMELINESERVICE']._serialized_start=3518
-  _globals['_TIMELINESERVICE']._serialized_end=4086
+  _globals['_CREATEEVENTSREQUEST_EVENTENTRY']._serialized_end=3630
+  _globals['_CREATEEVENTSRESPONSE']._serialized_start=3632
+  _globals['_CREATEEVENTSRESPONSE']._serialized_end=3654
+  _globals['_DELETEOLDEVENTSREQUEST']._serialized_start=3656
+  _globals['_DELETEOLDEVENTSREQUEST']._serialized_end=3782
+  _globals['_DELETEOLDEVENTSRESPONSE']._serialized_start=3784
+  _globals['_DELETEOLDEVENTSRESPONSE']._serialized_end=3846
+  _globals['_TIMELINESERVICE']._serialized_start=3849
+  _globals['_TIMELINESERVICE']._serialized_end=4417
 # @@protoc_insertion_point(module_scope)
@@ -122,7 +122,7 @@ class StreamTimelineResponse(_message.Message):
 class CreateEventsRequest(_message.Message):
     __slots__ = ("entries",)
     class EventEntry(_message.Message):
-        __slots__ = ("timestamp", "log", "call", "deployment_created", "deployment_updated", "ingress", "cron_scheduled", "async_execute", "pubsub_publish", "pubsub_consume")
+        __slots__ = ("timestamp", "log", "call", "deployment_created", "deployment_updated", "ingress", "cron_scheduled", "async_execute", "pubsub_publish", "pubsub_consume", "kv_write", "circuit_breaker", "config_change", "access_denied")
         TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
         LOG_FIELD_NUMBER: _ClassVar[int]
         CALL_FIELD_NUMBER: _ClassVar[int]
@@ -133,6 +133,10 @@ class CreateEventsRequest(_message.Message):
         ASYNC_EXECUTE_FIELD_NUMBER: _ClassVar[int]
         PUBSUB_PUBLISH_FIELD_NUMBER: _ClassVar[int]
         PUBSUB_CONSUME_FIELD_NUMBER: _ClassVar[int]
+        KV_WRITE_FIELD_NUMBER: _ClassVar[int]
+        CIRCUIT_BREAKER_FIELD_NUMBER: _ClassVar[int]
+        CONFIG_CHANGE_FIELD_NUMBER: _ClassVar[int]
+        ACCESS_DENIED_FIELD_NUMBER: _ClassVar[int]
         timestamp: _timestamp_pb2.Timestamp
         log: _event_pb2.LogEvent
         call: _event_pb2.CallEvent
@@ -143,7 +147,11 @@ class CreateEventsRequest(_message.Message):
         async_execute: _event_pb2.AsyncExecuteEvent
         pubsub_publish: _event_pb2.PubSubPublishEvent
         pubsub_consume: _event_pb2.PubSubConsumeEvent
-        def __init__(self, timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., log: _Optional[_Union[_event_pb2.LogEvent, _Mapping]] = ..., call: _Optional[_Union[_event_pb2.CallEvent, _Mapping]] = ..., deployment_created: _Optional[_Union[_event_pb2.DeploymentCreatedEvent, _Mapping]] = ..., deployment_updated: _Optional[_Union[_event_pb2.DeploymentUpdatedEvent, _Mapping]] = ..., ingress: _Optional[_Union[_event_pb2.IngressEvent, _Mapping]] = ..., cron_scheduled: _Optional[_Union[_event_pb2.CronScheduledEvent, _Mapping]] = ..., async_execute: _Optional[_Union[_event_pb2.AsyncExecuteEvent, _Mapping]] = ..., pubsub_publish: _Optional[_Union[_event_pb2.PubSubPublishEvent, _Mapping]] = ..., pubsub_consume: _Optional[_Union[_event_pb2.PubSubConsumeEvent, _Mapping]] = ...) -> None: ...
+        kv_write: _event_pb2.KVWriteEvent
+        circuit_breaker: _event_pb2.CircuitBreakerEvent
+        config_change: _event_pb2.ConfigChangeEvent
+        access_denied: _event_pb2.AccessDeniedEvent
+        def __init__(self, timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., log: _Optional[_Union[_event_pb2.LogEvent, _Mapping]] = ..., call: _Optional[_Union[_event_pb2.CallEvent, _Mapping]] = ..., deployment_created: _Optional[_Union[_event_pb2.DeploymentCreatedEvent, _Mapping]] = ..., deployment_updated: _Optional[_Union[_event_pb2.DeploymentUpdatedEvent, _Mapping]] = ..., ingress: _Optional[_Union[_event_pb2.IngressEvent, _Mapping]] = ..., cron_scheduled: _Optional[_Union[_event_pb2.CronScheduledEvent, _Mapping]] = ..., async_execute: _Optional[_Union[_event_pb2.AsyncExecuteEvent, _Mapping]] = ..., pubsub_publish: _Optional[_Union[_event_pb2.PubSubPublishEvent, _Mapping]] = ..., pubsub_consume: _Optional[_Union[_event_pb2.PubSubConsumeEvent, _Mapping]] = ..., kv_write: _Optional[_Union[_event_pb2.KVWriteEvent, _Mapping]] = ..., circuit_breaker: _Optional[_Union[_event_pb2.CircuitBreakerEvent, _Mapping]] = ..., config_change: _Optional[_Union[_event_pb2.ConfigChangeEvent, _Mapping]] = ..., access_denied: _Optional[_Union[_event_pb2.AccessDeniedEvent, _Mapping]] = ...) -> None: ...
     ENTRIES_FIELD_NUMBER: _ClassVar[int]
     entries: _containers.RepeatedCompositeFieldContainer[CreateEventsRequest.EventEntry]
     def __init__(self, entries: _Optional[_Iterable[_Union[CreateEventsRequest.EventEntry, _Mapping]]] = ...) -> None: ...
@@ -22,10 +22,12 @@ _runtime_version.ValidateProtobufRuntimeVersion(
 _sym_db = _symbol_database.Default()
 
 
+from xyz.block.ftl.v1 import circuitbreaker_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_circuitbreaker__pb2
+from xyz.block.ftl.v1 import confighistory_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_confighistory__pb2
 from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1cxyz/block/ftl/v1/admin.proto\x12\x10xyz.block.ftl.v1\x1a\x1axyz/block/ftl/v1/ftl.proto\"G\n\tConfigRef\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12\x12\n\x04name\x18\x02 \x01(\tR\x04nameB\t\n\x07_module\"\xca\x01\n\x11\x43onfigListRequest\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12*\n\x0einclude_values\x18\x02 \x01(\x08H\x01R\rincludeValues\x88\x01\x01\x12\x41\n\x08provider\x18\x03 \x01(\x0e\x32 .xyz.block.ftl.v1.ConfigProviderH\x02R\x08provider\x88\x01\x01\x42\t\n\x07_moduleB\x11\n\x0f_include_valuesB\x0b\n\t_provider\"\xa5\x01\n\x12\x43onfigListResponse\x12\x45\n\x07\x63onfigs\x18\x01 \x03(\x0b\x32+.xyz.block.ftl.v1.ConfigListResponse.ConfigR\x07\x63onfigs\x1aH\n\x06\x43onfig\x12\x19\n\x08ref_path\x18\x01 \x01(\tR\x07refPath\x12\x19\n\x05value\x18\x02 \x01(\x0cH\x00R\x05value\x88\x01\x01\x42\x08\n\x06_value\"A\n\x10\x43onfigGetRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\")\n\x11\x43onfigGetResponse\x12\x14\n\x05value\x18\x01 \x01(\x0cR\x05value\"\xa7\x01\n\x10\x43onfigSetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.ConfigProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\x12\x14\n\x05value\x18\x03 \x01(\x0cR\x05valueB\x0b\n\t_provider\"\x13\n\x11\x43onfigSetResponse\"\x93\x01\n\x12\x43onfigUnsetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.ConfigProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03refB\x0b\n\t_provider\"\x15\n\x13\x43onfigUnsetResponse\"\xcb\x01\n\x12SecretsListRequest\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12*\n\x0einclude_values\x18\x02 \x01(\x08H\x01R\rincludeValues\x88\x01\x01\x12\x41\n\x08provider\x18\x03 \x01(\x0e\x32 .xyz.block.ftl.v1.SecretProviderH\x02R\x08provider\x88\x01\x01\x42\t\n\x07_moduleB\x11\n\x0f_include_valuesB\x0b\n\t_provider\"\xa7\x01\n\x13SecretsListResponse\x12\x46\n\x07secrets\x18\x01 \x03(\x0b\x32,.xyz.block.ftl.v1.SecretsListResponse.SecretR\x07secrets\x1aH\n\x06Secret\x12\x19\n\x08ref_path\x18\x01 \x01(\tR\x07refPath\x12\x19\n\x05value\x18\x02 \x01(\x0cH\x00R\x05value\x88\x01\x01\x42\x08\n\x06_value\"A\n\x10SecretGetRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\")\n\x11SecretGetResponse\x12\x14\n\x05value\x18\x01 \x01(\x0cR\x05value\"\xa7\x01\n\x10SecretSetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.SecretProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\x12\x14\n\x05value\x18\x03 \x01(\x0cR\x05valueB\x0b\n\t_provider\"\x13\n\x11SecretSetResponse\"\x93\x01\n\x12SecretUnsetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.SecretProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03refB\x0b\n\t_provider\"\x15\n\x13SecretUnsetResponse\"4\n\x1aMapConfigsForModuleRequest\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\"\xab\x01\n\x1bMapConfigsForModuleResponse\x12Q\n\x06values\x18\x01 \x03(\x0b\x32\x39.xyz.block.ftl.v1.MapConfigsForModuleResponse.ValuesEntryR\x06values\x1a\x39\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\"4\n\x1aMapSecretsForModuleRequest\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\"\xab\x01\n\x1bMapSecretsForModuleResponse\x12Q\n\x06values\x18\x01 \x03(\x0b\x32\x39.xyz.block.ftl.v1.MapSecretsForModuleResponse.ValuesEntryR\x06values\x1a\x39\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01*h\n\x0e\x43onfigProvider\x12\x1f\n\x1b\x43ONFIG_PROVIDER_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x43ONFIG_PROVIDER_INLINE\x10\x01\x12\x19\n\x15\x43ONFIG_PROVIDER_ENVAR\x10\x02*\xb7\x01\n\x0eSecretProvider\x12\x1f\n\x1bSECRET_PROVIDER_UNSPECIFIED\x10\x00\x12\x1a\n\x16SECRET_PROVIDER_INLINE\x10\x01\x12\x19\n\x15SECRET_PROVIDER_ENVAR\x10\x02\x12\x1c\n\x18SECRET_PROVIDER_KEYCHAIN\x10\x03\x12\x16\n\x12SECRET_PROVIDER_OP\x10\x04\x12\x17\n\x13SECRET_PROVIDER_ASM\x10\x05\x32\x87\x08\n\x0c\x41\x64minService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12W\n\nConfigList\x12#.xyz.block.ftl.v1.ConfigListRequest\x1a$.xyz.block.ftl.v1.ConfigListResponse\x12T\n\tConfigGet\x12\".xyz.block.ftl.v1.ConfigGetRequest\x1a#.xyz.block.ftl.v1.ConfigGetResponse\x12T\n\tConfigSet\x12\".xyz.block.ftl.v1.ConfigSetRequest\x1a#.xyz.block.ftl.v1.ConfigSetResponse\x12Z\n\x0b\x43onfigUnset\x12$.xyz.block.ftl.v1.ConfigUnsetRequest\x1a%.xyz.block.ftl.v1.ConfigUnsetResponse\x12Z\n\x0bSecretsList\x12$.xyz.block.ftl.v1.SecretsListRequest\x1a%.xyz.block.ftl.v1.SecretsListResponse\x12T\n\tSecretGet\x12\".xyz.block.ftl.v1.SecretGetRequest\x1a#.xyz.block.ftl.v1.SecretGetResponse\x12T\n\tSecretSet\x12\".xyz.block.ftl.v1.SecretSetRequest\x1a#.xyz.block.ftl.v1.SecretSetResponse\x12Z\n\x0bSecretUnset\x12$.xyz.block.ftl.v1.SecretUnsetRequest\x1a%.xyz.block.ftl.v1.SecretUnsetResponse\x12r\n\x13MapConfigsForModule\x12,.xyz.block.ftl.v1.MapConfigsForModuleRequest\x1a-.xyz.block.ftl.v1.MapConfigsForModuleResponse\x12r\n\x13MapSecretsForModule\x12,.xyz.block.ftl.v1.MapSecretsForModuleRequest\x1a-.xyz.block.ftl.v1.MapSecretsForModuleResponseB>P\x01Z:github.com/block/ftl/backend/protos/xyz/block/ftl/v1;ftlv1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1cxyz/block/ftl/v1/admin.proto\x12\x10xyz.block.ftl.v1\x1a%xyz/block/ftl/v1/circuitbreaker.proto\x1a$xyz/block/ftl/v1/confighistory.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"G\n\tConfigRef\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12\x12\n\x04name\x18\x02 \x01(\tR\x04nameB\t\n\x07_module\"\xca\x01\n\x11\x43onfigListRequest\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12*\n\x0einclude_values\x18\x02 \x01(\x08H\x01R\rincludeValues\x88\x01\x01\x12\x41\n\x08provider\x18\x03 \x01(\x0e\x32 .xyz.block.ftl.v1.ConfigProviderH\x02R\x08provider\x88\x01\x01\x42\t\n\x07_moduleB\x11\n\x0f_include_valuesB\x0b\n\t_provider\"\xa5\x01\n\x12\x43onfigListResponse\x12\x45\n\x07\x63onfigs\x18\x01 \x03(\x0b\x32+.xyz.block.ftl.v1.ConfigListResponse.ConfigR\x07\x63onfigs\x1aH\n\x06\x43onfig\x12\x19\n\x08ref_path\x18\x01 \x01(\tR\x07refPath\x12\x19\n\x05value\x18\x02 \x01(\x0cH\x00R\x05value\x88\x01\x01\x42\x08\n\x06_value\"A\n\x10\x43onfigGetRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\")\n\x11\x43onfigGetResponse\x12\x14\n\x05value\x18\x01 \x01(\x0cR\x05value\"\xa7\x01\n\x10\x43onfigSetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.ConfigProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\x12\x14\n\x05value\x18\x03 \x01(\x0cR\x05valueB\x0b\n\t_provider\"\x13\n\x11\x43onfigSetResponse\"\x93\x01\n\x12\x43onfigUnsetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.ConfigProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03refB\x0b\n\t_provider\"\x15\n\x13\x43onfigUnsetResponse\"\xcb\x01\n\x12SecretsListRequest\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x12*\n\x0einclude_values\x18\x02 \x01(\x08H\x01R\rincludeValues\x88\x01\x01\x12\x41\n\x08provider\x18\x03 \x01(\x0e\x32 .xyz.block.ftl.v1.SecretProviderH\x02R\x08provider\x88\x01\x01\x42\t\n\x07_moduleB\x11\n\x0f_include_valuesB\x0b\n\t_provider\"\xa7\x01\n\x13SecretsListResponse\x12\x46\n\x07secrets\x18\x01 \x03(\x0b\x32,.xyz.block.ftl.v1.SecretsListResponse.SecretR\x07secrets\x1aH\n\x06Secret\x12\x19\n\x08ref_path\x18\x01 \x01(\tR\x07refPath\x12\x19\n\x05value\x18\x02 \x01(\x0cH\x00R\x05value\x88\x01\x01\x42\x08\n\x06_value\"A\n\x10SecretGetRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\")\n\x11SecretGetResponse\x12\x14\n\x05value\x18\x01 \x01(\x0cR\x05value\"\xa7\x01\n\x10SecretSetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.SecretProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\x12\x14\n\x05value\x18\x03 \x01(\x0cR\x05valueB\x0b\n\t_provider\"\x13\n\x11SecretSetResponse\"\x93\x01\n\x12SecretUnsetRequest\x12\x41\n\x08provider\x18\x01 \x01(\x0e\x32 .xyz.block.ftl.v1.SecretProviderH\x00R\x08provider\x88\x01\x01\x12-\n\x03ref\x18\x02 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03refB\x0b\n\t_provider\"\x15\n\x13SecretUnsetResponse\"4\n\x1aMapConfigsForModuleRequest\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\"\xab\x01\n\x1bMapConfigsForModuleResponse\x12Q\n\x06values\x18\x01 \x03(\x0b\x32\x39.xyz.block.ftl.v1.MapConfigsForModuleResponse.ValuesEntryR\x06values\x1a\x39\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\"4\n\x1aMapSecretsForModuleRequest\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\"\xab\x01\n\x1bMapSecretsForModuleResponse\x12Q\n\x06values\x18\x01 \x03(\x0b\x32\x39.xyz.block.ftl.v1.MapSecretsForModuleResponse.ValuesEntryR\x06values\x1a\x39\n\x0bValuesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\x0cR\x05value:\x02\x38\x01\"E\n\x14\x43onfigHistoryRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\"Q\n\x15\x43onfigHistoryResponse\x12\x38\n\x07\x63hanges\x18\x01 \x03(\x0b\x32\x1e.xyz.block.ftl.v1.ConfigChangeR\x07\x63hanges\"b\n\x14\x43onfigRestoreRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\x12\x1b\n\tchange_id\x18\x02 \x01(\x03R\x08\x63hangeId\"\x17\n\x15\x43onfigRestoreResponse\"<\n\x12\x43onfigCheckRequest\x12\x1b\n\x06module\x18\x01 \x01(\tH\x00R\x06module\x88\x01\x01\x42\t\n\x07_module\"\xc8\x01\n\x13\x43onfigCheckResponse\x12I\n\x08problems\x18\x01 \x03(\x0b\x32-.xyz.block.ftl.v1.ConfigCheckResponse.ProblemR\x08problems\x1a\x66\n\x07Problem\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\x12\x16\n\x06secret\x18\x02 \x01(\x08R\x06secret\x12\x14\n\x05\x65rror\x18\x03 \x01(\tR\x05\x65rror\"E\n\x14SecretHistoryRequest\x12-\n\x03ref\x18\x01 \x01(\x0b\x32\x1b.xyz.block.ftl.v1.ConfigRefR\x03ref\"Q\n\x15SecretHistoryResponse\x12\x38\n\x07\x63hanges\x18\x01 \x03(\x0b\x32\x1e.xyz.block.ftl.v1.ConfigChangeR\x07\x63hanges\"\x1b\n\x19GetCircuitBreakersRequest\"o\n\x1aGetCircuitBreakersResponse\x12Q\n\x10\x63ircuit_breakers\x18\x01 \x03(\x0b\x32&.xyz.block.ftl.v1.CircuitBreakerStatusR\x0f\x63ircuitBreakers*h\n\x0e\x43onfigProvider\x12\x1f\n\x1b\x43ONFIG_PROVIDER_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x43ONFIG_PROVIDER_INLINE\x10\x01\x12\x19\n\x15\x43ONFIG_PROVIDER_ENVAR\x10\x02*\xb7\x01\n\x0eSecretProvider\x12\x1f\n\x1bSECRET_PROVIDER_UNSPECIFIED\x10\x00\x12\x1a\n\x16SECRET_PROVIDER_INLINE\x10\x01\x12\x19\n\x15SECRET_PROVIDER_ENVAR\x10\x02\x12\x1c\n\x18SECRET_PROVIDER_KEYCHAIN\x10\x03\x12\x16\n\x12SECRET_PROVIDER_OP\x10\x04\x12\x17\n\x13SECRET_PROVIDER_ASM\x10\x05\x32\x8e\x0c\n\x0c\x41\x64minService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12W\n\nConfigList\x12#.xyz.block.ftl.v1.ConfigListRequest\x1a$.xyz.block.ftl.v1.ConfigListResponse\x12T\n\tConfigGet\x12\".xyz.block.ftl.v1.ConfigGetRequest\x1a#.xyz.block.ftl.v1.ConfigGetResponse\x12T\n\tConfigSet\x12\".xyz.block.ftl.v1.ConfigSetRequest\x1a#.xyz.block.ftl.v1.ConfigSetResponse\x12Z\n\x0b\x43onfigUnset\x12$.xyz.block.ftl.v1.ConfigUnsetRequest\x1a%.xyz.block.ftl.v1.ConfigUnsetResponse\x12\x65\n\rConfigHistory\x12&.xyz.block.ftl.v1.ConfigHistoryRequest\x1a\'.xyz.block.ftl.v1.ConfigHistoryResponse\"\x03\x90\x02\x01\x12`\n\rConfigRestore\x12&.xyz.block.ftl.v1.ConfigRestoreRequest\x1a\'.xyz.block.ftl.v1.ConfigRestoreResponse\x12_\n\x0b\x43onfigCheck\x12$.xyz.block.ftl.v1.ConfigCheckRequest\x1a%.xyz.block.ftl.v1.ConfigCheckResponse\"\x03\x90\x02\x01\x12Z\n\x0bSecretsList\x12$.xyz.block.ftl.v1.SecretsListRequest\x1a%.xyz.block.ftl.v1.SecretsListResponse\x12T\n\tSecretGet\x12\".xyz.block.ftl.v1.SecretGetRequest\x1a#.xyz.block.ftl.v1.SecretGetResponse\x12T\n\tSecretSet\x12\".xyz.block.ftl.v1.SecretSetRequest\x1a#.xyz.block.ftl.v1.SecretSetResponse\x12Z\n\x0bSecretUnset\x12$.xyz.block.ftl.v1.SecretUnsetRequest\x1a%.xyz.block.ftl.v1.SecretUnsetResponse\x12\x65\n\rSecretHistory\x12&.xyz.block.ftl.v1.SecretHistoryRequest\x1a\'.xyz.block.ftl.v1.SecretHistoryResponse\"\x03\x90\x02\x01\x12r\n\x13MapConfigsForModule\x12,.xyz.block.ftl.v1.MapConfigsForModuleRequest\x1a-.xyz.block.ftl.v1.MapConfigsForModuleResponse\x12r\n\x13MapSecretsForModule\x12,.xyz.block.ftl.v1.MapSecretsForModuleRequest\x1a-.xyz.block.ftl.v1.MapSecretsForModuleResponse\x12t\n\x12GetCircuitBreakers\x12+.xyz.block.ftl.v1.GetCircuitBreakersRequest\x1a,.xyz.block.ftl.v1.GetCircuitBreakersResponse\"\x03\x90\x02\x01\x42>P\x01Z:github.com/block/ftl/backend/protos/xyz/block/ftl/v1;ftlv1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -39,60 +41,90 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_MAPSECRETSFORMODULERESPONSE_VALUESENTRY']._serialized_options = b'8\001'
   _globals['_ADMINSERVICE'].methods_by_name['Ping']._loaded_options = None
   _globals['_ADMINSERVICE'].methods_by_name['Ping']._serialized_options = b'\220\002\001'
-  _globals['_CONFIGPROVIDER']._serialized_start=2304
-  _globals['_CONFIGPROVIDER']._serialized_end=2408
-  _globals['_SECRETPROVIDER']._serialized_start=2411
-  _globals['_SECRETPROVIDER']._serialized_end=2594
-  _globals['_CONFIGREF']._serialized_start=78
-  _globals['_CONFIGREF']._serialized_end=149
-  _globals['_CONFIGLISTREQUEST']._serialized_start=152
-  _globals['_CONFIGLISTREQUEST']._serialized_end=354
-  _globals['_CONFIGLISTRESPONSE']._serialized_start=357
-  _globals['_CONFIGLISTRESPONSE']._serialized_end=522
-  _globals['_CONFIGLISTRESPONSE_CONFIG']._serialized_start=450
-  _globals['_CONFIGLISTRESPONSE_CONFIG']._serialized_end=522
-  _globals['_CONFIGGETREQUEST']._serialized_start=524
-  _globals['_CONFIGGETREQUEST']._serialized_end=589
-  _globals['_CONFIGGETRESPONSE']._serialized_start=591
-  _globals['_CONFIGGETRESPONSE']._serialized_end=632
-  _globals['_CONFIGSETREQUEST']._serialized_start=635
-  _globals['_CONFIGSETREQUEST']._serialized_end=802
-  _globals['_CONFIGSETRESPONSE']._serialized_start=804
-  _globals['_CONFIGSETRESPONSE']._serialized_end=823
-  _globals['_CONFIGUNSETREQUEST']._serialized_start=826
-  _globals['_CONFIGUNSETREQUEST']._serialized_end=973
-  _globals['_CONFIGUNSETRESPONSE']._serialized_start=975
-  _globals['_CONFIGUNSETRESPONSE']._serialized_end=996
-  _globals['_SECRETSLISTREQUEST']._serialized_start=999
-  _globals['_SECRETSLISTREQUEST']._serialized_end=1202
-  _globals['_SECRETSLISTRESPONSE']._serialized_start=1205
-  _globals['_SECRETSLISTRESPONSE']._serialized_end=1372
-  _globals['_SECRETSLISTRESPONSE_SECRET']._serialized_start=1300
-  _globals['_SECRETSLISTRESPONSE_SECRET']._serialized_end=1372
-  _globals['_SECRETGETREQUEST']._serialized_start=1374
-  _globals['_SECRETGETREQUEST']._serialized_end=1439
-  _globals['_SECRETGETRESPONSE']._serialized_start=1441
-  _globals['_SECRETGETRESPONSE']._serialized_end=1482
-  _globals['_SECRETSETREQUEST']._serialized_start=1485
-  _globals['_SECRETSETREQUEST']._serialized_end=1652
-  _globals['_SECRETSETRESPONSE']._serialized_start=1654
-  _globals['_SECRETSETRESPONSE']._serialized_end=1673
-  _globals['_SECRETUNSETREQUEST']._serialized_start=1676
-  _globals['_SECRETUNSETREQUEST']._serialized_end=1823
-  _globals['_SECRETUNSETRESPONSE']._serialized_start=1825
-  _globals['_SECRETUNSETRESPONSE']._serialized_end=1846
-  _globals['_MAPCONFIGSFORMODULEREQUEST']._serialized_start=1848
-  _globals['_MAPCONFIGSFORMODULEREQUEST']._serialized_end=1900
-  _globals['_MAPCONFIGSFORMODULERESPONSE']._serialized_start=1903
-  _globals['_MAPCONFIGSFORMODULERESPONSE']._serialized_end=2074
-  _globals['_MAPCONFIGSFORMODULERESPONSE_VALUESENTRY']._serialized_start=2017
-  _globals['_MAPCONFIGSFORMODULERESPONSE_VALUESENTRY']._serialized_end=2074
-  _globals['_MAPSECRETSFORMODULEREQUEST']._serialized_start=2076
-  _globals['_MAPSECRETSFORMODULEREQUEST']._serialized_end=2128
-  _globals['_MAPSECRETSFORMODULERESPONSE']._serialized_start=2131
-  _globals['_MAPSECRETSFORMODULERESPONSE']._serialized_end=2302
-  _globals['_MAPSECRETSFORMODULERESPONSE_VALUESENTRY']._serialized_start=2017
-  _globals['_MAPSECRETSFORMODULERESPONSE_VALUESENTRY']._serialized_end=2074
-  _globals['_ADMINSERVICE']._serialized_start=2597
-  _globals['_ADMINSERVICE']._serialized_end=3628
+  _globals['_ADMINSERVICE'].methods_by_name['ConfigHistory']._loaded_options = None
+  _globals['_ADMINSERVICE'].methods_by_name['ConfigHistory']._serialized_options = b'\220\002\001'
+  _globals['_ADMINSERVICE'].methods_by_name['ConfigCheck']._loaded_options = None
+  _globals['_ADMINSERVICE'].methods_by_name['ConfigCheck']._serialized_options = b'\220\002\001'
+  _globals['_ADMINSERVICE'].methods_by_name['SecretHistory']._loaded_options = None
+  _globals['_ADMINSERVICE'].methods_by_name['SecretHistory']._serialized_options = b'\220\002\001'
+  _globals['_ADMINSERVICE'].methods_by_name['GetCircuitBreakers']._loaded_options = None
+  _globals['_ADMINSERVICE'].methods_by_name['GetCircuitBreakers']._serialized_options = b'\220\002\001'
+  _globals['_CONFIGPROVIDER']._serialized_start=3221
+  _globals['_CONFIGPROVIDER']._serialized_end=3325
+  _globals['_SECRETPROVIDER']._serialized_start=3328
+  _globals['_SECRETPROVIDER']._serialized_end=3511
+  _globals['_CONFIGREF']._serialized_start=155
+  _globals['_CONFIGREF']._serialized_end=226
+  _globals['_CONFIGLISTREQUEST']._serialized_start=229
+  _globals['_CONFIGLISTREQUEST']._serialized_end=431
+  _globals['_CONFIGLISTRESPONSE']._serialized_start=434
+  _globals['_CONFIGLISTRESPONSE']._serialized_end=599
+  _globals['_CONFIGLISTRESPONSE_CONFIG']._serialized_start=527
+  _globals['_CONFIGLISTRESPONSE_CONFIG']._serialized_end=599
+  _globals['_CONFIGGETREQUEST']._serialized_start=601
+  _globals['_CONFIGGETREQUEST']._serialized_end=666
+  _globals['_CONFIGGETRESPONSE']._serialized_start=668
+  _globals['_CONFIGGETRESPONSE']._serialized_end=709
+  _globals['_CONFIGSETREQUEST']._serialized_start=712
+  _globals['_CONFIGSETREQUEST']._serialized_end=879
+  _globals['_CONFIGSETRESPONSE']._serialized_start=881
+  _globals['_CONFIGSETRESPONSE']._serialized_end=900
+  _globals['_CONFIGUNSETREQUEST']._serialized_start=903
+  _globals['_CONFIGUNSETREQUEST']._serialized_end=1050
+  _globals['_CONFIGUNSETRESPONSE']._serialized_start=1052
+  _globals['_CONFIGUNSETRESPONSE']._serialized_end=1073
+  _globals['_SECRETSLISTREQUEST']._serialized_start=1076
+  _globals['_SECRETSLISTREQUEST']._serialized_end=1279
+  _globals['_SECRETSLISTRESPONSE']._serialized_start=1282
+  _globals['_SECRETSLISTRESPONSE']._serialized_end=1449
+  _globals['_SECRETSLISTRESPONSE_SECRET']._serialized_start=1377
+  _globals['_SECRETSLISTRESPONSE_SECRET']._serialized_end=1449
+  _globals['_SECRETGETREQUEST']._serialized_start=1451
+  _globals['_SECRETGETREQUEST']._serialized_end=1516
+  _globals['_SECRETGETRESPONSE']._serialized_start=1518
+  _globals['_SECRETGETRESPONSE']._serialized_end=1559
+  _globals['_SECRETSETREQUEST']._serialized_start=1562
+  _globals['_SECRETSETREQUEST']._serialized_end=1729
+  _globals['_SECRETSETRESPONSE']._serialized_start=1731
+  _globals['_SECRETSETRESPONSE']._serialized_end=1750
+  _globals['_SECRETUNSETREQUEST']._serialized_start=1753
+  _globals['_SECRETUNSETREQUEST']._serialized_end=1900
+  _globals['_SECRETUNSETRESPONSE']._serialized_start=1902
+  _globals['_SECRETUNSETRESPONSE']._serialized_end=1923
+  _globals['_MAPCONFIGSFORMODULEREQUEST']._serialized_start=1925
+  _globals['_MAPCONFIGSFORMODULEREQUEST']._serialized_end=1977
+  _globals['_MAPCONFIGSFORMODULERESPONSE']._serialized_start=1980
+  _globals['_MAPCONFIGSFORMODULERESPONSE']._serialized_end=2151
+  _globals['_MAPCONFIGSFORMODULERESPONSE_VALUESENTRY']._serialized_start=2094
+  _globals['_MAPCONFIGSFORMODULERESPONSE_VALUESENTRY']._serialized_end=2151
+  _globals['_MAPSECRETSFORMODULEREQUEST']._serialized_start=2153
+  _globals['_MAPSECRETSFORMODULEREQUEST']._serialized_end=2205
+  _globals['_MAPSECRETSFORMODULERESPONSE']._serialized_start=2208
+  _globals['_MAPSECRETSFORMODULERESPONSE']._serialized_end=2379
+  _globals['_MAPSECRETSFORMODULERESPONSE_VALUESENTRY']._serialized_start=2094
+  _globals['_MAPSECRETSFORMODULERESPONSE_VALUESENTRY']._serialized_end=2151
+  _globals['_CONFIGHISTORYREQUEST']._serialized_start=2381
+  _globals['_CONFIGHISTORYREQUEST']._serialized_end=2450
+  _globals['_CONFIGHISTORYRESPONSE']._serialized_start=2452
+  _globals['_CONFIGHISTORYRESPONSE']._serialized_end=2533
+  _globals['_CONFIGRESTOREREQUEST']._serialized_start=2535
+  _globals['_CONFIGRESTOREREQUEST']._serialized_end=2633
+  _globals['_CONFIGRESTORERESPONSE']._serialized_start=2635
+  _globals['_CONFIGRESTORERESPONSE']._serialized_end=2658
+  _globals['_CONFIGCHECKREQUEST']._serialized_start=2660
+  _globals['_CONFIGCHECKREQUEST']._serialized_end=2720
+  _globals['_CONFIGCHECKRESPONSE']._serialized_start=2723
+  _globals['_CONFIGCHECKRESPONSE']._serialized_end=2923
+  _globals['_CONFIGCHECKRESPONSE_PROBLEM']._serialized_start=2821
+  _globals['_CONFIGCHECKRESPONSE_PROBLEM']._serialized_end=2923
+  _globals['_SECRETHISTORYREQUEST']._serialized_start=2925
+  _globals['_SECRETHISTORYREQUEST']._serialized_end=2994
+  _globals['_SECRETHISTORYRESPONSE']._serialized_start=2996
+  _globals['_SECRETHISTORYRESPONSE']._serialized_end=3077
+  _globals['_GETCIRCUITBREAKERSREQUEST']._serialized_start=3079
+  _globals['_GETCIRCUITBREAKERSREQUEST']._serialized_end=3106
+  _globals['_GETCIRCUITBREAKERSRESPONSE']._serialized_start=3108
+  _globals['_GETCIRCUITBREAKERSRESPONSE']._serialized_end=3219
+  _globals['_ADMINSERVICE']._serialized_start=3514
+  _globals['_ADMINSERVICE']._serialized_end=5064
 # @@protoc_insertion_point(module_scope)
@@ -1,3 +1,5 @@
+from xyz.block.ftl.v1 import circuitbreaker_pb2 as _circuitbreaker_pb2
+from xyz.block.ftl.v1 import confighistory_pb2 as _confighistory_pb2
 from xyz.block.ftl.v1 import ftl_pb2 as _ftl_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
@@ -198,3 +200,70 @@ class MapSecretsForModuleResponse(_message.Message):
     VALUES_FIELD_NUMBER: _ClassVar[int]
     values: _containers.ScalarMap[str, bytes]
     def __init__(self, values: _Optional[_Mapping[str, bytes]] = ...) -> None: ...
+
+class ConfigHistoryRequest(_message.Message):
+    __slots__ = ("ref",)
+    REF_FIELD_NUMBER: _ClassVar[int]
+    ref: ConfigRef
+    def __init__(self, ref: _Optional[_Union[ConfigRef, _Mapping]] = ...) -> None: ...
+
+class ConfigHistoryResponse(_message.Message):
+    __slots__ = ("changes",)
+    CHANGES_FIELD_NUMBER: _ClassVar[int]
+    changes: _containers.RepeatedCompositeFieldContainer[_confighistory_pb2.ConfigChange]
+    def __init__(self, changes: _Optional[_Iterable[_Union[_confighistory_pb2.ConfigChange, _Mapping]]] = ...) -> None: ...
+
+class ConfigRestoreRequest(_message.Message):
+    __slots__ = ("ref", "change_id")
+    REF_FIELD_NUMBER: _ClassVar[int]
+    CHANGE_ID_FIELD_NUMBER: _ClassVar[int]
+    ref: ConfigRef
+    change_id: int
+    def __init__(self, ref: _Optional[_Union[ConfigRef, _Mapping]] = ..., change_id: _Optional[int] = ...) -> None: ...
+
+class ConfigRestoreResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ConfigCheckRequest(_message.Message):
+    __slots__ = ("module",)
+    MODULE_FIELD_NUMBER: _ClassVar[int]
+    module: str
+    def __init__(self, module: _Optional[str] = ...) -> None: ...
+
+class ConfigCheckResponse(_message.Message):
+    __slots__ = ("problems",)
+    class Problem(_message.Message):
+        __slots__ = ("ref", "secret", "error")
+        REF_FIELD_NUMBER: _ClassVar[int]
+        SECRET_FIELD_NUMBER: _ClassVar[int]
+        ERROR_FIELD_NUMBER: _ClassVar[int]
+        ref: ConfigRef
+        secret: bool
+        error: str
+        def __init__(self, ref: _Optional[_Union[ConfigRef, _Mapping]] = ..., secret: bool = ..., error: _Optional[str] = ...) -> None: ...
+    PROBLEMS_FIELD_NUMBER: _ClassVar[int]
+    problems: _containers.RepeatedCompositeFieldContainer[ConfigCheckResponse.Problem]
+    def __init__(self, problems: _Optional[_Iterable[_Union[ConfigCheckResponse.Problem, _Mapping]]] = ...) -> None: ...
+
+class SecretHistoryRequest(_message.Message):
+    __slots__ = ("ref",)
+    REF_FIELD_NUMBER: _ClassVar[int]
+    ref: ConfigRef
+    def __init__(self, ref: _Optional[_Union[ConfigRef, _Mapping]] = ...) -> None: ...
+
+class SecretHistoryResponse(_message.Message):
+    __slots__ = ("changes",)
+    CHANGES_FIELD_NUMBER: _ClassVar[int]
+    changes: _containers.RepeatedCompositeFieldContainer[_confighistory_pb2.ConfigChange]
+    def __init__(self, changes: _Optional[_Iterable[_Union[_confighistory_pb2.ConfigChange, _Mapping]]] = ...) -> None: ...
+
+class GetCircuitBreakersRequest(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class GetCircuitBreakersResponse(_message.Message):
+    __slots__ = ("circuit_breakers",)
+    CIRCUIT_BREAKERS_FIELD_NUMBER: _ClassVar[int]
+    circuit_breakers: _containers.RepeatedCompositeFieldContainer[_circuitbreaker_pb2.CircuitBreakerStatus]
+    def __init__(self, circuit_breakers: _Optional[_Iterable[_Union[_circuitbreaker_pb2.CircuitBreakerStatus, _Mapping]]] = ...) -> None: ...
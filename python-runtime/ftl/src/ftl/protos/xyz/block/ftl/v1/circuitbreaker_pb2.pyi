@@ -0,0 +1,39 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from xyz.block.ftl.schema.v1 import schema_pb2 as _schema_pb2
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class CircuitBreakerState(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    CIRCUIT_BREAKER_STATE_UNSPECIFIED: _ClassVar[CircuitBreakerState]
+    CIRCUIT_BREAKER_STATE_CLOSED: _ClassVar[CircuitBreakerState]
+    CIRCUIT_BREAKER_STATE_OPEN: _ClassVar[CircuitBreakerState]
+    CIRCUIT_BREAKER_STATE_HALF_OPEN: _ClassVar[CircuitBreakerState]
+CIRCUIT_BREAKER_STATE_UNSPECIFIED: CircuitBreakerState
+CIRCUIT_BREAKER_STATE_CLOSED: CircuitBreakerState
+CIRCUIT_BREAKER_STATE_OPEN: CircuitBreakerState
+CIRCUIT_BREAKER_STATE_HALF_OPEN: CircuitBreakerState
+
+class CircuitBreakerStatus(_message.Message):
+    __slots__ = ("deployment_key", "verb_ref", "state", "since", "requests", "failures", "service", "instance")
+    DEPLOYMENT_KEY_FIELD_NUMBER: _ClassVar[int]
+    VERB_REF_FIELD_NUMBER: _ClassVar[int]
+    STATE_FIELD_NUMBER: _ClassVar[int]
+    SINCE_FIELD_NUMBER: _ClassVar[int]
+    REQUESTS_FIELD_NUMBER: _ClassVar[int]
+    FAILURES_FIELD_NUMBER: _ClassVar[int]
+    SERVICE_FIELD_NUMBER: _ClassVar[int]
+    INSTANCE_FIELD_NUMBER: _ClassVar[int]
+    deployment_key: str
+    verb_ref: _schema_pb2.Ref
+    state: CircuitBreakerState
+    since: _timestamp_pb2.Timestamp
+    requests: int
+    failures: int
+    service: str
+    instance: str
+    def __init__(self, deployment_key: _Optional[str] = ..., verb_ref: _Optional[_Union[_schema_pb2.Ref, _Mapping]] = ..., state: _Optional[_Union[CircuitBreakerState, str]] = ..., since: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., requests: _Optional[int] = ..., failures: _Optional[int] = ..., service: _Optional[str] = ..., instance: _Optional[str] = ...) -> None: ...
@@ -0,0 +1,40 @@
+# -*- coding: utf-8 -*-
+# Generated by the protocol buffer compiler.  DO NOT EDIT!
+# NO CHECKED-IN PROTOBUF GENCODE
+# source: xyz/block/ftl/v1/confighistory.proto
+# Protobuf Python Version: 5.29.1
+"""Generated protocol buffer code."""
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import descriptor_pool as _descriptor_pool
+from google.protobuf import runtime_version as _runtime_version
+from google.protobuf import symbol_database as _symbol_database
+from google.protobuf.internal import builder as _builder
+_runtime_version.ValidateProtobufRuntimeVersion(
+    _runtime_version.Domain.PUBLIC,
+    5,
+    29,
+    1,
+    '',
+    'xyz/block/ftl/v1/confighistory.proto'
+)
+# @@protoc_insertion_point(imports)
+
+_sym_db = _symbol_database.Default()
+
+
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
+
+
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n$xyz/block/ftl/v1/confighistory.proto\x12\x10xyz.block.ftl.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x02\n\x0c\x43onfigChange\x12\x0e\n\x02id\x18\x01 \x01(\x03R\x02id\x12\x38\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n\x05\x61\x63tor\x18\x03 \x01(\tR\x05\x61\x63tor\x12\x1a\n\x08provider\x18\x04 \x01(\tR\x08provider\x12\x45\n\toperation\x18\x05 \x01(\x0e\x32\'.xyz.block.ftl.v1.ConfigChangeOperationR\toperation\x12\x19\n\x05value\x18\x06 \x01(\x0cH\x00R\x05value\x88\x01\x01\x12%\n\x0b\x66ingerprint\x18\x07 \x01(\tH\x01R\x0b\x66ingerprint\x88\x01\x01\x12(\n\rrestored_from\x18\x08 \x01(\x03H\x02R\x0crestoredFrom\x88\x01\x01\x42\x08\n\x06_valueB\x0e\n\x0c_fingerprintB\x10\n\x0e_restored_from*\x84\x01\n\x15\x43onfigChangeOperation\x12\'\n#CONFIG_CHANGE_OPERATION_UNSPECIFIED\x10\x00\x12\x1f\n\x1b\x43ONFIG_CHANGE_OPERATION_SET\x10\x01\x12!\n\x1d\x43ONFIG_CHANGE_OPERATION_UNSET\x10\x02\x42>P\x01Z:github.com/block/ftl/backend/protos/xyz/block/ftl/v1;ftlv1b\x06proto3')
+
+_globals = globals()
+_builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
+_builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'xyz.block.ftl.v1.confighistory_pb2', _globals)
+if not _descriptor._USE_C_DESCRIPTORS:
+  _globals['DESCRIPTOR']._loaded_options = None
+  _globals['DESCRIPTOR']._serialized_options = b'P\001Z:github.com/block/ftl/backend/protos/xyz/block/ftl/v1;ftlv1'
+  _globals['_CONFIGCHANGEOPERATION']._serialized_start=456
+  _globals['_CONFIGCHANGEOPERATION']._serialized_end=588
+  _globals['_CONFIGCHANGE']._serialized_start=92
+  _globals['_CONFIGCHANGE']._serialized_end=453
+# @@protoc_insertion_point(module_scope)
@@ -0,0 +1,36 @@
+from google.protobuf import timestamp_pb2 as _timestamp_pb2
+from google.protobuf.internal import enum_type_wrapper as _enum_type_wrapper
+from google.protobuf import descriptor as _descriptor
+from google.protobuf import message as _message
+from typing import ClassVar as _ClassVar, Mapping as _Mapping, Optional as _Optional, Union as _Union
+
+DESCRIPTOR: _descriptor.FileDescriptor
+
+class ConfigChangeOperation(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    CONFIG_CHANGE_OPERATION_UNSPECIFIED: _ClassVar[ConfigChangeOperation]
+    CONFIG_CHANGE_OPERATION_SET: _ClassVar[ConfigChangeOperation]
+    CONFIG_CHANGE_OPERATION_UNSET: _ClassVar[ConfigChangeOperation]
+CONFIG_CHANGE_OPERATION_UNSPECIFIED: ConfigChangeOperation
+CONFIG_CHANGE_OPERATION_SET: ConfigChangeOperation
+CONFIG_CHANGE_OPERATION_UNSET: ConfigChangeOperation
+
+class ConfigChange(_message.Message):
+    __slots__ = ("id", "timestamp", "actor", "provider", "operation", "value", "fingerprint", "restored_from")
+    ID_FIELD_NUMBER: _ClassVar[int]
+    TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    ACTOR_FIELD_NUMBER: _ClassVar[int]
+    PROVIDER_FIELD_NUMBER: _ClassVar[int]
+    OPERATION_FIELD_NUMBER: _ClassVar[int]
+    VALUE_FIELD_NUMBER: _ClassVar[int]
+    FINGERPRINT_FIELD_NUMBER: _ClassVar[int]
+    RESTORED_FROM_FIELD_NUMBER: _ClassVar[int]
+    id: int
+    timestamp: _timestamp_pb2.Timestamp
+    actor: str
+    provider: str
+    operation: ConfigChangeOperation
+    value: bytes
+    fingerprint: str
+    restored_from: int
+    def __init__(self, id: _Optional[int] = ..., timestamp: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., actor: _Optional[str] = ..., provider: _Optional[str] = ..., operation: _Optional[_Union[ConfigChangeOperation, str]] = ..., value: _Optional[bytes] = ..., fingerprint: _Optional[str] = ..., restored_from: _Optional[int] = ...) -> None: ...
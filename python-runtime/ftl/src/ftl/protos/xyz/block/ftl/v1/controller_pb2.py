@@ -25,10 +25,11 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 from xyz.block.ftl.schema.v1 import schema_pb2 as xyz_dot_block_dot_ftl_dot_schema_dot_v1_dot_schema__pb2
+from xyz.block.ftl.v1 import circuitbreaker_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_circuitbreaker__pb2
 from xyz.block.ftl.v1 import ftl_pb2 as xyz_dot_block_dot_ftl_dot_v1_dot_ftl__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n!xyz/block/ftl/v1/controller.proto\x12\x10xyz.block.ftl.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"@\n\x17GetArtefactDiffsRequest\x12%\n\x0e\x63lient_digests\x18\x01 \x03(\tR\rclientDigests\"\x94\x01\n\x18GetArtefactDiffsResponse\x12\'\n\x0fmissing_digests\x18\x01 \x03(\tR\x0emissingDigests\x12O\n\x10\x63lient_artefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\x0f\x63lientArtefacts\"1\n\x15UploadArtefactRequest\x12\x18\n\x07\x63ontent\x18\x01 \x01(\x0cR\x07\x63ontent\"0\n\x16UploadArtefactResponse\x12\x16\n\x06\x64igest\x18\x02 \x01(\x0cR\x06\x64igest\"`\n\x12\x44\x65ploymentArtefact\x12\x16\n\x06\x64igest\x18\x01 \x01(\tR\x06\x64igest\x12\x12\n\x04path\x18\x02 \x01(\tR\x04path\x12\x1e\n\nexecutable\x18\x03 \x01(\x08R\nexecutable\"\x96\x01\n\x17\x43reateDeploymentRequest\x12\x37\n\x06schema\x18\x01 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06schema\x12\x42\n\tartefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\tartefacts\"\x94\x01\n\x18\x43reateDeploymentResponse\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12\x37\n\x15\x61\x63tive_deployment_key\x18\x02 \x01(\tH\x00R\x13\x61\x63tiveDeploymentKey\x88\x01\x01\x42\x18\n\x16_active_deployment_key\"\x93\x01\n\x1dGetDeploymentArtefactsRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12K\n\x0ehave_artefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\rhaveArtefacts\"x\n\x1eGetDeploymentArtefactsResponse\x12@\n\x08\x61rtefact\x18\x01 \x01(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\x08\x61rtefact\x12\x14\n\x05\x63hunk\x18\x02 \x01(\x0cR\x05\x63hunk\"=\n\x14GetDeploymentRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\"\x94\x01\n\x15GetDeploymentResponse\x12\x37\n\x06schema\x18\x01 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06schema\x12\x42\n\tartefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\tartefacts\"\x96\x01\n\x15RegisterRunnerRequest\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12\x1e\n\ndeployment\x18\x03 \x01(\tR\ndeployment\x12/\n\x06labels\x18\x05 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\"\x18\n\x16RegisterRunnerResponse\"u\n\x13UpdateDeployRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12&\n\x0cmin_replicas\x18\x02 \x01(\x05H\x00R\x0bminReplicas\x88\x01\x01\x42\x0f\n\r_min_replicas\"\x16\n\x14UpdateDeployResponse\"`\n\x14ReplaceDeployRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\"\x17\n\x15ReplaceDeployResponse\"\xaf\x03\n\x1bStreamDeploymentLogsRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x39\n\ntime_stamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimeStamp\x12\x1b\n\tlog_level\x18\x04 \x01(\x05R\x08logLevel\x12]\n\nattributes\x18\x05 \x03(\x0b\x32=.xyz.block.ftl.v1.StreamDeploymentLogsRequest.AttributesEntryR\nattributes\x12\x18\n\x07message\x18\x06 \x01(\tR\x07message\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x1a=\n\x0f\x41ttributesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\x1e\n\x1cStreamDeploymentLogsResponse\"\x0f\n\rStatusRequest\"\xfc\x06\n\x0eStatusResponse\x12M\n\x0b\x63ontrollers\x18\x01 \x03(\x0b\x32+.xyz.block.ftl.v1.StatusResponse.ControllerR\x0b\x63ontrollers\x12\x41\n\x07runners\x18\x02 \x03(\x0b\x32\'.xyz.block.ftl.v1.StatusResponse.RunnerR\x07runners\x12M\n\x0b\x64\x65ployments\x18\x03 \x03(\x0b\x32+.xyz.block.ftl.v1.StatusResponse.DeploymentR\x0b\x64\x65ployments\x12>\n\x06routes\x18\x05 \x03(\x0b\x32&.xyz.block.ftl.v1.StatusResponse.RouteR\x06routes\x1aT\n\nController\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12\x18\n\x07version\x18\x03 \x01(\tR\x07version\x1a\x9b\x01\n\x06Runner\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12#\n\ndeployment\x18\x03 \x01(\tH\x00R\ndeployment\x88\x01\x01\x12/\n\x06labels\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labelsB\r\n\x0b_deployment\x1a\xf7\x01\n\nDeployment\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08language\x18\x02 \x01(\tR\x08language\x12\x12\n\x04name\x18\x03 \x01(\tR\x04name\x12!\n\x0cmin_replicas\x18\x04 \x01(\x05R\x0bminReplicas\x12\x1a\n\x08replicas\x18\x07 \x01(\x05R\x08replicas\x12/\n\x06labels\x18\x05 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\x12\x37\n\x06schema\x18\x06 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06schema\x1a[\n\x05Route\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\x12\x1e\n\ndeployment\x18\x02 \x01(\tR\ndeployment\x12\x1a\n\x08\x65ndpoint\x18\x03 \x01(\tR\x08\x65ndpoint\"\x14\n\x12ProcessListRequest\"\xaf\x03\n\x13ProcessListResponse\x12K\n\tprocesses\x18\x01 \x03(\x0b\x32-.xyz.block.ftl.v1.ProcessListResponse.ProcessR\tprocesses\x1an\n\rProcessRunner\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12/\n\x06labels\x18\x03 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\x1a\xda\x01\n\x07Process\x12\x1e\n\ndeployment\x18\x01 \x01(\tR\ndeployment\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\x12/\n\x06labels\x18\x03 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\x12P\n\x06runner\x18\x04 \x01(\x0b\x32\x33.xyz.block.ftl.v1.ProcessListResponse.ProcessRunnerH\x00R\x06runner\x88\x01\x01\x42\t\n\x07_runner2\xc5\t\n\x11\x43ontrollerService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12Z\n\x0bProcessList\x12$.xyz.block.ftl.v1.ProcessListRequest\x1a%.xyz.block.ftl.v1.ProcessListResponse\x12K\n\x06Status\x12\x1f.xyz.block.ftl.v1.StatusRequest\x1a .xyz.block.ftl.v1.StatusResponse\x12i\n\x10GetArtefactDiffs\x12).xyz.block.ftl.v1.GetArtefactDiffsRequest\x1a*.xyz.block.ftl.v1.GetArtefactDiffsResponse\x12\x63\n\x0eUploadArtefact\x12\'.xyz.block.ftl.v1.UploadArtefactRequest\x1a(.xyz.block.ftl.v1.UploadArtefactResponse\x12i\n\x10\x43reateDeployment\x12).xyz.block.ftl.v1.CreateDeploymentRequest\x1a*.xyz.block.ftl.v1.CreateDeploymentResponse\x12`\n\rGetDeployment\x12&.xyz.block.ftl.v1.GetDeploymentRequest\x1a\'.xyz.block.ftl.v1.GetDeploymentResponse\x12}\n\x16GetDeploymentArtefacts\x12/.xyz.block.ftl.v1.GetDeploymentArtefactsRequest\x1a\x30.xyz.block.ftl.v1.GetDeploymentArtefactsResponse0\x01\x12\x65\n\x0eRegisterRunner\x12\'.xyz.block.ftl.v1.RegisterRunnerRequest\x1a(.xyz.block.ftl.v1.RegisterRunnerResponse(\x01\x12]\n\x0cUpdateDeploy\x12%.xyz.block.ftl.v1.UpdateDeployRequest\x1a&.xyz.block.ftl.v1.UpdateDeployResponse\x12`\n\rReplaceDeploy\x12&.xyz.block.ftl.v1.ReplaceDeployRequest\x1a\'.xyz.block.ftl.v1.ReplaceDeployResponse\x12w\n\x14StreamDeploymentLogs\x12-.xyz.block.ftl.v1.StreamDeploymentLogsRequest\x1a..xyz.block.ftl.v1.StreamDeploymentLogsResponse(\x01\x42>P\x01Z:github.com/block/ftl/backend/protos/xyz/block/ftl/v1;ftlv1b\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n!xyz/block/ftl/v1/controller.proto\x12\x10xyz.block.ftl.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$xyz/block/ftl/schema/v1/schema.proto\x1a%xyz/block/ftl/v1/circuitbreaker.proto\x1a\x1axyz/block/ftl/v1/ftl.proto\"@\n\x17GetArtefactDiffsRequest\x12%\n\x0e\x63lient_digests\x18\x01 \x03(\tR\rclientDigests\"\x94\x01\n\x18GetArtefactDiffsResponse\x12\'\n\x0fmissing_digests\x18\x01 \x03(\tR\x0emissingDigests\x12O\n\x10\x63lient_artefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\x0f\x63lientArtefacts\"1\n\x15UploadArtefactRequest\x12\x18\n\x07\x63ontent\x18\x01 \x01(\x0cR\x07\x63ontent\"0\n\x16UploadArtefactResponse\x12\x16\n\x06\x64igest\x18\x02 \x01(\x0cR\x06\x64igest\"`\n\x12\x44\x65ploymentArtefact\x12\x16\n\x06\x64igest\x18\x01 \x01(\tR\x06\x64igest\x12\x12\n\x04path\x18\x02 \x01(\tR\x04path\x12\x1e\n\nexecutable\x18\x03 \x01(\x08R\nexecutable\"\xc7\x01\n\x17\x43reateDeploymentRequest\x12\x37\n\x06schema\x18\x01 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06schema\x12\x42\n\tartefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\tartefacts\x12!\n\tchangeset\x18\x03 \x01(\tH\x00R\tchangeset\x88\x01\x01\x42\x0c\n\n_changeset\"\x94\x01\n\x18\x43reateDeploymentResponse\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12\x37\n\x15\x61\x63tive_deployment_key\x18\x02 \x01(\tH\x00R\x13\x61\x63tiveDeploymentKey\x88\x01\x01\x42\x18\n\x16_active_deployment_key\"\x93\x01\n\x1dGetDeploymentArtefactsRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12K\n\x0ehave_artefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\rhaveArtefacts\"x\n\x1eGetDeploymentArtefactsResponse\x12@\n\x08\x61rtefact\x18\x01 \x01(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\x08\x61rtefact\x12\x14\n\x05\x63hunk\x18\x02 \x01(\x0cR\x05\x63hunk\"=\n\x14GetDeploymentRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\"\x94\x01\n\x15GetDeploymentResponse\x12\x37\n\x06schema\x18\x01 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06schema\x12\x42\n\tartefacts\x18\x02 \x03(\x0b\x32$.xyz.block.ftl.v1.DeploymentArtefactR\tartefacts\"\x96\x01\n\x15RegisterRunnerRequest\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12\x1e\n\ndeployment\x18\x03 \x01(\tR\ndeployment\x12/\n\x06labels\x18\x05 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\"\x18\n\x16RegisterRunnerResponse\"u\n\x13UpdateDeployRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12&\n\x0cmin_replicas\x18\x02 \x01(\x05H\x00R\x0bminReplicas\x88\x01\x01\x42\x0f\n\r_min_replicas\"\x16\n\x14UpdateDeployResponse\"`\n\x14ReplaceDeployRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\"\x17\n\x15ReplaceDeployResponse\"S\n\x16\x43reateChangesetRequest\x12\x39\n\x07modules\x18\x01 \x03(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x07modules\"7\n\x17\x43reateChangesetResponse\x12\x1c\n\tchangeset\x18\x01 \x01(\tR\tchangeset\"Y\n\x16\x43ommitChangesetRequest\x12\x1c\n\tchangeset\x18\x01 \x01(\tR\tchangeset\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\"\x19\n\x17\x43ommitChangesetResponse\"8\n\x18RollbackChangesetRequest\x12\x1c\n\tchangeset\x18\x01 \x01(\tR\tchangeset\"\x1b\n\x19RollbackChangesetResponse\"\x88\x01\n\x16\x44\x65ployChangesetRequest\x12K\n\x0b\x64\x65ployments\x18\x01 \x03(\x0b\x32).xyz.block.ftl.v1.CreateDeploymentRequestR\x0b\x64\x65ployments\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\"`\n\x17\x44\x65ployChangesetResponse\x12\x1c\n\tchangeset\x18\x01 \x01(\tR\tchangeset\x12\'\n\x0f\x64\x65ployment_keys\x18\x02 \x03(\tR\x0e\x64\x65ploymentKeys\"\xaf\x03\n\x1bStreamDeploymentLogsRequest\x12%\n\x0e\x64\x65ployment_key\x18\x01 \x01(\tR\rdeploymentKey\x12$\n\x0brequest_key\x18\x02 \x01(\tH\x00R\nrequestKey\x88\x01\x01\x12\x39\n\ntime_stamp\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.TimestampR\ttimeStamp\x12\x1b\n\tlog_level\x18\x04 \x01(\x05R\x08logLevel\x12]\n\nattributes\x18\x05 \x03(\x0b\x32=.xyz.block.ftl.v1.StreamDeploymentLogsRequest.AttributesEntryR\nattributes\x12\x18\n\x07message\x18\x06 \x01(\tR\x07message\x12\x19\n\x05\x65rror\x18\x07 \x01(\tH\x01R\x05\x65rror\x88\x01\x01\x1a=\n\x0f\x41ttributesEntry\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n\x05value\x18\x02 \x01(\tR\x05value:\x02\x38\x01\x42\x0e\n\x0c_request_keyB\x08\n\x06_error\"\x1e\n\x1cStreamDeploymentLogsResponse\"\x0f\n\rStatusRequest\"\xcf\x07\n\x0eStatusResponse\x12M\n\x0b\x63ontrollers\x18\x01 \x03(\x0b\x32+.xyz.block.ftl.v1.StatusResponse.ControllerR\x0b\x63ontrollers\x12\x41\n\x07runners\x18\x02 \x03(\x0b\x32\'.xyz.block.ftl.v1.StatusResponse.RunnerR\x07runners\x12M\n\x0b\x64\x65ployments\x18\x03 \x03(\x0b\x32+.xyz.block.ftl.v1.StatusResponse.DeploymentR\x0b\x64\x65ployments\x12>\n\x06routes\x18\x05 \x03(\x0b\x32&.xyz.block.ftl.v1.StatusResponse.RouteR\x06routes\x12Q\n\x10\x63ircuit_breakers\x18\x06 \x03(\x0b\x32&.xyz.block.ftl.v1.CircuitBreakerStatusR\x0f\x63ircuitBreakers\x1aT\n\nController\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12\x18\n\x07version\x18\x03 \x01(\tR\x07version\x1a\x9b\x01\n\x06Runner\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12#\n\ndeployment\x18\x03 \x01(\tH\x00R\ndeployment\x88\x01\x01\x12/\n\x06labels\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labelsB\r\n\x0b_deployment\x1a\xf7\x01\n\nDeployment\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08language\x18\x02 \x01(\tR\x08language\x12\x12\n\x04name\x18\x03 \x01(\tR\x04name\x12!\n\x0cmin_replicas\x18\x04 \x01(\x05R\x0bminReplicas\x12\x1a\n\x08replicas\x18\x07 \x01(\x05R\x08replicas\x12/\n\x06labels\x18\x05 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\x12\x37\n\x06schema\x18\x06 \x01(\x0b\x32\x1f.xyz.block.ftl.schema.v1.ModuleR\x06schema\x1a[\n\x05Route\x12\x16\n\x06module\x18\x01 \x01(\tR\x06module\x12\x1e\n\ndeployment\x18\x02 \x01(\tR\ndeployment\x12\x1a\n\x08\x65ndpoint\x18\x03 \x01(\tR\x08\x65ndpoint\"\x14\n\x12ProcessListRequest\"\xaf\x03\n\x13ProcessListResponse\x12K\n\tprocesses\x18\x01 \x03(\x0b\x32-.xyz.block.ftl.v1.ProcessListResponse.ProcessR\tprocesses\x1an\n\rProcessRunner\x12\x10\n\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n\x08\x65ndpoint\x18\x02 \x01(\tR\x08\x65ndpoint\x12/\n\x06labels\x18\x03 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\x1a\xda\x01\n\x07Process\x12\x1e\n\ndeployment\x18\x01 \x01(\tR\ndeployment\x12!\n\x0cmin_replicas\x18\x02 \x01(\x05R\x0bminReplicas\x12/\n\x06labels\x18\x03 \x01(\x0b\x32\x17.google.protobuf.StructR\x06labels\x12P\n\x06runner\x18\x04 \x01(\x0b\x32\x33.xyz.block.ftl.v1.ProcessListResponse.ProcessRunnerH\x00R\x06runner\x88\x01\x01\x42\t\n\x07_runner2\xeb\x0c\n\x11\x43ontrollerService\x12J\n\x04Ping\x12\x1d.xyz.block.ftl.v1.PingRequest\x1a\x1e.xyz.block.ftl.v1.PingResponse\"\x03\x90\x02\x01\x12Z\n\x0bProcessList\x12$.xyz.block.ftl.v1.ProcessListRequest\x1a%.xyz.block.ftl.v1.ProcessListResponse\x12K\n\x06Status\x12\x1f.xyz.block.ftl.v1.StatusRequest\x1a .xyz.block.ftl.v1.StatusResponse\x12i\n\x10GetArtefactDiffs\x12).xyz.block.ftl.v1.GetArtefactDiffsRequest\x1a*.xyz.block.ftl.v1.GetArtefactDiffsResponse\x12\x63\n\x0eUploadArtefact\x12\'.xyz.block.ftl.v1.UploadArtefactRequest\x1a(.xyz.block.ftl.v1.UploadArtefactResponse\x12i\n\x10\x43reateDeployment\x12).xyz.block.ftl.v1.CreateDeploymentRequest\x1a*.xyz.block.ftl.v1.CreateDeploymentResponse\x12`\n\rGetDeployment\x12&.xyz.block.ftl.v1.GetDeploymentRequest\x1a\'.xyz.block.ftl.v1.GetDeploymentResponse\x12}\n\x16GetDeploymentArtefacts\x12/.xyz.block.ftl.v1.GetDeploymentArtefactsRequest\x1a\x30.xyz.block.ftl.v1.GetDeploymentArtefactsResponse0\x01\x12\x65\n\x0eRegisterRunner\x12\'.xyz.block.ftl.v1.RegisterRunnerRequest\x1a(.xyz.block.ftl.v1.RegisterRunnerResponse(\x01\x12]\n\x0cUpdateDeploy\x12%.xyz.block.ftl.v1.UpdateDeployRequest\x1a&.xyz.block.ftl.v1.UpdateDeployResponse\x12`\n\rReplaceDeploy\x12&.xyz.block.ftl.v1.ReplaceDeployRequest\x1a\'.xyz.block.ftl.v1.ReplaceDeployResponse\x12\x66\n\x0f\x43reateChangeset\x12(.xyz.block.ftl.v1.CreateChangesetRequest\x1a).xyz.block.ftl.v1.CreateChangesetResponse\x12\x66\n\x0f\x43ommitChangeset\x12(.xyz.block.ftl.v1.CommitChangesetRequest\x1a).xyz.block.ftl.v1.CommitChangesetResponse\x12l\n\x11RollbackChangeset\x12*.xyz.block.ftl.v1.RollbackChangesetRequest\x1a+.xyz.block.ftl.v1.RollbackChangesetResponse\x12\x66\n\x0f\x44\x65ployChangeset\x12(.xyz.block.ftl.v1.DeployChangesetRequest\x1a).xyz.block.ftl.v1.DeployChangesetResponse\x12w\n\x14StreamDeploymentLogs\x12-.xyz.block.ftl.v1.StreamDeploymentLogsRequest\x1a..xyz.block.ftl.v1.StreamDeploymentLogsResponse(\x01\x42>P\x01Z:github.com/block/ftl/backend/protos/xyz/block/ftl/v1;ftlv1b\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -40,66 +41,82 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_STREAMDEPLOYMENTLOGSREQUEST_ATTRIBUTESENTRY']._serialized_options = b'8\001'
   _globals['_CONTROLLERSERVICE'].methods_by_name['Ping']._loaded_options = None
   _globals['_CONTROLLERSERVICE'].methods_by_name['Ping']._serialized_options = b'\220\002\001'
-  _globals['_GETARTEFACTDIFFSREQUEST']._serialized_start=184
-  _globals['_GETARTEFACTDIFFSREQUEST']._serialized_end=248
-  _globals['_GETARTEFACTDIFFSRESPONSE']._serialized_start=251
-  _globals['_GETARTEFACTDIFFSRESPONSE']._serialized_end=399
-  _globals['_UPLOADARTEFACTREQUEST']._serialized_start=401
-  _globals['_UPLOADARTEFACTREQUEST']._serialized_end=450
-  _globals['_UPLOADARTEFACTRESPONSE']._serialized_start=452
-  _globals['_UPLOADARTEFACTRESPONSE']._serialized_end=500
-  _globals['_DEPLOYMENTARTEFACT']._serialized_start=502
-  _globals['_DEPLOYMENTARTEFACT']._serialized_end=598
-  _globals['_CREATEDEPLOYMENTREQUEST']._serialized_start=601
-  _globals['_CREATEDEPLOYMENTREQUEST']._serialized_end=751
-  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_start=754
-  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_end=902
-  _globals['_GETDEPLOYMENTARTEFACTSREQUEST']._serialized_start=905
-  _globals['_GETDEPLOYMENTARTEFACTSREQUEST']._serialized_end=1052
-  _globals['_GETDEPLOYMENTARTEFACTSRESPONSE']._serialized_start=1054
-  _globals['_GETDEPLOYMENTARTEFACTSRESPONSE']._serialized_end=1174
-  _globals['_GETDEPLOYMENTREQUEST']._serialized_start=1176
-  _globals['_GETDEPLOYMENTREQUEST']._serialized_end=1237
-  _globals['_GETDEPLOYMENTRESPONSE']._serialized_start=1240
-  _globals['_GETDEPLOYMENTRESPONSE']._serialized_end=1388
-  _globals['_REGISTERRUNNERREQUEST']._serialized_start=1391
-  _globals['_REGISTERRUNNERREQUEST']._serialized_end=1541
-  _globals['_REGISTERRUNNERRESPONSE']._serialized_start=1543
-  _globals['_REGISTERRUNNERRESPONSE']._serialized_end=1567
-  _globals['_UPDATEDEPLOYREQUEST']._serialized_start=1569
-  _globals['_UPDATEDEPLOYREQUEST']._serialized_end=1686
-  _globals['_UPDATEDEPLOYRESPONSE']._serialized_start=1688
-  _globals['_UPDATEDEPLOYRESPONSE']._serialized_end=1710
-  _globals['_REPLACEDEPLOYREQUEST']._serialized_start=1712
-  _globals['_REPLACEDEPLOYREQUEST']._serialized_end=1808
-  _globals['_REPLACEDEPLOYRESPONSE']._serialized_start=1810
-  _globals['_REPLACEDEPLOYRESPONSE']._serialized_end=1833
-  _globals['_STREAMDEPLOYMENTLOGSREQUEST']._serialized_start=1836
-  _globals['_STREAMDEPLOYMENTLOGSREQUEST']._serialized_end=2267
-  _globals['_STREAMDEPLOYMENTLOGSREQUEST_ATTRIBUTESENTRY']._serialized_start=2180
-  _globals['_STREAMDEPLOYMENTLOGSREQUEST_ATTRIBUTESENTRY']._serialized_end=2241
-  _globals['_STREAMDEPLOYMENTLOGSRESPONSE']._serialized_start=2269
-  _globals['_STREAMDEPLOYMENTLOGSRESPONSE']._serialized_end=2299
-  _globals['_STATUSREQUEST']._serialized_start=2301
-  _globals['_STATUSREQUEST']._serialized_end=2316
-  _globals['_STATUSRESPONSE']._serialized_start=2319
-  _globals['_STATUSRESPONSE']._serialized_end=3211
-  _globals['_STATUSRESPONSE_CONTROLLER']._serialized_start=2626
-  _globals['_STATUSRESPONSE_CONTROLLER']._serialized_end=2710
-  _globals['_STATUSRESPONSE_RUNNER']._serialized_start=2713
-  _globals['_STATUSRESPONSE_RUNNER']._serialized_end=2868
-  _globals['_STATUSRESPONSE_DEPLOYMENT']._serialized_start=2871
-  _globals['_STATUSRESPONSE_DEPLOYMENT']._serialized_end=3118
-  _globals['_STATUSRESPONSE_ROUTE']._serialized_start=3120
-  _globals['_STATUSRESPONSE_ROUTE']._serialized_end=3211
-  _globals['_PROCESSLISTREQUEST']._serialized_start=3213
-  _globals['_PROCESSLISTREQUEST']._serialized_end=3233
-  _globals['_PROCESSLISTRESPONSE']._serialized_start=3236
-  _globals['_PROCESSLISTRESPONSE']._serialized_end=3667
-  _globals['_PROCESSLISTRESPONSE_PROCESSRUNNER']._serialized_start=3336
-  _globals['_PROCESSLISTRESPONSE_PROCESSRUNNER']._serialized_end=3446
-  _globals['_PROCESSLISTRESPONSE_PROCESS']._serialized_start=3449
-  _globals['_PROCESSLISTRESPONSE_PROCESS']._serialized_end=3667
-  _globals['_CONTROLLERSERVICE']._serialized_start=3670
-  _globals['_CONTROLLERSERVICE']._serialized_end=4891
+  _globals['_GETARTEFACTDIFFSREQUEST']._serialized_start=223
+  _globals['_GETARTEFACTDIFFSREQUEST']._serialized_end=287
+  _globals['_GETARTEFACTDIFFSRESPONSE']._serialized_start=290
+  _globals['_GETARTEFACTDIFFSRESPONSE']._serialized_end=438
+  _globals['_UPLOADARTEFACTREQUEST']._serialized_start=440
+  _globals['_UPLOADARTEFACTREQUEST']._serialized_end=489
+  _globals['_UPLOADARTEFACTRESPONSE']._serialized_start=491
+  _globals['_UPLOADARTEFACTRESPONSE']._serialized_end=539
+  _globals['_DEPLOYMENTARTEFACT']._serialized_start=541
+  _globals['_DEPLOYMENTARTEFACT']._serialized_end=637
+  _globals['_CREATEDEPLOYMENTREQUEST']._serialized_start=640
+  _globals['_CREATEDEPLOYMENTREQUEST']._serialized_end=839
+  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_start=842
+  _globals['_CREATEDEPLOYMENTRESPONSE']._serialized_end=990
+  _globals['_GETDEPLOYMENTARTEFACTSREQUEST']._serialized_start=993
+  _globals['_GETDEPLOYMENTARTEFACTSREQUEST']._serialized_end=1140
+  _globals['_GETDEPLOYMENTARTEFACTSRESPONSE']._serialized_start=1142
+  _globals['_GETDEPLOYMENTARTEFACTSRESPONSE']._serialized_end=1262
+  _globals['_GETDEPLOYMENTREQUEST']._serialized_start=1264
+  _globals['_GETDEPLOYMENTREQUEST']._serialized_end=1325
+  _globals['_GETDEPLOYMENTRESPONSE']._serialized_start=1328
+  _globals['_GETDEPLOYMENTRESPONSE']._serialized_end=1476
+  _globals['_REGISTERRUNNERREQUEST']._serialized_start=1479
+  _globals['_REGISTERRUNNERREQUEST']._serialized_end=1629
+  _globals['_REGISTERRUNNERRESPONSE']._serialized_start=1631
+  _globals['_REGISTERRUNNERRESPONSE']._serialized_end=1655
+  _globals['_UPDATEDEPLOYREQUEST']._serialized_start=1657
+  _globals['_UPDATEDEPLOYREQUEST']._serialized_end=1774
+  _globals['_UPDATEDEPLOYRESPONSE']._serialized_start=1776
+  _globals['_UPDATEDEPLOYRESPONSE']._serialized_end=1798
+  _globals['_REPLACEDEPLOYREQUEST']._serialized_start=1800
+  _globals['_REPLACEDEPLOYREQUEST']._serialized_end=1896
+  _globals['_REPLACEDEPLOYRESPONSE']._serialized_start=1898
+  _globals['_REPLACEDEPLOYRESPONSE']._serialized_end=1921
+  _globals['_CREATECHANGESETREQUEST']._serialized_start=1923
+  _globals['_CREATECHANGESETREQUEST']._serialized_end=2006
+  _globals['_CREATECHANGESETRESPONSE']._serialized_start=2008
+  _globals['_CREATECHANGESETRESPONSE']._serialized_end=2063
+  _globals['_COMMITCHANGESETREQUEST']._serialized_start=2065
+  _globals['_COMMITCHANGESETREQUEST']._serialized_end=2154
+  _globals['_COMMITCHANGESETRESPONSE']._serialized_start=2156
+  _globals['_COMMITCHANGESETRESPONSE']._serialized_end=2181
+  _globals['_ROLLBACKCHANGESETREQUEST']._serialized_start=2183
+  _globals['_ROLLBACKCHANGESETREQUEST']._serialized_end=2239
+  _globals['_ROLLBACKCHANGESETRESPONSE']._serialized_start=2241
+  _globals['_ROLLBACKCHANGESETRESPONSE']._serialized_end=2268
+  _globals['_DEPLOYCHANGESETREQUEST']._serialized_start=2271
+  _globals['_DEPLOYCHANGESETREQUEST']._serialized_end=2407
+  _globals['_DEPLOYCHANGESETRESPONSE']._serialized_start=2409
+  _globals['_DEPLOYCHANGESETRESPONSE']._serialized_end=2505
+  _globals['_STREAMDEPLOYMENTLOGSREQUEST']._serialized_start=2508
+  _globals['_STREAMDEPLOYMENTLOGSREQUEST']._serialized_end=2939
+  _globals['_STREAMDEPLOYMENTLOGSREQUEST_ATTRIBUTESENTRY']._serialized_start=2852
+  _globals['_STREAMDEPLOYMENTLOGSREQUEST_ATTRIBUTESENTRY']._serialized_end=2913
+  _globals['_STREAMDEPLOYMENTLOGSRESPONSE']._serialized_start=2941
+  _globals['_STREAMDEPLOYMENTLOGSRESPONSE']._serialized_end=2971
+  _globals['_STATUSREQUEST']._serialized_start=2973
+  _globals['_STATUSREQUEST']._serialized_end=2988
+  _globals['_STATUSRESPONSE']._serialized_start=2991
+  _globals['_STATUSRESPONSE']._serialized_end=3966
+  _globals['_STATUSRESPONSE_CONTROLLER']._serialized_start=3381
+  _globals['_STATUSRESPONSE_CONTROLLER']._serialized_end=3465
+  _globals['_STATUSRESPONSE_RUNNER']._serialized_start=3468
+  _globals['_STATUSRESPONSE_RUNNER']._serialized_end=3623
+  _globals['_STATUSRESPONSE_DEPLOYMENT']._serialized_start=3626
+  _globals['_STATUSRESPONSE_DEPLOYMENT']._serialized_end=3873
+  _globals['_STATUSRESPONSE_ROUTE']._serialized_start=3875
+  _globals['_STATUSRESPONSE_ROUTE']._serialized_end=3966
+  _globals['_PROCESSLISTREQUEST']._serialized_start=3968
+  _globals['_PROCESSLISTREQUEST']._serialized_end=3988
+  _globals['_PROCESSLISTRESPONSE']._serialized_start=3991
+  _globals['_PROCESSLISTRESPONSE']._serialized_end=4422
+  _globals['_PROCESSLISTRESPONSE_PROCESSRUNNER']._serialized_start=4091
+  _globals['_PROCESSLISTRESPONSE_PROCESSRUNNER']._serialized_end=4201
+  _globals['_PROCESSLISTRESPONSE_PROCESS']._serialized_start=4204
+  _globals['_PROCESSLISTRESPONSE_PROCESS']._serialized_end=4422
+  _globals['_CONTROLLERSERVICE']._serialized_start=4425
+  _globals['_CONTROLLERSERVICE']._serialized_end=6068
 # @@protoc_insertion_point(module_scope)
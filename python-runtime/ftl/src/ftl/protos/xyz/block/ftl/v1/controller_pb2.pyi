@@ -1,6 +1,7 @@
 from google.protobuf import struct_pb2 as _struct_pb2
 from google.protobuf import timestamp_pb2 as _timestamp_pb2
 from xyz.block.ftl.schema.v1 import schema_pb2 as _schema_pb2
+from xyz.block.ftl.v1 import circuitbreaker_pb2 as _circuitbreaker_pb2
 from xyz.block.ftl.v1 import ftl_pb2 as _ftl_pb2
 from google.protobuf.internal import containers as _containers
 from google.protobuf import descriptor as _descriptor
@@ -46,12 +47,14 @@ class DeploymentArtefact(_message.Message):
     def __init__(self, digest: _Optional[str] = ..., path: _Optional[str] = ..., executable: bool = ...) -> None: ...
 
 class CreateDeploymentRequest(_message.Message):
-    __slots__ = ("schema", "artefacts")
+    __slots__ = ("schema", "artefacts", "changeset")
     SCHEMA_FIELD_NUMBER: _ClassVar[int]
     ARTEFACTS_FIELD_NUMBER: _ClassVar[int]
+    CHANGESET_FIELD_NUMBER: _ClassVar[int]
     schema: _schema_pb2.Module
     artefacts: _containers.RepeatedCompositeFieldContainer[DeploymentArtefact]
-    def __init__(self, schema: _Optional[_Union[_schema_pb2.Module, _Mapping]] = ..., artefacts: _Optional[_Iterable[_Union[DeploymentArtefact, _Mapping]]] = ...) -> None: ...
+    changeset: str
+    def __init__(self, schema: _Optional[_Union[_schema_pb2.Module, _Mapping]] = ..., artefacts: _Optional[_Iterable[_Union[DeploymentArtefact, _Mapping]]] = ..., changeset: _Optional[str] = ...) -> None: ...
 
 class CreateDeploymentResponse(_message.Message):
     __slots__ = ("deployment_key", "active_deployment_key")
@@ -131,6 +134,56 @@ class ReplaceDeployResponse(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
 
+class CreateChangesetRequest(_message.Message):
+    __slots__ = ("modules",)
+    MODULES_FIELD_NUMBER: _ClassVar[int]
+    modules: _containers.RepeatedCompositeFieldContainer[_schema_pb2.Module]
+    def __init__(self, modules: _Optional[_Iterable[_Union[_schema_pb2.Module, _Mapping]]] = ...) -> None: ...
+
+class CreateChangesetResponse(_message.Message):
+    __slots__ = ("changeset",)
+    CHANGESET_FIELD_NUMBER: _ClassVar[int]
+    changeset: str
+    def __init__(self, changeset: _Optional[str] = ...) -> None: ...
+
+class CommitChangesetRequest(_message.Message):
+    __slots__ = ("changeset", "min_replicas")
+    CHANGESET_FIELD_NUMBER: _ClassVar[int]
+    MIN_REPLICAS_FIELD_NUMBER: _ClassVar[int]
+    changeset: str
+    min_replicas: int
+    def __init__(self, changeset: _Optional[str] = ..., min_replicas: _Optional[int] = ...) -> None: ...
+
+class CommitChangesetResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class RollbackChangesetRequest(_message.Message):
+    __slots__ = ("changeset",)
+    CHANGESET_FIELD_NUMBER: _ClassVar[int]
+    changeset: str
+    def __init__(self, changeset: _Optional[str] = ...) -> None: ...
+
+class RollbackChangesetResponse(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class DeployChangesetRequest(_message.Message):
+    __slots__ = ("deployments", "min_replicas")
+    DEPLOYMENTS_FIELD_NUMBER: _ClassVar[int]
+    MIN_REPLICAS_FIELD_NUMBER: _ClassVar[int]
+    deployments: _containers.RepeatedCompositeFieldContainer[CreateDeploymentRequest]
+    min_replicas: int
+    def __init__(self, deployments: _Optional[_Iterable[_Union[CreateDeploymentRequest, _Mapping]]] = ..., min_replicas: _Optional[int] = ...) -> None: ...
+
+class DeployChangesetResponse(_message.Message):
+    __slots__ = ("changeset", "deployment_keys")
+    CHANGESET_FIELD_NUMBER: _ClassVar[int]
+    DEPLOYMENT_KEYS_FIELD_NUMBER: _ClassVar[int]
+    changeset: str
+    deployment_keys: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, changeset: _Optional[str] = ..., deployment_keys: _Optional[_Iterable[str]] = ...) -> None: ...
+
 class StreamDeploymentLogsRequest(_message.Message):
     __slots__ = ("deployment_key", "request_key", "time_stamp", "log_level", "attributes", "message", "error")
     class AttributesEntry(_message.Message):
@@ -165,7 +218,7 @@ class StatusRequest(_message.Message):
     def __init__(self) -> None: ...
 
 class StatusResponse(_message.Message):
-    __slots__ = ("controllers", "runners", "deployments", "routes")
+    __slots__ = ("controllers", "runners", "deployments", "routes", "circuit_breakers")
     class Controller(_message.Message):
         __slots__ = ("key", "endpoint", "version")
         KEY_FIELD_NUMBER: _ClassVar[int]
@@ -216,11 +269,13 @@ class StatusResponse(_message.Message):
     RUNNERS_FIELD_NUMBER: _ClassVar[int]
     DEPLOYMENTS_FIELD_NUMBER: _ClassVar[int]
     ROUTES_FIELD_NUMBER: _ClassVar[int]
+    CIRCUIT_BREAKERS_FIELD_NUMBER: _ClassVar[int]
     controllers: _containers.RepeatedCompositeFieldContainer[StatusResponse.Controller]
     runners: _containers.RepeatedCompositeFieldContainer[StatusResponse.Runner]
     deployments: _containers.RepeatedCompositeFieldContainer[StatusResponse.Deployment]
     routes: _containers.RepeatedCompositeFieldContainer[StatusResponse.Route]
-    def __init__(self, controllers: _Optional[_Iterable[_Union[StatusResponse.Controller, _Mapping]]] = ..., runners: _Optional[_Iterable[_Union[StatusResponse.Runner, _Mapping]]] = ..., deployments: _Optional[_Iterable[_Union[StatusResponse.Deployment, _Mapping]]] = ..., routes: _Optional[_Iterable[_Union[StatusResponse.Route, _Mapping]]] = ...) -> None: ...
+    circuit_breakers: _containers.RepeatedCompositeFieldContainer[_circuitbreaker_pb2.CircuitBreakerStatus]
+    def __init__(self, controllers: _Optional[_Iterable[_Union[StatusResponse.Controller, _Mapping]]] = ..., runners: _Optional[_Iterable[_Union[StatusResponse.Runner, _Mapping]]] = ..., deployments: _Optional[_Iterable[_Union[StatusResponse.Deployment, _Mapping]]] = ..., routes: _Optional[_Iterable[_Union[StatusResponse.Route, _Mapping]]] = ..., circuit_breakers: _Optional[_Iterable[_Union[_circuitbreaker_pb2.CircuitBreakerStatus, _Mapping]]] = ...) -> None: ...
 
 class ProcessListRequest(_message.Message):
     __slots__ = ()